@@ -7,4 +7,6 @@ package main
 
 const (
 	Default_ihttp_Port = 80
+	logFormat_Text     = "text"
+	logFormat_JSON     = "json"
 )
@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 
 	voedger "github.com/voedger/voedger/cmd/voedger/voedgerimpl"
+	"github.com/voedger/voedger/pkg/goutils/logger"
 	"github.com/voedger/voedger/pkg/ihttp"
 	"github.com/voedger/voedger/pkg/iservices"
 	"github.com/voedger/voedger/pkg/iservicesctl"
@@ -19,10 +20,18 @@ import (
 func newServerCmd() *cobra.Command {
 	var httpCLIParams ihttp.CLIParams
 	var appsCLIParams voedger.CLIParams
+	var logFormat string
 	serverCmd := &cobra.Command{
 		Use:   "server",
 		Short: "Start server",
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			switch logFormat {
+			case logFormat_Text:
+			case logFormat_JSON:
+				logger.SetLogFormat(logger.LogFormat_JSON)
+			default:
+				return fmt.Errorf("unknown log format %q, expected %q or %q", logFormat, logFormat_Text, logFormat_JSON)
+			}
 			wired, cleanup, err := wireServer(httpCLIParams, appsCLIParams)
 			if err != nil {
 				return fmt.Errorf("services not wired: %w", err)
@@ -42,5 +51,6 @@ func newServerCmd() *cobra.Command {
 	serverCmd.PersistentFlags().IntVar(&httpCLIParams.Port, "ihttp.Port", Default_ihttp_Port, "")
 	serverCmd.Flags().StringVar(&appsCLIParams.Storage, "storage", "", "")
 	serverCmd.Flags().StringArrayVar((*[]string)(&httpCLIParams.AcmeDomains), "acme-domain", []string{}, "")
+	serverCmd.Flags().StringVar(&logFormat, "log-format", logFormat_Text, "log output format: text or json")
 	return serverCmd
 }
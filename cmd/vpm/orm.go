@@ -29,6 +29,11 @@ const (
 	gitignoreFileContent = `*
 `
 	unknownType                  = "Unknown"
+	decimalType                  = "Decimal"
+	dateType                     = "Date"
+	timeType                     = "Time"
+	timestampType                = "Timestamp"
+	uuidType                     = "UUID"
 	errInGeneratingOrmFileFormat = "error occurred while generating %s: %w"
 )
 
@@ -149,6 +154,9 @@ func generateOrmFiles(pkgData map[ormPackageInfo][]interface{}, dir string) erro
 	for _, pkgItems := range pkgData {
 		generalOrmPkgData.Items = append(generalOrmPkgData.Items, pkgItems...)
 	}
+	generalOrmPkgData.HasDecimal = hasFieldsOfType(generalOrmPkgData.Items, decimalType)
+	generalOrmPkgData.HasDateTime = hasFieldsOfType(generalOrmPkgData.Items, dateType, timeType, timestampType)
+	generalOrmPkgData.HasUUID = hasFieldsOfType(generalOrmPkgData.Items, uuidType)
 	// generating utils.go file according to the general package data
 	utilsFilePath, err := generateUtilsFile(generalOrmPkgData, dir)
 	if err != nil {
@@ -658,9 +666,19 @@ func getFieldType(field appdef.IField) string {
 		return "float32"
 	case appdef.DataKind_float64:
 		return "float64"
+	case appdef.DataKind_decimal:
+		return decimalType
+	case appdef.DataKind_date:
+		return dateType
+	case appdef.DataKind_time:
+		return timeType
+	case appdef.DataKind_timestamp:
+		return timestampType
+	case appdef.DataKind_uuid:
+		return uuidType
 	case appdef.DataKind_bytes:
 		return "Bytes"
-	case appdef.DataKind_string:
+	case appdef.DataKind_string, appdef.DataKind_json: // json is read and written as json text
 		return "string"
 	case appdef.DataKind_RecordID:
 		return "ID"
@@ -44,6 +44,12 @@ type ormPackageInfo struct {
 type ormPackage struct {
 	ormPackageInfo
 	Items []any
+	// HasDecimal is true if any item has decimal fields, used to generate Decimal type alias in utils.go
+	HasDecimal bool
+	// HasDateTime is true if any item has date, time or timestamp fields, used to generate Date, Time and Timestamp type aliases in utils.go
+	HasDateTime bool
+	// HasUUID is true if any item has uuid fields, used to generate UUID type alias in utils.go
+	HasUUID bool
 }
 
 type ormPackageItem struct {
@@ -109,6 +115,24 @@ type ormField struct {
 	SetMethodName string
 }
 
+// hasFieldsOfType returns true if any of the given items has fields of any of the given ORM types
+func hasFieldsOfType(items []any, types ...string) bool {
+	isOfType := func(f ormField) bool { return slices.Contains(types, f.Type) }
+	for _, item := range items {
+		switch t := item.(type) {
+		case ormTableItem:
+			if slices.ContainsFunc(t.Fields, isOfType) || slices.ContainsFunc(t.Keys, isOfType) {
+				return true
+			}
+		case ormCommand:
+			if slices.ContainsFunc(t.ResultObjectFields, isOfType) || hasFieldsOfType([]any{t.ArgumentObject, t.UnloggedArgumentObject}, types...) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func isExecutableWithParam(p ormProjector) bool {
 	return slices.ContainsFunc(p.On, doesExecuteWithParam)
 }
@@ -88,6 +88,58 @@ func IsOperationAllowed(ws appdef.IWorkspace, op appdef.OperationKind, res appde
 	return result, nil
 }
 
+// Returns row-level security policies, which restrict specified operation on specified table for any of specified roles.
+//
+// Policies are collected from the workspace and its ancestors.
+// Policy is applicable if it has no roles or if any of its roles is one of specified roles or their ancestors.
+// System role is never restricted.
+//
+// If result is empty, then rows are not restricted, else row is accessible if it matches any of returned policies.
+func RowPolicies(ws appdef.IWorkspace, op appdef.OperationKind, table appdef.QName, rol []appdef.QName) []appdef.IRowPolicy {
+	roles := appdef.QNamesFrom(rol...)
+	for _, r := range rol {
+		if role := appdef.Role(ws.Type, r); role != nil {
+			roles.Add(RecursiveRoleAncestors(role, ws)...)
+		}
+	}
+	if roles.Contains(appdef.QNameRoleSystem) {
+		return nil
+	}
+
+	var (
+		result  []appdef.IRowPolicy
+		stack   = map[appdef.QName]bool{}
+		collect func(appdef.IWorkspace)
+	)
+	collect = func(w appdef.IWorkspace) {
+		if stack[w.QName()] {
+			return
+		}
+		stack[w.QName()] = true
+		for _, anc := range w.Ancestors() {
+			collect(anc)
+		}
+		for _, p := range w.RowPolicies() {
+			if !p.Op(op) || (p.Table().QName() != table) {
+				continue
+			}
+			applicable := len(p.Roles()) == 0
+			for _, r := range p.Roles() {
+				if roles.Contains(r.QName()) {
+					applicable = true
+					break
+				}
+			}
+			if applicable {
+				result = append(result, p)
+			}
+		}
+	}
+	collect(ws)
+
+	return result
+}
+
 // [~server.apiv2.role/cmp.publishedTypes~impl]
 // PublishedTypes lists the resources allowed to the published role in the workspace and ancestors (including resources available to non-authenticated requests).
 //
@@ -14,6 +14,7 @@ import (
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appdef/acl"
 	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/appdef/exprs"
 	"github.com/voedger/voedger/pkg/appdef/filter"
 	"github.com/voedger/voedger/pkg/goutils/logger"
 	"github.com/voedger/voedger/pkg/goutils/set"
@@ -1281,3 +1282,82 @@ func TestIsOperationAllowed_SystemFieldsFollowGrant(t *testing.T) {
 		check(t, noSysID, []appdef.FieldName{"fld1", appdef.SystemField_ID}, false)
 	})
 }
+
+func TestRowPolicies(t *testing.T) {
+	// ABSTRACT WORKSPACE AbstractWS (
+	// 	TABLE orders INHERITS sys.CDoc (owner varchar, dep int32);
+	// 	TABLE menu INHERITS sys.CDoc (name varchar);
+	// 	ROLE waiter;
+	// 	ROLE manager;
+	// 	ROLE supervisor;
+	// 	GRANT waiter TO supervisor;
+	// 	POLICY own ON TABLE orders TO waiter USING (owner = CURRENT_SUBJECT);
+	// );
+	// WORKSPACE WS INHERITS AbstractWS (
+	// 	POLICY dep ON TABLE orders FOR SELECT USING (dep = 1);
+	// );
+
+	require := require.New(t)
+
+	awsName := appdef.NewQName("test", "abstractWS")
+	wsName := appdef.NewQName("test", "ws")
+	orders := appdef.NewQName("test", "orders")
+	menu := appdef.NewQName("test", "menu")
+	waiter := appdef.NewQName("test", "waiter")
+	manager := appdef.NewQName("test", "manager")
+	supervisor := appdef.NewQName("test", "supervisor")
+	own := appdef.NewQName("test", "own")
+	dep := appdef.NewQName("test", "dep")
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+
+	aws := adb.AddWorkspace(awsName)
+	aws.SetAbstract()
+	aws.AddCDoc(orders).
+		AddField("owner", appdef.DataKind_string, false).
+		AddField("dep", appdef.DataKind_int32, false)
+	aws.AddCDoc(menu).
+		AddField("name", appdef.DataKind_string, false)
+	_ = aws.AddRole(waiter)
+	_ = aws.AddRole(manager)
+	_ = aws.AddRole(supervisor)
+	aws.GrantAll(filter.QNames(waiter), supervisor)
+	aws.AddRowPolicy(own, []appdef.OperationKind{appdef.OperationKind_Select, appdef.OperationKind_Update}, orders,
+		[]appdef.QName{waiter}, exprs.Eq(exprs.Field("owner"), exprs.Subject()))
+
+	ws := adb.AddWorkspace(wsName)
+	ws.SetAncestors(awsName)
+	ws.AddRowPolicy(dep, []appdef.OperationKind{appdef.OperationKind_Select}, orders,
+		nil, exprs.Eq(exprs.Field("dep"), exprs.Value(1)))
+
+	app := adb.MustBuild()
+
+	tests := []struct {
+		ws    appdef.QName
+		op    appdef.OperationKind
+		table appdef.QName
+		role  appdef.QName
+		want  []appdef.QName
+	}{
+		{wsName, appdef.OperationKind_Select, orders, waiter, []appdef.QName{own, dep}},
+		{wsName, appdef.OperationKind_Update, orders, waiter, []appdef.QName{own}},
+		{wsName, appdef.OperationKind_Deactivate, orders, waiter, []appdef.QName{own}},
+		{wsName, appdef.OperationKind_Insert, orders, waiter, nil},
+		{wsName, appdef.OperationKind_Select, orders, manager, []appdef.QName{dep}},
+		{wsName, appdef.OperationKind_Update, orders, manager, nil},
+		{wsName, appdef.OperationKind_Update, orders, supervisor, []appdef.QName{own}},
+		{wsName, appdef.OperationKind_Select, orders, appdef.QNameRoleSystem, nil},
+		{wsName, appdef.OperationKind_Select, menu, waiter, nil},
+		{awsName, appdef.OperationKind_Select, orders, waiter, []appdef.QName{own}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v %v for %v in %v", tt.op.TrimString(), tt.table, tt.role, tt.ws), func(t *testing.T) {
+			var got []appdef.QName
+			for _, p := range acl.RowPolicies(app.Workspace(tt.ws), tt.op, tt.table, []appdef.QName{tt.role}) {
+				got = append(got, p.QName())
+			}
+			require.Equal(tt.want, got)
+		})
+	}
+}
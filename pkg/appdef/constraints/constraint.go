@@ -13,6 +13,7 @@ import (
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appdef/internal/comments"
 	"github.com/voedger/voedger/pkg/appdef/internal/datas"
+	"github.com/voedger/voedger/pkg/decimal"
 )
 
 // Return new minimum length constraint for string or bytes data types.
@@ -106,6 +107,36 @@ func MaxExcl(v float64, c ...string) appdef.IConstraint {
 	return datas.NewDataConstraint(appdef.ConstraintKind_MaxExcl, v, c...)
 }
 
+// Return new precision constraint for decimal data type.
+//
+// Precision is total count of significant digits, both before and after decimal separator.
+//
+// # Panics:
+//   - if value is zero
+//   - if value is greater than decimal.MaxPrecision (18)
+func Precision(v uint8, c ...string) appdef.IConstraint {
+	if v == 0 {
+		panic(appdef.ErrOutOfBounds("decimal precision value is zero"))
+	}
+	if v > decimal.MaxPrecision {
+		panic(appdef.ErrOutOfBounds("decimal precision value %d is greater than %d", v, decimal.MaxPrecision))
+	}
+	return datas.NewDataConstraint(appdef.ConstraintKind_Precision, v, c...)
+}
+
+// Return new scale constraint for decimal data type.
+//
+// Scale is count of digits after decimal separator.
+//
+// # Panics:
+//   - if value is greater than decimal.MaxPrecision (18)
+func Scale(v uint8, c ...string) appdef.IConstraint {
+	if v > decimal.MaxPrecision {
+		panic(appdef.ErrOutOfBounds("decimal scale value %d is greater than %d", v, decimal.MaxPrecision))
+	}
+	return datas.NewDataConstraint(appdef.ConstraintKind_Scale, v, c...)
+}
+
 // #3434 [~server.vsql.smallints/cmp.AppDef~impl]
 type enumerable interface {
 	string | int8 | int16 | int32 | int64 | float32 | float64
@@ -189,6 +220,10 @@ func NewConstraint(kind appdef.ConstraintKind, value any, c ...string) appdef.IC
 			}
 		}
 		return enum
+	case appdef.ConstraintKind_Precision:
+		return Precision(value.(uint8), c...)
+	case appdef.ConstraintKind_Scale:
+		return Scale(value.(uint8), c...)
 	}
 	panic(appdef.ErrUnsupported("constraint kind: %v", kind))
 }
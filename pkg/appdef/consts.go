@@ -65,17 +65,23 @@ const (
 
 // System data type names
 var (
-	SysData_int8     = SysDataName(DataKind_int8)  // #3434 [~server.vsql.smallints/cmp.AppDef~impl]
-	SysData_int16    = SysDataName(DataKind_int16) // #3434 [~server.vsql.smallints/cmp.AppDef~impl]
-	SysData_int32    = SysDataName(DataKind_int32)
-	SysData_int64    = SysDataName(DataKind_int64)
-	SysData_float32  = SysDataName(DataKind_float32)
-	SysData_float64  = SysDataName(DataKind_float64)
-	SysData_bytes    = SysDataName(DataKind_bytes)
-	SysData_String   = SysDataName(DataKind_string)
-	SysData_QName    = SysDataName(DataKind_QName)
-	SysData_bool     = SysDataName(DataKind_bool)
-	SysData_RecordID = SysDataName(DataKind_RecordID)
+	SysData_int8      = SysDataName(DataKind_int8)  // #3434 [~server.vsql.smallints/cmp.AppDef~impl]
+	SysData_int16     = SysDataName(DataKind_int16) // #3434 [~server.vsql.smallints/cmp.AppDef~impl]
+	SysData_int32     = SysDataName(DataKind_int32)
+	SysData_int64     = SysDataName(DataKind_int64)
+	SysData_float32   = SysDataName(DataKind_float32)
+	SysData_float64   = SysDataName(DataKind_float64)
+	SysData_bytes     = SysDataName(DataKind_bytes)
+	SysData_String    = SysDataName(DataKind_string)
+	SysData_QName     = SysDataName(DataKind_QName)
+	SysData_bool      = SysDataName(DataKind_bool)
+	SysData_RecordID  = SysDataName(DataKind_RecordID)
+	SysData_decimal   = SysDataName(DataKind_decimal)
+	SysData_date      = SysDataName(DataKind_date)
+	SysData_time      = SysDataName(DataKind_time)
+	SysData_timestamp = SysDataName(DataKind_timestamp)
+	SysData_uuid      = SysDataName(DataKind_uuid)
+	SysData_json      = SysDataName(DataKind_json)
 )
 
 // Maximum containers per one structured type
@@ -87,6 +93,9 @@ const MaxTypeUniqueFieldsCount = 256
 // Maximum uniques
 const MaxTypeUniqueCount = 100
 
+// Maximum checks per one structured type
+const MaxTypeCheckCount = 100
+
 // Maximum string and bytes data length
 const MaxFieldLength = uint16(math.MaxUint16)
 
@@ -94,3 +103,8 @@ const MaxFieldLength = uint16(math.MaxUint16)
 //
 // This value is used for MaxLen() constraint in system data types `sys.string` and `sys.bytes`.
 const DefaultFieldMaxLength = uint16(255)
+
+// Default json data max length.
+//
+// This value is used for json fields without MaxLen() constraint.
+const DefaultJSONFieldMaxLength = MaxFieldLength
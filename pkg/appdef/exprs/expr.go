@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package exprs
+
+import (
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/internal/checks"
+)
+
+// Returns new literal value expression.
+//
+// Integers are stored as int64, floats as float64.
+//
+// # Panics:
+//   - if value is not nil, integer, float, string or bool
+func Value(v any) appdef.IExpr { return checks.NewValue(v) }
+
+// Returns new field reference expression.
+//
+// # Panics:
+//   - if field name is invalid
+func Field(name appdef.FieldName) appdef.IExpr { return checks.NewField(name) }
+
+// Returns request subject reference expression, `CURRENT_SUBJECT`.
+//
+// Subject is the login name of the user or device that makes the request.
+// Allowed in row policy expressions only.
+func Subject() appdef.IExpr { return checks.NewSubject() }
+
+// Returns `NOT x` expression.
+func Not(x appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Not, x) }
+
+// Returns `l AND r` expression.
+func And(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_And, l, r) }
+
+// Returns `l OR r` expression.
+func Or(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Or, l, r) }
+
+// Returns `l = r` expression.
+func Eq(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Eq, l, r) }
+
+// Returns `l != r` expression.
+func Ne(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Ne, l, r) }
+
+// Returns `l < r` expression.
+func Lt(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Lt, l, r) }
+
+// Returns `l <= r` expression.
+func Le(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Le, l, r) }
+
+// Returns `l > r` expression.
+func Gt(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Gt, l, r) }
+
+// Returns `l >= r` expression.
+func Ge(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Ge, l, r) }
+
+// Returns `x IS NULL` expression.
+func IsNull(x appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_IsNull, x) }
+
+// Returns `x IS NOT NULL` expression.
+func IsNotNull(x appdef.IExpr) appdef.IExpr { return Not(IsNull(x)) }
+
+// Returns `l + r` expression.
+func Add(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Add, l, r) }
+
+// Returns `l - r` expression.
+func Sub(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Sub, l, r) }
+
+// Returns `l * r` expression.
+func Mul(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Mul, l, r) }
+
+// Returns `l / r` expression.
+func Div(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Div, l, r) }
+
+// Returns `l % r` expression.
+func Mod(l, r appdef.IExpr) appdef.IExpr { return checks.NewOperation(appdef.ExprKind_Mod, l, r) }
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package appdef
+
+// Check expression kind enumeration.
+type ExprKind uint8
+
+//go:generate stringer -type=ExprKind -output=stringer_exprkind.go
+
+const (
+	// null - no-value type. Returned when the requested kind does not exist
+	ExprKind_null ExprKind = iota
+
+	// Literal value, like `10`, `'abc'`, `TRUE` or `NULL`
+	ExprKind_Value
+	// Field reference, like `Qty`
+	ExprKind_Field
+	// Request subject reference, `CURRENT_SUBJECT`. Allowed in row policies only
+	ExprKind_Subject
+
+	// Logical operations
+	ExprKind_Not
+	ExprKind_And
+	ExprKind_Or
+
+	// Comparisons
+	ExprKind_Eq
+	ExprKind_Ne
+	ExprKind_Lt
+	ExprKind_Le
+	ExprKind_Gt
+	ExprKind_Ge
+
+	// `IS NULL` test
+	ExprKind_IsNull
+
+	// Arithmetic operations
+	ExprKind_Add
+	ExprKind_Sub
+	ExprKind_Mul
+	ExprKind_Div
+	ExprKind_Mod
+
+	ExprKind_count
+)
+
+// Check expression node.
+//
+// Ref. to appdef/exprs package for expression constructors.
+type IExpr interface {
+	// Returns expression kind
+	Kind() ExprKind
+
+	// Returns literal value for ExprKind_Value expression.
+	//
+	// # Returns:
+	//   - int64 value for integer literals,
+	//   - float64 value for float literals,
+	//   - string value for string literals,
+	//   - bool value for boolean literals,
+	//   - nil for NULL literal.
+	Value() any
+
+	// Returns field name for ExprKind_Field expression.
+	Field() FieldName
+
+	// Returns operands for operations.
+	Args() []IExpr
+
+	// Returns expression text, like `(Qty * Price) = Total`
+	String() string
+}
+
+// Describe single CHECK constraint for structure.
+type ICheck interface {
+	IWithComments
+
+	// Returns qualified name of check.
+	Name() QName
+
+	// Returns check expression.
+	//
+	// Expressions evaluating to TRUE or UNKNOWN (NULL) succeed.
+	Expr() IExpr
+
+	// Returns fields referenced by check expression in alphabetically order
+	Fields() []IField
+}
+
+// Final structures with checks are:
+// - TypeKind_GDoc and TypeKind_GRecord,
+// - TypeKind_CDoc and TypeKind_CRecord,
+// - TypeKind_WDoc and TypeKind_WRecord,
+// - TypeKind_ODoc and TypeKind_ORecord,
+// - TypeKind_Object
+type IWithChecks interface {
+	// Return check by qualified name.
+	//
+	// Returns nil if not check found
+	CheckByName(QName) ICheck
+
+	// Return checks count
+	CheckCount() int
+
+	// All checks in add order
+	Checks() []ICheck
+}
+
+type IChecksBuilder interface {
+	// Adds new check with specified name and expression.
+	//
+	// # Panics:
+	//   - if check name is empty,
+	//   - if check name is invalid,
+	//   - if name is already exists,
+	//   - if expression is nil,
+	//   - if some field referenced by expression not found.
+	AddCheck(name QName, expr IExpr, comment ...string) IChecksBuilder
+}
@@ -29,6 +29,24 @@ const (
 
 	DataKind_RecordID
 
+	// Fixed-point decimal with precision and scale, like `decimal(18,4)`
+	DataKind_decimal
+
+	// Calendar date, stored as count of days since 1970-01-01, like `2025-12-31`
+	DataKind_date
+
+	// Time of day, stored as count of milliseconds since midnight, like `23:59:59.999`
+	DataKind_time
+
+	// Date and time, stored as count of milliseconds since Unix epoch, like `2025-12-31T23:59:59.999Z`
+	DataKind_timestamp
+
+	// Universally unique identifier, stored as 16 bytes, like `f47ac10b-58cc-4372-a567-0e02b2c3d479`
+	DataKind_uuid
+
+	// Semi-structured document, stored as JSON text, like `{"theme":"dark","sizes":[1,2]}`
+	DataKind_json
+
 	// Complex types
 
 	DataKind_Record
@@ -106,6 +124,9 @@ const (
 
 	ConstraintKind_Enum
 
+	ConstraintKind_Precision
+	ConstraintKind_Scale
+
 	ConstraintKind_count
 )
 
@@ -124,6 +145,7 @@ type IConstraint interface {
 	//	- uint16 value for min/max length constraints,
 	// 	- *regexp.Regexp value for pattern constraint,
 	// 	- float64 value for min/max inclusive/exclusive constraints.
-	//	- sorted slice with values for enumeration constraint.
+	//	- sorted slice with values for enumeration constraint,
+	//	- uint8 value for decimal precision and scale constraints.
 	Value() any
 }
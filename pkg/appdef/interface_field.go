@@ -102,6 +102,14 @@ type IFieldsBuilder interface {
 	// # Panics:
 	//   - if field not found.
 	SetFieldVerify(FieldName, ...VerificationKind) IFieldsBuilder
+
+	// Sets field value to be encrypted at rest.
+	//
+	// # Panics:
+	//   - if field not found,
+	//   - if field is system,
+	//   - if field data kind is not string or bytes.
+	SetFieldEncrypted(FieldName) IFieldsBuilder
 }
 
 // Describe single field.
@@ -126,6 +134,9 @@ type IField interface {
 	// Returns is field verifiable by specified verification kind
 	VerificationKind(VerificationKind) bool
 
+	// Returns is field value encrypted at rest
+	Encrypted() bool
+
 	// Returns is field has fixed width data kind
 	IsFixedWidth() bool
 
@@ -5,16 +5,29 @@
 
 package appdef
 
+import "time"
+
 // Query is a function that returns data from system state.
 type IQuery interface {
 	IFunction
 
+	// Returns time to live of the cached query result.
+	// Zero if query result is not cached.
+	CacheTTL() time.Duration
+
 	// Unwanted type assertion stub
 	IsQuery()
 }
 
 type IQueryBuilder interface {
 	IFunctionBuilder
+
+	// Sets time to live of the cached query result.
+	// Zero disables query result caching.
+	//
+	// # Panics:
+	//   - if ttl is negative.
+	SetCacheTTL(ttl time.Duration) IQueryBuilder
 }
 
 type IQueriesBuilder interface {
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package appdef
+
+// Represents a row-level security policy.
+//
+// Policy restricts table rows, which can be selected or updated by roles.
+// Policy expression is evaluated for each row, row is accessible if result is TRUE.
+type IRowPolicy interface {
+	IWithComments
+
+	// Returns qualified name of policy.
+	QName() QName
+
+	// Returns is policy restricts specified operation.
+	//
+	// UPDATE policy also restricts ACTIVATE and DEACTIVATE operations.
+	Op(OperationKind) bool
+
+	// Returns restricted operations. Only SELECT and UPDATE are supported.
+	Ops() []OperationKind
+
+	// Returns table, which rows are restricted.
+	Table() IRecord
+
+	// Returns roles, which are restricted by policy.
+	//
+	// If empty, then policy restricts any role.
+	Roles() []IRole
+
+	// Returns row predicate.
+	//
+	// Expression can refer to table fields and to request subject, see ExprKind_Subject.
+	Expr() IExpr
+
+	// Returns fields, referenced by expression, sorted by name.
+	Fields() []IField
+
+	// Returns workspace where policy is defined.
+	Workspace() IWorkspace
+}
+
+// IWithRowPolicies is an interface for entities that have row-level security policies.
+type IWithRowPolicies interface {
+	// Returns row policy by name.
+	//
+	// Returns nil if not found.
+	RowPolicy(QName) IRowPolicy
+
+	// Enumerates all row policies.
+	//
+	// Policies are enumerated in the order they are added.
+	RowPolicies() []IRowPolicy
+}
+
+type IRowPoliciesBuilder interface {
+	// Adds row-level security policy for table.
+	//
+	// If roles are empty, then policy restricts any role.
+	//
+	// # Panics:
+	//   - if name is empty or invalid,
+	//   - if policy with the same name already exists,
+	//   - if ops is empty or contains operations other than SELECT and UPDATE,
+	//   - if table is not found or it is not a record,
+	//   - if some role is not found,
+	//   - if expression is nil or refers to unknown field.
+	AddRowPolicy(name QName, ops []OperationKind, table QName, roles []QName, expr IExpr, comment ...string) IRowPoliciesBuilder
+}
@@ -5,12 +5,13 @@
 
 package appdef
 
-// Structure is a type with fields, containers and uniques.
+// Structure is a type with fields, containers, uniques and checks.
 type IStructure interface {
 	IType
 	IWithFields
 	IWithContainers
 	IWithUniques
+	IWithChecks
 	IWithAbstract
 
 	// Returns definition for «sys.QName» field
@@ -22,6 +23,7 @@ type IStructureBuilder interface {
 	IFieldsBuilder
 	IContainersBuilder
 	IUniquesBuilder
+	IChecksBuilder
 	IWithAbstractBuilder
 }
 
@@ -33,10 +35,21 @@ type IRecord interface {
 
 	// Returns definition for «sys.ID» field
 	SystemField_ID() IField
+
+	// Returns is record audited.
+	//
+	// Old and new values of changed fields of audited records are stored in record history.
+	Audit() bool
 }
 
 type IRecordBuilder interface {
 	IStructureBuilder
+
+	// Makes record audited.
+	//
+	// # Panics:
+	//   - if record is not CDoc, CRecord, WDoc or WRecord
+	SetAudit()
 }
 
 // Document is a record.
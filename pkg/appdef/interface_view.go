@@ -17,6 +17,9 @@ type IView interface {
 
 	// Returns view value
 	Value() IViewValue
+
+	// Returns is view changes (keys and values) are published to n10n subscribers along with the offset.
+	N10nPayload() bool
 }
 
 type IViewBuilder interface {
@@ -27,6 +30,9 @@ type IViewBuilder interface {
 
 	// Returns view value builder
 	Value() IViewValueBuilder
+
+	// Sets is view changes are published to n10n subscribers along with the offset.
+	SetN10nPayload(bool) IViewBuilder
 }
 
 type IViewsBuilder interface {
@@ -11,6 +11,7 @@ type IWorkspace interface {
 	IWithAbstract
 
 	IWithACL
+	IWithRowPolicies
 
 	// Returns ancestors workspaces.
 	//
@@ -84,6 +85,7 @@ type IWorkspaceBuilder interface {
 
 	IRolesBuilder
 	IACLBuilder
+	IRowPoliciesBuilder
 
 	IRatesBuilder
 	ILimitsBuilder
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package checks
+
+import (
+	"fmt"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/internal/comments"
+)
+
+// # Supports:
+//   - appdef.ICheck
+type Check struct {
+	comments.WithComments
+	name   appdef.QName
+	expr   appdef.IExpr
+	fields []appdef.IField
+}
+
+func NewCheck(name appdef.QName, expr appdef.IExpr, fields appdef.IWithFields) *Check {
+	return &Check{
+		name:   name,
+		expr:   expr,
+		fields: ExprFields(expr, fields),
+	}
+}
+
+func (c Check) Expr() appdef.IExpr {
+	return c.expr
+}
+
+func (c Check) Fields() []appdef.IField {
+	return c.fields
+}
+
+func (c Check) Name() appdef.QName {
+	return c.name
+}
+
+func (c Check) String() string {
+	return fmt.Sprintf("check «%v»", c.name)
+}
+
+// # Supports:
+//   - appdef.IWithChecks
+type WithChecks struct {
+	find   appdef.FindType
+	fields appdef.IWithFields
+	checks []appdef.ICheck
+}
+
+func MakeWithChecks(find appdef.FindType, fields appdef.IWithFields) WithChecks {
+	cc := WithChecks{
+		find:   find,
+		fields: fields,
+		checks: make([]appdef.ICheck, 0),
+	}
+	return cc
+}
+
+func (cc *WithChecks) CheckByName(name appdef.QName) appdef.ICheck {
+	for _, c := range cc.checks {
+		if c.Name() == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func (cc *WithChecks) CheckCount() int {
+	return len(cc.checks)
+}
+
+func (cc *WithChecks) Checks() []appdef.ICheck {
+	return cc.checks
+}
+
+func (cc *WithChecks) addCheck(name appdef.QName, expr appdef.IExpr, comment ...string) {
+	if name == appdef.NullQName {
+		panic(appdef.ErrMissed("check name"))
+	}
+	if ok, err := appdef.ValidQName(name); !ok {
+		panic(fmt.Errorf("check name «%v» is invalid: %w", name, err))
+	}
+	if cc.CheckByName(name) != nil {
+		panic(appdef.ErrAlreadyExists("check «%v»", name))
+	}
+
+	if t := cc.find(name); t.Kind() != appdef.TypeKind_null {
+		panic(appdef.ErrAlreadyExists("name «%v» already used for %v", name, t))
+	}
+
+	if expr == nil {
+		panic(appdef.ErrMissed("check «%v» expression", name))
+	}
+	if ExprUses(expr, appdef.ExprKind_Subject) {
+		panic(appdef.ErrUnsupported("request subject in check «%v» expression", name))
+	}
+
+	if len(cc.checks) >= appdef.MaxTypeCheckCount {
+		panic(appdef.ErrTooMany("checks, maximum is %d", appdef.MaxTypeCheckCount))
+	}
+
+	c := NewCheck(name, expr, cc.fields)
+
+	comments.SetComment(&c.WithComments, comment...)
+
+	cc.checks = append(cc.checks, c)
+}
+
+// # Supports:
+//   - appdef.IChecksBuilder
+type ChecksBuilder struct {
+	*WithChecks
+}
+
+func MakeChecksBuilder(checks *WithChecks) ChecksBuilder {
+	return ChecksBuilder{WithChecks: checks}
+}
+
+func (cb *ChecksBuilder) AddCheck(name appdef.QName, expr appdef.IExpr, comment ...string) appdef.IChecksBuilder {
+	cb.addCheck(name, expr, comment...)
+	return cb
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/appdef/exprs"
+	"github.com/voedger/voedger/pkg/goutils/testingu/require"
+)
+
+func Test_Checks(t *testing.T) {
+	require := require.New(t)
+
+	docName := appdef.NewQName("test", "doc")
+	objName := appdef.NewQName("test", "obj")
+	ch1 := appdef.CheckQName(docName, "Dates")
+	ch2 := appdef.CheckQName(docName, "Total")
+	ch3 := appdef.CheckQName(objName, "01")
+
+	var app appdef.IAppDef
+
+	t.Run("should be ok to add structures with checks", func(t *testing.T) {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+
+		doc := wsb.AddCDoc(docName)
+		doc.
+			AddField("start", appdef.DataKind_date, true).
+			AddField("end", appdef.DataKind_date, false).
+			AddField("qty", appdef.DataKind_int32, false).
+			AddField("price", appdef.DataKind_float64, false).
+			AddField("total", appdef.DataKind_float64, false)
+		doc.
+			AddCheck(ch1, exprs.Gt(exprs.Field("end"), exprs.Field("start")), "end must be after start").
+			AddCheck(ch2, exprs.Eq(exprs.Mul(exprs.Field("qty"), exprs.Field("price")), exprs.Field("total")))
+
+		obj := wsb.AddObject(objName)
+		obj.AddField("name", appdef.DataKind_string, false)
+		obj.AddCheck(ch3, exprs.Or(exprs.IsNull(exprs.Field("name")), exprs.Ne(exprs.Field("name"), exprs.Value("it's"))))
+
+		a, err := adb.Build()
+		require.NoError(err)
+
+		app = a
+	})
+
+	t.Run("should be ok to read checks", func(t *testing.T) {
+		doc := appdef.CDoc(app.Type, docName)
+
+		require.Equal(2, doc.CheckCount())
+		require.Len(doc.Checks(), 2)
+		require.Equal(ch1, doc.Checks()[0].Name())
+		require.Equal(ch2, doc.Checks()[1].Name())
+
+		c1 := doc.CheckByName(ch1)
+		require.Equal("end must be after start", c1.Comment())
+		require.Equal("end > start", c1.Expr().String())
+		require.Equal(fmt.Sprint(c1), "check «test.doc$checks$Dates»")
+		ff := c1.Fields()
+		require.Len(ff, 2)
+		require.Equal("end", ff[0].Name())
+		require.Equal("start", ff[1].Name())
+
+		c2 := doc.CheckByName(ch2)
+		require.Equal("(qty * price) = total", c2.Expr().String())
+		require.Equal(appdef.ExprKind_Eq, c2.Expr().Kind())
+		require.Len(c2.Fields(), 3)
+
+		require.Nil(doc.CheckByName(appdef.CheckQName(docName, "unknown")))
+
+		obj := appdef.Object(app.Type, objName)
+		require.Equal("(name IS NULL) OR (name != 'it''s')", obj.CheckByName(ch3).Expr().String())
+	})
+
+	t.Run("should be ok to render expressions", func(t *testing.T) {
+		tests := []struct {
+			e    appdef.IExpr
+			want string
+		}{
+			{exprs.Value(nil), "NULL"},
+			{exprs.Value(1), "1"},
+			{exprs.Value(float32(1.5)), "1.5"},
+			{exprs.Value(true), "TRUE"},
+			{exprs.Not(exprs.Value(false)), "NOT FALSE"},
+			{exprs.IsNotNull(exprs.Field("f")), "NOT (f IS NULL)"},
+			{exprs.Eq(exprs.Field("owner"), exprs.Subject()), "owner = CURRENT_SUBJECT"},
+			{exprs.And(exprs.Le(exprs.Field("a"), exprs.Value(1)), exprs.Ge(exprs.Field("b"), exprs.Value(-2))), "(a <= 1) AND (b >= -2)"},
+			{exprs.Lt(exprs.Mod(exprs.Sub(exprs.Field("a"), exprs.Value(1)), exprs.Value(2)), exprs.Div(exprs.Add(exprs.Field("b"), exprs.Value(1)), exprs.Value(3.5))), "((a - 1) % 2) < ((b + 1) / 3.5)"},
+		}
+		for _, test := range tests {
+			require.Equal(test.want, test.e.String())
+		}
+	})
+}
+
+func Test_ChecksPanics(t *testing.T) {
+	require := require.New(t)
+
+	docName := appdef.NewQName("test", "doc")
+	ch1 := appdef.CheckQName(docName, "01")
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+	wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+
+	doc := wsb.AddCDoc(docName)
+	doc.AddField("f", appdef.DataKind_int32, false)
+	doc.AddCheck(ch1, exprs.Gt(exprs.Field("f"), exprs.Value(0)))
+
+	t.Run("should be panics", func(t *testing.T) {
+		expr := exprs.Ge(exprs.Field("f"), exprs.Value(0))
+
+		require.Panics(func() {
+			doc.AddCheck(appdef.NullQName, expr)
+		}, require.Is(appdef.ErrMissedError),
+			"if missed check name")
+
+		require.Panics(func() {
+			doc.AddCheck(appdef.NewQName("naked", "🔫"), expr)
+		}, require.Is(appdef.ErrInvalidError), require.Has("naked.🔫"),
+			"if invalid check name")
+
+		require.Panics(func() {
+			doc.AddCheck(ch1, expr)
+		}, require.Is(appdef.ErrAlreadyExistsError), require.Has(ch1),
+			"if check name already used")
+
+		require.Panics(func() {
+			doc.AddCheck(docName, expr)
+		}, require.Is(appdef.ErrAlreadyExistsError), require.Has(docName),
+			"if check name used by other package entity")
+
+		require.Panics(func() {
+			doc.AddCheck(appdef.CheckQName(docName, "nil"), nil)
+		}, require.Is(appdef.ErrMissedError),
+			"if expression missed")
+
+		require.Panics(func() {
+			doc.AddCheck(appdef.CheckQName(docName, "unknown"), exprs.IsNull(exprs.Field("unknown")))
+		}, require.Is(appdef.ErrNotFoundError), require.Has("unknown"),
+			"if unknown field")
+
+		require.Panics(func() {
+			doc.AddCheck(appdef.CheckQName(docName, "subject"), exprs.Eq(exprs.Field("f"), exprs.Subject()))
+		}, require.Is(appdef.ErrUnsupportedError), require.Has("subject"),
+			"if expression refers to request subject")
+
+		require.Panics(func() { exprs.Field("naked 🔫") },
+			require.Is(appdef.ErrInvalidError), "if invalid field name")
+
+		require.Panics(func() { exprs.Value([]int{1}) },
+			require.Is(appdef.ErrUnsupportedError), "if unsupported value")
+
+		require.Panics(func() { exprs.And(expr, nil) },
+			require.Is(appdef.ErrMissedError), require.Has("And"), "if missed argument")
+
+		t.Run("if too many checks", func(t *testing.T) {
+			adb := builder.New()
+			adb.AddPackage("test", "test.com/test")
+			ws := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+			rec := ws.AddCRecord(appdef.NewQName("test", "rec"))
+			rec.AddField("f", appdef.DataKind_int32, false)
+			for i := range appdef.MaxTypeCheckCount {
+				rec.AddCheck(appdef.NewQName("test", fmt.Sprintf("rec$checks$%d", i)), expr)
+			}
+			require.Panics(func() {
+				rec.AddCheck(appdef.NewQName("test", "rec$checks$lastStraw"), expr)
+			},
+				require.Is(appdef.ErrTooManyError))
+		})
+	})
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package checks
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/voedger/voedger/pkg/appdef"
+)
+
+// # Supports:
+//   - appdef.IExpr
+type Expr struct {
+	kind  appdef.ExprKind
+	value any
+	field appdef.FieldName
+	args  []appdef.IExpr
+}
+
+// Returns new literal value expression.
+//
+// # Panics:
+//   - if value type is not supported
+func NewValue(v any) *Expr {
+	switch t := v.(type) {
+	case nil, int64, float64, string, bool:
+	case int:
+		v = int64(t)
+	case int32:
+		v = int64(t)
+	case float32:
+		v = float64(t)
+	default:
+		panic(appdef.ErrUnsupported("check expression value %v (%T)", v, v))
+	}
+	return &Expr{kind: appdef.ExprKind_Value, value: v}
+}
+
+// Returns new field reference expression.
+//
+// # Panics:
+//   - if field name is invalid
+func NewField(name appdef.FieldName) *Expr {
+	if ok, err := appdef.ValidFieldName(name); !ok {
+		panic(fmt.Errorf("check expression field name «%v» is invalid: %w", name, err))
+	}
+	return &Expr{kind: appdef.ExprKind_Field, field: name}
+}
+
+// Returns new request subject reference expression.
+func NewSubject() *Expr {
+	return &Expr{kind: appdef.ExprKind_Subject}
+}
+
+// Returns new operation expression.
+//
+// # Panics:
+//   - if some argument is nil
+func NewOperation(kind appdef.ExprKind, args ...appdef.IExpr) *Expr {
+	for i, a := range args {
+		if a == nil {
+			panic(appdef.ErrMissed("%v argument %d", kind.TrimString(), i))
+		}
+	}
+	return &Expr{kind: kind, args: args}
+}
+
+func (e Expr) Kind() appdef.ExprKind { return e.kind }
+
+func (e Expr) Value() any { return e.value }
+
+func (e Expr) Field() appdef.FieldName { return e.field }
+
+func (e Expr) Args() []appdef.IExpr { return e.args }
+
+func (e Expr) String() string {
+	switch e.kind {
+	case appdef.ExprKind_Value:
+		switch v := e.value.(type) {
+		case nil:
+			return "NULL"
+		case string:
+			return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+		case bool:
+			return strings.ToUpper(strconv.FormatBool(v))
+		case float64:
+			return strconv.FormatFloat(v, 'g', -1, 64)
+		default:
+			return fmt.Sprint(v)
+		}
+	case appdef.ExprKind_Field:
+		return e.field
+	case appdef.ExprKind_Subject:
+		return "CURRENT_SUBJECT"
+	case appdef.ExprKind_Not:
+		return "NOT " + argString(e.args[0])
+	case appdef.ExprKind_IsNull:
+		return argString(e.args[0]) + " IS NULL"
+	}
+	if op, ok := binaryOps[e.kind]; ok && len(e.args) == 2 {
+		return argString(e.args[0]) + " " + op + " " + argString(e.args[1])
+	}
+	return e.kind.TrimString()
+}
+
+// Returns argument string, enclosed in parentheses if argument is operation
+func argString(a appdef.IExpr) string {
+	switch a.Kind() {
+	case appdef.ExprKind_Value, appdef.ExprKind_Field, appdef.ExprKind_Subject:
+		return a.String()
+	}
+	return "(" + a.String() + ")"
+}
+
+var binaryOps = map[appdef.ExprKind]string{
+	appdef.ExprKind_And: "AND",
+	appdef.ExprKind_Or:  "OR",
+	appdef.ExprKind_Eq:  "=",
+	appdef.ExprKind_Ne:  "!=",
+	appdef.ExprKind_Lt:  "<",
+	appdef.ExprKind_Le:  "<=",
+	appdef.ExprKind_Gt:  ">",
+	appdef.ExprKind_Ge:  ">=",
+	appdef.ExprKind_Add: "+",
+	appdef.ExprKind_Sub: "-",
+	appdef.ExprKind_Mul: "*",
+	appdef.ExprKind_Div: "/",
+	appdef.ExprKind_Mod: "%",
+}
+
+// Calls visit for all field names referenced by expression
+func exprFields(e appdef.IExpr, visit func(appdef.FieldName)) {
+	if e.Kind() == appdef.ExprKind_Field {
+		visit(e.Field())
+	}
+	for _, a := range e.Args() {
+		exprFields(a, visit)
+	}
+}
+
+// Returns is expression or some of its arguments has specified kind
+func ExprUses(e appdef.IExpr, kind appdef.ExprKind) bool {
+	if e.Kind() == kind {
+		return true
+	}
+	for _, a := range e.Args() {
+		if ExprUses(a, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns sorted list of fields referenced by expression.
+//
+// # Panics:
+//   - if some field is not found
+func ExprFields(e appdef.IExpr, fields appdef.IWithFields) []appdef.IField {
+	names := make([]appdef.FieldName, 0)
+	exprFields(e, func(n appdef.FieldName) {
+		if !slices.Contains(names, n) {
+			names = append(names, n)
+		}
+	})
+	slices.Sort(names)
+	res := make([]appdef.IField, 0, len(names))
+	for _, n := range names {
+		fld := fields.Field(n)
+		if fld == nil {
+			panic(appdef.ErrFieldNotFound(n))
+		}
+		res = append(res, fld)
+	}
+	return res
+}
@@ -122,6 +122,20 @@ func (d *Data) addConstraints(cc ...appdef.IConstraint) {
 		}
 		d.constraints[ck] = c
 	}
+	if dk == appdef.DataKind_decimal {
+		d.checkDecimalScale()
+	}
+}
+
+// Checks that decimal scale is not greater than precision.
+//
+// # Panics:
+//   - if scale is greater than precision
+func (d *Data) checkDecimalScale() {
+	p, s := appdef.DecimalPrecisionScale(d)
+	if s > p {
+		panic(appdef.ErrOutOfBounds("decimal scale %d is greater than precision %d for data type «%v»", s, p, d))
+	}
 }
 
 // # Supports:
@@ -346,6 +346,22 @@ func Test_DataBuilder_AddConstraint(t *testing.T) {
 			args{appdef.DataKind_string, appdef.ConstraintKind_Enum, []string{"a", "b", "c"}}, false, nil},
 		{"string: enum constraint should fail if incompatible enum type",
 			args{appdef.DataKind_float64, appdef.ConstraintKind_Enum, []int32{1, 2, 3}}, true, appdef.ErrIncompatibleError},
+		//- Precision, Scale
+		{"decimal: precision constraint should be ok",
+			args{appdef.DataKind_decimal, appdef.ConstraintKind_Precision, uint8(10)}, false, nil},
+		{"decimal: scale constraint should be ok",
+			args{appdef.DataKind_decimal, appdef.ConstraintKind_Scale, uint8(4)}, false, nil},
+		{"decimal: enum constraint should fail",
+			args{appdef.DataKind_decimal, appdef.ConstraintKind_Enum, []float64{1.0, 2.0}}, true, appdef.ErrIncompatibleError},
+		//- Date and time ranges
+		{"date: min inclusive constraint should be ok",
+			args{appdef.DataKind_date, appdef.ConstraintKind_MinIncl, float64(0)}, false, nil},
+		{"time: max exclusive constraint should be ok",
+			args{appdef.DataKind_time, appdef.ConstraintKind_MaxExcl, float64(12 * 60 * 60 * 1000)}, false, nil},
+		{"timestamp: max length constraint should fail",
+			args{appdef.DataKind_timestamp, appdef.ConstraintKind_MaxLen, uint16(10)}, true, appdef.ErrIncompatibleError},
+		{"int64: scale constraint should fail",
+			args{appdef.DataKind_int64, appdef.ConstraintKind_Scale, uint8(2)}, true, appdef.ErrIncompatibleError},
 	}
 	require := require.New(t)
 	for _, tt := range tests {
@@ -368,6 +384,38 @@ func Test_DataBuilder_AddConstraint(t *testing.T) {
 	}
 }
 
+func Test_DecimalPrecisionScale(t *testing.T) {
+	require := require.New(t)
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+	wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+
+	money := appdef.NewQName("test", "money")
+	wsb.AddData(money, appdef.DataKind_decimal, appdef.NullQName, constraints.Precision(14), constraints.Scale(4))
+	price := appdef.NewQName("test", "price")
+	wsb.AddData(price, appdef.DataKind_decimal, money, constraints.Precision(10))
+
+	require.Panics(func() {
+		wsb.AddData(appdef.NewQName("test", "wrong"), appdef.DataKind_decimal, appdef.NullQName, constraints.Precision(2), constraints.Scale(3))
+	}, require.Is(appdef.ErrOutOfBoundsError))
+
+	app, err := adb.Build()
+	require.NoError(err)
+
+	p, s := appdef.DecimalPrecisionScale(appdef.Data(app.Type, money))
+	require.EqualValues(14, p)
+	require.EqualValues(4, s)
+
+	p, s = appdef.DecimalPrecisionScale(appdef.Data(app.Type, price))
+	require.EqualValues(10, p)
+	require.EqualValues(4, s, "scale should be inherited")
+
+	p, s = appdef.DecimalPrecisionScale(appdef.Data(app.Type, appdef.SysData_decimal))
+	require.EqualValues(18, p)
+	require.Zero(s)
+}
+
 func Test_DataConstraint_String(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -6,6 +6,8 @@
 package extensions
 
 import (
+	"time"
+
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appdef/internal/types"
 )
@@ -14,6 +16,7 @@ import (
 //   - appdef.IQuery
 type Query struct {
 	Function
+	cacheTTL time.Duration
 }
 
 func NewQuery(ws appdef.IWorkspace, name appdef.QName) *Query {
@@ -22,8 +25,17 @@ func NewQuery(ws appdef.IWorkspace, name appdef.QName) *Query {
 	return q
 }
 
+func (q *Query) CacheTTL() time.Duration { return q.cacheTTL }
+
 func (Query) IsQuery() {}
 
+func (q *Query) setCacheTTL(ttl time.Duration) {
+	if ttl < 0 {
+		panic(appdef.ErrOutOfBounds("query %v cache TTL %v", q, ttl))
+	}
+	q.cacheTTL = ttl
+}
+
 // # Supports:
 //   - appdef.IQueryBuilder
 type QueryBuilder struct {
@@ -37,3 +49,8 @@ func NewQueryBuilder(q *Query) *QueryBuilder {
 		q:               q,
 	}
 }
+
+func (qb *QueryBuilder) SetCacheTTL(ttl time.Duration) appdef.IQueryBuilder {
+	qb.q.setCacheTTL(ttl)
+	return qb
+}
@@ -7,6 +7,7 @@ package extensions_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appdef/builder"
@@ -160,3 +161,36 @@ func Test_QueryWithAnyResult(t *testing.T) {
 		require.Equal(query, appdef.Query(app.Workspace(wsName).Type, queryName))
 	})
 }
+
+func Test_QueryCacheTTL(t *testing.T) {
+	require := require.New(t)
+
+	wsName := appdef.NewQName("test", "workspace")
+	queryName := appdef.NewQName("test", "query")
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+
+	wsb := adb.AddWorkspace(wsName)
+
+	query := wsb.AddQuery(queryName)
+	query.SetResult(appdef.QNameANY)
+
+	t.Run("should be not cached by default", func(t *testing.T) {
+		app, err := adb.Build()
+		require.NoError(err)
+		require.Zero(appdef.Query(app.Type, queryName).CacheTTL())
+	})
+
+	t.Run("should be ok to set cache TTL", func(t *testing.T) {
+		query.SetCacheTTL(10 * time.Second)
+		app, err := adb.Build()
+		require.NoError(err)
+		require.Equal(10*time.Second, appdef.Query(app.Type, queryName).CacheTTL())
+	})
+
+	t.Run("should be panic if cache TTL is negative", func(t *testing.T) {
+		require.Panics(func() { query.SetCacheTTL(-time.Second) },
+			require.Is(appdef.ErrOutOfBoundsError), require.Has(queryName))
+	})
+}
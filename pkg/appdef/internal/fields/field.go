@@ -24,6 +24,7 @@ type Field struct {
 	required    bool
 	verifiable  bool
 	verify      map[appdef.VerificationKind]bool
+	encrypted   bool
 	constraints map[appdef.ConstraintKind]appdef.IConstraint
 }
 
@@ -50,6 +51,8 @@ func (fld *Field) Constraints() map[appdef.ConstraintKind]appdef.IConstraint {
 
 func (fld *Field) Data() appdef.IData { return fld.data }
 
+func (fld *Field) Encrypted() bool { return fld.encrypted }
+
 func (fld *Field) DataKind() appdef.DataKind { return fld.Data().DataKind() }
 
 func (fld *Field) IsFixedWidth() bool { return fld.DataKind().IsFixed() }
@@ -78,6 +81,18 @@ func (fld *Field) setVerify(k ...appdef.VerificationKind) {
 	fld.verifiable = len(fld.verify) > 0
 }
 
+func (fld *Field) setEncrypted() {
+	if fld.IsSys() {
+		panic(appdef.ErrUnsupported("encryption of system %v", fld))
+	}
+	switch fld.DataKind() {
+	case appdef.DataKind_string, appdef.DataKind_bytes:
+		fld.encrypted = true
+	default:
+		panic(appdef.ErrUnsupported("encryption of %v", fld))
+	}
+}
+
 // # Supports:
 //   - appdef.IFields
 type WithFields struct {
@@ -247,6 +262,17 @@ func (ff *WithFields) setFieldVerify(name appdef.FieldName, vk ...appdef.Verific
 	vf.setVerify(vk...)
 }
 
+func (ff *WithFields) setFieldEncrypted(name appdef.FieldName) {
+	fld := ff.fields[name]
+	if fld == nil {
+		panic(appdef.ErrFieldNotFound(name))
+	}
+	ef := fld.(interface {
+		setEncrypted()
+	})
+	ef.setEncrypted()
+}
+
 // # Supports:
 //   - appdef.IFieldsBuilder
 type FieldsBuilder struct {
@@ -282,6 +308,11 @@ func (fb *FieldsBuilder) SetFieldVerify(name appdef.FieldName, vk ...appdef.Veri
 	return fb
 }
 
+func (fb *FieldsBuilder) SetFieldEncrypted(name appdef.FieldName) appdef.IFieldsBuilder {
+	fb.WithFields.setFieldEncrypted(name)
+	return fb
+}
+
 // # Supports:
 //   - appdef.IRefField
 type RefField struct {
@@ -349,3 +380,7 @@ func SetFieldComment(fields *WithFields, name appdef.FieldName, comment ...strin
 func SetFieldVerify(fields *WithFields, name appdef.FieldName, vk ...appdef.VerificationKind) {
 	fields.setFieldVerify(name, vk...)
 }
+
+func SetFieldEncrypted(fields *WithFields, name appdef.FieldName) {
+	fields.setFieldEncrypted(name)
+}
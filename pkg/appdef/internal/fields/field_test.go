@@ -142,6 +142,7 @@ func Test_Fields(t *testing.T) {
 
 			require.True(f.Required())
 			require.False(f.Verifiable())
+			require.False(f.Encrypted())
 
 			cc := f.Constraints()
 			require.Len(cc, 2)
@@ -306,6 +307,50 @@ func Test_SetFieldVerify(t *testing.T) {
 	})
 }
 
+func Test_SetFieldEncrypted(t *testing.T) {
+	require := require.New(t)
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+
+	wsName := appdef.NewQName("test", "workspace")
+	wsb := adb.AddWorkspace(wsName)
+
+	docName := appdef.NewQName("test", "doc")
+	wsb.AddCDoc(docName).
+		AddField("name", appdef.DataKind_string, true).
+		SetFieldEncrypted("name").
+		AddField("photo", appdef.DataKind_bytes, false).
+		SetFieldEncrypted("photo").
+		AddField("age", appdef.DataKind_int32, false)
+
+	app, err := adb.Build()
+	require.NoError(err)
+
+	t.Run("should be ok to obtain encrypted fields", func(t *testing.T) {
+		doc := appdef.CDoc(app.Type, docName)
+		require.True(doc.Field("name").Encrypted())
+		require.True(doc.Field("photo").Encrypted())
+		require.False(doc.Field("age").Encrypted())
+		require.False(doc.Field(appdef.SystemField_ID).Encrypted())
+	})
+
+	t.Run("should be panics", func(t *testing.T) {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(wsName)
+		doc := wsb.AddCDoc(docName)
+		doc.AddField("age", appdef.DataKind_int32, false)
+
+		require.Panics(func() { doc.SetFieldEncrypted("unknownField") },
+			require.Is(appdef.ErrNotFoundError), require.Has("unknownField"))
+		require.Panics(func() { doc.SetFieldEncrypted(appdef.SystemField_QName) },
+			require.Is(appdef.ErrUnsupportedError), require.Has(appdef.SystemField_QName))
+		require.Panics(func() { doc.SetFieldEncrypted("age") },
+			require.Is(appdef.ErrUnsupportedError), require.Has("age"))
+	})
+}
+
 func Test_AddRefField(t *testing.T) {
 	require := require.New(t)
 
@@ -470,4 +515,10 @@ func TestExportedRoutines(t *testing.T) {
 		fields.SetFieldVerify(&ff, "f2", appdef.VerificationKind_EMail)
 		require.True(ff.Field("f2").VerificationKind(appdef.VerificationKind_EMail))
 	})
+
+	t.Run("should be ok to use SetFieldEncrypted", func(t *testing.T) {
+		fields.AddField(&ff, "f4", appdef.DataKind_string, false)
+		fields.SetFieldEncrypted(&ff, "f4")
+		require.True(ff.Field("f4").Encrypted())
+	})
 }
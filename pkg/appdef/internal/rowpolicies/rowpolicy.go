@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package rowpolicies
+
+import (
+	"fmt"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/internal/checks"
+	"github.com/voedger/voedger/pkg/appdef/internal/comments"
+	"github.com/voedger/voedger/pkg/goutils/set"
+)
+
+// Operations which can be restricted by row policy
+var policyOperations = set.From(appdef.OperationKind_Select, appdef.OperationKind_Update)
+
+// # Supports:
+//   - appdef.IRowPolicy
+type RowPolicy struct {
+	comments.WithComments
+	name   appdef.QName
+	ops    []appdef.OperationKind
+	opSet  set.Set[appdef.OperationKind]
+	table  appdef.IRecord
+	roles  []appdef.IRole
+	expr   appdef.IExpr
+	fields []appdef.IField
+	ws     appdef.IWorkspace
+}
+
+func NewRowPolicy(ws appdef.IWorkspace, name appdef.QName, ops []appdef.OperationKind, table appdef.QName, roles []appdef.QName, expr appdef.IExpr, comment ...string) *RowPolicy {
+	if name == appdef.NullQName {
+		panic(appdef.ErrMissed("row policy name"))
+	}
+	if ok, err := appdef.ValidQName(name); !ok {
+		panic(fmt.Errorf("row policy name «%v» is invalid: %w", name, err))
+	}
+	if ws.(appdef.IWithRowPolicies).RowPolicy(name) != nil {
+		panic(appdef.ErrAlreadyExists("row policy «%v»", name))
+	}
+
+	if len(ops) == 0 {
+		panic(appdef.ErrMissed("row policy «%v» operations", name))
+	}
+	if !policyOperations.ContainsAll(ops...) {
+		panic(appdef.ErrUnsupported("row policy operations %v", ops))
+	}
+
+	p := &RowPolicy{
+		WithComments: comments.MakeWithComments(comment...),
+		name:         name,
+		opSet:        set.From(ops...),
+		table:        appdef.Record(ws.Type, table),
+		roles:        make([]appdef.IRole, 0, len(roles)),
+		expr:         expr,
+		ws:           ws,
+	}
+	p.ops = p.opSet.AsArray()
+
+	if p.table == nil {
+		panic(appdef.ErrNotFound("record «%v»", table))
+	}
+
+	for _, r := range roles {
+		role := appdef.Role(ws.Type, r)
+		if role == nil {
+			panic(appdef.ErrRoleNotFound(r))
+		}
+		p.roles = append(p.roles, role)
+	}
+
+	if expr == nil {
+		panic(appdef.ErrMissed("row policy «%v» expression", name))
+	}
+	p.fields = checks.ExprFields(expr, p.table)
+
+	ws.(interface{ AppendRowPolicy(appdef.IRowPolicy) }).AppendRowPolicy(p)
+
+	return p
+}
+
+func (p RowPolicy) Expr() appdef.IExpr { return p.expr }
+
+func (p RowPolicy) Fields() []appdef.IField { return p.fields }
+
+func (p RowPolicy) Op(op appdef.OperationKind) bool {
+	switch op {
+	case appdef.OperationKind_Activate, appdef.OperationKind_Deactivate:
+		op = appdef.OperationKind_Update
+	}
+	return p.opSet.Contains(op)
+}
+
+func (p RowPolicy) Ops() []appdef.OperationKind { return p.ops }
+
+func (p RowPolicy) QName() appdef.QName { return p.name }
+
+func (p RowPolicy) Roles() []appdef.IRole { return p.roles }
+
+func (p RowPolicy) String() string {
+	// POLICY test.ownRows ON test.doc FOR [Update Select] USING (Owner = CURRENT_SUBJECT)
+	return fmt.Sprintf("POLICY %v ON %v FOR %v USING (%v)", p.name, p.table.QName(), p.opSet, p.expr)
+}
+
+func (p RowPolicy) Table() appdef.IRecord { return p.table }
+
+func (p RowPolicy) Workspace() appdef.IWorkspace { return p.ws }
+
+// # Supports:
+//   - appdef.IWithRowPolicies
+type WithRowPolicies struct {
+	policies []appdef.IRowPolicy
+}
+
+func MakeWithRowPolicies() WithRowPolicies {
+	return WithRowPolicies{policies: make([]appdef.IRowPolicy, 0)}
+}
+
+func (pp *WithRowPolicies) AppendRowPolicy(p appdef.IRowPolicy) {
+	pp.policies = append(pp.policies, p)
+}
+
+func (pp *WithRowPolicies) RowPolicies() []appdef.IRowPolicy { return pp.policies }
+
+func (pp *WithRowPolicies) RowPolicy(name appdef.QName) appdef.IRowPolicy {
+	for _, p := range pp.policies {
+		if p.QName() == name {
+			return p
+		}
+	}
+	return nil
+}
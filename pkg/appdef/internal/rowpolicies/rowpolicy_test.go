@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package rowpolicies_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/appdef/exprs"
+	"github.com/voedger/voedger/pkg/goutils/testingu/require"
+)
+
+func Test_RowPolicies(t *testing.T) {
+	require := require.New(t)
+
+	wsName := appdef.NewQName("test", "workspace")
+	docName := appdef.NewQName("test", "orders")
+	waiterName := appdef.NewQName("test", "waiter")
+	managerName := appdef.NewQName("test", "manager")
+	ownName := appdef.NewQName("test", "ownOrders")
+	depName := appdef.NewQName("test", "depOrders")
+
+	var app appdef.IAppDef
+
+	t.Run("should be ok to add row policies", func(t *testing.T) {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(wsName)
+
+		wsb.AddCDoc(docName).
+			AddField("createdBy", appdef.DataKind_string, false).
+			AddField("department", appdef.DataKind_int32, false)
+		wsb.AddRole(waiterName)
+		wsb.AddRole(managerName)
+
+		wsb.AddRowPolicy(ownName, []appdef.OperationKind{appdef.OperationKind_Select, appdef.OperationKind_Update}, docName,
+			[]appdef.QName{waiterName}, exprs.Eq(exprs.Field("createdBy"), exprs.Subject()), "waiter sees own orders only")
+		wsb.AddRowPolicy(depName, []appdef.OperationKind{appdef.OperationKind_Select}, docName,
+			nil, exprs.Eq(exprs.Field("department"), exprs.Value(1)))
+
+		a, err := adb.Build()
+		require.NoError(err)
+
+		app = a
+	})
+
+	t.Run("should be ok to read row policies", func(t *testing.T) {
+		ws := app.Workspace(wsName)
+
+		pp := ws.RowPolicies()
+		require.Len(pp, 2)
+		require.Equal(ownName, pp[0].QName())
+		require.Equal(depName, pp[1].QName())
+
+		own := ws.RowPolicy(ownName)
+		require.Equal("waiter sees own orders only", own.Comment())
+		require.Equal(docName, own.Table().QName())
+		require.Equal([]appdef.OperationKind{appdef.OperationKind_Update, appdef.OperationKind_Select}, own.Ops())
+		require.True(own.Op(appdef.OperationKind_Select))
+		require.True(own.Op(appdef.OperationKind_Update))
+		require.True(own.Op(appdef.OperationKind_Deactivate), "update policy should restrict deactivate")
+		require.False(own.Op(appdef.OperationKind_Insert))
+		require.Len(own.Roles(), 1)
+		require.Equal(waiterName, own.Roles()[0].QName())
+		require.Equal("createdBy = CURRENT_SUBJECT", own.Expr().String())
+		require.Len(own.Fields(), 1)
+		require.Equal("createdBy", own.Fields()[0].Name())
+		require.Equal(ws, own.Workspace())
+		require.Equal("POLICY test.ownOrders ON test.orders FOR [Update Select] USING (createdBy = CURRENT_SUBJECT)", fmt.Sprint(own))
+
+		dep := ws.RowPolicy(depName)
+		require.False(dep.Op(appdef.OperationKind_Update))
+		require.Empty(dep.Roles())
+
+		require.Nil(ws.RowPolicy(appdef.NewQName("test", "unknown")))
+	})
+}
+
+func Test_RowPoliciesPanics(t *testing.T) {
+	require := require.New(t)
+
+	docName := appdef.NewQName("test", "doc")
+	objName := appdef.NewQName("test", "obj")
+	roleName := appdef.NewQName("test", "role")
+	policyName := appdef.NewQName("test", "policy")
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+	wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+	wsb.AddCDoc(docName).AddField("f", appdef.DataKind_int32, false)
+	wsb.AddObject(objName).AddField("f", appdef.DataKind_int32, false)
+	wsb.AddRole(roleName)
+
+	sel := []appdef.OperationKind{appdef.OperationKind_Select}
+	expr := exprs.Gt(exprs.Field("f"), exprs.Value(0))
+
+	wsb.AddRowPolicy(policyName, sel, docName, nil, expr)
+
+	require.Panics(func() {
+		wsb.AddRowPolicy(appdef.NullQName, sel, docName, nil, expr)
+	}, require.Is(appdef.ErrMissedError), "if missed name")
+
+	require.Panics(func() {
+		wsb.AddRowPolicy(appdef.NewQName("naked", "🔫"), sel, docName, nil, expr)
+	}, require.Is(appdef.ErrInvalidError), require.Has("naked.🔫"), "if invalid name")
+
+	require.Panics(func() {
+		wsb.AddRowPolicy(policyName, sel, docName, nil, expr)
+	}, require.Is(appdef.ErrAlreadyExistsError), require.Has(policyName), "if name already used")
+
+	newName := appdef.NewQName("test", "new")
+
+	require.Panics(func() {
+		wsb.AddRowPolicy(newName, nil, docName, nil, expr)
+	}, require.Is(appdef.ErrMissedError), "if missed operations")
+
+	require.Panics(func() {
+		wsb.AddRowPolicy(newName, []appdef.OperationKind{appdef.OperationKind_Insert}, docName, nil, expr)
+	}, require.Is(appdef.ErrUnsupportedError), require.Has("Insert"), "if unsupported operation")
+
+	require.Panics(func() {
+		wsb.AddRowPolicy(newName, sel, objName, nil, expr)
+	}, require.Is(appdef.ErrNotFoundError), require.Has(objName), "if table is not a record")
+
+	require.Panics(func() {
+		wsb.AddRowPolicy(newName, sel, docName, []appdef.QName{appdef.NewQName("test", "unknown")}, expr)
+	}, require.Is(appdef.ErrNotFoundError), require.Has("test.unknown"), "if unknown role")
+
+	require.Panics(func() {
+		wsb.AddRowPolicy(newName, sel, docName, nil, nil)
+	}, require.Is(appdef.ErrMissedError), "if missed expression")
+
+	require.Panics(func() {
+		wsb.AddRowPolicy(newName, sel, docName, nil, exprs.IsNull(exprs.Field("unknown")))
+	}, require.Is(appdef.ErrNotFoundError), require.Has("unknown"), "if unknown field")
+}
@@ -151,3 +151,40 @@ func Test_Docs(t *testing.T) {
 		})
 	}
 }
+
+func Test_RecordAudit(t *testing.T) {
+	require := require.New(t)
+	for _, fx := range docFixtures {
+		t.Run(fx.name, func(t *testing.T) {
+			wsName := appdef.NewQName("test", "workspace")
+			docName, recName := appdef.NewQName("test", "doc"), appdef.NewQName("test", "rec")
+
+			adb := builder.New()
+			adb.AddPackage("test", "test.com/test")
+			ws := adb.AddWorkspace(wsName)
+			doc := fx.addDoc(ws, docName)
+			rec := fx.addRec(ws, recName)
+
+			switch fx.docKind {
+			case appdef.TypeKind_CDoc, appdef.TypeKind_WDoc:
+				doc.SetAudit()
+				rec.SetAudit()
+
+				app, err := adb.Build()
+				require.NoError(err)
+
+				require.True(fx.findDoc(app.Type, docName).Audit())
+				require.True(fx.findRec(app.Type, recName).Audit())
+			default:
+				require.Panics(func() { doc.SetAudit() })
+				require.Panics(func() { rec.SetAudit() })
+
+				app, err := adb.Build()
+				require.NoError(err)
+
+				require.False(fx.findDoc(app.Type, docName).Audit())
+				require.False(fx.findRec(app.Type, recName).Audit())
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appdef/internal/abstracts"
+	"github.com/voedger/voedger/pkg/appdef/internal/checks"
 	"github.com/voedger/voedger/pkg/appdef/internal/containers"
 	"github.com/voedger/voedger/pkg/appdef/internal/fields"
 	"github.com/voedger/voedger/pkg/appdef/internal/types"
@@ -23,6 +24,7 @@ type Structure struct {
 	fields.WithFields
 	containers.WithContainers
 	uniques.WithUniques
+	checks.WithChecks
 	abstracts.WithAbstract
 }
 
@@ -36,6 +38,7 @@ func MakeStructure(ws appdef.IWorkspace, name appdef.QName, kind appdef.TypeKind
 	}
 	s.MakeSysFields()
 	s.WithUniques = uniques.MakeWithUniques(ws.App().Type, &s.WithFields)
+	s.WithChecks = checks.MakeWithChecks(ws.App().Type, &s.WithFields)
 	return s
 }
 
@@ -57,6 +60,7 @@ type StructureBuilder struct {
 	fields.FieldsBuilder
 	containers.ContainersBuilder
 	uniques.UniquesBuilder
+	checks.ChecksBuilder
 	abstracts.WithAbstractBuilder
 	*Structure
 }
@@ -67,6 +71,7 @@ func MakeStructureBuilder(structure *Structure) StructureBuilder {
 		FieldsBuilder:       fields.MakeFieldsBuilder(&structure.WithFields),
 		ContainersBuilder:   containers.MakeContainersBuilder(&structure.WithContainers),
 		UniquesBuilder:      uniques.MakeUniquesBuilder(&structure.WithUniques),
+		ChecksBuilder:       checks.MakeChecksBuilder(&structure.WithChecks),
 		WithAbstractBuilder: abstracts.MakeWithAbstractBuilder(&structure.WithAbstract),
 		Structure:           structure,
 	}
@@ -76,12 +81,24 @@ func MakeStructureBuilder(structure *Structure) StructureBuilder {
 //   - appdef.IRecord
 type Record struct {
 	Structure
+	audit bool
 }
 
 func (r Record) SystemField_ID() appdef.IField {
 	return r.Field(appdef.SystemField_ID)
 }
 
+func (r *Record) Audit() bool { return r.audit }
+
+func (r *Record) setAudit() {
+	switch r.Kind() {
+	case appdef.TypeKind_CDoc, appdef.TypeKind_CRecord, appdef.TypeKind_WDoc, appdef.TypeKind_WRecord:
+		r.audit = true
+	default:
+		panic(appdef.ErrUnsupported("audit of %v", r))
+	}
+}
+
 // Makes new record
 func MakeRecord(ws appdef.IWorkspace, name appdef.QName, kind appdef.TypeKind) Record {
 	r := Record{
@@ -104,6 +121,10 @@ func MakeRecordBuilder(record *Record) RecordBuilder {
 	}
 }
 
+func (rb *RecordBuilder) SetAudit() {
+	rb.Record.setAudit()
+}
+
 // # Supports:
 //   - appdef.IDoc
 type Doc struct {
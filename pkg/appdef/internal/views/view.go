@@ -20,6 +20,7 @@ type View struct {
 	fields.WithFields // all fields, include key and value
 	key               *ViewKey
 	value             *ViewValue
+	n10nPayload       bool
 }
 
 func NewView(ws appdef.IWorkspace, name appdef.QName) *View {
@@ -38,6 +39,8 @@ func (v *View) Key() appdef.IViewKey { return v.key }
 
 func (v *View) Value() appdef.IViewValue { return v.value }
 
+func (v *View) N10nPayload() bool { return v.n10nPayload }
+
 // Validates view
 func (v *View) Validate() error {
 	return errors.Join(
@@ -69,6 +72,11 @@ func (vb *ViewBuilder) Key() appdef.IViewKeyBuilder { return vb.key }
 
 func (vb *ViewBuilder) Value() appdef.IViewValueBuilder { return vb.val }
 
+func (vb *ViewBuilder) SetN10nPayload(n10nPayload bool) appdef.IViewBuilder {
+	vb.n10nPayload = n10nPayload
+	return vb
+}
+
 // # Supports:
 //   - IViewKey
 type ViewKey struct {
@@ -347,6 +355,11 @@ func (v *ViewValue) setFieldVerify(name appdef.FieldName, vk ...appdef.Verificat
 	fields.SetFieldVerify(&v.WithFields, name, vk...)
 }
 
+func (v *ViewValue) setFieldEncrypted(name appdef.FieldName) {
+	fields.SetFieldEncrypted(&v.view.WithFields, name)
+	fields.SetFieldEncrypted(&v.WithFields, name)
+}
+
 // Validates view value
 func (v *ViewValue) Validate() error { return nil }
 
@@ -384,3 +397,8 @@ func (vb *ViewValueBuilder) SetFieldVerify(name appdef.FieldName, vk ...appdef.V
 	vb.setFieldVerify(name, vk...)
 	return vb
 }
+
+func (vb *ViewValueBuilder) SetFieldEncrypted(name appdef.FieldName) appdef.IFieldsBuilder {
+	vb.setFieldEncrypted(name)
+	return vb
+}
@@ -45,6 +45,7 @@ func Test_Views(t *testing.T) {
 	t.Run("should be ok to build view", func(t *testing.T) {
 
 		vb.SetComment("test view")
+		vb.SetN10nPayload(true)
 
 		t.Run("should be ok to add partition key fields", func(t *testing.T) {
 			vb.Key().PartKey().AddDataField("pkF1", numName)
@@ -108,6 +109,7 @@ func Test_Views(t *testing.T) {
 			require.Equal("test view", view.Comment())
 			require.Equal(viewName, view.QName())
 			require.Equal(appdef.TypeKind_ViewRecord, view.Kind())
+			require.True(view.N10nPayload())
 
 			checkValueValF2 := func(f appdef.IField) {
 				require.Equal("valF2", f.Name())
@@ -307,7 +309,7 @@ func Test_Views(t *testing.T) {
 
 		vb.Value().
 			AddRefField("valF3", false, docName).
-			AddField("valF4", appdef.DataKind_bytes, false, constraints.MaxLen(1024)).SetFieldComment("valF4", "test comment").
+			AddField("valF4", appdef.DataKind_bytes, false, constraints.MaxLen(1024)).SetFieldComment("valF4", "test comment").SetFieldEncrypted("valF4").
 			AddField("valF5", appdef.DataKind_bool, false).SetFieldVerify("valF5", appdef.VerificationKind_EMail)
 
 		a, err := adb.Build()
@@ -352,6 +354,7 @@ func Test_Views(t *testing.T) {
 				require.Equal(appdef.DataKind_bytes, f.DataKind())
 				require.False(f.Required())
 				require.Equal("test comment", f.Comment())
+				require.True(f.Encrypted())
 				cnt := 0
 				for _, c := range f.Constraints() {
 					cnt++
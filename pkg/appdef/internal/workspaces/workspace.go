@@ -17,6 +17,7 @@ import (
 	"github.com/voedger/voedger/pkg/appdef/internal/extensions"
 	"github.com/voedger/voedger/pkg/appdef/internal/rates"
 	"github.com/voedger/voedger/pkg/appdef/internal/roles"
+	"github.com/voedger/voedger/pkg/appdef/internal/rowpolicies"
 	"github.com/voedger/voedger/pkg/appdef/internal/structures"
 	"github.com/voedger/voedger/pkg/appdef/internal/types"
 	"github.com/voedger/voedger/pkg/appdef/internal/views"
@@ -28,6 +29,7 @@ type Workspace struct {
 	types.Typ
 	abstracts.WithAbstract
 	acl.WithACL
+	rowpolicies.WithRowPolicies
 	types.WithTypes
 	allTypes  []appdef.IType
 	ancestors *Workspaces
@@ -37,12 +39,13 @@ type Workspace struct {
 
 func NewWorkspace(app appdef.IAppDef, name appdef.QName) *Workspace {
 	ws := &Workspace{
-		Typ:          types.MakeType(app, nil, name, appdef.TypeKind_Workspace),
-		WithAbstract: abstracts.MakeWithAbstract(),
-		WithACL:      acl.MakeWithACL(),
-		WithTypes:    types.MakeWithTypes(),
-		ancestors:    NewWorkspaces(),
-		usedWS:       NewWorkspaces(),
+		Typ:             types.MakeType(app, nil, name, appdef.TypeKind_Workspace),
+		WithAbstract:    abstracts.MakeWithAbstract(),
+		WithACL:         acl.MakeWithACL(),
+		WithRowPolicies: rowpolicies.MakeWithRowPolicies(),
+		WithTypes:       types.MakeWithTypes(),
+		ancestors:       NewWorkspaces(),
+		usedWS:          NewWorkspaces(),
 	}
 	if name != appdef.SysWorkspaceQName {
 		ws.ancestors.Add(app.Workspace(appdef.SysWorkspaceQName))
@@ -309,6 +312,10 @@ func (ws *Workspace) revokeAll(flt appdef.IFilter, fromRole appdef.QName, commen
 	acl.NewRevokeAll(ws, flt, r, comment...)
 }
 
+func (ws *Workspace) addRowPolicy(name appdef.QName, ops []appdef.OperationKind, table appdef.QName, roles []appdef.QName, expr appdef.IExpr, comment ...string) {
+	rowpolicies.NewRowPolicy(ws, name, ops, table, roles, expr, comment...)
+}
+
 func (ws *Workspace) setAncestors(name appdef.QName, names ...appdef.QName) {
 	add := func(n appdef.QName) {
 		anc := ws.App().Workspace(n)
@@ -476,6 +483,11 @@ func (wb *WorkspaceBuilder) RevokeAll(flt appdef.IFilter, fromRole appdef.QName,
 	return wb
 }
 
+func (wb *WorkspaceBuilder) AddRowPolicy(name appdef.QName, ops []appdef.OperationKind, table appdef.QName, roles []appdef.QName, expr appdef.IExpr, comment ...string) appdef.IRowPoliciesBuilder {
+	wb.ws.addRowPolicy(name, ops, table, roles, expr, comment...)
+	return wb
+}
+
 func (wb *WorkspaceBuilder) SetAncestors(name appdef.QName, names ...appdef.QName) appdef.IWorkspaceBuilder {
 	wb.ws.setAncestors(name, names...)
 	return wb
@@ -20,14 +20,20 @@ func _() {
 	_ = x[DataKind_QName-9]
 	_ = x[DataKind_bool-10]
 	_ = x[DataKind_RecordID-11]
-	_ = x[DataKind_Record-12]
-	_ = x[DataKind_Event-13]
-	_ = x[DataKind_FakeLast-14]
+	_ = x[DataKind_decimal-12]
+	_ = x[DataKind_date-13]
+	_ = x[DataKind_time-14]
+	_ = x[DataKind_timestamp-15]
+	_ = x[DataKind_uuid-16]
+	_ = x[DataKind_json-17]
+	_ = x[DataKind_Record-18]
+	_ = x[DataKind_Event-19]
+	_ = x[DataKind_FakeLast-20]
 }
 
-const _DataKind_name = "DataKind_nullDataKind_int8DataKind_int16DataKind_int32DataKind_int64DataKind_float32DataKind_float64DataKind_bytesDataKind_stringDataKind_QNameDataKind_boolDataKind_RecordIDDataKind_RecordDataKind_EventDataKind_FakeLast"
+const _DataKind_name = "DataKind_nullDataKind_int8DataKind_int16DataKind_int32DataKind_int64DataKind_float32DataKind_float64DataKind_bytesDataKind_stringDataKind_QNameDataKind_boolDataKind_RecordIDDataKind_decimalDataKind_dateDataKind_timeDataKind_timestampDataKind_uuidDataKind_jsonDataKind_RecordDataKind_EventDataKind_FakeLast"
 
-var _DataKind_index = [...]uint8{0, 13, 26, 40, 54, 68, 84, 100, 114, 129, 143, 156, 173, 188, 202, 219}
+var _DataKind_index = [...]uint16{0, 13, 26, 40, 54, 68, 84, 100, 114, 129, 143, 156, 173, 189, 202, 215, 233, 246, 259, 274, 288, 305}
 
 func (i DataKind) String() string {
 	if i >= DataKind(len(_DataKind_index)-1) {
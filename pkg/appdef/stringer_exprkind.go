@@ -0,0 +1,42 @@
+// Code generated by "stringer -type=ExprKind -output=stringer_exprkind.go"; DO NOT EDIT.
+
+package appdef
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ExprKind_null-0]
+	_ = x[ExprKind_Value-1]
+	_ = x[ExprKind_Field-2]
+	_ = x[ExprKind_Subject-3]
+	_ = x[ExprKind_Not-4]
+	_ = x[ExprKind_And-5]
+	_ = x[ExprKind_Or-6]
+	_ = x[ExprKind_Eq-7]
+	_ = x[ExprKind_Ne-8]
+	_ = x[ExprKind_Lt-9]
+	_ = x[ExprKind_Le-10]
+	_ = x[ExprKind_Gt-11]
+	_ = x[ExprKind_Ge-12]
+	_ = x[ExprKind_IsNull-13]
+	_ = x[ExprKind_Add-14]
+	_ = x[ExprKind_Sub-15]
+	_ = x[ExprKind_Mul-16]
+	_ = x[ExprKind_Div-17]
+	_ = x[ExprKind_Mod-18]
+	_ = x[ExprKind_count-19]
+}
+
+const _ExprKind_name = "ExprKind_nullExprKind_ValueExprKind_FieldExprKind_SubjectExprKind_NotExprKind_AndExprKind_OrExprKind_EqExprKind_NeExprKind_LtExprKind_LeExprKind_GtExprKind_GeExprKind_IsNullExprKind_AddExprKind_SubExprKind_MulExprKind_DivExprKind_ModExprKind_count"
+
+var _ExprKind_index = [...]uint8{0, 13, 27, 41, 57, 69, 81, 92, 103, 114, 125, 136, 147, 158, 173, 185, 197, 209, 221, 233, 247}
+
+func (i ExprKind) String() string {
+	if i >= ExprKind(len(_ExprKind_index)-1) {
+		return "ExprKind(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _ExprKind_name[_ExprKind_index[i]:_ExprKind_index[i+1]]
+}
@@ -0,0 +1,22 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package appdef
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Constructs and returns QName for the check for the structure.
+func CheckQName(structQName QName, checkName string) QName {
+	return NewQName(structQName.Pkg(), fmt.Sprintf("%s$checks$%s", structQName.Entity(), checkName))
+}
+
+// Renders an ExprKind in human-readable form, without "ExprKind_" prefix,
+// suitable for debugging or error messages
+func (k ExprKind) TrimString() string {
+	const pref = "ExprKind_"
+	return strings.TrimPrefix(k.String(), pref)
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package appdef_test
+
+import (
+	"testing"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/goutils/testingu/require"
+)
+
+func Test_CheckQName(t *testing.T) {
+	tests := []struct {
+		d    string
+		n    string
+		want string
+	}{
+		{"test.table", "01", "test.table$checks$01"},
+		{"test.table", "Dates", "test.table$checks$Dates"},
+	}
+
+	require := require.New(t)
+	for _, tt := range tests {
+		require.Equal(
+			appdef.MustParseQName(tt.want),
+			appdef.CheckQName(appdef.MustParseQName(tt.d), tt.n))
+	}
+}
+
+func TestExprKind_TrimString(t *testing.T) {
+	require := require.New(t)
+	require.Equal("Gt", appdef.ExprKind_Gt.TrimString())
+	require.Equal("ExprKind(255)", appdef.ExprKind(255).TrimString())
+}
@@ -8,6 +8,7 @@ package appdef
 import (
 	"strings"
 
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/goutils/strconvu"
 )
 
@@ -33,7 +34,12 @@ func (k DataKind) IsFixed() bool {
 		DataKind_float64,
 		DataKind_QName,
 		DataKind_bool,
-		DataKind_RecordID:
+		DataKind_RecordID,
+		DataKind_decimal,
+		DataKind_date,
+		DataKind_time,
+		DataKind_timestamp,
+		DataKind_uuid:
 		return true
 	}
 	return false
@@ -52,12 +58,33 @@ func (k DataKind) IsFixed() bool {
 //   - ConstraintKind_Pattern
 //   - ConstraintKind_Enum
 //
+// # JSON data supports:
+//   - ConstraintKind_MinLen
+//   - ConstraintKind_MaxLen
+//
 // # Numeric data supports:
 //   - ConstraintKind_MinIncl
 //   - ConstraintKind_MinExcl
 //   - ConstraintKind_MaxIncl
 //   - ConstraintKind_MaxExcl
 //   - ConstraintKind_Enum
+//
+// # Decimal data supports:
+//   - ConstraintKind_MinIncl
+//   - ConstraintKind_MinExcl
+//   - ConstraintKind_MaxIncl
+//   - ConstraintKind_MaxExcl
+//   - ConstraintKind_Precision
+//   - ConstraintKind_Scale
+//
+// # Date, time and timestamp data supports:
+//   - ConstraintKind_MinIncl
+//   - ConstraintKind_MinExcl
+//   - ConstraintKind_MaxIncl
+//   - ConstraintKind_MaxExcl
+//
+// Date and time range constraints values are compared with stored values,
+// see [DataKind_date], [DataKind_time] and [DataKind_timestamp] for details.
 func (k DataKind) IsCompatibleWithConstraint(c ConstraintKind) bool {
 	switch k {
 	case DataKind_bytes:
@@ -77,6 +104,13 @@ func (k DataKind) IsCompatibleWithConstraint(c ConstraintKind) bool {
 			ConstraintKind_Enum:
 			return true
 		}
+	case DataKind_json:
+		switch c {
+		case
+			ConstraintKind_MinLen,
+			ConstraintKind_MaxLen:
+			return true
+		}
 	case DataKind_int8, DataKind_int16, // #3434 [~server.vsql.smallints/cmp.AppDef~impl]
 		DataKind_int32, DataKind_int64, DataKind_float32, DataKind_float64:
 		switch c {
@@ -88,10 +122,46 @@ func (k DataKind) IsCompatibleWithConstraint(c ConstraintKind) bool {
 			ConstraintKind_Enum:
 			return true
 		}
+	case DataKind_decimal:
+		switch c {
+		case
+			ConstraintKind_MinIncl,
+			ConstraintKind_MinExcl,
+			ConstraintKind_MaxIncl,
+			ConstraintKind_MaxExcl,
+			ConstraintKind_Precision,
+			ConstraintKind_Scale:
+			return true
+		}
+	case DataKind_date, DataKind_time, DataKind_timestamp:
+		switch c {
+		case
+			ConstraintKind_MinIncl,
+			ConstraintKind_MinExcl,
+			ConstraintKind_MaxIncl,
+			ConstraintKind_MaxExcl:
+			return true
+		}
 	}
 	return false
 }
 
+// Returns precision and scale of decimal data type, include inherited constraints.
+//
+// If precision or scale is not constrained, then decimal.DefaultPrecision or
+// decimal.DefaultScale is returned.
+func DecimalPrecisionScale(d IData) (precision, scale uint8) {
+	precision, scale = decimal.DefaultPrecision, decimal.DefaultScale
+	cc := d.Constraints(true)
+	if c, ok := cc[ConstraintKind_Precision]; ok {
+		precision = c.Value().(uint8)
+	}
+	if c, ok := cc[ConstraintKind_Scale]; ok {
+		scale = c.Value().(uint8)
+	}
+	return precision, scale
+}
+
 func (k DataKind) MarshalText() ([]byte, error) {
 	var s string
 	if k < DataKind_FakeLast {
@@ -58,9 +58,21 @@ func TestDataKindType_IsFixed(t *testing.T) {
 		{name: "int32 must be fixed",
 			args: args{kind: appdef.DataKind_int32},
 			want: true},
+		{name: "decimal must be fixed",
+			args: args{kind: appdef.DataKind_decimal},
+			want: true},
+		{name: "date must be fixed",
+			args: args{kind: appdef.DataKind_date},
+			want: true},
+		{name: "timestamp must be fixed",
+			args: args{kind: appdef.DataKind_timestamp},
+			want: true},
 		{name: "string must be variable",
 			args: args{kind: appdef.DataKind_string},
 			want: false},
+		{name: "json must be variable",
+			args: args{kind: appdef.DataKind_json},
+			want: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -98,6 +110,11 @@ func TestDataKind_IsSupportedConstraint(t *testing.T) {
 		{"bytes: MaxIncl", appdef.DataKind_bytes, args{appdef.ConstraintKind_MaxIncl}, false},
 		{"bytes: MaxExcl", appdef.DataKind_bytes, args{appdef.ConstraintKind_MaxExcl}, false},
 		{"bytes: Enum", appdef.DataKind_bytes, args{appdef.ConstraintKind_Enum}, false},
+		//-
+		{"json: MinLen", appdef.DataKind_json, args{appdef.ConstraintKind_MinLen}, true},
+		{"json: MaxLen", appdef.DataKind_json, args{appdef.ConstraintKind_MaxLen}, true},
+		{"json: Pattern", appdef.DataKind_json, args{appdef.ConstraintKind_Pattern}, false},
+		{"json: Enum", appdef.DataKind_json, args{appdef.ConstraintKind_Enum}, false},
 		//- // #3434 [~server.vsql.smallints/cmp.AppDef~impl]
 		{"int8: MinLen", appdef.DataKind_int8, args{appdef.ConstraintKind_MinLen}, false},
 		{"int8: MaxLen", appdef.DataKind_int8, args{appdef.ConstraintKind_MaxLen}, false},
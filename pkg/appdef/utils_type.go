@@ -440,6 +440,12 @@ var (
 		DataKind_QName,
 		DataKind_bool,
 		DataKind_RecordID,
+		DataKind_decimal,
+		DataKind_date,
+		DataKind_time,
+		DataKind_timestamp,
+		DataKind_uuid,
+		DataKind_json,
 	)
 
 	typeKindStructProps = map[TypeKind]*structuralTypeProps{
@@ -550,6 +556,12 @@ var (
 				DataKind_QName,
 				DataKind_bool,
 				DataKind_RecordID,
+				DataKind_decimal,
+				DataKind_date,
+				DataKind_time,
+				DataKind_timestamp,
+				DataKind_uuid,
+				DataKind_json,
 				DataKind_Record,
 				DataKind_Event,
 			),
@@ -33,6 +33,7 @@ const (
 	NodeNameFields          = "Fields"
 	NodeNameUniques         = "Uniques"
 	NodeNameUniqueFields    = "UniqueFields"
+	NodeNameChecks          = "Checks"
 	NodeNameAbstract        = "Abstract"
 	NodeNameContainers      = "Containers"
 	NodeNameAppDef          = "AppDef"
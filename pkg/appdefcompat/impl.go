@@ -6,6 +6,7 @@
 package appdefcompat
 
 import (
+	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -21,6 +22,7 @@ var constrains = []NodeConstraint{
 	{NodeNameTypes, ConstraintInsertOnly},
 	{NodeNameFields, ConstraintAppendOnly},
 	{NodeNameUniqueFields, ConstraintOrderChangeOnly},
+	{NodeNameChecks, ConstraintDeleteOnly},
 	{NodeNamePartKeyFields, ConstraintNonModifiable},
 	{NodeNameClustColsFields, ConstraintNonModifiable},
 	{NodeNameCommandArgs, ConstraintNonModifiable},
@@ -98,6 +100,7 @@ func buildTableNode(parentNode *CompatibilityTreeNode, item appdef.IDoc) (node *
 	node = newNode(parentNode, item.QName().String(), nil)
 	node.Props = append(node.Props,
 		buildUniquesNode(node, item),
+		buildChecksNode(node, item),
 		buildFieldsNode(node, item, NodeNameFields),
 		buildContainersNode(node, item),
 		buildAbstractNode(node, item),
@@ -132,6 +135,9 @@ func buildQNameNode(parentNode *CompatibilityTreeNode, item appdef.IType, name s
 		if t, ok := item.(appdef.IWithContainers); ok {
 			node.Props = append(node.Props, buildContainersNode(node, t))
 		}
+		if t, ok := item.(appdef.IWithChecks); ok {
+			node.Props = append(node.Props, buildChecksNode(node, t))
+		}
 	}
 	return node
 }
@@ -154,7 +160,13 @@ func buildContainerNode(parentNode *CompatibilityTreeNode, item appdef.IContaine
 }
 
 func buildFieldNode(parentNode *CompatibilityTreeNode, item appdef.IField) (node *CompatibilityTreeNode) {
-	return newNode(parentNode, item.Name(), item.DataKind())
+	var value interface{} = item.DataKind()
+	if item.DataKind() == appdef.DataKind_decimal {
+		// decimal values are stored unscaled, so precision and scale are part of the field type
+		precision, scale := appdef.DecimalPrecisionScale(item.Data())
+		value = fmt.Sprintf("%s(%d,%d)", item.DataKind().TrimString(), precision, scale)
+	}
+	return newNode(parentNode, item.Name(), value)
 }
 
 func buildFieldsNode(parentNode *CompatibilityTreeNode, item interface{}, nodeName string) (node *CompatibilityTreeNode) {
@@ -194,6 +206,18 @@ func buildUniquesNode(parentNode *CompatibilityTreeNode, item appdef.IWithUnique
 	return node
 }
 
+func buildCheckNode(parentNode *CompatibilityTreeNode, item appdef.ICheck) (node *CompatibilityTreeNode) {
+	return newNode(parentNode, item.Name().String(), item.Expr().String())
+}
+
+func buildChecksNode(parentNode *CompatibilityTreeNode, item appdef.IWithChecks) (node *CompatibilityTreeNode) {
+	node = newNode(parentNode, NodeNameChecks, nil)
+	for _, check := range item.Checks() {
+		node.Props = append(node.Props, buildCheckNode(node, check))
+	}
+	return node
+}
+
 func buildContainersNode(parentNode *CompatibilityTreeNode, item appdef.IWithContainers) (node *CompatibilityTreeNode) {
 	node = newNode(parentNode, NodeNameContainers, nil)
 	for _, container := range item.Containers() {
@@ -318,6 +342,13 @@ func checkConstraint(oldTreePath []string, m *matchNodesResult, constraint Const
 		}
 	}
 
+	if constraint&ConstraintDeleteOnly > 0 {
+		// new checks may be violated by already stored data
+		if m.AppendedNodeCount > 0 || m.InsertedNodeCount > 0 {
+			cerrs = append(cerrs, newCompatibilityError(constraint, oldTreePath, ErrorTypeNodeInserted))
+		}
+	}
+
 	if constraint&ConstraintOrderChangeOnly > 0 {
 		if m.AppendedNodeCount > 0 || len(m.DeletedNodeNames) > 0 || m.InsertedNodeCount > 0 {
 			cerrs = append(cerrs, newCompatibilityError(constraint, oldTreePath, ErrorTypeNodeModified))
@@ -102,6 +102,10 @@ func Test_Basic(t *testing.T) {
 			{OldTreePath: []string{"AppDef", "Types", "sys.SomeView", "Fields", "E"}, ErrorType: ErrorTypeValueChanged},
 			{OldTreePath: []string{"AppDef", "Types", "sys.SomeView", "ClustColsFields", "B"}, ErrorType: ErrorTypeValueChanged},
 			{OldTreePath: []string{"AppDef", "Types", "sys.AnotherOneTable", "Uniques", "sys.AnotherOneTable$uniques$01", "UniqueFields"}, ErrorType: ErrorTypeNodeModified},
+			{OldTreePath: []string{"AppDef", "Types", "sys.AnotherOneTable", "Checks"}, ErrorType: ErrorTypeNodeInserted},
+			{OldTreePath: []string{"AppDef", "Types", "sys.DecimalType", "Fields", "C"}, ErrorType: ErrorTypeValueChanged},
+			{OldTreePath: []string{"AppDef", "Types", "sys.DecimalType", "Fields", "D"}, ErrorType: ErrorTypeValueChanged},
+			{OldTreePath: []string{"AppDef", "Types", "sys.AnotherOneTable", "Checks", "sys.AnotherOneTable$checks$Changed"}, ErrorType: ErrorTypeValueChanged},
 			{OldTreePath: []string{"AppDef", "Packages", "pkg1"}, ErrorType: ErrorTypeValueChanged},
 			{OldTreePath: []string{"AppDef", "Packages", "pkg2"}, ErrorType: ErrorTypeValueChanged},
 		}
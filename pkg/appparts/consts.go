@@ -16,6 +16,12 @@ import (
 
 const AppPartitionBorrowRetryDelay = 50 * time.Millisecond
 
+// Tracing
+const (
+	spanNamePrefixInvoke    = "extension.Invoke "
+	spanAttrExtensionEngine = "extension.engine"
+)
+
 // NullSyncActualizerFactory should be used in test only
 var NullSyncActualizerFactory = func(istructs.IAppStructs, istructs.PartitionID) pipeline.ISyncOperator {
 	return &pipeline.NOOP{}
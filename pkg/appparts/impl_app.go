@@ -21,6 +21,7 @@ import (
 	"github.com/voedger/voedger/pkg/iextengine"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/pipeline"
+	"github.com/voedger/voedger/pkg/tracing"
 )
 
 type engines map[appdef.ExtensionEngineKind]iextengine.IExtensionEngine
@@ -314,7 +315,11 @@ func (bp *borrowedPartition) Invoke(ctx context.Context, name appdef.QName, stat
 		return fmt.Errorf("no extension engine for extension kind %s", e.Engine().String())
 	}
 
-	return extEngine.Invoke(ctx, extName, io)
+	ctx, span := tracing.StartChild(ctx, spanNamePrefixInvoke+extName.String(),
+		tracing.WithAttr(spanAttrExtensionEngine, e.Engine().TrimString()))
+	err := extEngine.Invoke(ctx, extName, io)
+	span.End(err)
+	return err
 }
 
 func (bp *borrowedPartition) IsLimitExceeded(resource appdef.QName, operation appdef.OperationKind, workspace istructs.WSID, remoteAddr string) (bool, appdef.QName) {
@@ -15,3 +15,10 @@ const (
 	// how often to warn if the first response is not received yet
 	firstResponseWaitWarningInterval = time.Minute
 )
+
+// Tracing
+const (
+	spanNameSendRequest = "bus.SendRequest"
+	spanAttrResource    = "bus.resource"
+	spanAttrWSID        = "bus.wsid"
+)
@@ -16,9 +16,16 @@ import (
 
 	"github.com/voedger/voedger/pkg/goutils/httpu"
 	"github.com/voedger/voedger/pkg/goutils/logger"
+	"github.com/voedger/voedger/pkg/tracing"
 )
 
 func (rs *implIRequestSender) SendRequest(clientCtx context.Context, req Request) (responseCh <-chan any, responseMeta ResponseMeta, responseErr *error, err error) {
+	// the span covers the hop till the first response
+	clientCtx, span := tracing.StartChild(clientCtx, spanNameSendRequest,
+		tracing.WithAttr(spanAttrResource, req.Resource),
+		tracing.WithAttr(spanAttrWSID, uint64(req.WSID)),
+	)
+	defer func() { span.End(err) }()
 	respWriter := &implResponseWriter{
 		ch:        make(chan any, 1), // buf size 1 to make single write on Respond()
 		clientCtx: clientCtx,
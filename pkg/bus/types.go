@@ -23,6 +23,10 @@ type Request struct {
 	Host     string // client IP address (host only, port stripped) from http.Request.RemoteAddr
 	IsN10N   bool
 
+	// generated by the router or provided by the caller in X-Request-ID header
+	// correlates log records of the request along processors, extensions and federation calls
+	RequestID string
+
 	// apiV2
 	Query          map[string]string
 	QName          appdef.QName // e.g. DocName, extension QName, role Qname, view to subscribe on
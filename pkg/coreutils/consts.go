@@ -18,4 +18,8 @@ const (
 	emailVerificationCodeAlphabet = "1234567890"
 	lowercaseDigitsAlphabet       = "abcdefghijklmnopqrstuvwxyz234567"
 	deviceLoginAndPwdLen          = 26
+	recoveryCodeLen               = 10
 )
+
+// MaskedValue replaces values of masked fields, see WithMask
+const MaskedValue = "***"
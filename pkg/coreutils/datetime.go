@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package coreutils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/datetime"
+)
+
+// Returns stored value of date, time or timestamp data kind for specified time.
+//
+// Panics if kind is not date, time or timestamp
+func DateTimeValue(kind appdef.DataKind, t time.Time) int64 {
+	switch kind {
+	case appdef.DataKind_date:
+		return datetime.Date(t)
+	case appdef.DataKind_time:
+		return datetime.Time(t)
+	case appdef.DataKind_timestamp:
+		return datetime.Timestamp(t)
+	}
+	panic(fmt.Sprintf("unsupported data kind %s for time", kind.TrimString()))
+}
+
+// Returns UTC time from stored value of date, time or timestamp data kind.
+//
+// Panics if kind is not date, time or timestamp
+func DateTimeFromValue(kind appdef.DataKind, v int64) time.Time {
+	switch kind {
+	case appdef.DataKind_date:
+		return datetime.FromDate(v)
+	case appdef.DataKind_time:
+		return datetime.FromTime(v)
+	case appdef.DataKind_timestamp:
+		return datetime.FromTimestamp(v)
+	}
+	panic(fmt.Sprintf("unsupported data kind %s for time", kind.TrimString()))
+}
+
+// Parses ISO-8601 string into stored value of date, time or timestamp data kind.
+//
+// Panics if kind is not date, time or timestamp
+func ParseDateTime(kind appdef.DataKind, s string) (int64, error) {
+	switch kind {
+	case appdef.DataKind_date:
+		return datetime.ParseDate(s)
+	case appdef.DataKind_time:
+		return datetime.ParseTime(s)
+	case appdef.DataKind_timestamp:
+		return datetime.ParseTimestamp(s)
+	}
+	panic(fmt.Sprintf("unsupported data kind %s for time", kind.TrimString()))
+}
+
+// Renders stored value of date, time or timestamp data kind as ISO-8601 string.
+//
+// Panics if kind is not date, time or timestamp
+func FormatDateTime(kind appdef.DataKind, v int64) string {
+	switch kind {
+	case appdef.DataKind_date:
+		return datetime.FormatDate(v)
+	case appdef.DataKind_time:
+		return datetime.FormatTime(v)
+	case appdef.DataKind_timestamp:
+		return datetime.FormatTimestamp(v)
+	}
+	panic(fmt.Sprintf("unsupported data kind %s for time", kind.TrimString()))
+}
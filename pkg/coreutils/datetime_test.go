@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package coreutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/voedger/voedger/pkg/appdef"
+)
+
+func TestDateTime(t *testing.T) {
+	require := require.New(t)
+
+	tm := time.Date(2025, 12, 31, 23, 59, 59, 999_000_000, time.UTC)
+
+	tests := []struct {
+		kind  appdef.DataKind
+		value int64
+		str   string
+		time  time.Time
+	}{
+		{appdef.DataKind_date, 20453, "2025-12-31", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{appdef.DataKind_time, 86399999, "23:59:59.999", time.Date(1970, 1, 1, 23, 59, 59, 999_000_000, time.UTC)},
+		{appdef.DataKind_timestamp, 1767225599999, "2025-12-31T23:59:59.999Z", tm},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind.TrimString(), func(t *testing.T) {
+			require.Equal(tt.value, DateTimeValue(tt.kind, tm))
+			require.Equal(tt.time, DateTimeFromValue(tt.kind, tt.value))
+			require.Equal(tt.str, FormatDateTime(tt.kind, tt.value))
+
+			v, err := ParseDateTime(tt.kind, tt.str)
+			require.NoError(err)
+			require.Equal(tt.value, v)
+
+			_, err = ParseDateTime(tt.kind, "wrong")
+			require.Error(err)
+		})
+	}
+
+	t.Run("should panic if not date, time or timestamp kind", func(t *testing.T) {
+		require.Panics(func() { DateTimeValue(appdef.DataKind_int64, tm) })
+		require.Panics(func() { DateTimeFromValue(appdef.DataKind_int64, 0) })
+		require.Panics(func() { _, _ = ParseDateTime(appdef.DataKind_string, "") })
+		require.Panics(func() { FormatDateTime(appdef.DataKind_string, 0) })
+	})
+}
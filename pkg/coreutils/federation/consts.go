@@ -21,3 +21,9 @@ var (
 	blobCreatePersistentRespRE = regexp.MustCompile(`"blobID":\s*(\d+)`)
 	blobCreateTempRespRE       = regexp.MustCompile(`"blobSUUID":\s*"(.+)"`)
 )
+
+// Tracing
+const (
+	spanNameRequest = "federation.Req"
+	spanAttrURL     = "http.url"
+)
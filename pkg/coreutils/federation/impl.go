@@ -22,6 +22,7 @@ import (
 	"github.com/voedger/voedger/pkg/iblobstorage"
 	"github.com/voedger/voedger/pkg/in10n"
 	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/tracing"
 )
 
 // wrapped ErrUnexpectedStatusCode is returned -> *HTTPResponse contains a valid response body
@@ -49,12 +50,32 @@ func (f *implIFederation) reqFederation(apiPath string, body string, optFuncs ..
 func (f *implIFederation) reqReader(relativeURL string, bodyReader io.Reader, optFuncs ...httpu.ReqOptFunc) (*httpu.HTTPResponse, error) {
 	url := f.federationURL().String() + "/" + relativeURL
 	optFuncs = append(slices.Clone(f.defaultReqOptFuncs), optFuncs...)
-	return f.httpClient.ReqReader(f.vvmCtx, url, bodyReader, optFuncs...)
+	return f.traced(url, optFuncs, func(optFuncs []httpu.ReqOptFunc) (*httpu.HTTPResponse, error) {
+		return f.httpClient.ReqReader(f.vvmCtx, url, bodyReader, optFuncs...)
+	})
 }
 
 func (f *implIFederation) reqURL(url string, body string, optFuncs ...httpu.ReqOptFunc) (*httpu.HTTPResponse, error) {
 	optFuncs = append(slices.Clone(f.defaultReqOptFuncs), optFuncs...)
-	return f.httpClient.Req(f.vvmCtx, url, body, optFuncs...)
+	return f.traced(url, optFuncs, func(optFuncs []httpu.ReqOptFunc) (*httpu.HTTPResponse, error) {
+		return f.httpClient.Req(f.vvmCtx, url, body, optFuncs...)
+	})
+}
+
+// client span is the child of the span from the context provided by tracing.WithTraceContext() option
+// the traceparent header is sent to the target VVM
+func (f *implIFederation) traced(url string, optFuncs []httpu.ReqOptFunc,
+	req func(optFuncs []httpu.ReqOptFunc) (*httpu.HTTPResponse, error)) (*httpu.HTTPResponse, error) {
+	if !f.tracer.Enabled() {
+		return req(optFuncs)
+	}
+	var span tracing.ISpan
+	optFuncs = append(optFuncs, tracing.WithClientSpan(f.tracer, &span, spanNameRequest, tracing.WithAttr(spanAttrURL, url)))
+	resp, err := req(optFuncs)
+	if span != nil {
+		span.End(err)
+	}
+	return resp, err
 }
 
 func (f *implIFederation) UploadTempBLOB(appQName appdef.AppQName, wsid istructs.WSID, blobReader iblobstorage.BLOBReader, duration iblobstorage.DurationType,
@@ -181,8 +202,7 @@ func (f *implIFederation) Query(relativeURL string, optFuncs ...httpu.ReqOptFunc
 func (f *implIFederation) AdminFunc(relativeURL string, body string, optFuncs ...httpu.ReqOptFunc) (*FuncResponse, error) {
 	optFuncs = append(optFuncs, httpu.WithMethod(http.MethodPost))
 	url := fmt.Sprintf("http://127.0.0.1:%d/%s", f.adminPortGetter(), relativeURL)
-	optFuncs = append(slices.Clone(f.defaultReqOptFuncs), optFuncs...)
-	httpResp, err := f.httpClient.Req(f.vvmCtx, url, body, optFuncs...)
+	httpResp, err := f.reqURL(url, body, optFuncs...)
 	return HTTPRespToFuncResp(httpResp, err)
 }
 
@@ -300,5 +320,6 @@ func (f *implIFederation) WithRetry() IFederationWithRetry {
 		},
 		vvmCtx:                 f.vvmCtx,
 		policyOptsForWithRetry: f.policyOptsForWithRetry,
+		tracer:                 f.tracer,
 	}
 }
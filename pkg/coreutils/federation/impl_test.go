@@ -21,6 +21,7 @@ import (
 	"github.com/voedger/voedger/pkg/goutils/httpu"
 	"github.com/voedger/voedger/pkg/goutils/testingu/require"
 	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/tracing"
 )
 
 func TestFederationFunc(t *testing.T) {
@@ -48,7 +49,7 @@ func TestFederationFunc(t *testing.T) {
 	require.NoError(err)
 	federation, cleanup := New(context.Background(), func() *url.URL {
 		return federationURL
-	}, coreutils.NilAdminPortGetter, httpu.DefaultRetryPolicyOpts)
+	}, coreutils.NilAdminPortGetter, httpu.DefaultRetryPolicyOpts, tracing.NewNoopTracer())
 	defer cleanup()
 
 	t.Run("basic", func(t *testing.T) {
@@ -197,7 +198,7 @@ func TestFederationFunc(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		federation, cleanup := New(ctx, func() *url.URL {
 			return federationURL
-		}, coreutils.NilAdminPortGetter, httpu.DefaultRetryPolicyOpts)
+		}, coreutils.NilAdminPortGetter, httpu.DefaultRetryPolicyOpts, tracing.NewNoopTracer())
 		defer cleanup()
 		counter := 0
 		handler = func(w http.ResponseWriter, _ *http.Request) {
@@ -219,7 +220,7 @@ func TestPanicOnGETAndDiscardResponse(t *testing.T) {
 	require.NoError(err)
 	federation, cleanup := New(context.Background(), func() *url.URL {
 		return federationURL
-	}, coreutils.NilAdminPortGetter, httpu.DefaultRetryPolicyOpts)
+	}, coreutils.NilAdminPortGetter, httpu.DefaultRetryPolicyOpts, tracing.NewNoopTracer())
 	defer cleanup()
 
 	require.Panics(func() {
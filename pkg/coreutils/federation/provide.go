@@ -10,9 +10,12 @@ import (
 	"net/url"
 
 	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/tracing"
 )
 
-func New(vvmCtx context.Context, federationURL func() *url.URL, adminPortGetter func() int, policyForWithRetry PolicyOptsForWithRetry) (federation IFederation, cleanup func()) {
+// tracer: use tracing.NewNoopTracer() if tracing is not needed
+func New(vvmCtx context.Context, federationURL func() *url.URL, adminPortGetter func() int, policyForWithRetry PolicyOptsForWithRetry,
+	tracer tracing.ITracer) (federation IFederation, cleanup func()) {
 	httpClient, cln := httpu.NewIHTTPClient(
 		httpu.WithNoRetryPolicy(),
 		httpu.WithOptsValidator(httpu.DenyGETAndDiscardResponse), // to prevent discarding possible sys.Error
@@ -23,6 +26,7 @@ func New(vvmCtx context.Context, federationURL func() *url.URL, adminPortGetter
 		adminPortGetter:        adminPortGetter,
 		vvmCtx:                 vvmCtx,
 		policyOptsForWithRetry: policyForWithRetry,
+		tracer:                 tracer,
 	}
 	return fed, cln
 }
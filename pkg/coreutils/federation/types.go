@@ -11,6 +11,7 @@ import (
 
 	"github.com/voedger/voedger/pkg/goutils/httpu"
 	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/tracing"
 )
 
 type implIFederation struct {
@@ -20,6 +21,7 @@ type implIFederation struct {
 	defaultReqOptFuncs     []httpu.ReqOptFunc
 	vvmCtx                 context.Context
 	policyOptsForWithRetry PolicyOptsForWithRetry
+	tracer                 tracing.ITracer
 }
 
 type OffsetsChan chan istructs.Offset
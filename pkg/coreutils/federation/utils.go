@@ -24,6 +24,16 @@ import (
 	"github.com/voedger/voedger/pkg/istructs"
 )
 
+// WithRequestIDFrom sends the request ID taken from the log attributes of ctx in X-Request-ID header
+// so that log records of the called function are correlated with the calling request
+func WithRequestIDFrom(ctx context.Context) httpu.ReqOptFunc {
+	return func(opts httpu.IReqOpts) {
+		if reqID, ok := logger.CtxAttr(ctx, logger.LogAttr_ReqID); ok {
+			opts.Append(httpu.WithHeaders(httpu.XRequestID, fmt.Sprint(reqID)))
+		}
+	}
+}
+
 // launches listening for sse events from body reader in a separate goroutine
 // returns when the first sse packet with channelID is came from the server, i.e. when the subscribing is actually done
 // if caller side unsubscribes from events, then it must:
@@ -57,6 +67,9 @@ func ListenSSEEvents(ctx context.Context, body io.Reader) (offsetsChan OffsetsCh
 			if event == "channelId" {
 				channelID = in10n.ChannelID(data)
 				close(subscribed)
+			} else if event == in10n.SSEEvent_Payload {
+				// payloads are not forwarded, the offset event of the same projection is already handled
+				continue
 			} else {
 				offset, err := strconvu.ParseUint64(data)
 				if err != nil {
@@ -12,6 +12,8 @@ import (
 	"math"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/datetime"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 )
 
@@ -89,6 +91,24 @@ func ClarifyJSONNumber(value json.Number, kind appdef.DataKind) (val interface{}
 			return nil, errNumberOverflow(value, kind.TrimString())
 		}
 		return istructs.RecordID(int64Val), nil
+	case appdef.DataKind_decimal:
+		d, err := decimal.Parse(string(value))
+		if err != nil {
+			return nil, errFailedToCast(value, kind.TrimString(), err)
+		}
+		return d, nil
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		// stored value, e.g. Unix milliseconds for timestamp
+		int64Val, err := value.Int64()
+		if err != nil {
+			return nil, errFailedToCast(value, kind.TrimString(), err)
+		}
+		if kind == appdef.DataKind_time {
+			if err := datetime.CheckTime(int64Val); err != nil {
+				return nil, errFailedToCast(value, kind.TrimString(), err)
+			}
+		}
+		return int64Val, nil
 	}
 	panic(fmt.Sprintf("unsupported data kind %s for json.Number", kind.TrimString()))
 }
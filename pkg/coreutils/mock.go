@@ -11,9 +11,12 @@ package coreutils
 import (
 	"context"
 	"encoding/json"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 )
 
@@ -42,9 +45,27 @@ func (m *MockCUDRow) ID() istructs.RecordID              { return m.Called().Get
 func (m *MockCUDRow) SpecifiedValues(cb func(appdef.IField, any) bool) {
 	m.Called(cb)
 }
+func (m *MockCUDRow) Origin() istructs.IRowReader {
+	if r := m.Called().Get(0); r != nil {
+		return r.(istructs.IRowReader)
+	}
+	return nil
+}
 func (m *MockCUDRow) AsRecordID(name appdef.FieldName) istructs.RecordID {
 	return m.Called(name).Get(0).(istructs.RecordID)
 }
+func (m *MockCUDRow) AsDecimal(name appdef.FieldName) decimal.Decimal {
+	return m.Called(name).Get(0).(decimal.Decimal)
+}
+func (m *MockCUDRow) AsTime(name appdef.FieldName) time.Time {
+	return m.Called(name).Get(0).(time.Time)
+}
+func (m *MockCUDRow) AsUUID(name appdef.FieldName) uuid.UUID {
+	return m.Called(name).Get(0).(uuid.UUID)
+}
+func (m *MockCUDRow) AsJSONPath(name appdef.FieldName, path string) any {
+	return m.Called(name, path).Get(0)
+}
 func (m *MockCUDRow) RecordIDs(includeNulls bool) func(func(appdef.FieldName, istructs.RecordID) bool) {
 	return m.Called(includeNulls).Get(0).(func(func(appdef.FieldName, istructs.RecordID) bool))
 }
@@ -110,6 +131,18 @@ func (m *MockObject) Fields(cb func(appdef.IField) bool) { m.Called(cb) }
 func (m *MockObject) AsRecordID(name appdef.FieldName) istructs.RecordID {
 	return m.Called(name).Get(0).(istructs.RecordID)
 }
+func (m *MockObject) AsDecimal(name appdef.FieldName) decimal.Decimal {
+	return m.Called(name).Get(0).(decimal.Decimal)
+}
+func (m *MockObject) AsTime(name appdef.FieldName) time.Time {
+	return m.Called(name).Get(0).(time.Time)
+}
+func (m *MockObject) AsUUID(name appdef.FieldName) uuid.UUID {
+	return m.Called(name).Get(0).(uuid.UUID)
+}
+func (m *MockObject) AsJSONPath(name appdef.FieldName, path string) any {
+	return m.Called(name, path).Get(0)
+}
 func (m *MockObject) RecordIDs(includeNulls bool) func(func(appdef.FieldName, istructs.RecordID) bool) {
 	return m.Called(includeNulls).Get(0).(func(func(appdef.FieldName, istructs.RecordID) bool))
 }
@@ -226,6 +259,15 @@ func (m *MockStateKeyBuilder) PutBool(name appdef.FieldName, value bool) {
 func (m *MockStateKeyBuilder) PutRecordID(name appdef.FieldName, value istructs.RecordID) {
 	m.Called(name, value)
 }
+func (m *MockStateKeyBuilder) PutDecimal(name appdef.FieldName, value decimal.Decimal) {
+	m.Called(name, value)
+}
+func (m *MockStateKeyBuilder) PutTime(name appdef.FieldName, value time.Time) {
+	m.Called(name, value)
+}
+func (m *MockStateKeyBuilder) PutUUID(name appdef.FieldName, value uuid.UUID) {
+	m.Called(name, value)
+}
 func (m *MockStateKeyBuilder) PutNumber(name appdef.FieldName, value json.Number) {
 	m.Called(name, value)
 }
@@ -285,6 +327,18 @@ func (m *MockStateValue) AsBool(name appdef.FieldName) bool { return m.Called(na
 func (m *MockStateValue) AsRecordID(name appdef.FieldName) istructs.RecordID {
 	return m.Called(name).Get(0).(istructs.RecordID)
 }
+func (m *MockStateValue) AsDecimal(name appdef.FieldName) decimal.Decimal {
+	return m.Called(name).Get(0).(decimal.Decimal)
+}
+func (m *MockStateValue) AsTime(name appdef.FieldName) time.Time {
+	return m.Called(name).Get(0).(time.Time)
+}
+func (m *MockStateValue) AsUUID(name appdef.FieldName) uuid.UUID {
+	return m.Called(name).Get(0).(uuid.UUID)
+}
+func (m *MockStateValue) AsJSONPath(name appdef.FieldName, path string) any {
+	return m.Called(name, path).Get(0)
+}
 func (m *MockStateValue) RecordIDs(includeNulls bool) func(func(appdef.FieldName, istructs.RecordID) bool) {
 	return m.Called(includeNulls).Get(0).(func(func(appdef.FieldName, istructs.RecordID) bool))
 }
@@ -354,6 +408,15 @@ func (m *MockStateValueBuilder) PutBool(name appdef.FieldName, value bool) {
 func (m *MockStateValueBuilder) PutRecordID(name appdef.FieldName, value istructs.RecordID) {
 	m.Called(name, value)
 }
+func (m *MockStateValueBuilder) PutDecimal(name appdef.FieldName, value decimal.Decimal) {
+	m.Called(name, value)
+}
+func (m *MockStateValueBuilder) PutTime(name appdef.FieldName, value time.Time) {
+	m.Called(name, value)
+}
+func (m *MockStateValueBuilder) PutUUID(name appdef.FieldName, value uuid.UUID) {
+	m.Called(name, value)
+}
 func (m *MockStateValueBuilder) PutNumber(name appdef.FieldName, value json.Number) {
 	m.Called(name, value)
 }
@@ -427,6 +490,18 @@ func (m *MockKey) Fields(cb func(appdef.IField) bool) { m.Called(cb) }
 func (m *MockKey) AsRecordID(name appdef.FieldName) istructs.RecordID {
 	return m.Called(name).Get(0).(istructs.RecordID)
 }
+func (m *MockKey) AsDecimal(name appdef.FieldName) decimal.Decimal {
+	return m.Called(name).Get(0).(decimal.Decimal)
+}
+func (m *MockKey) AsTime(name appdef.FieldName) time.Time {
+	return m.Called(name).Get(0).(time.Time)
+}
+func (m *MockKey) AsUUID(name appdef.FieldName) uuid.UUID {
+	return m.Called(name).Get(0).(uuid.UUID)
+}
+func (m *MockKey) AsJSONPath(name appdef.FieldName, path string) any {
+	return m.Called(name, path).Get(0)
+}
 func (m *MockKey) RecordIDs(includeNulls bool) func(func(appdef.FieldName, istructs.RecordID) bool) {
 	return m.Called(includeNulls).Get(0).(func(func(appdef.FieldName, istructs.RecordID) bool))
 }
@@ -5,12 +5,18 @@
 package coreutils
 
 import (
+	"encoding/json"
 	"maps"
 	"fmt"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/goutils/logger"
 	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/jsonpath"
 )
 
 // panics on an unsupported kind guessing that pair <name, kind> could be taken from IDef.Fields() callback only
@@ -44,6 +50,17 @@ func ReadByKind(name appdef.FieldName, kind appdef.DataKind, rr istructs.IRowRea
 		return rr.AsQName(name) // not .String(), see https://github.com/voedger/voedger/issues/3477
 	case appdef.DataKind_bool:
 		return rr.AsBool(name)
+	case appdef.DataKind_decimal:
+		return rr.AsDecimal(name)
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		return FormatDateTime(kind, rr.AsInt64(name))
+	case appdef.DataKind_uuid:
+		return rr.AsUUID(name).String()
+	case appdef.DataKind_json:
+		if s := rr.AsString(name); len(s) > 0 {
+			return json.RawMessage(s)
+		}
+		return nil
 	default:
 		panic("unsupported kind " + kind.String() + " for field " + name)
 	}
@@ -51,6 +68,7 @@ func ReadByKind(name appdef.FieldName, kind appdef.DataKind, rr istructs.IRowRea
 
 type mapperOpts struct {
 	filter    func(name string, kind appdef.DataKind) bool
+	mask      func(qName appdef.QName, name string) bool
 	allFields bool
 }
 
@@ -62,6 +80,13 @@ func Filter(filterFunc func(name string, kind appdef.DataKind) bool) MapperOpt {
 	}
 }
 
+// values of fields for which maskFunc returns true will be replaced by MaskedValue
+func WithMask(maskFunc func(qName appdef.QName, name string) bool) MapperOpt {
+	return func(opts *mapperOpts) {
+		opts.mask = maskFunc
+	}
+}
+
 // will run on all fields independing on wether is has value or not
 // zero values will be emitted for fields that has no value
 func WithAllFields() MapperOpt {
@@ -90,6 +115,10 @@ func FieldsToMap(obj istructs.IRowReader, appDef appdef.IAppDef, optFuncs ...Map
 				return
 			}
 		}
+		if opts.mask != nil && opts.mask(qn, fieldName) {
+			res[fieldName] = MaskedValue
+			return
+		}
 		if kind == appdef.DataKind_Record {
 			v, ok := obj.(istructs.IValue)
 			if !ok {
@@ -119,6 +148,9 @@ func FieldsToMap(obj istructs.IRowReader, appDef appdef.IAppDef, optFuncs ...Map
 						return true
 					}
 				}
+				if opts.mask != nil && opts.mask(qn, iField.Name()) {
+					val = MaskedValue
+				}
 				res[iField.Name()] = val
 				return true
 			})
@@ -149,6 +181,7 @@ func ObjectToMap(obj istructs.IObject, appDef appdef.IAppDef, opts ...MapperOpt)
 
 type cudsOpts struct {
 	filter     func(appdef.QName) bool
+	rowFilter  func(istructs.ICUDRow) bool
 	mapperOpts []MapperOpt
 }
 
@@ -160,6 +193,13 @@ func WithFilter(filterFunc func(appdef.QName) bool) CUDsOpt {
 	}
 }
 
+// CUD rows, which are not accepted by filterFunc, are skipped
+func WithRowFilter(filterFunc func(istructs.ICUDRow) bool) CUDsOpt {
+	return func(co *cudsOpts) {
+		co.rowFilter = filterFunc
+	}
+}
+
 func WithMapperOpts(opts ...MapperOpt) CUDsOpt {
 	return func(co *cudsOpts) {
 		co.mapperOpts = opts
@@ -176,6 +216,9 @@ func CUDsToMap(event istructs.IDbEvent, appDef appdef.IAppDef, optFuncs ...CUDsO
 		if opts.filter != nil && !opts.filter(rec.QName()) {
 			continue
 		}
+		if opts.rowFilter != nil && !opts.rowFilter(rec) {
+			continue
+		}
 		cudData := make(map[string]interface{})
 		cudData["sys.ID"] = rec.ID()
 		cudData["sys.QName"] = rec.QName().String()
@@ -216,18 +259,38 @@ func CheckValueByKind(val interface{}, kind appdef.DataKind) error {
 	case bool:
 		ok = kind == appdef.DataKind_bool
 	case string:
-		if kind == appdef.DataKind_QName {
+		switch kind {
+		case appdef.DataKind_QName:
 			_, err := appdef.ParseQName(typed)
 			ok = err == nil
-		} else {
+		case appdef.DataKind_decimal:
+			_, err := decimal.Parse(typed)
+			ok = err == nil
+		case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+			_, err := ParseDateTime(kind, typed)
+			ok = err == nil
+		case appdef.DataKind_uuid:
+			_, err := uuid.Parse(typed)
+			ok = err == nil
+		case appdef.DataKind_json:
+			ok = jsonpath.Valid(typed)
+		default:
 			ok = kind == appdef.DataKind_string
 		}
 	case []byte:
-		ok = kind == appdef.DataKind_bytes
+		ok = kind == appdef.DataKind_bytes || kind == appdef.DataKind_uuid && len(typed) == len(uuid.UUID{})
 	case istructs.RecordID:
 		ok = kind == appdef.DataKind_RecordID || kind == appdef.DataKind_int64
 	case appdef.QName:
 		ok = kind == appdef.DataKind_QName
+	case decimal.Decimal:
+		ok = kind == appdef.DataKind_decimal
+	case time.Time:
+		ok = kind == appdef.DataKind_date || kind == appdef.DataKind_time || kind == appdef.DataKind_timestamp
+	case uuid.UUID:
+		ok = kind == appdef.DataKind_uuid
+	case map[string]any, []any, json.RawMessage:
+		ok = kind == appdef.DataKind_json
 	}
 	if !ok {
 		return fmt.Errorf("provided value %v has type %T but %s is expected: %w", val, val, kind.String(), appdef.ErrInvalidError)
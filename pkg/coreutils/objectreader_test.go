@@ -9,6 +9,7 @@ import (
 	"maps"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	"github.com/voedger/voedger/pkg/appdef"
@@ -154,6 +155,34 @@ func TestToMap_Filter(t *testing.T) {
 	})
 }
 
+func TestToMap_Mask(t *testing.T) {
+	require := require.New(t)
+	obj := &TestObject{
+		Name: testQName,
+		ID_:  42,
+		Data: testData,
+	}
+
+	mask := WithMask(func(qName appdef.QName, name string) bool {
+		require.Equal(testQName, qName)
+		return name == "string"
+	})
+
+	appDef := testAppDef(t)
+
+	t.Run("specified values", func(t *testing.T) {
+		m := FieldsToMap(obj, appDef, mask)
+		require.Equal(MaskedValue, m["string"])
+		require.Equal(true, m["bool"])
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		m := ObjectToMap(obj, appDef, mask, WithAllFields())
+		require.Equal(MaskedValue, m["string"])
+		require.Equal(true, m["bool"])
+	})
+}
+
 func TestReadValue(t *testing.T) {
 	require := require.New(t)
 
@@ -236,6 +265,12 @@ func TestCheckValueByKind(t *testing.T) {
 		{istructs.RecordID(10), appdef.DataKind_RecordID},
 		{int64(11), appdef.DataKind_RecordID},
 		{appdef.NewQName("1", "1"), appdef.DataKind_QName},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", appdef.DataKind_uuid},
+		{uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479"), appdef.DataKind_uuid},
+		{make([]byte, 16), appdef.DataKind_uuid},
+		{`{"theme":"dark"}`, appdef.DataKind_json},
+		{map[string]any{"theme": "dark"}, appdef.DataKind_json},
+		{[]any{1, 2}, appdef.DataKind_json},
 	}
 	for _, c := range okCases {
 		t.Run(fmt.Sprintf("%v", c.val), func(t *testing.T) {
@@ -243,6 +278,10 @@ func TestCheckValueByKind(t *testing.T) {
 		})
 	}
 
+	t.Run("invalid json", func(t *testing.T) {
+		require.Error(t, CheckValueByKind(`{"theme":`, appdef.DataKind_json))
+	})
+
 	t.Run("not ok", func(t *testing.T) {
 		for kind := appdef.DataKind(1); kind < appdef.DataKind_FakeLast; kind++ {
 			t.Run(kind.String(), func(t *testing.T) {
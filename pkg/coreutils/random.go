@@ -9,8 +9,15 @@ import "crypto/rand"
 
 func DeviceRandomLoginPwd() (login, pwd string) {
 	login = "device" + randomString(lowercaseDigitsAlphabet, deviceLoginAndPwdLen)
-	pwd = randomString(lowercaseDigitsAlphabet, deviceLoginAndPwdLen)
-	return login, pwd
+	return login, RandomPwd()
+}
+
+func RandomPwd() string {
+	return randomString(lowercaseDigitsAlphabet, deviceLoginAndPwdLen)
+}
+
+func RecoveryCode() string {
+	return randomString(lowercaseDigitsAlphabet, recoveryCodeLen)
 }
 
 func EmailVerificationCode() (verificationCode string) {
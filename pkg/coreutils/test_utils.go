@@ -7,9 +7,14 @@ package coreutils
 import (
 	"encoding/json"
 	"maps"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/jsonpath"
 )
 
 const TooBigNumberStr = "1111111111111111111111111111111111999999999999999999999999999111111111111111111111111111111111111111119999999999999999999999999991111111111111111111111111111111111111111199999999999999999999999999911111111111111111111111111111111111111111999999999999999999999999999111111111111111111111111111111111111111119999999999999999999999999991111111"
@@ -23,6 +28,7 @@ type TestObject struct {
 	Data        map[string]interface{}
 	Containers_ map[string][]*TestObject
 	IsNew_      bool
+	Origin_     istructs.IRowReader
 }
 
 type TestValue struct {
@@ -48,6 +54,9 @@ func (o *TestObject) PutQName(name string, value appdef.QName)         { o.Data[
 func (o *TestObject) PutBool(name string, value bool)                  { o.Data[name] = value }
 func (o *TestObject) PutRecordID(name string, value istructs.RecordID) { o.Data[name] = value }
 func (o *TestObject) PutNumber(name string, value json.Number)         { o.Data[name] = value }
+func (o *TestObject) PutDecimal(name string, value decimal.Decimal)    { o.Data[name] = value }
+func (o *TestObject) PutTime(name string, value time.Time)             { o.Data[name] = value }
+func (o *TestObject) PutUUID(name string, value uuid.UUID)             { o.Data[name] = value }
 func (o *TestObject) PutChars(name string, value string)               { o.Data[name] = value }
 func (o *TestObject) PutFromJSON(value map[string]any)                 { maps.Copy(o.Data, value) }
 
@@ -84,6 +93,8 @@ func (o *TestObject) IsDeactivated() bool {
 
 func (o *TestObject) IsNew() bool { return o.IsNew_ }
 
+func (o *TestObject) Origin() istructs.IRowReader { return o.Origin_ }
+
 func (o *TestObject) SpecifiedValues(cb func(appdef.IField, any) bool) {
 	if !cb(&MockIField{FieldName: appdef.SystemField_ID, FieldDataKind: appdef.DataKind_RecordID}, o.ID_) {
 		return
@@ -242,6 +253,52 @@ func (o *TestObject) AsRecordID(name string) istructs.RecordID {
 	}
 	return istructs.NullRecordID
 }
+func (o *TestObject) AsDecimal(name string) decimal.Decimal {
+	if resIntf, ok := o.Data[name]; ok {
+		switch v := resIntf.(type) {
+		case string:
+			return decimal.MustParse(v)
+		case json.Number:
+			return decimal.MustParse(string(v))
+		}
+		return resIntf.(decimal.Decimal)
+	}
+	return decimal.Decimal{}
+}
+func (o *TestObject) AsTime(name string) time.Time {
+	if resIntf, ok := o.Data[name]; ok {
+		return resIntf.(time.Time)
+	}
+	return time.Time{}
+}
+func (o *TestObject) AsUUID(name string) uuid.UUID {
+	if resIntf, ok := o.Data[name]; ok {
+		if s, ok := resIntf.(string); ok {
+			return uuid.MustParse(s)
+		}
+		return resIntf.(uuid.UUID)
+	}
+	return uuid.Nil
+}
+func (o *TestObject) AsJSONPath(name string, path string) any {
+	resIntf, ok := o.Data[name]
+	if !ok {
+		return nil
+	}
+	if s, ok := resIntf.(string); ok {
+		res, _, err := jsonpath.Get(s, path)
+		if err != nil {
+			panic(err)
+		}
+		return res
+	}
+	p, err := jsonpath.Parse(path)
+	if err != nil {
+		panic(err)
+	}
+	res, _ := p.Value(resIntf)
+	return res
+}
 func (o *TestObject) Children(container ...string) func(func(istructs.IObject) bool) {
 	cc := make(map[string]bool)
 	for _, c := range container {
@@ -280,6 +337,12 @@ func intfToDataKind(value interface{}) appdef.DataKind {
 		return appdef.DataKind_bool
 	case appdef.QName:
 		return appdef.DataKind_QName
+	case decimal.Decimal:
+		return appdef.DataKind_decimal
+	case time.Time:
+		return appdef.DataKind_timestamp
+	case uuid.UUID:
+		return appdef.DataKind_uuid
 	case map[string]interface{}:
 		return appdef.DataKind_Record
 	default:
@@ -303,6 +366,7 @@ func (f *MockIField) DataKind() appdef.DataKind                     { return f.F
 func (f *MockIField) Required() bool                                { return false }
 func (f *MockIField) Verifiable() bool                              { panic(notImplemented) }
 func (f *MockIField) VerificationKind(appdef.VerificationKind) bool { panic(notImplemented) }
+func (f *MockIField) Encrypted() bool                               { panic(notImplemented) }
 func (f *MockIField) IsFixedWidth() bool                            { panic(notImplemented) }
 func (f *MockIField) IsSys() bool                                   { panic(notImplemented) }
 func (f *MockIField) Constraints() map[appdef.ConstraintKind]appdef.IConstraint {
@@ -0,0 +1,30 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package datetime
+
+import "time"
+
+// ISO-8601 calendar date layout, like "2025-12-31"
+const DateLayout = time.DateOnly
+
+// ISO-8601 time of day layout with milliseconds, like "23:59:59.999".
+//
+// Fixed width keeps lexicographical order of formatted values
+const TimeLayout = "15:04:05.000"
+
+// ISO-8601 (RFC 3339) date and time layout with milliseconds, like "2025-12-31T23:59:59.999Z".
+//
+// Fixed width keeps lexicographical order of formatted UTC values
+const TimestampLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// Count of milliseconds in day. Time values are in range [0, MillisPerDay)
+const MillisPerDay int64 = 24 * 60 * 60 * 1000
+
+const (
+	secondsPerDay       = 24 * 60 * 60
+	shortTimeLayout     = "15:04"
+	timeParseLayout     = "15:04:05.999999999"
+	nanosecondsPerMilli = int64(time.Millisecond)
+)
@@ -0,0 +1,13 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package datetime
+
+import "errors"
+
+var ErrInvalidDate = errors.New("invalid date value")
+
+var ErrInvalidTime = errors.New("invalid time value")
+
+var ErrInvalidTimestamp = errors.New("invalid timestamp value")
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package datetime
+
+import (
+	"fmt"
+	"time"
+)
+
+// Returns date value, i.e. count of days since 1970-01-01, of calendar date of specified time.
+//
+// Time location is kept, i.e. 2025-12-31T23:00:00-02:00 is 2025-12-31.
+func Date(t time.Time) int64 {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay
+}
+
+// Returns time value, i.e. count of milliseconds since midnight, of clock of specified time.
+//
+// Time location is kept, i.e. 2025-12-31T23:00:00-02:00 is 23:00:00.
+func Time(t time.Time) int64 {
+	h, m, s := t.Clock()
+	return (int64(h)*60*60+int64(m)*60+int64(s))*1000 + int64(t.Nanosecond())/nanosecondsPerMilli
+}
+
+// Returns timestamp value, i.e. count of milliseconds since Unix epoch, of specified time
+func Timestamp(t time.Time) int64 {
+	return t.UnixMilli()
+}
+
+// Returns UTC midnight of specified date value
+func FromDate(days int64) time.Time {
+	return time.Unix(days*secondsPerDay, 0).UTC()
+}
+
+// Returns UTC time of 1970-01-01 with clock of specified time value
+func FromTime(millis int64) time.Time {
+	return time.UnixMilli(millis).UTC()
+}
+
+// Returns UTC time of specified timestamp value
+func FromTimestamp(millis int64) time.Time {
+	return time.UnixMilli(millis).UTC()
+}
+
+// Returns error if specified time value is out of day range [0, MillisPerDay)
+func CheckTime(millis int64) error {
+	if (millis < 0) || (millis >= MillisPerDay) {
+		return fmt.Errorf("%w: %d ms out of day range", ErrInvalidTime, millis)
+	}
+	return nil
+}
+
+// Parses date value from ISO-8601 calendar date string, like "2025-12-31"
+func ParseDate(s string) (int64, error) {
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidDate, err)
+	}
+	return Date(t), nil
+}
+
+// Parses time value from ISO-8601 time of day string, like "23:59", "23:59:59" or "23:59:59.999"
+func ParseTime(s string) (int64, error) {
+	t, err := time.Parse(timeParseLayout, s)
+	if err != nil {
+		if t, err = time.Parse(shortTimeLayout, s); err != nil {
+			return 0, fmt.Errorf("%w: %w", ErrInvalidTime, err)
+		}
+	}
+	return Time(t), nil
+}
+
+// Parses timestamp value from RFC 3339 date and time string, like "2025-12-31T23:59:59.999Z"
+func ParseTimestamp(s string) (int64, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidTimestamp, err)
+	}
+	return Timestamp(t), nil
+}
+
+// Renders date value as ISO-8601 calendar date string, like "2025-12-31"
+func FormatDate(days int64) string {
+	return FromDate(days).Format(DateLayout)
+}
+
+// Renders time value as ISO-8601 time of day string, like "23:59:59.999"
+func FormatTime(millis int64) string {
+	return FromTime(millis).Format(TimeLayout)
+}
+
+// Renders timestamp value as RFC 3339 UTC date and time string, like "2025-12-31T23:59:59.999Z"
+func FormatTimestamp(millis int64) string {
+	return FromTimestamp(millis).Format(TimestampLayout)
+}
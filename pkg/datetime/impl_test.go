@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDate(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		s    string
+		days int64
+	}{
+		{"1970-01-01", 0},
+		{"1970-01-02", 1},
+		{"1969-12-31", -1},
+		{"2025-12-31", 20453},
+		{"0001-01-01", -719162},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			days, err := ParseDate(tt.s)
+			require.NoError(err)
+			require.Equal(tt.days, days)
+			require.Equal(tt.s, FormatDate(days))
+			require.Equal(days, Date(FromDate(days)))
+		})
+	}
+
+	t.Run("should keep calendar date of time location", func(t *testing.T) {
+		loc := time.FixedZone("UTC-2", -2*60*60)
+		days := Date(time.Date(2025, 12, 31, 23, 0, 0, 0, loc))
+		require.Equal("2025-12-31", FormatDate(days))
+	})
+
+	t.Run("should be error if invalid date", func(t *testing.T) {
+		for _, s := range []string{"", "2025-13-01", "2025-02-30", "2025-12-31T00:00:00Z", "31.12.2025"} {
+			_, err := ParseDate(s)
+			require.ErrorIs(err, ErrInvalidDate, s)
+		}
+	})
+}
+
+func TestTime(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		s      string
+		millis int64
+		str    string
+	}{
+		{"00:00", 0, "00:00:00.000"},
+		{"00:00:00", 0, "00:00:00.000"},
+		{"00:00:00.001", 1, "00:00:00.001"},
+		{"12:30:15.5", 45015500, "12:30:15.500"},
+		{"23:59:59.999", MillisPerDay - 1, "23:59:59.999"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			millis, err := ParseTime(tt.s)
+			require.NoError(err)
+			require.Equal(tt.millis, millis)
+			require.Equal(tt.str, FormatTime(millis))
+			require.NoError(CheckTime(millis))
+		})
+	}
+
+	t.Run("should be error if invalid time", func(t *testing.T) {
+		for _, s := range []string{"", "24:00", "12:60:00", "12-30-00", "2025-12-31"} {
+			_, err := ParseTime(s)
+			require.ErrorIs(err, ErrInvalidTime, s)
+		}
+	})
+
+	t.Run("should be error if time out of day range", func(t *testing.T) {
+		require.ErrorIs(CheckTime(-1), ErrInvalidTime)
+		require.ErrorIs(CheckTime(MillisPerDay), ErrInvalidTime)
+	})
+}
+
+func TestTimestamp(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		s      string
+		millis int64
+		str    string
+	}{
+		{"1970-01-01T00:00:00Z", 0, "1970-01-01T00:00:00.000Z"},
+		{"2025-12-31T23:59:59.999Z", 1767225599999, "2025-12-31T23:59:59.999Z"},
+		{"2026-01-01T01:59:59.999+02:00", 1767225599999, "2025-12-31T23:59:59.999Z"},
+		{"1969-12-31T23:59:59.9Z", -100, "1969-12-31T23:59:59.900Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			millis, err := ParseTimestamp(tt.s)
+			require.NoError(err)
+			require.Equal(tt.millis, millis)
+			require.Equal(tt.str, FormatTimestamp(millis))
+			require.Equal(millis, Timestamp(FromTimestamp(millis)))
+		})
+	}
+
+	t.Run("should be error if invalid timestamp", func(t *testing.T) {
+		for _, s := range []string{"", "2025-12-31", "2025-12-31T23:59:59", "2025-12-31 23:59:59Z"} {
+			_, err := ParseTimestamp(s)
+			require.ErrorIs(err, ErrInvalidTimestamp, s)
+		}
+	})
+}
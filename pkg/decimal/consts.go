@@ -0,0 +1,18 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package decimal
+
+// Maximum decimal precision, i.e. total count of significant digits.
+//
+// Unscaled decimal value is stored as int64, so precision can not exceed 18 digits.
+const MaxPrecision = 18
+
+// Default precision of decimal data type declared without precision, i.e. `decimal`
+const DefaultPrecision = MaxPrecision
+
+// Default scale of decimal data type declared without scale, i.e. `decimal` or `decimal(10)`
+const DefaultScale = 0
+
+const decimalSeparator = '.'
@@ -0,0 +1,13 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package decimal
+
+import "errors"
+
+var ErrInvalidDecimal = errors.New("invalid decimal value")
+
+var ErrDecimalOverflow = errors.New("decimal value overflow")
+
+var ErrDecimalScaleLoss = errors.New("decimal value can not be rescaled without rounding")
@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Returns unscaled value, e.g. 12345 for 123.45
+func (d Decimal) Unscaled() int64 { return d.unscaled }
+
+// Returns scale, i.e. count of digits after decimal separator, e.g. 2 for 123.45
+func (d Decimal) Scale() uint8 { return d.scale }
+
+// Returns count of significant digits of unscaled value, e.g. 5 for 123.45 and 0 for zero
+func (d Decimal) Digits() int {
+	n := 0
+	for u := absUnscaled(d.unscaled); u > 0; u /= 10 {
+		n++
+	}
+	return n
+}
+
+// Returns -1 if value is negative, 0 if value is zero and +1 if value is positive
+func (d Decimal) Sign() int {
+	switch {
+	case d.unscaled < 0:
+		return -1
+	case d.unscaled > 0:
+		return 1
+	}
+	return 0
+}
+
+func (d Decimal) IsZero() bool { return d.unscaled == 0 }
+
+// Returns decimal value with specified scale.
+//
+// # Errors:
+//   - ErrDecimalScaleLoss if scale decreases and non-zero digits would be lost
+//   - ErrDecimalOverflow if scale increases and unscaled value overflows
+func (d Decimal) Rescale(scale uint8) (Decimal, error) {
+	if scale > MaxPrecision {
+		return Decimal{}, fmt.Errorf("%w: scale %d is greater than %d", ErrDecimalOverflow, scale, MaxPrecision)
+	}
+	switch {
+	case scale > d.scale:
+		m := pow10[scale-d.scale]
+		if d.unscaled > math.MaxInt64/m || d.unscaled < math.MinInt64/m {
+			return Decimal{}, fmt.Errorf("%w: %v with scale %d", ErrDecimalOverflow, d, scale)
+		}
+		return Decimal{unscaled: d.unscaled * m, scale: scale}, nil
+	case scale < d.scale:
+		m := pow10[d.scale-scale]
+		if d.unscaled%m != 0 {
+			return Decimal{}, fmt.Errorf("%w: %v with scale %d", ErrDecimalScaleLoss, d, scale)
+		}
+		return Decimal{unscaled: d.unscaled / m, scale: scale}, nil
+	}
+	return d, nil
+}
+
+// Compares decimal values. Returns -1 if d < other, 0 if d == other and +1 if d > other.
+func (d Decimal) Cmp(other Decimal) int {
+	a, b := d, other
+	scale := max(a.scale, b.scale)
+	ra, errA := a.Rescale(scale)
+	rb, errB := b.Rescale(scale)
+	if errA != nil || errB != nil {
+		// overflow possible only if values are too far from each other
+		return a.rat().Cmp(b.rat())
+	}
+	switch {
+	case ra.unscaled < rb.unscaled:
+		return -1
+	case ra.unscaled > rb.unscaled:
+		return 1
+	}
+	return 0
+}
+
+// Compares decimal value with float64 value without rounding of decimal to float64.
+// Float64 value is taken as its shortest decimal representation, e.g. 0.1 is exactly 0.1.
+// Returns -1 if d < f, 0 if d == f and +1 if d > f.
+//
+// # Panics:
+//   - if f is NaN
+func (d Decimal) CmpFloat64(f float64) int {
+	switch {
+	case math.IsInf(f, 1):
+		return -1
+	case math.IsInf(f, -1):
+		return 1
+	}
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	if !ok {
+		panic(fmt.Errorf("%w: %v", ErrInvalidDecimal, f))
+	}
+	return d.rat().Cmp(r)
+}
+
+// Returns is decimal values are numerically equal, e.g. 1.5 and 1.50 are equal
+func (d Decimal) Equal(other Decimal) bool { return d.Cmp(other) == 0 }
+
+// Returns nearest float64 value
+func (d Decimal) Float64() float64 {
+	return float64(d.unscaled) / float64(pow10[d.scale])
+}
+
+// Renders decimal value with all scale digits, e.g. "-123.450" for {-123450, 3}
+func (d Decimal) String() string {
+	digits := strconv.FormatUint(absUnscaled(d.unscaled), 10)
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		p := len(digits) - int(d.scale)
+		digits = digits[:p] + string(decimalSeparator) + digits[p:]
+	}
+	if d.unscaled < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Decimal value is marshaled to JSON as string to avoid loss of precision
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// Decimal value is unmarshaled from JSON string or JSON number
+func (d *Decimal) UnmarshalJSON(data []byte) (err error) {
+	s := string(data)
+	if unq, e := strconv.Unquote(s); e == nil {
+		s = unq
+	}
+	*d, err = Parse(s)
+	return err
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) (err error) {
+	*d, err = Parse(string(text))
+	return err
+}
+
+func parse(s string) (Decimal, error) {
+	str := s
+	neg := false
+	if len(str) > 0 && (str[0] == '-' || str[0] == '+') {
+		neg = str[0] == '-'
+		str = str[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(str, string(decimalSeparator))
+	if len(intPart)+len(fracPart) == 0 {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+	}
+	for _, part := range []string{intPart, fracPart} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+			}
+		}
+	}
+	if len(fracPart) > MaxPrecision {
+		return Decimal{}, fmt.Errorf("%w: %q has more than %d digits after decimal separator", ErrDecimalOverflow, s, MaxPrecision)
+	}
+
+	digits := strings.TrimLeft(intPart+fracPart, "0")
+	if len(digits) > MaxPrecision {
+		return Decimal{}, fmt.Errorf("%w: %q has more than %d significant digits", ErrDecimalOverflow, s, MaxPrecision)
+	}
+
+	var u int64
+	if len(digits) > 0 {
+		v, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("%w: %q: %w", ErrInvalidDecimal, s, err)
+		}
+		u = v
+	}
+	if neg {
+		u = -u
+	}
+	return Decimal{unscaled: u, scale: uint8(len(fracPart))}, nil // nolint G115 len(fracPart) <= MaxPrecision checked above
+}
+
+// Returns exact rational value
+func (d Decimal) rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(d.unscaled), big.NewInt(pow10[d.scale]))
+}
+
+func absUnscaled(v int64) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1 // nolint G115 safe for math.MinInt64
+	}
+	return uint64(v) // nolint G115 v is not negative
+}
+
+var pow10 = [MaxPrecision + 1]int64{
+	1,
+	10,
+	100,
+	1_000,
+	10_000,
+	100_000,
+	1_000_000,
+	10_000_000,
+	100_000_000,
+	1_000_000_000,
+	10_000_000_000,
+	100_000_000_000,
+	1_000_000_000_000,
+	10_000_000_000_000,
+	100_000_000_000_000,
+	1_000_000_000_000_000,
+	10_000_000_000_000_000,
+	100_000_000_000_000_000,
+	1_000_000_000_000_000_000,
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package decimal
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("valid", func(t *testing.T) {
+		tests := []struct {
+			s        string
+			unscaled int64
+			scale    uint8
+			str      string
+		}{
+			{"0", 0, 0, "0"},
+			{"-0", 0, 0, "0"},
+			{"1", 1, 0, "1"},
+			{"+1", 1, 0, "1"},
+			{"123.45", 12345, 2, "123.45"},
+			{"-123.450", -123450, 3, "-123.450"},
+			{"0.0001", 1, 4, "0.0001"},
+			{"-.5", -5, 1, "-0.5"},
+			{"7.", 7, 0, "7"},
+			{"000123.4", 1234, 1, "123.4"},
+			{"99999999999999.9999", 999999999999999999, 4, "99999999999999.9999"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.s, func(t *testing.T) {
+				d, err := Parse(tt.s)
+				require.NoError(err)
+				require.Equal(tt.unscaled, d.Unscaled())
+				require.Equal(tt.scale, d.Scale())
+				require.Equal(tt.str, d.String())
+			})
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			s   string
+			err error
+		}{
+			{"", ErrInvalidDecimal},
+			{"-", ErrInvalidDecimal},
+			{".", ErrInvalidDecimal},
+			{"1e3", ErrInvalidDecimal},
+			{"1.2.3", ErrInvalidDecimal},
+			{" 1", ErrInvalidDecimal},
+			{"1234567890123456789", ErrDecimalOverflow},
+			{"0.1234567890123456789", ErrDecimalOverflow},
+		}
+		for _, tt := range tests {
+			t.Run(tt.s, func(t *testing.T) {
+				_, err := Parse(tt.s)
+				require.ErrorIs(err, tt.err)
+			})
+		}
+		require.Panics(func() { MustParse("abc") })
+	})
+}
+
+func TestDecimal(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("New", func(t *testing.T) {
+		d := New(-5, 3)
+		require.Equal("-0.005", d.String())
+		require.Equal(1, d.Digits())
+		require.Equal(-1, d.Sign())
+		require.Panics(func() { New(1, MaxPrecision+1) })
+
+		require.Equal("-9223372036854775808", New(math.MinInt64, 0).String())
+		require.True(Decimal{}.IsZero())
+		require.Equal("0", Decimal{}.String())
+	})
+
+	t.Run("Rescale", func(t *testing.T) {
+		d, err := MustParse("12.5").Rescale(4)
+		require.NoError(err)
+		require.Equal("12.5000", d.String())
+
+		d, err = d.Rescale(1)
+		require.NoError(err)
+		require.Equal("12.5", d.String())
+
+		_, err = d.Rescale(0)
+		require.ErrorIs(err, ErrDecimalScaleLoss)
+
+		_, err = New(math.MaxInt64/10, 0).Rescale(2)
+		require.ErrorIs(err, ErrDecimalOverflow)
+
+		_, err = d.Rescale(MaxPrecision + 1)
+		require.ErrorIs(err, ErrDecimalOverflow)
+	})
+
+	t.Run("Cmp", func(t *testing.T) {
+		require.Zero(MustParse("1.5").Cmp(MustParse("1.50")))
+		require.True(MustParse("1.5").Equal(MustParse("1.500")))
+		require.Equal(-1, MustParse("1.49").Cmp(MustParse("1.5")))
+		require.Equal(1, MustParse("-1.49").Cmp(MustParse("-1.5")))
+		require.Equal(1, New(math.MaxInt64, 0).Cmp(MustParse("0.000000000000000001")))
+		require.InEpsilon(123.45, MustParse("123.45").Float64(), 1e-15)
+		require.Equal(1, New(math.MaxInt64, 0).Cmp(New(math.MaxInt64-1, 0)))
+		require.Equal(-1, New(math.MaxInt64, 1).Cmp(New(math.MaxInt64/10+1, 0)))
+	})
+
+	t.Run("CmpFloat64", func(t *testing.T) {
+		require.Zero(MustParse("1.50").CmpFloat64(1.5))
+		require.Equal(-1, MustParse("-0.01").CmpFloat64(0))
+		require.Zero(MustParse("0.10").CmpFloat64(0.1), "float64 should be taken as shortest decimal")
+		require.Equal(1, New(100_000_000_000_000_001, 0).CmpFloat64(1e17), "should not be rounded to float64")
+		require.Equal(-1, New(math.MaxInt64, 0).CmpFloat64(1e30))
+		require.Equal(-1, New(math.MaxInt64, 0).CmpFloat64(math.Inf(1)))
+		require.Equal(1, New(math.MinInt64, 0).CmpFloat64(math.Inf(-1)))
+		require.Panics(func() { New(1, 0).CmpFloat64(math.NaN()) })
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type s struct {
+			Amount Decimal `json:"amount"`
+		}
+		b, err := json.Marshal(s{Amount: MustParse("-10.25")})
+		require.NoError(err)
+		require.JSONEq(`{"amount":"-10.25"}`, string(b))
+
+		v := s{}
+		require.NoError(json.Unmarshal([]byte(`{"amount":"7.10"}`), &v))
+		require.Equal("7.10", v.Amount.String())
+
+		require.NoError(json.Unmarshal([]byte(`{"amount":3.5}`), &v))
+		require.Equal("3.5", v.Amount.String())
+
+		require.ErrorIs(json.Unmarshal([]byte(`{"amount":"x"}`), &v), ErrInvalidDecimal)
+	})
+}
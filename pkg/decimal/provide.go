@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package decimal
+
+// Returns new decimal value unscaled * 10^(-scale).
+//
+// # Panics:
+//   - if scale is greater than MaxPrecision
+func New(unscaled int64, scale uint8) Decimal {
+	if scale > MaxPrecision {
+		panic(ErrDecimalOverflow)
+	}
+	return Decimal{unscaled: unscaled, scale: scale}
+}
+
+// Parses decimal value from string like "-123.45".
+//
+// Scale of result is equal to count of digits after decimal separator.
+// Exponent notation is not supported.
+func Parse(s string) (Decimal, error) {
+	return parse(s)
+}
+
+// Parses decimal value from string.
+//
+// # Panics:
+//   - if string is not valid decimal
+func MustParse(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
@@ -0,0 +1,15 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package decimal
+
+// Fixed-point decimal value.
+//
+// Value is unscaled * 10^(-scale), e.g. {unscaled: 12345, scale: 2} is 123.45
+//
+// Zero value is valid decimal zero with zero scale.
+type Decimal struct {
+	unscaled int64
+	scale    uint8
+}
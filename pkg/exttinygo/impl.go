@@ -6,6 +6,8 @@
 package exttinygo
 
 import (
+	"github.com/google/uuid"
+
 	"github.com/voedger/voedger/pkg/exttinygo/internal"
 	safe "github.com/voedger/voedger/pkg/state/isafestateapi"
 )
@@ -16,6 +18,10 @@ func Assert(condition bool, msg string) {
 	}
 }
 
+func newUUIDImpl() uuid.UUID {
+	return uuid.New()
+}
+
 func keyBuilderImpl(storage, entity string) (b TKeyBuilder) {
 	return TKeyBuilder(internal.SafeStateAPI.KeyBuilder(storage, entity))
 }
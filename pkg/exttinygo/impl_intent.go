@@ -6,6 +6,12 @@
 package exttinygo
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/voedger/voedger/pkg/datetime"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/exttinygo/internal"
 	safe "github.com/voedger/voedger/pkg/state/isafestateapi"
 )
@@ -30,6 +36,30 @@ func (i TIntent) PutString(name string, value string) {
 	internal.SafeStateAPI.IntentPutString(safe.TIntent(i), name, value)
 }
 
+func (i TIntent) PutDecimal(name string, value decimal.Decimal) {
+	internal.SafeStateAPI.IntentPutDecimal(safe.TIntent(i), name, value.String())
+}
+
+// Puts calendar date of specified time to date field
+func (i TIntent) PutDate(name string, value time.Time) {
+	i.PutInt64(name, datetime.Date(value))
+}
+
+// Puts clock of specified time to time field
+func (i TIntent) PutTime(name string, value time.Time) {
+	i.PutInt64(name, datetime.Time(value))
+}
+
+// Puts specified time to timestamp field
+func (i TIntent) PutTimestamp(name string, value time.Time) {
+	i.PutInt64(name, datetime.Timestamp(value))
+}
+
+// Puts specified uuid to uuid field
+func (i TIntent) PutUUID(name string, value uuid.UUID) {
+	i.PutBytes(name, value[:])
+}
+
 func (i TIntent) PutBytes(name string, value []byte) {
 	internal.SafeStateAPI.IntentPutBytes(safe.TIntent(i), name, value)
 }
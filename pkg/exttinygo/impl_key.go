@@ -6,6 +6,12 @@
 package exttinygo
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/voedger/voedger/pkg/datetime"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/exttinygo/internal"
 	safe "github.com/voedger/voedger/pkg/state/isafestateapi"
 )
@@ -34,6 +40,34 @@ func (k TKey) AsString(name string) string {
 	return internal.SafeStateAPI.KeyAsString(safe.TKey(k), name)
 }
 
+func (k TKey) AsDecimal(name string) decimal.Decimal {
+	return decimal.MustParse(internal.SafeStateAPI.KeyAsDecimal(safe.TKey(k), name))
+}
+
+// Returns date field value as UTC midnight
+func (k TKey) AsDate(name string) time.Time {
+	return datetime.FromDate(k.AsInt64(name))
+}
+
+// Returns time field value as UTC time of 1970-01-01
+func (k TKey) AsTime(name string) time.Time {
+	return datetime.FromTime(k.AsInt64(name))
+}
+
+// Returns timestamp field value in UTC
+func (k TKey) AsTimestamp(name string) time.Time {
+	return datetime.FromTimestamp(k.AsInt64(name))
+}
+
+// Returns uuid field value, or uuid.Nil if the field is not set
+func (k TKey) AsUUID(name string) uuid.UUID {
+	b := k.AsBytes(name)
+	if len(b) == 0 {
+		return uuid.Nil
+	}
+	return uuid.Must(uuid.FromBytes(b))
+}
+
 func (k TKey) AsQName(name string) QName {
 	return QName(internal.SafeStateAPI.KeyAsQName(safe.TKey(k), name))
 }
@@ -6,6 +6,12 @@
 package exttinygo
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/voedger/voedger/pkg/datetime"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/exttinygo/internal"
 	safe "github.com/voedger/voedger/pkg/state/isafestateapi"
 )
@@ -34,6 +40,30 @@ func (kb TKeyBuilder) PutString(name string, value string) {
 	internal.SafeStateAPI.KeyBuilderPutString(safe.TKeyBuilder(kb), name, value)
 }
 
+func (kb TKeyBuilder) PutDecimal(name string, value decimal.Decimal) {
+	internal.SafeStateAPI.KeyBuilderPutDecimal(safe.TKeyBuilder(kb), name, value.String())
+}
+
+// Puts calendar date of specified time to date field
+func (kb TKeyBuilder) PutDate(name string, value time.Time) {
+	kb.PutInt64(name, datetime.Date(value))
+}
+
+// Puts clock of specified time to time field
+func (kb TKeyBuilder) PutTime(name string, value time.Time) {
+	kb.PutInt64(name, datetime.Time(value))
+}
+
+// Puts specified time to timestamp field
+func (kb TKeyBuilder) PutTimestamp(name string, value time.Time) {
+	kb.PutInt64(name, datetime.Timestamp(value))
+}
+
+// Puts specified uuid to uuid field
+func (kb TKeyBuilder) PutUUID(name string, value uuid.UUID) {
+	kb.PutBytes(name, value[:])
+}
+
 func (kb TKeyBuilder) PutBytes(name string, value []byte) {
 	internal.SafeStateAPI.KeyBuilderPutBytes(safe.TKeyBuilder(kb), name, value)
 }
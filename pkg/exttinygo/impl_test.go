@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package exttinygo
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/exttinygo/internal"
+	safe "github.com/voedger/voedger/pkg/state/isafestateapi"
+)
+
+type uuidStateAPI struct {
+	safe.IStateSafeAPI
+	fields map[string][]byte
+}
+
+func (s uuidStateAPI) KeyAsBytes(_ safe.TKey, name string) []byte     { return s.fields[name] }
+func (s uuidStateAPI) ValueAsBytes(_ safe.TValue, name string) []byte { return s.fields[name] }
+
+func TestAsUUID(t *testing.T) {
+	require := require.New(t)
+	id := uuid.New()
+	saved := internal.SafeStateAPI
+	defer func() { internal.SafeStateAPI = saved }()
+	internal.SafeStateAPI = uuidStateAPI{fields: map[string][]byte{"set": id[:]}}
+
+	t.Run("value", func(t *testing.T) {
+		require.Equal(id, TValue(0).AsUUID("set"))
+		require.Equal(uuid.Nil, TValue(0).AsUUID("unset"))
+	})
+
+	t.Run("key", func(t *testing.T) {
+		require.Equal(id, TKey(0).AsUUID("set"))
+		require.Equal(uuid.Nil, TKey(0).AsUUID("unset"))
+	})
+}
@@ -6,7 +6,14 @@
 package exttinygo
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/voedger/voedger/pkg/datetime"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/exttinygo/internal"
+	"github.com/voedger/voedger/pkg/jsonpath"
 	safe "github.com/voedger/voedger/pkg/state/isafestateapi"
 )
 
@@ -30,6 +37,44 @@ func (v TValue) AsString(name string) string {
 	return internal.SafeStateAPI.ValueAsString(safe.TValue(v), name)
 }
 
+func (v TValue) AsDecimal(name string) decimal.Decimal {
+	return decimal.MustParse(internal.SafeStateAPI.ValueAsDecimal(safe.TValue(v), name))
+}
+
+// Returns date field value as UTC midnight
+func (v TValue) AsDate(name string) time.Time {
+	return datetime.FromDate(v.AsInt64(name))
+}
+
+// Returns time field value as UTC time of 1970-01-01
+func (v TValue) AsTime(name string) time.Time {
+	return datetime.FromTime(v.AsInt64(name))
+}
+
+// Returns timestamp field value in UTC
+func (v TValue) AsTimestamp(name string) time.Time {
+	return datetime.FromTimestamp(v.AsInt64(name))
+}
+
+// Returns uuid field value, or uuid.Nil if the field is not set
+func (v TValue) AsUUID(name string) uuid.UUID {
+	b := v.AsBytes(name)
+	if len(b) == 0 {
+		return uuid.Nil
+	}
+	return uuid.Must(uuid.FromBytes(b))
+}
+
+// Returns value addressed by path in json field, or nil if path does not exist.
+// Objects and arrays are returned as map[string]any and []any.
+func (v TValue) AsJSONPath(name string, path string) any {
+	res, _, err := jsonpath.Get(v.AsString(name), path)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
 func (v TValue) AsBytes(name string) []byte {
 	return internal.SafeStateAPI.ValueAsBytes(safe.TValue(v), name)
 }
@@ -34,6 +34,9 @@ var UpdateValue = updateValueImpl
 // NewValue creates intent for new value
 var NewValue = newValueImpl
 
+// NewUUID generates new random (version 4) UUID, e.g. to be put into uuid field of a new record
+var NewUUID = newUUIDImpl
+
 /*
 type IKey interface {
 	AsString(name string) string
@@ -41,6 +44,11 @@ type IKey interface {
 	AsInt64(name string) int64
 	AsFloat32(name string) float32
 	AsFloat64(name string) float64
+	AsDecimal(name string) decimal.Decimal
+	AsDate(name string) time.Time      // UTC midnight
+	AsTime(name string) time.Time      // UTC time of 1970-01-01
+	AsTimestamp(name string) time.Time // UTC
+	AsUUID(name string) uuid.UUID
 	AsBytes(name string) []byte
 	AsQName(name string) QName
 	AsBool(name string) bool
@@ -55,6 +63,12 @@ type IValue interface {
 	AsInt64(name string) int64
 	AsFloat32(name string) float32
 	AsFloat64(name string) float64
+	AsDecimal(name string) decimal.Decimal
+	AsDate(name string) time.Time      // UTC midnight
+	AsTime(name string) time.Time      // UTC time of 1970-01-01
+	AsTimestamp(name string) time.Time // UTC
+	AsUUID(name string) uuid.UUID
+	AsJSONPath(name string, path string) any // value by path in json field, like `$.sizes[0]`
 	AsQName(name string) QName
 	AsBool(name string) bool
 	AsValue(name string) IValue // throws panic if field is not an object or array
@@ -75,6 +89,11 @@ type IRowWriter interface {
 	PutInt64(name string, value int64)
 	PutFloat32(name string, value float32)
 	PutFloat64(name string, value float64)
+	PutDecimal(name string, value decimal.Decimal)
+	PutDate(name string, value time.Time)      // calendar date of value
+	PutTime(name string, value time.Time)      // clock of value
+	PutTimestamp(name string, value time.Time) // value as Unix milliseconds
+	PutUUID(name string, value uuid.UUID)
 	PutString(name string, value string)
 	PutBytes(name string, value []byte)
 	PutQName(name string, value QName)
@@ -10,6 +10,9 @@ package internal
 func hostRowWriterPutString(_ uint64, _ uint32, _, _, _, _ uint32) {
 }
 
+func hostRowWriterPutDecimal(_ uint64, _ uint32, _, _, _, _ uint32) {
+}
+
 func hostRowWriterPutBytes(_ uint64, _ uint32, _, _, _, _ uint32) {
 }
 
@@ -59,6 +62,10 @@ func hostValueAsString(_ uint64, _, _ uint32) uint64 {
 	return 0
 }
 
+func hostValueAsDecimal(_ uint64, _, _ uint32) uint64 {
+	return 0
+}
+
 func hostValueAsInt32(_ uint64, _, _ uint32) uint32 {
 	return 0
 }
@@ -135,6 +142,10 @@ func hostKeyAsString(_ uint64, _, _ uint32) uint64 {
 	return 0
 }
 
+func hostKeyAsDecimal(_ uint64, _, _ uint32) uint64 {
+	return 0
+}
+
 func hostKeyAsBytes(_ uint64, _, _ uint32) uint64 {
 	return 0
 }
@@ -73,6 +73,10 @@ func (hostSafeStateAPI) KeyBuilderPutString(key safe.TKeyBuilder, name string, v
 	hostRowWriterPutString(uint64(key), 0, uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name)), uint32(uintptr(unsafe.Pointer(unsafe.StringData(value)))), uint32(len(value)))
 }
 
+func (hostSafeStateAPI) KeyBuilderPutDecimal(key safe.TKeyBuilder, name string, value string) {
+	hostRowWriterPutDecimal(uint64(key), 0, uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name)), uint32(uintptr(unsafe.Pointer(unsafe.StringData(value)))), uint32(len(value)))
+}
+
 func (hostSafeStateAPI) KeyBuilderPutBytes(key safe.TKeyBuilder, name string, value []byte) {
 	hostRowWriterPutBytes(uint64(key), 0, uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name)), uint32(uintptr(unsafe.Pointer(unsafe.SliceData(value)))), uint32(len(value)))
 }
@@ -113,6 +117,10 @@ func (hostSafeStateAPI) IntentPutString(i safe.TIntent, name string, value strin
 	hostRowWriterPutString(uint64(i), 1, uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name)), uint32(uintptr(unsafe.Pointer(unsafe.StringData(value)))), uint32(len(value)))
 }
 
+func (hostSafeStateAPI) IntentPutDecimal(i safe.TIntent, name string, value string) {
+	hostRowWriterPutDecimal(uint64(i), 1, uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name)), uint32(uintptr(unsafe.Pointer(unsafe.StringData(value)))), uint32(len(value)))
+}
+
 func (hostSafeStateAPI) IntentPutBytes(i safe.TIntent, name string, value []byte) {
 	hostRowWriterPutBytes(uint64(i), 1, uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name)), uint32(uintptr(unsafe.Pointer(unsafe.SliceData(value)))), uint32(len(value)))
 }
@@ -159,6 +167,11 @@ func (hostSafeStateAPI) ValueAsString(v safe.TValue, name string) string {
 	return decodeString(ptr)
 }
 
+func (hostSafeStateAPI) ValueAsDecimal(v safe.TValue, name string) string {
+	ptr := hostValueAsDecimal(uint64(v), uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name)))
+	return decodeString(ptr)
+}
+
 func (hostSafeStateAPI) ValueAsBytes(v safe.TValue, name string) []byte {
 	ptr := hostValueAsBytes(uint64(v), uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name)))
 	return decodeSlice(ptr)
@@ -249,6 +262,10 @@ func (hostSafeStateAPI) KeyAsString(k safe.TKey, name string) string {
 	return decodeString(hostKeyAsString(uint64(k), uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name))))
 }
 
+func (hostSafeStateAPI) KeyAsDecimal(k safe.TKey, name string) string {
+	return decodeString(hostKeyAsDecimal(uint64(k), uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name))))
+}
+
 func (hostSafeStateAPI) KeyAsQName(k safe.TKey, name string) safe.QName {
 	pkgPtr := hostKeyAsQNamePkg(uint64(k), uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name)))
 	entityPtr := hostKeyAsQNameEntity(uint64(k), uint32(uintptr(unsafe.Pointer(unsafe.StringData(name)))), uint32(len(name)))
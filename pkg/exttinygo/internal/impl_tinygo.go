@@ -21,6 +21,9 @@ func HostPanic(msgPtr, msgSize uint32)
 //export hostRowWriterPutString
 func hostRowWriterPutString(id uint64, typ uint32, namePtr, nameSize, valuePtr, valueSize uint32)
 
+//export hostRowWriterPutDecimal
+func hostRowWriterPutDecimal(id uint64, typ uint32, namePtr, nameSize, valuePtr, valueSize uint32)
+
 //export hostRowWriterPutBytes
 func hostRowWriterPutBytes(id uint64, typ uint32, namePtr, nameSize, valuePtr, valueSize uint32)
 
@@ -63,6 +66,9 @@ func hostValueAsBytes(id uint64, namePtr, nameSize uint32) uint64
 //export hostValueAsString
 func hostValueAsString(id uint64, namePtr, nameSize uint32) uint64
 
+//export hostValueAsDecimal
+func hostValueAsDecimal(id uint64, namePtr, nameSize uint32) uint64
+
 //export hostValueAsInt32
 func hostValueAsInt32(id uint64, namePtr, nameSize uint32) uint32
 
@@ -120,6 +126,9 @@ func hostValueGetAsBool(id uint64, index uint32) uint64
 //export hostKeyAsString
 func hostKeyAsString(id uint64, namePtr, nameSize uint32) uint64
 
+//export hostKeyAsDecimal
+func hostKeyAsDecimal(id uint64, namePtr, nameSize uint32) uint64
+
 //export hostKeyAsBytes
 func hostKeyAsBytes(id uint64, namePtr, nameSize uint32) uint64
 
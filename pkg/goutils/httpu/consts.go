@@ -21,6 +21,8 @@ const (
 	Accept                                       = "Accept"
 	Origin                                       = "Origin"
 	RetryAfter                                   = "Retry-After"
+	XRequestID                                   = "X-Request-ID"
+	IdempotencyKey                               = "Idempotency-Key"
 	ContentType_ApplicationJSON                  = "application/json"
 	ContentType_ApplicationXBinary               = "application/x-binary"
 	ContentType_TextPlain                        = "text/plain"
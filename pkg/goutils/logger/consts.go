@@ -6,6 +6,7 @@
 package logger
 
 import (
+	"io"
 	"log/slog"
 	"os"
 	"time"
@@ -24,13 +25,26 @@ const (
 	LogAttr_WSID      = "wsid"
 	LogAttr_Extension = "extension"
 	LogAttr_Stage     = "stage"
+	LogAttr_PartID    = "partid"
 )
 
+const (
+	// human-readable lines, the default
+	LogFormat_Text LogFormat = iota
+
+	// one JSON object per line, for log aggregation
+	LogFormat_JSON
+)
+
+const jsonTimeLayout = "2006-01-02T15:04:05.000Z07:00" // same as slog.JSONHandler
+
 var (
 	// ctxHandlerOpts disables handler-level filtering (isEnabled() already gates all calls).
 	ctxHandlerOpts = &slog.HandlerOptions{
 		Level: slog.LevelDebug,
 	}
-	slogOut = slog.New(slog.NewTextHandler(os.Stdout, ctxHandlerOpts))
-	slogErr = slog.New(slog.NewTextHandler(os.Stderr, ctxHandlerOpts))
+	ctxOut  io.Writer = os.Stdout
+	ctxErr  io.Writer = os.Stderr
+	slogOut           = slog.New(slog.NewTextHandler(ctxOut, ctxHandlerOpts))
+	slogErr           = slog.New(slog.NewTextHandler(ctxErr, ctxHandlerOpts))
 )
@@ -9,6 +9,7 @@
 package logger
 
 import (
+	"encoding/json"
 	"fmt"
 	"runtime"
 	"strings"
@@ -30,10 +31,16 @@ const (
 
 var globalLogPrinter = logPrinter{logLevel: LogLevelInfo}
 
+var globalLogFormat = LogFormat_Text
+
 type logPrinter struct {
 	logLevel TLogLevel
 }
 
+func isJSONFormat() bool {
+	return LogFormat(atomic.LoadInt32((*int32)(&globalLogFormat))) == LogFormat_JSON
+}
+
 func isEnabled(logLevel TLogLevel) bool {
 	curLogLevel := TLogLevel(atomic.LoadInt32((*int32)(&globalLogPrinter.logLevel)))
 	return curLogLevel >= logLevel
@@ -67,9 +74,35 @@ func (p *logPrinter) getFormattedMsg(msgType string, funcName string, line int,
 	return out
 }
 
+func (p *logPrinter) getJSONMsg(level TLogLevel, funcName string, line int, args ...interface{}) string {
+	var sb strings.Builder
+	for i, arg := range args {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		fmt.Fprint(&sb, arg)
+	}
+	res, err := json.Marshal(jsonLine{
+		Time:  time.Now().Format(jsonTimeLayout),
+		Level: loggerLevelToSLogLevel(level).String(),
+		Msg:   sb.String(),
+		Src:   fmt.Sprintf("%s:%d", funcName, line),
+	})
+	if err != nil {
+		// notest: struct of strings is always marshalable
+		panic(err)
+	}
+	return string(res)
+}
+
 func (p *logPrinter) print(skipStackFrames int, level TLogLevel, msgType string, args ...interface{}) {
 	funcName, line := getFuncName(skipStackFrames + globalSkipStackFramesCount)
-	out := p.getFormattedMsg(msgType, funcName, line, args...)
+	var out string
+	if isJSONFormat() {
+		out = p.getJSONMsg(level, funcName, line, args...)
+	} else {
+		out = p.getFormattedMsg(msgType, funcName, line, args...)
+	}
 
 	PrintLine(level, out)
 }
@@ -14,13 +14,13 @@ func StartCapture(t TB, level TLogLevel) ILogCaptor {
 	c := &captor{
 		t: t,
 	}
-	oldSLogOut, oldSLogErr := slogOut, slogErr
+	oldCtxOut, oldCtxErr := ctxOut, ctxErr
 	oldLegacyOut, oldLegacyErr := legacyOut, legacyErr
 	SetCtxWriters(c, c)
 	legacyOut, legacyErr = c, c
 	restoreLevel := SetLogLevelWithRestore(level)
 	t.Cleanup(func() {
-		slogOut, slogErr = oldSLogOut, oldSLogErr
+		SetCtxWriters(oldCtxOut, oldCtxErr)
 		legacyOut, legacyErr = oldLegacyOut, oldLegacyErr
 		restoreLevel()
 	})
@@ -40,6 +40,14 @@ func SetLogLevelWithRestore(logLevel TLogLevel) (restore func()) {
 	}
 }
 
+// SetLogFormat switches the output format of all logging functions: legacy, *Ctx and the std log bridge.
+// Should be called on startup before any logging.
+func SetLogFormat(format LogFormat) (old LogFormat) {
+	old = LogFormat(atomic.SwapInt32((*int32)(&globalLogFormat), int32(format)))
+	SetCtxWriters(ctxOut, ctxErr)
+	return old
+}
+
 func Error(args ...interface{}) {
 	printIfLevel(0, LogLevelError, args...)
 }
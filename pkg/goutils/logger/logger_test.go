@@ -10,6 +10,7 @@ package logger_test
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 	"strings"
 	"sync"
@@ -306,6 +307,71 @@ func Test_NewStdLogBridge(t *testing.T) {
 	})
 }
 
+func TestJSONFormat(t *testing.T) {
+	require := require.New(t)
+	logCap := logger.StartCapture(t, logger.LogLevelVerbose)
+	old := logger.SetLogFormat(logger.LogFormat_JSON)
+	defer logger.SetLogFormat(old)
+	require.Equal(logger.LogFormat_Text, old)
+
+	ctx := logger.WithContextAttrs(context.Background(), map[string]any{
+		logger.LogAttr_ReqID:  "req-1",
+		logger.LogAttr_WSID:   42,
+		logger.LogAttr_PartID: 3,
+	})
+	logger.Info("legacy", "message")
+	logger.ErrorCtx(ctx, "test.stage", "ctx message")
+	logger.NewStdErrorLogBridge(ctx, "std.stage").Println("std message")
+
+	lines := strings.Split(strings.TrimSpace(logCap.String()), "\n")
+	require.Len(lines, 3)
+	records := make([]map[string]any, len(lines))
+	for i, line := range lines {
+		require.NoError(json.Unmarshal([]byte(line), &records[i]), line)
+		require.NotEmpty(records[i]["time"])
+		require.Contains(records[i]["src"], "logger_test.TestJSONFormat:")
+	}
+
+	t.Run("legacy", func(t *testing.T) {
+		require.Equal("INFO", records[0]["level"])
+		require.Equal("legacy message", records[0]["msg"])
+	})
+
+	t.Run("ctx", func(t *testing.T) {
+		require.Equal("ERROR", records[1]["level"])
+		require.Equal("ctx message", records[1]["msg"])
+		require.Equal("test.stage", records[1][logger.LogAttr_Stage])
+		require.Equal("req-1", records[1][logger.LogAttr_ReqID])
+		require.Equal(42.0, records[1][logger.LogAttr_WSID])
+		require.Equal(3.0, records[1][logger.LogAttr_PartID])
+	})
+
+	t.Run("std log bridge", func(t *testing.T) {
+		require.Equal("std message", records[2]["msg"])
+		require.Equal("std.stage", records[2][logger.LogAttr_Stage])
+		require.Equal("req-1", records[2][logger.LogAttr_ReqID])
+	})
+}
+
+func TestCtxAttr(t *testing.T) {
+	require := require.New(t)
+	ctx := logger.WithContextAttrs(context.Background(), map[string]any{logger.LogAttr_ReqID: "first"})
+	ctx = logger.WithContextAttrs(ctx, map[string]any{logger.LogAttr_WSID: 42})
+
+	reqID, ok := logger.CtxAttr(ctx, logger.LogAttr_ReqID)
+	require.True(ok)
+	require.Equal("first", reqID)
+
+	ctx = logger.WithContextAttrs(ctx, map[string]any{logger.LogAttr_ReqID: "second"})
+	reqID, _ = logger.CtxAttr(ctx, logger.LogAttr_ReqID)
+	require.Equal("second", reqID)
+
+	_, ok = logger.CtxAttr(ctx, logger.LogAttr_VApp)
+	require.False(ok)
+	_, ok = logger.CtxAttr(context.Background(), logger.LogAttr_VApp)
+	require.False(ok)
+}
+
 func TestMultithread(t *testing.T) {
 	toLog := []string{}
 	for i := range 100 {
@@ -15,8 +15,16 @@ import (
 // SetCtxWriters replaces the writers used by all *Ctx logging functions.
 // Must be used in tests only: create an os.Pipe(), call SetCtxWriters, run the code, read the pipe.
 func SetCtxWriters(out, err io.Writer) {
-	slogOut = slog.New(slog.NewTextHandler(out, ctxHandlerOpts))
-	slogErr = slog.New(slog.NewTextHandler(err, ctxHandlerOpts))
+	ctxOut, ctxErr = out, err
+	slogOut = slog.New(newSLogHandler(out))
+	slogErr = slog.New(newSLogHandler(err))
+}
+
+func newSLogHandler(w io.Writer) slog.Handler {
+	if isJSONFormat() {
+		return slog.NewJSONHandler(w, ctxHandlerOpts)
+	}
+	return slog.NewTextHandler(w, ctxHandlerOpts)
 }
 
 // WithContextAttrs returns a new context with the given attributes added. Later calls shadow earlier ones for the same key.
@@ -25,6 +33,16 @@ func WithContextAttrs(ctx context.Context, attrs map[string]any) context.Context
 	return context.WithValue(ctx, ctxKey{}, &logAttrs{attrs: attrs, parent: prev})
 }
 
+// CtxAttr returns the value of the attribute stored in ctx by WithContextAttrs
+func CtxAttr(ctx context.Context, key string) (value any, ok bool) {
+	for node, _ := ctx.Value(ctxKey{}).(*logAttrs); node != nil; node = node.parent {
+		if value, ok = node.attrs[key]; ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
 // Context-aware logging functions. Each reads slog.Attr values stored in ctx
 // (via WithContextAttrs) and appends them to the log record.
 
@@ -14,6 +14,17 @@ import (
 
 type ctxKey struct{}
 
+// LogFormat_* constants
+type LogFormat int32
+
+// legacy functions line in LogFormat_JSON, keys are the same as written by *Ctx functions
+type jsonLine struct {
+	Time  string `json:"time"`
+	Level string `json:"level"`
+	Msg   string `json:"msg"`
+	Src   string `json:"src"`
+}
+
 type logAttrs struct {
 	attrs  map[string]any
 	parent *logAttrs
@@ -27,6 +27,7 @@ type Principal struct {
 	// PrincipalKind_Group 	- Workspace this group belongs to
 	// PrincipalKind_Device - ProfileWSID
 	// PrincipalKind_Role   - Workspace this role belongs to
+	// PrincipalKind_APIKey - Workspace the API key is issued for
 	WSID istructs.WSID
 
 	// PrincipalKind_Host	- Host address
@@ -37,6 +38,7 @@ type Principal struct {
 	QName appdef.QName
 
 	// PrincipalKind_Group	- GroupID
+	// PrincipalKind_APIKey	- ID of cdoc.sys.APIKey
 	ID istructs.IDType
 }
 
@@ -49,6 +51,7 @@ const (
 	PrincipalKind_Role
 	PrincipalKind_Group
 	PrincipalKind_Device
+	PrincipalKind_APIKey
 	PrincipalKind_FakeLast
 )
 
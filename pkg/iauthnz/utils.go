@@ -27,3 +27,15 @@ func IsSystemPrincipal(principals []Principal, wsid istructs.WSID) bool {
 	}
 	return false
 }
+
+// Returns the name of the user or device that makes the request.
+//
+// Returns empty string if there is no user or device principal
+func SubjectName(principals []Principal) string {
+	for _, p := range principals {
+		if p.Kind == PrincipalKind_User || p.Kind == PrincipalKind_Device {
+			return p.Name
+		}
+	}
+	return ""
+}
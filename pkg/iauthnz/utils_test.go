@@ -41,3 +41,11 @@ func TestIsSystemPrincipal(t *testing.T) {
 	require.False(IsSystemPrincipal([]Principal{{Kind: PrincipalKind_Role, WSID: 42, QName: QNameRoleWorkspaceAdmin}}, 42))
 	require.False(IsSystemPrincipal(nil, 42))
 }
+
+func TestSubjectName(t *testing.T) {
+	require := require.New(t)
+	require.Equal("login", SubjectName([]Principal{{Kind: PrincipalKind_Role, QName: QNameRoleSystem}, {Kind: PrincipalKind_User, Name: "login"}}))
+	require.Equal("device", SubjectName([]Principal{{Kind: PrincipalKind_Device, Name: "device"}}))
+	require.Empty(SubjectName([]Principal{{Kind: PrincipalKind_Role, QName: QNameRoleSystem}}))
+	require.Empty(SubjectName(nil))
+}
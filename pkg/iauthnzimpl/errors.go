@@ -10,4 +10,5 @@ import "errors"
 var (
 	ErrPersonalAccessTokenOnSystemRole = errors.New("personal access token on a system role")
 	ErrPersonalAccessTokenOnNullWSID   = errors.New("personal access token on null WSID")
+	ErrAPIKeyIsNotValid                = errors.New("API key is revoked or is issued for another workspace")
 )
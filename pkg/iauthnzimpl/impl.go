@@ -6,12 +6,14 @@ package iauthnzimpl
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/iauthnz"
 	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/itokens"
 	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
 )
 
@@ -52,6 +54,14 @@ func (i *implIAuthenticator) Authenticate(requestContext context.Context, as ist
 	}
 
 	if _, err = appTokens.ValidateToken(req.Token, &principalPayload); err != nil {
+		if errors.Is(err, itokens.ErrInvalidAudience) {
+			// not a principal token -> could be an API key
+			apiKeyPrincipals, err := i.authenticateAPIKey(requestContext, as, appTokens, req)
+			if err != nil {
+				return nil, istructs.NullWSID, err
+			}
+			return append(principals, apiKeyPrincipals...), istructs.NullWSID, nil
+		}
 		return nil, istructs.NullWSID, err
 	}
 
@@ -217,6 +227,39 @@ func (i *implIAuthenticator) Authenticate(requestContext context.Context, as ist
 	return principals, profileWSID, nil
 }
 
+// API key principal gets the roles of the API key only, the roles are valid in the workspace the key is issued for
+// AuthenticatedUser role is not provided: API key is not a user
+func (i *implIAuthenticator) authenticateAPIKey(requestContext context.Context, as istructs.IAppStructs, appTokens istructs.IAppTokens,
+	req iauthnz.AuthnRequest) (principals []iauthnz.Principal, err error) {
+	apiKeyPayload := payloads.APIKeyPayload{}
+	if _, err = appTokens.ValidateToken(req.Token, &apiKeyPayload); err != nil {
+		return nil, err
+	}
+	if apiKeyPayload.WSID != req.RequestWSID {
+		return nil, ErrAPIKeyIsNotValid
+	}
+	apiKeyID, roles, err := i.apiKeyGetter(requestContext, req.Token, as, req.RequestWSID)
+	if err != nil {
+		return nil, err
+	}
+	if apiKeyID == istructs.NullRecordID {
+		return nil, ErrAPIKeyIsNotValid
+	}
+	principals = append(principals, iauthnz.Principal{
+		Kind: iauthnz.PrincipalKind_APIKey,
+		WSID: req.RequestWSID,
+		ID:   istructs.IDType(apiKeyID),
+	})
+	for _, role := range roles {
+		principals = append(principals, iauthnz.Principal{
+			Kind:  iauthnz.PrincipalKind_Role,
+			WSID:  req.RequestWSID,
+			QName: role,
+		})
+	}
+	return principals, nil
+}
+
 func (i *implIAuthenticator) rolesFromSubjects(requestContext context.Context, name string, as istructs.IAppStructs, wsid istructs.WSID) (res []iauthnz.Principal, err error) {
 	// read roles from cdoc.sys.Subjects from the current workspace
 	subjectRoles, err := i.subjectRolesGetter(requestContext, name, as, wsid)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/voedger/voedger/pkg/goutils/logger"
+	"github.com/voedger/voedger/pkg/goutils/testingu"
 	"github.com/voedger/voedger/pkg/goutils/timeu"
 
 	"github.com/voedger/voedger/pkg/appdef"
@@ -18,6 +19,7 @@ import (
 	"github.com/voedger/voedger/pkg/iauthnz"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
+	"github.com/voedger/voedger/pkg/itokens"
 	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
 	"github.com/voedger/voedger/pkg/itokensjwt"
 )
@@ -71,7 +73,7 @@ func TestBasicUsage(t *testing.T) {
 			},
 		},
 	})
-	authn := NewDefaultAuthenticator(TestSubjectRolesGetter, TestIsDeviceAllowedFuncs)
+	authn := NewDefaultAuthenticator(TestSubjectRolesGetter, TestAPIKeyGetter, TestIsDeviceAllowedFuncs)
 	t.Run("authenticate in the profile", func(t *testing.T) {
 		req := iauthnz.AuthnRequest{
 			Host:        "127.0.0.1",
@@ -389,7 +391,7 @@ func TestAuthenticate(t *testing.T) {
 	subjectsGetter := func(context.Context, string, istructs.IAppStructs, istructs.WSID) ([]appdef.QName, error) {
 		return *subjects, nil
 	}
-	authn := NewDefaultAuthenticator(subjectsGetter, TestIsDeviceAllowedFuncs)
+	authn := NewDefaultAuthenticator(subjectsGetter, TestAPIKeyGetter, TestIsDeviceAllowedFuncs)
 	for _, tc := range testCases {
 		localVarSubjects := &tc.subjects
 		t.Run(tc.desc, func(t *testing.T) {
@@ -408,7 +410,7 @@ func TestErrors(t *testing.T) {
 	appTokens := payloads.ProvideIAppTokensFactory(tokens).New(istructs.AppQName_test1_app1)
 
 	appStructs := &implIAppStructs{}
-	authn := NewDefaultAuthenticator(TestSubjectRolesGetter, TestIsDeviceAllowedFuncs)
+	authn := NewDefaultAuthenticator(TestSubjectRolesGetter, TestAPIKeyGetter, TestIsDeviceAllowedFuncs)
 
 	t.Run("wrong token", func(t *testing.T) {
 		req := iauthnz.AuthnRequest{
@@ -482,7 +484,7 @@ func TestSubjectRolesResolveAgainstLoginAndAlias(t *testing.T) {
 		}
 		token, err := appTokens.IssueToken(time.Minute, &pp)
 		require.NoError(err)
-		authn := NewDefaultAuthenticator(rolesGetterFor(getterMatch), TestIsDeviceAllowedFuncs)
+		authn := NewDefaultAuthenticator(rolesGetterFor(getterMatch), TestAPIKeyGetter, TestIsDeviceAllowedFuncs)
 		principals, _, err := authn.Authenticate(context.Background(), appStructs, appTokens, iauthnz.AuthnRequest{
 			Host:        "127.0.0.1",
 			RequestWSID: 1,
@@ -510,6 +512,67 @@ func TestSubjectRolesResolveAgainstLoginAndAlias(t *testing.T) {
 	})
 }
 
+func TestAPIKey(t *testing.T) {
+	require := require.New(t)
+
+	tokens := itokensjwt.ProvideITokens(itokensjwt.SecretKeyExample, timeu.NewITime())
+	appTokens := payloads.ProvideIAppTokensFactory(tokens).New(istructs.AppQName_test1_app1)
+	apiKey, err := appTokens.IssueToken(time.Minute, &payloads.APIKeyPayload{WSID: 42})
+	require.NoError(err)
+
+	role := appdef.NewQName("app1pkg", "Reader")
+	revoked := false
+	apiKeyGetter := func(_ context.Context, key string, _ istructs.IAppStructs, wsid istructs.WSID) (istructs.RecordID, []appdef.QName, error) {
+		if revoked || key != apiKey || wsid != 42 {
+			return istructs.NullRecordID, nil, nil
+		}
+		return 5, []appdef.QName{role}, nil
+	}
+	authn := NewDefaultAuthenticator(TestSubjectRolesGetter, apiKeyGetter, TestIsDeviceAllowedFuncs)
+	appStructs := &implIAppStructs{}
+
+	t.Run("basic usage", func(t *testing.T) {
+		req := iauthnz.AuthnRequest{
+			Host:        "127.0.0.1",
+			RequestWSID: 42,
+			Token:       apiKey,
+		}
+		principals, profileWSID, err := authn.Authenticate(context.Background(), appStructs, appTokens, req)
+		require.NoError(err)
+		require.Zero(profileWSID)
+		require.Equal([]iauthnz.Principal{
+			{Kind: iauthnz.PrincipalKind_APIKey, WSID: 42, ID: 5},
+			{Kind: iauthnz.PrincipalKind_Role, WSID: 42, QName: role},
+			{Kind: iauthnz.PrincipalKind_Host, Name: "127.0.0.1"},
+		}, principals)
+	})
+
+	t.Run("another workspace", func(t *testing.T) {
+		req := iauthnz.AuthnRequest{RequestWSID: 43, Token: apiKey}
+		_, _, err := authn.Authenticate(context.Background(), appStructs, appTokens, req)
+		require.ErrorIs(err, ErrAPIKeyIsNotValid)
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		revoked = true
+		defer func() { revoked = false }()
+		req := iauthnz.AuthnRequest{RequestWSID: 42, Token: apiKey}
+		_, _, err := authn.Authenticate(context.Background(), appStructs, appTokens, req)
+		require.ErrorIs(err, ErrAPIKeyIsNotValid)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		mockTime := testingu.NewMockTime()
+		mockAppTokens := payloads.ProvideIAppTokensFactory(itokensjwt.ProvideITokens(itokensjwt.SecretKeyExample, mockTime)).New(istructs.AppQName_test1_app1)
+		expiringKey, err := mockAppTokens.IssueToken(time.Minute, &payloads.APIKeyPayload{WSID: 42})
+		require.NoError(err)
+		mockTime.Add(2 * time.Minute)
+		req := iauthnz.AuthnRequest{RequestWSID: 42, Token: expiringKey}
+		_, _, err = authn.Authenticate(context.Background(), appStructs, mockAppTokens, req)
+		require.ErrorIs(err, itokens.ErrTokenExpired)
+	})
+}
+
 func AppStructsWithTestStorage(appQName appdef.AppQName, data map[istructs.WSID]map[appdef.QName]map[istructs.RecordID]map[string]interface{}) istructs.IAppStructs {
 	recs := &implIRecords{data: data}
 	return &implIAppStructs{records: recs, views: &implIViewRecords{records: recs}, appQName: appQName}
@@ -541,6 +604,9 @@ func (as *implIAppStructs) GetEventReapplier(istructs.IPLogEvent) istructs.IEven
 func (as *implIAppStructs) SeqTypes() map[istructs.QNameID]map[istructs.QNameID]uint64     { panic("") }
 func (as *implIAppStructs) QNameID(appdef.QName) (istructs.QNameID, error)                 { panic("") }
 func (as *implIAppStructs) AppTTLStorage() istructs.IAppTTLStorage                         { panic("") }
+func (as *implIAppStructs) PurgeWorkspace(context.Context, istructs.WSID, ...istructs.WSID) (istructs.WorkspacePurgeStats, error) {
+	panic("")
+}
 
 type implIRecords struct {
 	data map[istructs.WSID]map[appdef.QName]map[istructs.RecordID]map[string]interface{}
@@ -623,6 +689,7 @@ func (*implIViewRecords) Put(istructs.WSID, istructs.IKeyBuilder, istructs.IValu
 }
 func (*implIViewRecords) PutBatch(istructs.WSID, []istructs.ViewKV) error                  { panic("") }
 func (*implIViewRecords) PutJSON(istructs.WSID, map[appdef.FieldName]any) error            { panic("") }
+func (*implIViewRecords) Delete(istructs.WSID, istructs.IKeyBuilder) error                 { panic("") }
 func (*implIViewRecords) Get(istructs.WSID, istructs.IKeyBuilder) (istructs.IValue, error) { panic("") }
 func (vr *implIViewRecords) GetBatch(workspace istructs.WSID, kv []istructs.ViewRecordGetBatchItem) error {
 	if wsData, ok := vr.records.data[workspace]; ok {
@@ -9,9 +9,10 @@ import (
 	"github.com/voedger/voedger/pkg/istructs"
 )
 
-func NewDefaultAuthenticator(subjectRolesGetter SubjectGetterFunc, isDeviceAllowedFuncs IsDeviceAllowedFuncs) iauthnz.IAuthenticator {
+func NewDefaultAuthenticator(subjectRolesGetter SubjectGetterFunc, apiKeyGetter APIKeyGetterFunc, isDeviceAllowedFuncs IsDeviceAllowedFuncs) iauthnz.IAuthenticator {
 	return &implIAuthenticator{
 		subjectRolesGetter:   subjectRolesGetter,
+		apiKeyGetter:         apiKeyGetter,
 		isDeviceAllowedFuncs: isDeviceAllowedFuncs,
 	}
 }
@@ -13,10 +13,14 @@ import (
 
 type implIAuthenticator struct {
 	subjectRolesGetter   SubjectGetterFunc
+	apiKeyGetter         APIKeyGetterFunc
 	isDeviceAllowedFuncs IsDeviceAllowedFuncs
 }
 
 type SubjectGetterFunc = func(requestContext context.Context, name string, as istructs.IAppStructs, wsid istructs.WSID) ([]appdef.QName, error)
 
+// returns NullRecordID if the API key is not issued in the workspace or is revoked
+type APIKeyGetterFunc = func(requestContext context.Context, apiKey string, as istructs.IAppStructs, wsid istructs.WSID) (apiKeyID istructs.RecordID, roles []appdef.QName, err error)
+
 type IsDeviceAllowedFunc = func(as istructs.IAppStructs, requestWSID istructs.WSID, deviceProfileWSID istructs.WSID) (ok bool, err error)
 type IsDeviceAllowedFuncs map[appdef.AppQName]IsDeviceAllowedFunc
@@ -18,6 +18,10 @@ var TestSubjectRolesGetter = func(context.Context, string, istructs.IAppStructs,
 	return nil, nil
 }
 
+var TestAPIKeyGetter = func(context.Context, string, istructs.IAppStructs, istructs.WSID) (istructs.RecordID, []appdef.QName, error) {
+	return istructs.NullRecordID, nil, nil
+}
+
 func IssueAPIToken(appTokens istructs.IAppTokens, duration time.Duration, roles []appdef.QName, wsid istructs.WSID, currentPrincipalPayload payloads.PrincipalPayload) (token string, err error) {
 	if wsid == istructs.NullWSID {
 		return "", ErrPersonalAccessTokenOnNullWSID
@@ -33,4 +33,8 @@ type IBLOBStorage interface {
 
 	// state missing but the blob data exists -> ErrBLOBNotFound unlike ReadBLOB: it will return ErrBLOBCorrupted in this case
 	QueryBLOBState(ctx context.Context, key IBLOBKey) (state BLOBState, err error)
+
+	// Physically deletes the blob data and state
+	// Deleting of the missing blob is not an error
+	DeleteBLOB(ctx context.Context, key PersistentBLOBKeyType) (err error)
 }
@@ -5,6 +5,7 @@
 package iblobstoragestg
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/json"
@@ -250,6 +251,52 @@ func (b *bStorageType) QueryBLOBState(_ context.Context, key iblobstorage.IBLOBK
 	return state, nil
 }
 
+func (b *bStorageType) DeleteBLOB(ctx context.Context, key iblobstorage.PersistentBLOBKeyType) (err error) {
+	blobKeyBytes := key.Bytes()
+
+	// data is deleted first, so failed deletion could be repeated while the state exists
+	bucketNumber := uint64(1)
+	pKeyWithBucket := newKeyWithBucketNumber(blobKeyBytes, bucketNumber)
+	for ctx.Err() == nil {
+		type chunk struct{ cCols, data []byte }
+		chunks := []chunk{}
+		err = (*(b.blobStorage)).Read(ctx, pKeyWithBucket, nil, nil, func(cCols []byte, data []byte) error {
+			// can not delete during read
+			chunks = append(chunks, chunk{cCols: bytes.Clone(cCols), data: bytes.Clone(data)})
+			return nil
+		})
+		if err != nil || len(chunks) == 0 {
+			break
+		}
+		for _, c := range chunks {
+			if _, err = (*(b.blobStorage)).CompareAndDelete(pKeyWithBucket, c.cCols, c.data); err != nil {
+				// notest
+				return err
+			}
+		}
+		bucketNumber++
+		pKeyWithBucket = mutateBucketNumber(pKeyWithBucket, bucketNumber)
+	}
+
+	if err != nil {
+		// notest
+		return err
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	pKeyState, cColState := getStateKeys(blobKeyBytes)
+	var stateBytes []byte
+	ok, err := (*(b.blobStorage)).Get(pKeyState, cColState, &stateBytes)
+	if err != nil || !ok {
+		return err
+	}
+	_, err = (*(b.blobStorage)).CompareAndDelete(pKeyState, cColState, stateBytes)
+	return err
+}
+
 func (b *bStorageType) readState(pKey, cCol []byte, isPersistent bool) (state iblobstorage.BLOBState, ok bool, err error) {
 	var stateBytes []byte
 	if isPersistent {
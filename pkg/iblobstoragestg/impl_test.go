@@ -210,6 +210,62 @@ func TestFewBucketsBLOB(t *testing.T) {
 	require.Equal(bigBLOB, buf.Bytes())
 }
 
+func TestDeleteBLOB(t *testing.T) {
+	var (
+		key = iblobstorage.PersistentBLOBKeyType{
+			ClusterAppID: 2,
+			WSID:         2,
+			BlobID:       2,
+		}
+		anotherKey = iblobstorage.PersistentBLOBKeyType{
+			ClusterAppID: 2,
+			WSID:         2,
+			BlobID:       3,
+		}
+		desc = iblobstorage.DescrType{
+			Name:        "test",
+			ContentType: "image/png",
+		}
+	)
+	require := require.New(t)
+
+	asf := mem.Provide(testingu.MockTime)
+	asp := istorageimpl.Provide(asf)
+	storage, err := asp.AppStorage(istructs.AppQName_test1_app1)
+	require.NoError(err)
+	blobber := Provide(&storage, timeu.NewITime())
+	ctx := context.Background()
+
+	// few buckets
+	bigBLOB := make([]byte, chunkSize*bucketSize*2+1)
+	_, err = rand.Read(bigBLOB)
+	require.NoError(err)
+
+	for _, k := range []iblobstorage.PersistentBLOBKeyType{key, anotherKey} {
+		_, err = blobber.WriteBLOB(ctx, k, desc, bytes.NewReader(bigBLOB), NewWLimiter_Size(iblobstorage.BLOBMaxSizeType(len(bigBLOB))))
+		require.NoError(err)
+	}
+
+	require.NoError(blobber.DeleteBLOB(ctx, key))
+
+	t.Run("deleted blob is not found", func(t *testing.T) {
+		_, err := blobber.QueryBLOBState(ctx, &key)
+		require.ErrorIs(err, iblobstorage.ErrBLOBNotFound)
+		err = blobber.ReadBLOB(ctx, &key, nil, io.Discard, RLimiter_Null)
+		require.ErrorIs(err, iblobstorage.ErrBLOBNotFound)
+	})
+
+	t.Run("another blob is kept", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(blobber.ReadBLOB(ctx, &anotherKey, nil, &buf, RLimiter_Null))
+		require.Equal(bigBLOB, buf.Bytes())
+	})
+
+	t.Run("delete missing blob", func(t *testing.T) {
+		require.NoError(blobber.DeleteBLOB(ctx, key))
+	})
+}
+
 func TestReadBLOBStopLimiter(t *testing.T) {
 	outerRequire := require.New(t)
 	asf := mem.Provide(testingu.MockTime)
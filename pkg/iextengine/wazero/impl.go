@@ -217,6 +217,7 @@ func (f *wazeroExtEngine) init(ctx context.Context) error {
 		NewFunctionBuilder().WithFunc(f.hostReadValues).Export("hostReadValues").
 		// IKey
 		NewFunctionBuilder().WithFunc(f.hostKeyAsString).Export("hostKeyAsString").
+		NewFunctionBuilder().WithFunc(f.hostKeyAsDecimal).Export("hostKeyAsDecimal").
 		NewFunctionBuilder().WithFunc(f.hostKeyAsBytes).Export("hostKeyAsBytes").
 		NewFunctionBuilder().WithFunc(f.hostKeyAsInt32).Export("hostKeyAsInt32").
 		NewFunctionBuilder().WithFunc(f.hostKeyAsInt64).Export("hostKeyAsInt64").
@@ -229,6 +230,7 @@ func (f *wazeroExtEngine) init(ctx context.Context) error {
 		NewFunctionBuilder().WithFunc(f.hostValueLength).Export("hostValueLength").
 		NewFunctionBuilder().WithFunc(f.hostValueAsValue).Export("hostValueAsValue").
 		NewFunctionBuilder().WithFunc(f.hostValueAsString).Export("hostValueAsString").
+		NewFunctionBuilder().WithFunc(f.hostValueAsDecimal).Export("hostValueAsDecimal").
 		NewFunctionBuilder().WithFunc(f.hostValueAsBytes).Export("hostValueAsBytes").
 		NewFunctionBuilder().WithFunc(f.hostValueAsInt32).Export("hostValueAsInt32").
 		NewFunctionBuilder().WithFunc(f.hostValueAsInt64).Export("hostValueAsInt64").
@@ -252,6 +254,7 @@ func (f *wazeroExtEngine) init(ctx context.Context) error {
 		NewFunctionBuilder().WithFunc(f.hostUpdateValue).Export("hostUpdateValue").
 		// RowWriters
 		NewFunctionBuilder().WithFunc(f.hostRowWriterPutString).Export("hostRowWriterPutString").
+		NewFunctionBuilder().WithFunc(f.hostRowWriterPutDecimal).Export("hostRowWriterPutDecimal").
 		NewFunctionBuilder().WithFunc(f.hostRowWriterPutBytes).Export("hostRowWriterPutBytes").
 		NewFunctionBuilder().WithFunc(f.hostRowWriterPutInt32).Export("hostRowWriterPutInt32").
 		NewFunctionBuilder().WithFunc(f.hostRowWriterPutInt64).Export("hostRowWriterPutInt64").
@@ -517,6 +520,11 @@ func (f *wazeroExtEngine) hostKeyAsString(id uint64, namePtr uint32, nameSize ui
 	return f.allocAndSend([]byte(v))
 }
 
+func (f *wazeroExtEngine) hostKeyAsDecimal(id uint64, namePtr uint32, nameSize uint32) (result uint64) {
+	v := f.safeApi.KeyAsDecimal(safe.TKey(id), f.decodeStr(namePtr, nameSize))
+	return f.allocAndSend([]byte(v))
+}
+
 func (f *wazeroExtEngine) hostKeyAsBytes(id uint64, namePtr uint32, nameSize uint32) (result uint64) {
 	v := f.safeApi.KeyAsBytes(safe.TKey(id), f.decodeStr(namePtr, nameSize))
 	return f.allocAndSend(v)
@@ -608,6 +616,11 @@ func (f *wazeroExtEngine) hostValueAsString(id uint64, namePtr uint32, nameSize
 	return f.allocAndSend([]byte(s))
 }
 
+func (f *wazeroExtEngine) hostValueAsDecimal(id uint64, namePtr uint32, nameSize uint32) (result uint64) {
+	s := f.safeApi.ValueAsDecimal(safe.TValue(id), f.decodeStr(namePtr, nameSize))
+	return f.allocAndSend([]byte(s))
+}
+
 func (f *wazeroExtEngine) hostValueAsBytes(id uint64, namePtr uint32, nameSize uint32) (result uint64) {
 	b := f.safeApi.ValueAsBytes(safe.TValue(id), f.decodeStr(namePtr, nameSize))
 	return f.allocAndSend(b)
@@ -760,6 +773,14 @@ func (f *wazeroExtEngine) hostRowWriterPutString(id uint64, typ uint32, namePtr
 	}
 }
 
+func (f *wazeroExtEngine) hostRowWriterPutDecimal(id uint64, typ uint32, namePtr uint32, nameSize, valuePtr, valueSize uint32) {
+	if typ == 0 {
+		f.safeApi.KeyBuilderPutDecimal(safe.TKeyBuilder(id), f.decodeStr(namePtr, nameSize), f.decodeStr(valuePtr, valueSize))
+	} else {
+		f.safeApi.IntentPutDecimal(safe.TIntent(id), f.decodeStr(namePtr, nameSize), f.decodeStr(valuePtr, valueSize))
+	}
+}
+
 func (f *wazeroExtEngine) hostRowWriterPutBytes(id uint64, typ uint32, namePtr uint32, nameSize, valuePtr, valueSize uint32) {
 	var bytes []byte
 	var ok bool
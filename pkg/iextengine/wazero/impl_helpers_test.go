@@ -12,9 +12,13 @@ import (
 	"fmt"
 	"net/url"
 	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/state/stateprovide"
 	"github.com/voedger/voedger/pkg/sys"
@@ -275,6 +279,9 @@ func (kb *mockKeyBuilder) PutString(name, value string)                     {}
 func (kb *mockKeyBuilder) PutQName(name string, value appdef.QName)         { kb.data[name] = value }
 func (kb *mockKeyBuilder) PutBool(name string, value bool)                  {}
 func (kb *mockKeyBuilder) PutRecordID(name string, value istructs.RecordID) {}
+func (kb *mockKeyBuilder) PutDecimal(name string, value decimal.Decimal)    {}
+func (kb *mockKeyBuilder) PutTime(name string, value time.Time)             {}
+func (kb *mockKeyBuilder) PutUUID(name string, value uuid.UUID)             {}
 func (kb *mockKeyBuilder) ToBytes(istructs.WSID) (pk []byte, cc []byte, err error) {
 	return nil, nil, nil
 }
@@ -406,6 +413,9 @@ func (vb *mockValueBuilder) PutString(name, value string)                     {
 func (vb *mockValueBuilder) PutQName(name string, value appdef.QName)         { vb.items[name] = value }
 func (vb *mockValueBuilder) PutBool(name string, value bool)                  {}
 func (vb *mockValueBuilder) PutRecordID(name string, value istructs.RecordID) {}
+func (vb *mockValueBuilder) PutDecimal(name string, value decimal.Decimal)    {}
+func (vb *mockValueBuilder) PutTime(name string, value time.Time)             {}
+func (vb *mockValueBuilder) PutUUID(name string, value uuid.UUID)             {}
 func (vb *mockValueBuilder) PutFromJSON(map[string]any)                       {}
 func (vb *mockValueBuilder) ToBytes() ([]byte, error)                         { return nil, nil }
 func (vb *mockValueBuilder) PutNumber(name string, value json.Number)         {}
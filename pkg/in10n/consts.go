@@ -17,6 +17,9 @@ var QNameHeartbeat30 = appdef.NewQName(appdef.SysPackage, "Heartbeat30")
 
 const Heartbeat30Duration = 30 * time.Second
 
+// SSE event used to forward payloads to the subscriber, follows the offset event of the same projection
+const SSEEvent_Payload = "payload"
+
 // [~server.n10n.heartbeats/freq.ZeroKey~impl]
 var Heartbeat30ProjectionKey = ProjectionKey{
 	App:        appdef.AppQName{},
@@ -8,7 +8,9 @@ package in10n
 import (
 	"bytes"
 
+	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/goutils/strconvu"
+	"github.com/voedger/voedger/pkg/istructs"
 )
 
 func (pk ProjectionKey) ToJSON() string {
@@ -33,3 +35,29 @@ func ProjectionKeysToJSON(keys []ProjectionKey) string {
 	buf.WriteString("]")
 	return buf.String()
 }
+
+// Returns empty string if none of payloads is marshaled
+func PayloadsToJSON(projection ProjectionKey, offset istructs.Offset, payloads []Payload, fieldFilter func(appdef.FieldName) bool) string {
+	buf := bytes.NewBufferString(`{"Projection":`)
+	buf.WriteString(projection.ToJSON())
+	buf.WriteString(`,"Offset":`)
+	buf.WriteString(strconvu.UintToString(offset))
+	buf.WriteString(`,"Payloads":[`)
+	marshaled := 0
+	for _, p := range payloads {
+		data := p.JSON(fieldFilter)
+		if data == nil {
+			continue
+		}
+		if marshaled > 0 {
+			buf.WriteString(",")
+		}
+		buf.Write(data)
+		marshaled++
+	}
+	if marshaled == 0 {
+		return ""
+	}
+	buf.WriteString("]}")
+	return buf.String()
+}
@@ -6,6 +6,7 @@
 package in10n
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -22,3 +23,44 @@ func TestProjectionToJSON(t *testing.T) {
 
 	require.JSONEq(t, `{"App":"owner/app", "Projection":"ownertab.table", "WS":42}`, pk.ToJSON())
 }
+
+// test payload is the map of field values
+type testPayload map[appdef.FieldName]int
+
+func (p testPayload) JSON(fieldFilter func(appdef.FieldName) bool) []byte {
+	res := map[appdef.FieldName]int{}
+	for f, v := range p {
+		if fieldFilter(f) {
+			res[f] = v
+		}
+	}
+	data, _ := json.Marshal(res)
+	return data
+}
+
+// test payload which could not be marshaled
+type brokenPayload struct{}
+
+func (brokenPayload) JSON(func(appdef.FieldName) bool) []byte { return nil }
+
+func TestPayloadsToJSON(t *testing.T) {
+	pk := ProjectionKey{
+		App:        appdef.NewAppQName("owner", "app"),
+		Projection: appdef.NewQName("ownertab", "view"),
+		WS:         istructs.WSID(42),
+	}
+	allFields := func(appdef.FieldName) bool { return true }
+
+	require.JSONEq(t, `{"Projection":{"App":"owner/app", "Projection":"ownertab.view", "WS":42},"Offset":5,"Payloads":[{"a":1},{"b":2}]}`,
+		PayloadsToJSON(pk, istructs.Offset(5), []Payload{testPayload{"a": 1}, brokenPayload{}, testPayload{"b": 2}}, allFields))
+
+	t.Run("fields are filtered", func(t *testing.T) {
+		require.JSONEq(t, `{"Projection":{"App":"owner/app", "Projection":"ownertab.view", "WS":42},"Offset":5,"Payloads":[{"a":1},{}]}`,
+			PayloadsToJSON(pk, istructs.Offset(5), []Payload{testPayload{"a": 1, "secret": 2}, testPayload{"secret": 3}},
+				func(f appdef.FieldName) bool { return f != "secret" }))
+	})
+
+	t.Run("empty if nothing is marshaled", func(t *testing.T) {
+		require.Empty(t, PayloadsToJSON(pk, istructs.Offset(5), []Payload{brokenPayload{}}, allFields))
+	})
+}
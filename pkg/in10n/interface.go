@@ -41,11 +41,23 @@ type IN10nBroker interface {
 	//
 	WatchChannel(ctx context.Context, channelID ChannelID, notifySubscriber func(projection ProjectionKey, offset istructs.Offset))
 
+	// Same as WatchChannel but also delivers payloads published by UpdateWithPayload() since the previous notification
+	// payloads is nil if the projection does not publish payloads or if the channel payload buffer overflowed,
+	// the subscriber should re-read the projection in this case
+	// Only one client must call WatchChannel or WatchChannelWithPayloads, concurrent use is not allowed
+	WatchChannelWithPayloads(ctx context.Context, channelID ChannelID, notifySubscriber func(projection ProjectionKey, offset istructs.Offset, payloads []Payload))
+
 	// This method MUST NOT BLOCK longer than 500 ns
 	// Updates all channels which subscribed for this projection
 	// @ConcurrentAccess
 	Update(projection ProjectionKey, offset istructs.Offset)
 
+	// Same as Update but also buffers the payload for every channel subscribed for this projection
+	// Could block longer than Update, proportionally to the number of subscribed channels
+	// Same as Update if payload is nil
+	// @ConcurrentAccess
+	UpdateWithPayload(projection ProjectionKey, offset istructs.Offset, payload Payload)
+
 	// ChannelID must be taken from NewChannel()
 	// Errors: ErrChannelDoesNotExist
 	// @ConcurrentAccess
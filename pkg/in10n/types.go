@@ -19,9 +19,21 @@ type ProjectionKey struct {
 	WS         istructs.WSID
 }
 
+// Payload is the changed data of a projection (e.g. changed view keys and values) published along with the offset
+// Payload is marshaled on delivery only, so nothing is marshaled if the projection has no subscribers
+type Payload interface {
+	// Returns JSON of the changed data with the fields accepted by fieldFilter only
+	// Returns nil if the data could not be marshaled
+	JSON(fieldFilter func(appdef.FieldName) bool) []byte
+}
+
 type Quotas struct {
 	Channels                int
 	ChannelsPerSubject      int
 	Subscriptions           int
 	SubscriptionsPerSubject int
+
+	// Maximum number of payloads buffered per channel between deliveries
+	// Projections whose payloads do not fit are delivered offset-only
+	PayloadsPerChannel int
 }
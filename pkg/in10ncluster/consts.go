@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package in10ncluster
+
+import "time"
+
+const (
+	// updates which are not sent to a peer yet; if exceeded, new updates to the peer are dropped
+	peerQueueSize = 1000
+
+	// updates which are received from peers but not delivered to the local broker yet
+	receivedQueueSize = 1000
+
+	// delay between attempts to connect to a peer
+	peerRedialInterval = time.Second
+
+	peerDialTimeout      = 5 * time.Second
+	peerWriteTimeout     = 5 * time.Second
+	peerHandshakeTimeout = 5 * time.Second
+
+	// size of the random challenge each side sends on handshake
+	peerChallengeSize = 32
+)
+
+// roles are mixed into the challenge response so that the response of one side could not be replayed by another
+const (
+	roleListener byte = 'L'
+	roleDialer   byte = 'D'
+)
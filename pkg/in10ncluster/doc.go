@@ -0,0 +1,9 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+// Package in10ncluster implements in10n.IN10nBroker which replicates projection updates among VVMs.
+// Local broker keeps channels and subscriptions, ITransport delivers updates to peer VVMs.
+// So a subscriber connected to any VVM is notified regardless of which VVM owns the partition.
+// TCP peers are authenticated by the shared secret using HMAC-SHA256 challenge-response on connect.
+package in10ncluster
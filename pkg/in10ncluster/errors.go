@@ -0,0 +1,11 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package in10ncluster
+
+import "errors"
+
+var ErrEmptySecret = errors.New("n10n cluster secret must not be empty")
+
+var ErrPeerNotAuthenticated = errors.New("n10n peer is not authenticated")
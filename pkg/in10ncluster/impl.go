@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package in10ncluster
+
+import (
+	"encoding/json"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/goutils/logger"
+	"github.com/voedger/voedger/pkg/in10n"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+// Update @ConcurrentAccess
+// Updates the local broker and replicates the update to peers
+func (b *broker) Update(projection in10n.ProjectionKey, offset istructs.Offset) {
+	b.IN10nBroker.Update(projection, offset)
+	b.transport.Publish(Update{Projection: projection, Offset: offset})
+}
+
+// UpdateWithPayload @ConcurrentAccess
+// Updates the local broker and replicates the update with the payload to peers
+func (b *broker) UpdateWithPayload(projection in10n.ProjectionKey, offset istructs.Offset, payload in10n.Payload) {
+	b.IN10nBroker.UpdateWithPayload(projection, offset, payload)
+	b.transport.Publish(Update{Projection: projection, Offset: offset, Payload: payload})
+}
+
+// receive delivers the update from a peer to the local broker, not replicated further
+func (b *broker) receive(u Update) {
+	b.IN10nBroker.UpdateWithPayload(u.Projection, u.Offset, u.Payload)
+}
+
+// MarshalJSON marshals the payload with all fields
+// The update is sent offset-only if the payload could not be marshaled
+func (u Update) MarshalJSON() ([]byte, error) {
+	w := updateJSON{Projection: u.Projection, Offset: u.Offset}
+	if u.Payload != nil {
+		w.Payload = u.Payload.JSON(func(appdef.FieldName) bool { return true })
+	}
+	return json.Marshal(w)
+}
+
+func (u *Update) UnmarshalJSON(data []byte) error {
+	w := updateJSON{}
+	if err := json.Unmarshal(data, &w); err != nil {
+		return err
+	}
+	u.Projection = w.Projection
+	u.Offset = w.Offset
+	u.Payload = nil
+	if len(w.Payload) > 0 {
+		u.Payload = peerPayload(w.Payload)
+	}
+	return nil
+}
+
+// Returns the payload with the fields accepted by fieldFilter only
+// Returns nil if the payload is not an array of field maps, the subscribers fall back to offset-only notification then
+func (p peerPayload) JSON(fieldFilter func(appdef.FieldName) bool) []byte {
+	changes := []map[string]map[string]json.RawMessage{}
+	if err := json.Unmarshal(p, &changes); err != nil {
+		logger.Error("n10n peer payload is not an array of field maps, payload is dropped:", err)
+		return nil
+	}
+	for _, change := range changes {
+		for _, fields := range change {
+			for name := range fields {
+				if !fieldFilter(name) {
+					delete(fields, name)
+				}
+			}
+		}
+	}
+	res, err := json.Marshal(changes)
+	if err != nil {
+		// notest
+		return nil
+	}
+	return res
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package in10ncluster
+
+import (
+	"context"
+
+	"github.com/voedger/voedger/pkg/goutils/logger"
+)
+
+func (h *loopbackHub) NewTransport() ITransport {
+	t := &loopbackTransport{
+		hub:     h,
+		updates: make(chan Update, receivedQueueSize),
+	}
+	h.Lock()
+	h.transports = append(h.transports, t)
+	h.Unlock()
+	return t
+}
+
+func (t *loopbackTransport) Publish(u Update) {
+	t.hub.RLock()
+	defer t.hub.RUnlock()
+	for _, peer := range t.hub.transports {
+		if peer == t {
+			continue
+		}
+		select {
+		case peer.updates <- u:
+		default:
+			logger.Error("n10n loopback peer queue is full, update is dropped:", u.Projection, u.Offset)
+		}
+	}
+}
+
+func (t *loopbackTransport) Receive(ctx context.Context, onUpdate func(u Update)) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case u := <-t.updates:
+			onUpdate(u)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package in10ncluster
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/voedger/voedger/pkg/goutils/logger"
+)
+
+func (t *tcpTransport) Publish(u Update) {
+	for _, peer := range t.peers {
+		select {
+		case peer.updates <- u:
+		default:
+			logger.Error("n10n peer", peer.addr, "queue is full, update is dropped:", u.Projection, u.Offset)
+		}
+	}
+}
+
+func (t *tcpTransport) Receive(ctx context.Context, onUpdate func(u Update)) {
+	wg := sync.WaitGroup{}
+	for _, peer := range t.peers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			peer.send(ctx)
+		}()
+	}
+
+	received := make(chan Update, receivedQueueSize)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			conn, err := t.listener.Accept()
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Error("n10n peers listener failed:", err)
+				}
+				return
+			}
+			stopClose := context.AfterFunc(ctx, func() { conn.Close() })
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer conn.Close()
+				defer stopClose()
+				if err := acceptHandshake(conn, t.secret); err != nil {
+					logger.Error("n10n peer", conn.RemoteAddr(), "is rejected:", err)
+					return
+				}
+				readUpdates(ctx, conn, received)
+			}()
+		}
+	}()
+
+	func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case u := <-received:
+				onUpdate(u)
+			}
+		}
+	}()
+
+	t.listener.Close()
+	wg.Wait()
+}
+
+func (t *tcpTransport) addr() net.Addr {
+	return t.listener.Addr()
+}
+
+func readUpdates(ctx context.Context, conn net.Conn, received chan<- Update) {
+	dec := json.NewDecoder(conn)
+	for {
+		u := Update{}
+		if err := dec.Decode(&u); err != nil {
+			if ctx.Err() == nil && !errors.Is(err, net.ErrClosed) {
+				logger.Verbose("n10n peer", conn.RemoteAddr(), "disconnected:", err)
+			}
+			return
+		}
+		select {
+		case received <- u:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// send sends queued updates to the peer, reconnects on failure
+// The update which failed to be sent is dropped
+func (p *tcpPeer) send(ctx context.Context) {
+	var conn net.Conn
+	defer func() {
+		if conn != nil {
+			conn.Close()
+		}
+	}()
+	var enc *json.Encoder
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case u := <-p.updates:
+			if conn == nil {
+				conn = p.dial(ctx)
+				if conn == nil {
+					return
+				}
+				enc = json.NewEncoder(conn)
+			}
+			err := conn.SetWriteDeadline(time.Now().Add(peerWriteTimeout))
+			if err == nil {
+				err = enc.Encode(u)
+			}
+			if err != nil {
+				logger.Error("n10n peer", p.addr, "send failed, update is dropped:", err)
+				conn.Close()
+				conn = nil
+			}
+		}
+	}
+}
+
+// returns nil if ctx is done
+func (p *tcpPeer) dial(ctx context.Context) net.Conn {
+	dialer := net.Dialer{Timeout: peerDialTimeout}
+	for {
+		conn, err := dialer.DialContext(ctx, "tcp", p.addr)
+		if err == nil {
+			if err = dialHandshake(conn, p.secret); err == nil {
+				return conn
+			}
+			conn.Close()
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		logger.Error("n10n peer", p.addr, "dial failed:", err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(peerRedialInterval):
+		}
+	}
+}
+
+// acceptHandshake authenticates the peer which dialed the listener:
+//   - listener sends its challenge
+//   - dialer sends its challenge and the response to the listener's challenge
+//   - listener checks the response and sends the response to the dialer's challenge
+func acceptHandshake(conn net.Conn, secret []byte) error {
+	if err := conn.SetDeadline(time.Now().Add(peerHandshakeTimeout)); err != nil {
+		return err
+	}
+	challenge, err := newChallenge()
+	if err != nil {
+		// notest
+		return err
+	}
+	if _, err := conn.Write(challenge); err != nil {
+		return err
+	}
+	peerChallenge := make([]byte, peerChallengeSize)
+	if _, err := io.ReadFull(conn, peerChallenge); err != nil {
+		return err
+	}
+	peerResponse := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(conn, peerResponse); err != nil {
+		return err
+	}
+	if !hmac.Equal(peerResponse, challengeResponse(secret, roleDialer, challenge)) {
+		return ErrPeerNotAuthenticated
+	}
+	if _, err := conn.Write(challengeResponse(secret, roleListener, peerChallenge)); err != nil {
+		return err
+	}
+	return conn.SetDeadline(time.Time{})
+}
+
+// dialHandshake authenticates the listener the peer dialed to, see [acceptHandshake]
+func dialHandshake(conn net.Conn, secret []byte) error {
+	if err := conn.SetDeadline(time.Now().Add(peerHandshakeTimeout)); err != nil {
+		return err
+	}
+	peerChallenge := make([]byte, peerChallengeSize)
+	if _, err := io.ReadFull(conn, peerChallenge); err != nil {
+		return err
+	}
+	challenge, err := newChallenge()
+	if err != nil {
+		// notest
+		return err
+	}
+	if _, err := conn.Write(append(challenge, challengeResponse(secret, roleDialer, peerChallenge)...)); err != nil {
+		return err
+	}
+	peerResponse := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(conn, peerResponse); err != nil {
+		return err
+	}
+	if !hmac.Equal(peerResponse, challengeResponse(secret, roleListener, challenge)) {
+		return ErrPeerNotAuthenticated
+	}
+	return conn.SetDeadline(time.Time{})
+}
+
+func newChallenge() ([]byte, error) {
+	challenge := make([]byte, peerChallengeSize)
+	_, err := rand.Read(challenge)
+	return challenge, err
+}
+
+// HMAC-SHA256 of the role and the challenge keyed by the secret
+func challengeResponse(secret []byte, role byte, challenge []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte{role})
+	mac.Write(challenge)
+	return mac.Sum(nil)
+}
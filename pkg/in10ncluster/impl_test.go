@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package in10ncluster
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/goutils/timeu"
+	"github.com/voedger/voedger/pkg/in10n"
+	"github.com/voedger/voedger/pkg/in10nmem"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+const testSecret = "n10n cluster secret"
+
+var (
+	quotasExample = in10n.Quotas{
+		Channels:                10,
+		ChannelsPerSubject:      10,
+		Subscriptions:           10,
+		SubscriptionsPerSubject: 10,
+		PayloadsPerChannel:      10,
+	}
+	projectionKey1 = in10n.ProjectionKey{
+		App:        istructs.AppQName_test1_app1,
+		Projection: appdef.NewQName("test", "restaurant"),
+		WS:         istructs.WSID(8),
+	}
+)
+
+type notification struct {
+	offset   istructs.Offset
+	payloads []string // JSON of the payloads without the field "b"
+}
+
+// returns the channel of notifications about projectionKey1 received by the broker subscriber
+func watch(t *testing.T, ctx context.Context, wg *sync.WaitGroup, nb in10n.IN10nBroker) <-chan notification {
+	channelID, channelCleanup, err := nb.NewChannel("paa", 24*time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, nb.Subscribe(channelID, projectionKey1))
+	notifications := make(chan notification, 10)
+	wg.Go(func() {
+		defer channelCleanup()
+		nb.WatchChannelWithPayloads(ctx, channelID, func(_ in10n.ProjectionKey, offset istructs.Offset, payloads []in10n.Payload) {
+			n := notification{offset: offset}
+			for _, p := range payloads {
+				n.payloads = append(n.payloads, string(p.JSON(func(name appdef.FieldName) bool { return name != "b" })))
+			}
+			notifications <- n
+		})
+	})
+	return notifications
+}
+
+func testReplication(t *testing.T, transportA, transportB ITransport) {
+	require := require.New(t)
+
+	localA, localACleanup := in10nmem.NewN10nBroker(quotasExample, timeu.NewITime())
+	defer localACleanup()
+	localB, localBCleanup := in10nmem.NewN10nBroker(quotasExample, timeu.NewITime())
+	defer localBCleanup()
+
+	nbA, nbACleanup := NewClusterBroker(localA, transportA)
+	defer nbACleanup()
+	nbB, nbBCleanup := NewClusterBroker(localB, transportB)
+	defer nbBCleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	defer wg.Wait()
+	defer cancel()
+
+	notificationsA := watch(t, ctx, &wg, nbA)
+	notificationsB := watch(t, ctx, &wg, nbB)
+
+	t.Run("Update is delivered to local and peer subscribers", func(t *testing.T) {
+		nbA.Update(projectionKey1, 1)
+		require.Equal(notification{offset: 1}, <-notificationsA)
+		require.Equal(notification{offset: 1}, <-notificationsB)
+	})
+
+	t.Run("Payload is delivered to peer subscribers", func(t *testing.T) {
+		nbB.UpdateWithPayload(projectionKey1, 2, peerPayload(`[{"key":{"k":1},"value":{"a":1,"b":2}}]`))
+		expected := notification{offset: 2, payloads: []string{`[{"key":{"k":1},"value":{"a":1}}]`}}
+		require.Equal(expected, <-notificationsA)
+		require.Equal(expected, <-notificationsB)
+	})
+
+	t.Run("Payload which is not an array of field maps is not delivered", func(t *testing.T) {
+		nbB.UpdateWithPayload(projectionKey1, 3, peerPayload(`{"a":1}`))
+		for _, n := range []notification{<-notificationsA, <-notificationsB} {
+			require.Equal(istructs.Offset(3), n.offset)
+			for _, p := range n.payloads {
+				require.Empty(p)
+			}
+		}
+	})
+}
+
+func TestLoopback(t *testing.T) {
+	hub := NewLoopbackHub()
+	testReplication(t, hub.NewTransport(), hub.NewTransport())
+}
+
+func TestTCP(t *testing.T) {
+	require := require.New(t)
+
+	transportA, err := NewTCPTransport("127.0.0.1:0", nil, testSecret)
+	require.NoError(err)
+	transportB, err := NewTCPTransport("127.0.0.1:0", []string{transportA.(*tcpTransport).addr().String()}, testSecret)
+	require.NoError(err)
+	transportA.(*tcpTransport).peers = []*tcpPeer{{
+		addr:    transportB.(*tcpTransport).addr().String(),
+		updates: make(chan Update, peerQueueSize),
+		secret:  []byte(testSecret),
+	}}
+
+	testReplication(t, transportA, transportB)
+
+	t.Run("Listener is closed when Receive is finished", func(t *testing.T) {
+		transport, err := NewTCPTransport(transportA.(*tcpTransport).addr().String(), nil, testSecret)
+		require.NoError(err)
+		transport.(*tcpTransport).listener.Close()
+	})
+
+	t.Run("ErrEmptySecret", func(t *testing.T) {
+		_, err := NewTCPTransport("127.0.0.1:0", nil, "")
+		require.ErrorIs(err, ErrEmptySecret)
+	})
+}
+
+func TestHandshake(t *testing.T) {
+	require := require.New(t)
+
+	handshake := func(listenerSecret, dialerSecret string) (acceptErr, dialErr error) {
+		listenerConn, dialerConn := net.Pipe()
+		defer listenerConn.Close()
+		defer dialerConn.Close()
+		wg := sync.WaitGroup{}
+		wg.Go(func() {
+			if acceptErr = acceptHandshake(listenerConn, []byte(listenerSecret)); acceptErr != nil {
+				listenerConn.Close()
+			}
+		})
+		dialErr = dialHandshake(dialerConn, []byte(dialerSecret))
+		wg.Wait()
+		return acceptErr, dialErr
+	}
+
+	t.Run("peers with the same secret are authenticated", func(t *testing.T) {
+		acceptErr, dialErr := handshake(testSecret, testSecret)
+		require.NoError(acceptErr)
+		require.NoError(dialErr)
+	})
+
+	t.Run("peer with another secret is rejected", func(t *testing.T) {
+		acceptErr, dialErr := handshake(testSecret, "another secret")
+		require.ErrorIs(acceptErr, ErrPeerNotAuthenticated)
+		require.Error(dialErr)
+	})
+}
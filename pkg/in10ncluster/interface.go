@@ -0,0 +1,25 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package in10ncluster
+
+import "context"
+
+// ITransport delivers projection updates between VVMs
+type ITransport interface {
+	// Publish sends the update to all peers
+	// Must not block: if a peer is slow or unavailable the update could be dropped
+	// @ConcurrentAccess
+	Publish(u Update)
+
+	// Receive calls onUpdate for each update published by peers
+	// Blocks until ctx is done
+	Receive(ctx context.Context, onUpdate func(u Update))
+}
+
+// ILoopbackHub connects in-process transports, used in tests
+type ILoopbackHub interface {
+	// NewTransport returns the transport which exchanges updates with all other transports of the hub
+	NewTransport() ITransport
+}
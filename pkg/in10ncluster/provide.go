@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package in10ncluster
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/voedger/voedger/pkg/in10n"
+)
+
+// NewClusterBroker returns the broker which notifies both local subscribers and peer VVMs about updates.
+// Updates received from peers are delivered to the local broker only.
+// Cleanup does not clean up the local broker
+func NewClusterBroker(local in10n.IN10nBroker, transport ITransport) (nb in10n.IN10nBroker, cleanup func()) {
+	b := &broker{
+		IN10nBroker: local,
+		transport:   transport,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		transport.Receive(ctx, b.receive)
+	}()
+	cleanup = func() {
+		cancel()
+		wg.Wait()
+	}
+	return b, cleanup
+}
+
+// NewLoopbackHub returns the hub for in-process transports
+func NewLoopbackHub() ILoopbackHub {
+	return &loopbackHub{}
+}
+
+// NewTCPTransport returns the transport which listens for peers on listenAddr and sends updates to peersAddrs
+// All VVMs of the cluster must use the same secret, connections of peers which do not know it are rejected
+func NewTCPTransport(listenAddr string, peersAddrs []string, secret string) (ITransport, error) {
+	if len(secret) == 0 {
+		return nil, ErrEmptySecret
+	}
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return nil, err
+	}
+	t := &tcpTransport{
+		listener: listener,
+		secret:   []byte(secret),
+	}
+	for _, addr := range peersAddrs {
+		t.peers = append(t.peers, &tcpPeer{
+			addr:    addr,
+			updates: make(chan Update, peerQueueSize),
+			secret:  t.secret,
+		})
+	}
+	return t, nil
+}
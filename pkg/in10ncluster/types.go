@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package in10ncluster
+
+import (
+	"encoding/json"
+	"net"
+	"sync"
+
+	"github.com/voedger/voedger/pkg/in10n"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+// Update is a projection update replicated between VVMs
+// Payload is sent to peers as JSON with all fields, peers filter the fields on delivery, see [peerPayload]
+type Update struct {
+	Projection in10n.ProjectionKey
+	Offset     istructs.Offset
+	Payload    in10n.Payload
+}
+
+// updateJSON is the wire form of the Update
+type updateJSON struct {
+	Projection in10n.ProjectionKey
+	Offset     istructs.Offset
+	Payload    json.RawMessage `json:",omitempty"`
+}
+
+// peerPayload is the payload received from a peer: JSON array of objects whose members are field maps,
+// e.g. [{"key":{...},"value":{...}}, ...]
+type peerPayload []byte
+
+type broker struct {
+	in10n.IN10nBroker // local broker, channels and subscriptions are served by it
+	transport         ITransport
+}
+
+type loopbackHub struct {
+	sync.RWMutex
+	transports []*loopbackTransport
+}
+
+type loopbackTransport struct {
+	hub     *loopbackHub
+	updates chan Update
+}
+
+// Wire protocol: each VVM accepts connections from peers and dials each peer.
+// Updates are sent over the dialed connection as a stream of JSON-encoded Update values.
+// Peers are authenticated by the shared secret on handshake, see [acceptHandshake] and [dialHandshake].
+type tcpTransport struct {
+	listener net.Listener
+	peers    []*tcpPeer
+	secret   []byte
+}
+
+type tcpPeer struct {
+	addr    string
+	updates chan Update
+	secret  []byte
+}
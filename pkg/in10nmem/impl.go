@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"maps"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/voedger/voedger/pkg/goutils/logger"
@@ -195,6 +196,13 @@ func (nb *n10nBroker) Unsubscribe(channelID in10n.ChannelID, projectionKey in10n
 // Implementation of the in10n.IN10nBroker
 // watchCtx normally is normally request+VVM ctx
 func (nb *n10nBroker) WatchChannel(watchCtx context.Context, channelID in10n.ChannelID, notifySubscriber func(projection in10n.ProjectionKey, offset istructs.Offset)) {
+	nb.WatchChannelWithPayloads(watchCtx, channelID, func(projection in10n.ProjectionKey, offset istructs.Offset, _ []in10n.Payload) {
+		notifySubscriber(projection, offset)
+	})
+}
+
+// Implementation of the in10n.IN10nBroker
+func (nb *n10nBroker) WatchChannelWithPayloads(watchCtx context.Context, channelID in10n.ChannelID, notifySubscriber func(projection in10n.ProjectionKey, offset istructs.Offset, payloads []in10n.Payload)) {
 	// check that the channelID with the given ChannelID exists
 	channel := func() *channel {
 		nb.RLock()
@@ -241,13 +249,17 @@ func (nb *n10nBroker) WatchChannel(watchCtx context.Context, channelID in10n.Cha
 						UpdateUnit{
 							Projection: projection,
 							Offset:     *channelOffsets.currentOffset,
+							Payloads:   channel.takePayloads(projection),
 						})
 					channelOffsets.deliveredOffset = *channelOffsets.currentOffset
 				}
 			}
+			// payloads of unsubscribed projections are not needed anymore
+			channel.payloads = channel.payloads[:0]
+			clear(channel.payloadsLost)
 			nb.Unlock()
 			for _, unit := range updateUnits {
-				notifySubscriber(unit.Projection, unit.Offset)
+				notifySubscriber(unit.Projection, unit.Offset, unit.Payloads)
 			}
 			updateUnits = updateUnits[:0]
 		}
@@ -361,6 +373,31 @@ func (nb *n10nBroker) Update(projection in10n.ProjectionKey, offset istructs.Off
 	nb.events <- e
 }
 
+// UpdateWithPayload @ConcurrentAccess
+// Update projections map with new offset and buffer the payload for subscribed channels
+func (nb *n10nBroker) UpdateWithPayload(projection in10n.ProjectionKey, offset istructs.Offset, payload in10n.Payload) {
+	if payload == nil {
+		nb.Update(projection, offset)
+		return
+	}
+
+	nb.Lock()
+	*guaranteeProjection(nb.projections, projection) = offset
+	prj := nb.projections[projection]
+	prj.Lock()
+	for _, ch := range prj.subscribedChannels {
+		// prj.subscribedChannels is merged by notifier, so the channel could be already unsubscribed
+		if _, ok := ch.subscriptions[projection]; ok {
+			ch.bufferPayload(projection, payload, nb.quotas.PayloadsPerChannel)
+		}
+	}
+	prj.Unlock()
+	nb.Unlock()
+
+	e := event{prj: prj}
+	nb.events <- e
+}
+
 // MetricNumChannels @ConcurrentAccess
 // return channels count
 func (nb *n10nBroker) MetricNumChannels() int {
@@ -437,3 +474,34 @@ func (nb *n10nBroker) validateChannel(channel *channel) error {
 	}
 	return nil
 }
+
+// must be called under the broker lock
+// on overflow buffered payloads of the projection are dropped and the projection is delivered offset-only
+func (ch *channel) bufferPayload(projection in10n.ProjectionKey, payload in10n.Payload, limit int) {
+	if _, lost := ch.payloadsLost[projection]; lost {
+		return
+	}
+	if len(ch.payloads) < limit {
+		ch.payloads = append(ch.payloads, bufferedPayload{projection: projection, payload: payload})
+		return
+	}
+	ch.payloads = slices.DeleteFunc(ch.payloads, func(bp bufferedPayload) bool { return bp.projection == projection })
+	if ch.payloadsLost == nil {
+		ch.payloadsLost = make(map[in10n.ProjectionKey]struct{})
+	}
+	ch.payloadsLost[projection] = struct{}{}
+}
+
+// must be called under the broker lock
+// returns nil if there are no payloads for the projection or if they were lost
+func (ch *channel) takePayloads(projection in10n.ProjectionKey) (payloads []in10n.Payload) {
+	if _, lost := ch.payloadsLost[projection]; lost {
+		return nil
+	}
+	for _, bp := range ch.payloads {
+		if bp.projection == projection {
+			payloads = append(payloads, bp.payload)
+		}
+	}
+	return payloads
+}
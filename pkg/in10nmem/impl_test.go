@@ -648,3 +648,98 @@ func TestDoubleSubscribeAndUnsubscribe(t *testing.T) {
 	checkMetricsZero(t, broker, projectionKey1)
 	brokerCleanup()
 }
+
+// test payload is JSON itself
+type testPayload string
+
+func (p testPayload) JSON(func(appdef.FieldName) bool) []byte { return []byte(p) }
+
+func TestPayloads(t *testing.T) {
+	require := require.New(t)
+
+	quotas := quotasExample
+	quotas.PayloadsPerChannel = 2
+	broker, brokerCleanup := NewN10nBroker(quotas, timeu.NewITime())
+	defer brokerCleanup()
+
+	channelID, channelCleanup, err := broker.NewChannel("testuser", 24*time.Hour)
+	require.NoError(err)
+	defer channelCleanup()
+
+	// watches the channel until numUnits notifications are received
+	watch := func(numUnits int) (units []UpdateUnit) {
+		unitsChan := make(chan UpdateUnit, numUnits)
+		watchCtx, cancel := context.WithCancel(context.Background())
+		wg := sync.WaitGroup{}
+		wg.Go(func() {
+			broker.WatchChannelWithPayloads(watchCtx, channelID, func(projection in10n.ProjectionKey, offset istructs.Offset, payloads []in10n.Payload) {
+				unitsChan <- UpdateUnit{Projection: projection, Offset: offset, Payloads: payloads}
+			})
+		})
+		for range numUnits {
+			units = append(units, <-unitsChan)
+		}
+		cancel()
+		wg.Wait()
+		return units
+	}
+
+	// the channel must be known by the projections before payloads are published
+	broker.Update(projectionKey1, istructs.Offset(1))
+	broker.Update(projectionKey2, istructs.Offset(1))
+	require.NoError(broker.Subscribe(channelID, projectionKey1))
+	require.NoError(broker.Subscribe(channelID, projectionKey2))
+	watch(2)
+
+	t.Run("payloads are delivered along with the offset", func(t *testing.T) {
+		broker.UpdateWithPayload(projectionKey1, istructs.Offset(2), testPayload(`{"a":1}`))
+		broker.UpdateWithPayload(projectionKey1, istructs.Offset(3), testPayload(`{"a":2}`))
+
+		units := watch(1)
+		require.Equal(projectionKey1, units[0].Projection)
+		require.Equal(istructs.Offset(3), units[0].Offset)
+		require.Equal([]in10n.Payload{testPayload(`{"a":1}`), testPayload(`{"a":2}`)}, units[0].Payloads)
+	})
+
+	t.Run("overflowed projection is delivered offset-only", func(t *testing.T) {
+		broker.UpdateWithPayload(projectionKey1, istructs.Offset(4), testPayload(`{"a":3}`))
+		broker.UpdateWithPayload(projectionKey1, istructs.Offset(5), testPayload(`{"a":4}`))
+		broker.UpdateWithPayload(projectionKey2, istructs.Offset(2), testPayload(`{"b":1}`))
+
+		units := watch(2)
+		byProjection := map[in10n.ProjectionKey]UpdateUnit{}
+		for _, u := range units {
+			byProjection[u.Projection] = u
+		}
+		require.Equal(istructs.Offset(5), byProjection[projectionKey1].Offset)
+		require.Equal([]in10n.Payload{testPayload(`{"a":3}`), testPayload(`{"a":4}`)}, byProjection[projectionKey1].Payloads)
+		require.Equal(istructs.Offset(2), byProjection[projectionKey2].Offset)
+		require.Nil(byProjection[projectionKey2].Payloads)
+	})
+
+	t.Run("already buffered payloads of the overflowed projection are dropped", func(t *testing.T) {
+		broker.UpdateWithPayload(projectionKey1, istructs.Offset(6), testPayload(`{"a":5}`))
+		broker.UpdateWithPayload(projectionKey1, istructs.Offset(7), testPayload(`{"a":6}`))
+		broker.UpdateWithPayload(projectionKey1, istructs.Offset(8), testPayload(`{"a":7}`))
+
+		units := watch(1)
+		require.Equal(istructs.Offset(8), units[0].Offset)
+		require.Nil(units[0].Payloads)
+	})
+
+	t.Run("buffer is reset after delivery", func(t *testing.T) {
+		broker.UpdateWithPayload(projectionKey2, istructs.Offset(3), testPayload(`{"b":2}`))
+
+		units := watch(1)
+		require.Equal(istructs.Offset(3), units[0].Offset)
+		require.Equal([]in10n.Payload{testPayload(`{"b":2}`)}, units[0].Payloads)
+	})
+
+	t.Run("offset-only update", func(t *testing.T) {
+		broker.UpdateWithPayload(projectionKey2, istructs.Offset(4), nil)
+
+		units := watch(1)
+		require.Equal(istructs.Offset(4), units[0].Offset)
+		require.Nil(units[0].Payloads)
+	})
+}
@@ -60,6 +60,15 @@ type channel struct {
 	cchan           chan struct{}
 	terminated      bool
 	watching        atomic.Bool
+
+	// guarded by the broker lock, drained by WatchChannel
+	payloads     []bufferedPayload
+	payloadsLost map[in10n.ProjectionKey]struct{}
+}
+
+type bufferedPayload struct {
+	projection in10n.ProjectionKey
+	payload    in10n.Payload
 }
 
 type metricType struct {
@@ -70,6 +79,7 @@ type metricType struct {
 type UpdateUnit struct {
 	Projection in10n.ProjectionKey
 	Offset     istructs.Offset
+	Payloads   []in10n.Payload
 }
 
 type CreateChannelParamsType struct {
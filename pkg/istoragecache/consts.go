@@ -5,4 +5,45 @@
 
 package istoragecache
 
+import (
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+)
+
 const stackKeySize = 512
+
+// the first uint16 of the partition key is the table ID, see istructsmem/internal/consts
+// must be in sync with consts.SysView_Records, consts.SysView_PLog and consts.SysView_WLog
+const (
+	tableIDSize    = 2
+	tableIDRecords = uint16(19)
+	tableIDPLog    = uint16(20)
+	tableIDWLog    = uint16(21)
+)
+
+// tables are used as `qname` label of the per-table metrics
+var (
+	qNameTableRecords = appdef.NewQName(appdef.SysPackage, "Records")
+	qNameTablePLog    = appdef.NewQName(appdef.SysPackage, "PLog")
+	qNameTableWLog    = appdef.NewQName(appdef.SysPackage, "WLog")
+	qNameTableViews   = appdef.NewQName(appdef.SysPackage, "Views")
+	qNameTableOther   = appdef.NewQName(appdef.SysPackage, "Other")
+
+	tables = []appdef.QName{qNameTableRecords, qNameTablePLog, qNameTableWLog, qNameTableViews, qNameTableOther}
+)
+
+const (
+	// fastcache allocates 512 buckets of 64KB chunks at least, smaller sizes are rounded up
+	minAppCacheBytes = 32 * 1024 * 1024
+
+	// app cache is resized by rebalance only if the new size differs from the current one more than by 1/rebalanceThresholdDivisor
+	rebalanceThresholdDivisor = 4
+
+	// resized app cache loses its content, so the size is changed by rebalance only if the significant difference
+	// in the same direction is kept during rebalancesToResize consecutive rebalances
+	rebalancesToResize = 3
+
+	statsUpdateInterval      = 10 * time.Second
+	DefaultRebalanceInterval = time.Minute
+)
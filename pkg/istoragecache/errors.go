@@ -0,0 +1,9 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragecache
+
+import "errors"
+
+var ErrAppNotCached = errors.New("app storage is not cached")
@@ -6,26 +6,48 @@ package istoragecache
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/VictoriaMetrics/fastcache"
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils/utils"
+	"github.com/voedger/voedger/pkg/goutils/logger"
 	"github.com/voedger/voedger/pkg/goutils/timeu"
 	"github.com/voedger/voedger/pkg/istorage"
+	"github.com/voedger/voedger/pkg/istructs"
 	imetrics "github.com/voedger/voedger/pkg/metrics"
 )
 
 type cachedAppStorage struct {
-	cache    *fastcache.Cache
+	// replaced on resize and flush, sets are made under cacheMu
+	cache    atomic.Pointer[fastcache.Cache]
 	storage  istorage.IAppStorage
 	cacheMu  sync.Mutex
 	vvm      string
 	appQName appdef.AppQName
 	iTime    timeu.ITime
 
+	// guarded by cacheMu, reset on the cache replace
+	maxBytes int
+	newKeys  uint64
+	dels     uint64
+	evicted  uint64
+
+	// Get, GetBatch and TTLGet items since the previous rebalance
+	lookups atomic.Uint64
+
+	// guarded by cacheMu, consecutive rebalances which would grow (or shrink) the cache significantly
+	rebalanceVotes int
+	rebalanceGrow  bool
+
+	// read-only after creation
+	tables map[appdef.QName]*tableMetrics
+
 	/* metrics */
 	mGetSeconds                 *imetrics.MetricValue
 	mGetTotal                   *imetrics.MetricValue
@@ -47,14 +69,23 @@ type cachedAppStorage struct {
 	mTTLReadSeconds             *imetrics.MetricValue
 	mQueryTTLSeconds            *imetrics.MetricValue
 	mQueryTTLTotal              *imetrics.MetricValue
+	mEvictionsTotal             *imetrics.MetricValue
+	mEntries                    *imetrics.MetricValue
+	mSizeBytes                  *imetrics.MetricValue
+	mMaxSizeBytes               *imetrics.MetricValue
 }
 
 type implCachingAppStorageProvider struct {
 	storageProvider istorage.IAppStorageProvider
 	maxBytes        int
+	budget          Budget
 	metrics         imetrics.IMetrics
 	vvmName         string
 	iTime           timeu.ITime
+	lock            sync.Mutex
+	apps            map[appdef.AppQName]*cachedAppStorage
+	stop            chan struct{}
+	stopOnce        sync.Once
 }
 
 func (asp *implCachingAppStorageProvider) Prepare(work any) error {
@@ -62,29 +93,134 @@ func (asp *implCachingAppStorageProvider) Prepare(work any) error {
 }
 
 func (asp *implCachingAppStorageProvider) Run(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		asp.updateStatsAndRebalance(ctx)
+	}()
 	asp.storageProvider.Run(ctx)
+	wg.Wait()
 }
 
 func (asp *implCachingAppStorageProvider) Stop() {
+	asp.stopOnce.Do(func() { close(asp.stop) })
 	asp.storageProvider.Stop()
 }
 
-// normally must be called once per app
-// Currently is called once in appStructsProviderType.Builtin() on VVMAppsBuilder.BuildAppsArtefacts() stage
+// the same cache is returned for the app on each call
+// Currently is called once per app in appStructsProviderType.Builtin() on VVMAppsBuilder.BuildAppsArtefacts() stage
 func (asp *implCachingAppStorageProvider) AppStorage(appQName appdef.AppQName) (istorage.IAppStorage, error) {
+	asp.lock.Lock()
+	defer asp.lock.Unlock()
+
+	if s, ok := asp.apps[appQName]; ok {
+		return s, nil
+	}
+
 	nonCachingAppStorage, err := asp.storageProvider.AppStorage(appQName)
 	if err != nil {
 		return nil, err
 	}
 
-	return newCachingAppStorage(
+	s := newCachingAppStorage(
 		asp.maxBytes,
 		nonCachingAppStorage,
 		asp.metrics,
 		asp.vvmName,
 		appQName,
 		asp.iTime,
-	), nil
+	)
+	asp.apps[appQName] = s
+
+	if asp.budget.Bytes > 0 {
+		// caches are empty on the VVM start, so share the budget evenly
+		share := max(asp.budget.Bytes/len(asp.apps), minAppCacheBytes)
+		for _, app := range asp.apps {
+			app.resize(share)
+		}
+	}
+
+	return s, nil
+}
+
+func (asp *implCachingAppStorageProvider) AppStats(app appdef.AppQName) (AppStats, error) {
+	s, err := asp.cachedAppStorage(app)
+	if err != nil {
+		return AppStats{}, err
+	}
+	s.updateStats()
+	return s.stats(), nil
+}
+
+func (asp *implCachingAppStorageProvider) Flush(app appdef.AppQName) error {
+	s, err := asp.cachedAppStorage(app)
+	if err != nil {
+		return err
+	}
+	s.flush()
+	return nil
+}
+
+func (asp *implCachingAppStorageProvider) cachedAppStorage(app appdef.AppQName) (*cachedAppStorage, error) {
+	asp.lock.Lock()
+	defer asp.lock.Unlock()
+	s, ok := asp.apps[app]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrAppNotCached, app)
+	}
+	return s, nil
+}
+
+func (asp *implCachingAppStorageProvider) appStorages() []*cachedAppStorage {
+	asp.lock.Lock()
+	defer asp.lock.Unlock()
+	res := make([]*cachedAppStorage, 0, len(asp.apps))
+	for _, s := range asp.apps {
+		res = append(res, s)
+	}
+	return res
+}
+
+func (asp *implCachingAppStorageProvider) updateStatsAndRebalance(ctx context.Context) {
+	ticker := time.NewTicker(statsUpdateInterval)
+	defer ticker.Stop()
+	lastRebalance := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-asp.stop:
+			return
+		case <-ticker.C:
+			for _, s := range asp.appStorages() {
+				s.updateStats()
+			}
+			if asp.budget.Bytes > 0 && time.Since(lastRebalance) >= asp.budget.RebalanceInterval {
+				asp.rebalance()
+				lastRebalance = time.Now()
+			}
+		}
+	}
+}
+
+// shares the budget between apps proportionally to their lookups since the previous rebalance
+// nothing is changed if there were no lookups
+func (asp *implCachingAppStorageProvider) rebalance() {
+	storages := asp.appStorages()
+	lookups := make([]uint64, len(storages))
+	totalLookups := uint64(0)
+	for i, s := range storages {
+		lookups[i] = s.lookups.Swap(0)
+		totalLookups += lookups[i]
+	}
+	if totalLookups == 0 {
+		return
+	}
+	spare := max(asp.budget.Bytes-len(storages)*minAppCacheBytes, 0)
+	for i, s := range storages {
+		s.rebalance(minAppCacheBytes + int(float64(spare)*float64(lookups[i])/float64(totalLookups)))
+	}
 }
 
 func newCachingAppStorage(
@@ -94,9 +230,8 @@ func newCachingAppStorage(
 	vvm string,
 	appQName appdef.AppQName,
 	iTime timeu.ITime,
-) istorage.IAppStorage {
-	return &cachedAppStorage{
-		cache:                       fastcache.New(maxBytes),
+) *cachedAppStorage {
+	s := &cachedAppStorage{
 		storage:                     nonCachingAppStorage,
 		mGetTotal:                   metrics.AppMetricAddr(getTotal, vvm, appQName),
 		mGetCachedTotal:             metrics.AppMetricAddr(getCachedTotal, vvm, appQName),
@@ -118,10 +253,25 @@ func newCachingAppStorage(
 		mTTLReadSeconds:             metrics.AppMetricAddr(ttlReadSeconds, vvm, appQName),
 		mQueryTTLSeconds:            metrics.AppMetricAddr(queryTTLSeconds, vvm, appQName),
 		mQueryTTLTotal:              metrics.AppMetricAddr(queryTTLTotal, vvm, appQName),
+		mEvictionsTotal:             metrics.AppMetricAddr(evictionsTotal, vvm, appQName),
+		mEntries:                    metrics.AppMetricAddr(entries, vvm, appQName),
+		mSizeBytes:                  metrics.AppMetricAddr(sizeBytes, vvm, appQName),
+		mMaxSizeBytes:               metrics.AppMetricAddr(maxSizeBytes, vvm, appQName),
 		vvm:                         vvm,
 		appQName:                    appQName,
 		iTime:                       iTime,
+		maxBytes:                    maxBytes,
+		tables:                      make(map[appdef.QName]*tableMetrics, len(tables)),
+	}
+	s.cache.Store(fastcache.New(maxBytes))
+	for _, table := range tables {
+		s.tables[table] = &tableMetrics{
+			hits:         metrics.AppQNameMetricAddr(hitsTotal, vvm, appQName, table),
+			negativeHits: metrics.AppQNameMetricAddr(negativeHitsTotal, vvm, appQName, table),
+			misses:       metrics.AppQNameMetricAddr(missesTotal, vvm, appQName, table),
+		}
 	}
+	return s
 }
 
 //nolint:revive
@@ -144,7 +294,7 @@ func (s *cachedAppStorage) InsertIfNotExists(pKey []byte, cCols []byte, value []
 
 		d := istorage.DataWithExpiration{Data: value, ExpireAt: expireAt}
 		s.cacheMu.Lock()
-		s.cache.Set(makeKey(pKey, cCols), d.ToBytes())
+		s.set(makeKey(pKey, cCols), d.ToBytes())
 		s.cacheMu.Unlock()
 	}
 
@@ -171,7 +321,7 @@ func (s *cachedAppStorage) CompareAndSwap(pKey []byte, cCols []byte, oldValue, n
 
 		d := istorage.DataWithExpiration{Data: newValue, ExpireAt: expireAt}
 		s.cacheMu.Lock()
-		s.cache.Set(makeKey(pKey, cCols), d.ToBytes())
+		s.set(makeKey(pKey, cCols), d.ToBytes())
 		s.cacheMu.Unlock()
 	}
 
@@ -192,7 +342,7 @@ func (s *cachedAppStorage) CompareAndDelete(pKey []byte, cCols []byte, expectedV
 
 	if ok {
 		s.cacheMu.Lock()
-		s.cache.Del(makeKey(pKey, cCols))
+		s.del(makeKey(pKey, cCols))
 		s.cacheMu.Unlock()
 	}
 
@@ -207,20 +357,25 @@ func (s *cachedAppStorage) TTLGet(pKey []byte, cCols []byte, data *[]byte) (ok b
 	}()
 
 	var key = makeKey(pKey, cCols)
+	table := s.table(pKey)
+	s.lookups.Add(1)
 
 	*data = (*data)[0:0]
 
 	s.cacheMu.Lock()
-	cachedData, isCached := s.cache.HasGet(*data, key)
+	cachedData, isCached := s.cache.Load().HasGet(*data, key)
 	if isCached {
+		table.hits.Increase(1.0)
 		if len(cachedData) == 0 {
 			s.cacheMu.Unlock()
+			table.negativeHits.Increase(1.0)
 			return false, nil
 		}
 		d := istorage.ReadWithExpiration(cachedData)
 		if d.IsExpired(s.iTime.Now()) {
-			s.cache.Del(key)
+			s.del(key)
 			s.cacheMu.Unlock()
+			table.negativeHits.Increase(1.0)
 			return false, nil
 		}
 		s.cacheMu.Unlock()
@@ -228,14 +383,12 @@ func (s *cachedAppStorage) TTLGet(pKey []byte, cCols []byte, data *[]byte) (ok b
 		return true, nil
 	}
 	s.cacheMu.Unlock()
+	table.misses.Increase(1.0)
 
 	ok, err = s.storage.TTLGet(pKey, cCols, data)
 	if err == nil && !ok {
 		s.cacheMu.Lock()
-		_, alreadyCached := s.cache.HasGet(nil, key)
-		if !alreadyCached {
-			s.cache.Set(key, nil)
-		}
+		s.setNotFound(key)
 		s.cacheMu.Unlock()
 	}
 	// note: we do not have expiration info from the underlying storage so we do not cache it
@@ -274,7 +427,7 @@ func (s *cachedAppStorage) Put(pKey []byte, cCols []byte, value []byte) (err err
 	if err == nil {
 		data := istorage.DataWithExpiration{Data: value}
 		s.cacheMu.Lock()
-		s.cache.Set(makeKey(pKey, cCols), data.ToBytes())
+		s.set(makeKey(pKey, cCols), data.ToBytes())
 		s.cacheMu.Unlock()
 	}
 
@@ -294,7 +447,7 @@ func (s *cachedAppStorage) PutBatch(items []istorage.BatchItem) (err error) {
 		s.cacheMu.Lock()
 		for _, i := range items {
 			data := istorage.DataWithExpiration{Data: i.Value}
-			s.cache.Set(makeKey(i.PKey, i.CCols), data.ToBytes())
+			s.set(makeKey(i.PKey, i.CCols), data.ToBytes())
 		}
 		s.cacheMu.Unlock()
 	}
@@ -310,37 +463,37 @@ func (s *cachedAppStorage) Get(pKey []byte, cCols []byte, data *[]byte) (ok bool
 	s.mGetTotal.Increase(1.0)
 
 	key := makeKey(pKey, cCols)
+	table := s.table(pKey)
+	s.lookups.Add(1)
 	*data = (*data)[0:0]
 	cachedData := make([]byte, 0)
-	cachedData, isCached := s.cache.HasGet(cachedData, key)
+	cachedData, isCached := s.cache.Load().HasGet(cachedData, key)
 
 	if isCached {
 		s.mGetCachedTotal.Increase(1.0)
+		table.hits.Increase(1.0)
 		if len(cachedData) == 0 {
+			table.negativeHits.Increase(1.0)
 			return false, nil
 		}
 		*data = cachedData[utils.Uint64Size:]
 		return true, nil
 	}
+	table.misses.Increase(1.0)
 
 	ok, err = s.storage.Get(pKey, cCols, data)
 	if err != nil {
 		return false, err
 	}
 
+	s.cacheMu.Lock()
 	if ok {
 		d := istorage.DataWithExpiration{Data: *data}
-		s.cacheMu.Lock()
-		s.cache.Set(key, d.ToBytes())
-		s.cacheMu.Unlock()
+		s.set(key, d.ToBytes())
 	} else {
-		s.cacheMu.Lock()
-		_, alreadyCached := s.cache.HasGet(nil, key)
-		if !alreadyCached {
-			s.cache.Set(key, nil)
-		}
-		s.cacheMu.Unlock()
+		s.setNotFound(key)
 	}
+	s.cacheMu.Unlock()
 
 	return ok, nil
 }
@@ -351,27 +504,35 @@ func (s *cachedAppStorage) GetBatch(pKey []byte, items []istorage.GetBatchItem)
 		s.mGetBatchSeconds.Increase(time.Since(start).Seconds())
 	}()
 	s.mGetBatchTotal.Increase(1.0)
-	if !s.getBatchFromCache(pKey, items) {
+	table := s.table(pKey)
+	s.lookups.Add(uint64(len(items)))
+	if !s.getBatchFromCache(pKey, items, table) {
+		table.misses.Increase(float64(len(items)))
 		return s.getBatchFromStorage(pKey, items)
 	}
 	return nil
 }
 
-func (s *cachedAppStorage) getBatchFromCache(pKey []byte, items []istorage.GetBatchItem) (ok bool) {
+func (s *cachedAppStorage) getBatchFromCache(pKey []byte, items []istorage.GetBatchItem, table *tableMetrics) (ok bool) {
+	cache := s.cache.Load()
+	notFound := 0
 	for i := range items {
-		cachedData, isCached := s.cache.HasGet((*items[i].Data)[0:0], makeKey(pKey, items[i].CCols))
+		cachedData, isCached := cache.HasGet((*items[i].Data)[0:0], makeKey(pKey, items[i].CCols))
 		if !isCached {
 			return false
 		}
 
 		if len(cachedData) == 0 {
 			items[i].Ok = false
+			notFound++
 		} else {
 			*items[i].Data = cachedData[utils.Uint64Size:]
 			items[i].Ok = true
 		}
 	}
 	s.mGetBatchCachedTotal.Increase(1.0)
+	table.hits.Increase(float64(len(items)))
+	table.negativeHits.Increase(float64(notFound))
 	return true
 }
 
@@ -385,13 +546,9 @@ func (s *cachedAppStorage) getBatchFromStorage(pKey []byte, items []istorage.Get
 	for _, item := range items {
 		if item.Ok {
 			d := istorage.DataWithExpiration{Data: *item.Data}
-			s.cache.Set(makeKey(pKey, item.CCols), d.ToBytes())
+			s.set(makeKey(pKey, item.CCols), d.ToBytes())
 		} else {
-			key := makeKey(pKey, item.CCols)
-			_, alreadyCached := s.cache.HasGet(nil, key)
-			if !alreadyCached {
-				s.cache.Set(key, nil)
-			}
+			s.setNotFound(makeKey(pKey, item.CCols))
 		}
 	}
 	s.cacheMu.Unlock()
@@ -417,6 +574,156 @@ func (s *cachedAppStorage) SetTestDelayPut(delay time.Duration) {
 	s.storage.(istorage.IStorageDelaySetter).SetTestDelayPut(delay)
 }
 
+// cacheMu must be locked
+func (s *cachedAppStorage) set(key, value []byte) {
+	cache := s.cache.Load()
+	if !cache.Has(key) {
+		s.newKeys++
+	}
+	cache.Set(key, value)
+}
+
+// not-found results are cached as empty values
+// existing value is kept since it could be put by the concurrent Put
+// cacheMu must be locked
+func (s *cachedAppStorage) setNotFound(key []byte) {
+	cache := s.cache.Load()
+	if !cache.Has(key) {
+		s.newKeys++
+		cache.Set(key, nil)
+	}
+}
+
+// cacheMu must be locked
+func (s *cachedAppStorage) del(key []byte) {
+	cache := s.cache.Load()
+	if cache.Has(key) {
+		s.dels++
+	}
+	cache.Del(key)
+}
+
+func (s *cachedAppStorage) table(pKey []byte) *tableMetrics {
+	if len(pKey) < tableIDSize {
+		return s.tables[qNameTableOther]
+	}
+	switch tableID := binary.BigEndian.Uint16(pKey); {
+	case tableID == tableIDRecords:
+		return s.tables[qNameTableRecords]
+	case tableID == tableIDPLog:
+		return s.tables[qNameTablePLog]
+	case tableID == tableIDWLog:
+		return s.tables[qNameTableWLog]
+	case tableID > istructs.QNameIDSysLast:
+		return s.tables[qNameTableViews]
+	}
+	return s.tables[qNameTableOther]
+}
+
+// fastcache does not count evictions, so keys that were added but are neither deleted nor present are considered as evicted
+// evicted entries are removed from fastcache lazily, so evictions are reported with a delay
+func (s *cachedAppStorage) updateStats() {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	st := fastcache.Stats{}
+	s.cache.Load().UpdateStats(&st)
+	if present := s.dels + st.EntriesCount; s.newKeys > present && s.newKeys-present > s.evicted {
+		evicted := s.newKeys - present
+		s.mEvictionsTotal.Increase(float64(evicted - s.evicted))
+		s.evicted = evicted
+	}
+	setGauge(s.mEntries, float64(st.EntriesCount))
+	setGauge(s.mSizeBytes, float64(st.BytesSize))
+	setGauge(s.mMaxSizeBytes, float64(st.MaxBytesSize))
+}
+
+func (s *cachedAppStorage) stats() AppStats {
+	s.cacheMu.Lock()
+	st := fastcache.Stats{}
+	s.cache.Load().UpdateStats(&st)
+	s.cacheMu.Unlock()
+	res := AppStats{
+		MaxBytes:  st.MaxBytesSize,
+		Bytes:     st.BytesSize,
+		Entries:   st.EntriesCount,
+		Evictions: uint64(s.mEvictionsTotal.Value()),
+		Tables:    make(map[appdef.QName]TableStats, len(s.tables)),
+	}
+	for table, m := range s.tables {
+		res.Tables[table] = TableStats{
+			Hits:         uint64(m.hits.Value()),
+			NegativeHits: uint64(m.negativeHits.Value()),
+			Misses:       uint64(m.misses.Value()),
+		}
+	}
+	return res
+}
+
+func (s *cachedAppStorage) flush() {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	s.replaceCacheLocked(s.maxBytes)
+}
+
+// app cache is recreated if the new size differs from the current one significantly
+func (s *cachedAppStorage) resize(maxBytes int) {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	if !s.differsSignificantlyLocked(maxBytes) {
+		return
+	}
+	s.resizeLocked(maxBytes)
+}
+
+// app cache is recreated only if the new size differs from the current one significantly in the same direction
+// during rebalancesToResize consecutive rebalances, so the content is not dropped on the load fluctuations
+func (s *cachedAppStorage) rebalance(maxBytes int) {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	if !s.differsSignificantlyLocked(maxBytes) {
+		s.rebalanceVotes = 0
+		return
+	}
+	if grow := maxBytes > s.maxBytes; s.rebalanceVotes == 0 || grow != s.rebalanceGrow {
+		s.rebalanceVotes, s.rebalanceGrow = 0, grow
+	}
+	s.rebalanceVotes++
+	if s.rebalanceVotes < rebalancesToResize {
+		return
+	}
+	s.resizeLocked(maxBytes)
+}
+
+// cacheMu must be locked
+func (s *cachedAppStorage) differsSignificantlyLocked(maxBytes int) bool {
+	diff := maxBytes - s.maxBytes
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff > s.maxBytes/rebalanceThresholdDivisor
+}
+
+// cacheMu must be locked
+func (s *cachedAppStorage) resizeLocked(maxBytes int) {
+	logger.Verbose(fmt.Sprintf("%s: storage cache is resized from %d to %d bytes", s.appQName, s.maxBytes, maxBytes))
+	s.replaceCacheLocked(maxBytes)
+}
+
+// cacheMu must be locked
+func (s *cachedAppStorage) replaceCacheLocked(maxBytes int) {
+	old := s.cache.Swap(fastcache.New(maxBytes))
+	s.maxBytes = maxBytes
+	s.newKeys, s.dels, s.evicted = 0, 0, 0
+	s.rebalanceVotes = 0
+	// concurrent reads from the old cache are safe, they just miss
+	old.Reset()
+}
+
+// gauges are updated under cacheMu only
+func setGauge(m *imetrics.MetricValue, value float64) {
+	m.Increase(value - m.Value())
+}
+
 func makeKey(pKey []byte, cCols []byte) (res []byte) {
 	res = make([]byte, 0, stackKeySize)
 	// res = make([]byte, 0, len(pKey)+len(cCols)) // escapes to heap
@@ -7,6 +7,7 @@ package istoragecache
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -606,6 +607,185 @@ func TestRaceCondition_GetVsInsertIfNotExists(t *testing.T) {
 	require.Equal(value, data)
 }
 
+func TestStats(t *testing.T) {
+	require := require.New(t)
+	ts := &testStorage{
+		put: func(_, _, _ []byte) (err error) { return nil },
+		get: func(_, _ []byte, _ *[]byte) (ok bool, err error) { return false, nil },
+		getBatch: func(_ []byte, items []istorage.GetBatchItem) (err error) {
+			for i := range items {
+				items[i].Ok = false
+			}
+			return nil
+		},
+	}
+	asp := Provide(testCacheSize, &testStorageProvider{storage: ts}, imetrics.Provide(), "vvm", timeu.NewITime())
+	admin := asp.(IStorageCacheAdmin)
+
+	_, err := admin.AppStats(istructs.AppQName_test1_app1)
+	require.ErrorIs(err, ErrAppNotCached)
+	require.ErrorIs(admin.Flush(istructs.AppQName_test1_app1), ErrAppNotCached)
+
+	storage, err := asp.AppStorage(istructs.AppQName_test1_app1)
+	require.NoError(err)
+	storage2, err := asp.AppStorage(istructs.AppQName_test1_app1)
+	require.NoError(err)
+	require.Same(storage, storage2)
+
+	recordPKey := []byte{0, byte(tableIDRecords), 1}
+	viewPKey := []byte{1, 0, 1}
+	data := []byte{}
+
+	// not found is cached
+	for range 2 {
+		ok, err := storage.Get(recordPKey, []byte("cc"), &data)
+		require.NoError(err)
+		require.False(ok)
+	}
+
+	require.NoError(storage.Put(viewPKey, []byte("cc"), []byte("value")))
+	ok, err := storage.Get(viewPKey, []byte("cc"), &data)
+	require.NoError(err)
+	require.True(ok)
+
+	items := []istorage.GetBatchItem{{CCols: []byte("1"), Data: &[]byte{}}, {CCols: []byte("2"), Data: &[]byte{}}}
+	for range 2 {
+		require.NoError(storage.GetBatch([]byte{0, 1}, items))
+	}
+
+	stats, err := admin.AppStats(istructs.AppQName_test1_app1)
+	require.NoError(err)
+	require.Equal(TableStats{Hits: 1, NegativeHits: 1, Misses: 1}, stats.Tables[qNameTableRecords])
+	require.Equal(TableStats{Hits: 1}, stats.Tables[qNameTableViews])
+	require.Equal(TableStats{Hits: 2, NegativeHits: 2, Misses: 2}, stats.Tables[qNameTableOther])
+	require.Equal(TableStats{}, stats.Tables[qNameTablePLog])
+	require.Equal(uint64(4), stats.Entries)
+	require.Positive(stats.MaxBytes)
+
+	t.Run("flush", func(*testing.T) {
+		require.NoError(admin.Flush(istructs.AppQName_test1_app1))
+
+		stats, err := admin.AppStats(istructs.AppQName_test1_app1)
+		require.NoError(err)
+		require.Zero(stats.Entries)
+
+		ok, err := storage.Get(recordPKey, []byte("cc"), &data)
+		require.NoError(err)
+		require.False(ok)
+
+		stats, err = admin.AppStats(istructs.AppQName_test1_app1)
+		require.NoError(err)
+		require.Equal(TableStats{Hits: 1, NegativeHits: 1, Misses: 2}, stats.Tables[qNameTableRecords])
+	})
+
+	t.Run("evictions", func(*testing.T) {
+		require.NoError(admin.Flush(istructs.AppQName_test1_app1))
+
+		const keys = 10000
+		value := make([]byte, 4096) // 40MB in total, more than the minimal cache size
+		for i := range keys {
+			require.NoError(storage.Put(viewPKey, []byte(fmt.Sprint(i)), value))
+		}
+
+		stats, err := admin.AppStats(istructs.AppQName_test1_app1)
+		require.NoError(err)
+		require.Positive(stats.Evictions)
+		require.Equal(uint64(keys), stats.Entries+stats.Evictions)
+	})
+
+	t.Run("metrics", func(*testing.T) {
+		metrics := imetrics.Provide()
+		asp := Provide(testCacheSize, &testStorageProvider{storage: ts}, metrics, "vvm", timeu.NewITime())
+		storage, err := asp.AppStorage(istructs.AppQName_test1_app1)
+		require.NoError(err)
+		_, err = storage.Get(recordPKey, []byte("cc"), &data)
+		require.NoError(err)
+
+		collection := map[string]bool{}
+		require.NoError(metrics.List(func(metric imetrics.IMetric, metricValue float64) (err error) {
+			collection[string(imetrics.ToPrometheus(metric, metricValue))] = true
+			return nil
+		}))
+		require.True(collection[`voedger_istoragecache_misses_total{app="test1/app1",vvm="vvm",qname="sys.Records"} 1`+"\n"])
+		require.True(collection[`voedger_istoragecache_hits_total{app="test1/app1",vvm="vvm",qname="sys.Records"} 0`+"\n"])
+	})
+}
+
+func TestBudget(t *testing.T) {
+	require := require.New(t)
+	ts := &testStorage{
+		get: func(_, _ []byte, _ *[]byte) (ok bool, err error) { return false, nil },
+	}
+	asp := Provide(testCacheSize, &testStorageProvider{storage: ts}, imetrics.Provide(), "vvm", timeu.NewITime(),
+		WithBudget(Budget{Bytes: 4 * minAppCacheBytes}))
+	admin := asp.(IStorageCacheAdmin)
+
+	storage1, err := asp.AppStorage(istructs.AppQName_test1_app1)
+	require.NoError(err)
+	_, err = asp.AppStorage(istructs.AppQName_test1_app2)
+	require.NoError(err)
+
+	requireMaxBytes := func(app appdef.AppQName, expected int) {
+		t.Helper()
+		stats, err := admin.AppStats(app)
+		require.NoError(err)
+		require.EqualValues(expected, stats.MaxBytes)
+	}
+
+	// shared evenly on start
+	requireMaxBytes(istructs.AppQName_test1_app1, 2*minAppCacheBytes)
+	requireMaxBytes(istructs.AppQName_test1_app2, 2*minAppCacheBytes)
+
+	// no lookups -> nothing changed
+	asp.(*implCachingAppStorageProvider).rebalance()
+	requireMaxBytes(istructs.AppQName_test1_app1, 2*minAppCacheBytes)
+
+	lookupApp := func(storage istorage.IAppStorage) {
+		for i := range 10 {
+			_, err = storage.Get([]byte{1, 0}, []byte(fmt.Sprint(i)), &[]byte{})
+			require.NoError(err)
+		}
+	}
+
+	// app1 is used only -> app1 gets all the budget except the minimal size of app2
+	// the size is changed only if it is confirmed by the consecutive rebalances
+	for range rebalancesToResize - 1 {
+		lookupApp(storage1)
+		asp.(*implCachingAppStorageProvider).rebalance()
+		requireMaxBytes(istructs.AppQName_test1_app1, 2*minAppCacheBytes)
+		requireMaxBytes(istructs.AppQName_test1_app2, 2*minAppCacheBytes)
+	}
+	lookupApp(storage1)
+	asp.(*implCachingAppStorageProvider).rebalance()
+	requireMaxBytes(istructs.AppQName_test1_app1, 3*minAppCacheBytes)
+	requireMaxBytes(istructs.AppQName_test1_app2, minAppCacheBytes)
+
+	t.Run("load fluctuations do not resize", func(t *testing.T) {
+		storage2, err := asp.AppStorage(istructs.AppQName_test1_app2)
+		require.NoError(err)
+		for i := range 2 * rebalancesToResize {
+			if i%2 == 0 {
+				lookupApp(storage2)
+			} else {
+				lookupApp(storage1)
+			}
+			asp.(*implCachingAppStorageProvider).rebalance()
+			requireMaxBytes(istructs.AppQName_test1_app1, 3*minAppCacheBytes)
+			requireMaxBytes(istructs.AppQName_test1_app2, minAppCacheBytes)
+		}
+	})
+
+	t.Run("run and stop", func(*testing.T) {
+		runDone := make(chan struct{})
+		go func() {
+			asp.Run(context.Background())
+			close(runDone)
+		}()
+		asp.Stop()
+		<-runDone
+	})
+}
+
 func TestMakeKeys(t *testing.T) {
 	require := require.New(t)
 	require.Equal([]byte{1, 2, 3, 4, 5, 6}, makeKey([]byte{1, 2, 3}, []byte{4, 5, 6}))
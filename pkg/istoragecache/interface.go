@@ -0,0 +1,21 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragecache
+
+import "github.com/voedger/voedger/pkg/appdef"
+
+// Implemented by the caching IAppStorageProvider returned by Provide()
+type IStorageCacheAdmin interface {
+	// Returns ErrAppNotCached if the app storage was not requested yet
+	//
+	// @ConcurrentAccess
+	AppStats(app appdef.AppQName) (AppStats, error)
+
+	// Drops all entries of the app cache, the underlying storage is not affected
+	// Returns ErrAppNotCached if the app storage was not requested yet
+	//
+	// @ConcurrentAccess
+	Flush(app appdef.AppQName) error
+}
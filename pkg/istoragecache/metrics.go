@@ -25,4 +25,16 @@ const (
 	ttlGetSeconds            = "voedger_istoragecache_ttlget_seconds"
 	queryTTLSeconds          = "voedger_istoragecache_queryttl_seconds"
 	queryTTLTotal            = "voedger_istoragecache_queryttl_total"
+
+	// per-table, Get, GetBatch and TTLGet items
+	// hits include negative hits, i.e. not-found results served from the cache
+	hitsTotal         = "voedger_istoragecache_hits_total"
+	negativeHitsTotal = "voedger_istoragecache_negative_hits_total"
+	missesTotal       = "voedger_istoragecache_misses_total"
+
+	// per-app, updated every statsUpdateInterval
+	evictionsTotal = "voedger_istoragecache_evictions_total"
+	entries        = "voedger_istoragecache_entries"
+	sizeBytes      = "voedger_istoragecache_size_bytes"
+	maxSizeBytes   = "voedger_istoragecache_max_size_bytes"
 )
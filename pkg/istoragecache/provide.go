@@ -5,24 +5,44 @@
 package istoragecache
 
 import (
+	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/goutils/timeu"
 	"github.com/voedger/voedger/pkg/istorage"
 	"github.com/voedger/voedger/pkg/metrics"
 )
 
 // Provide s.e.
+// The result implements IStorageCacheAdmin
 func Provide(
 	maxBytes int,
 	storageProvider istorage.IAppStorageProvider,
 	metrics imetrics.IMetrics,
 	vvmName string,
 	iTime timeu.ITime,
+	opts ...OptFunc,
 ) istorage.IAppStorageProvider {
-	return &implCachingAppStorageProvider{
+	asp := &implCachingAppStorageProvider{
 		maxBytes:        maxBytes,
 		storageProvider: storageProvider,
 		metrics:         metrics,
 		vvmName:         vvmName,
 		iTime:           iTime,
+		apps:            map[appdef.AppQName]*cachedAppStorage{},
+		stop:            make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(asp)
+	}
+	return asp
+}
+
+// WithBudget limits the total size of the caches of all apps instead of maxBytes per app
+// budget.Bytes == 0 -> no effect
+func WithBudget(budget Budget) OptFunc {
+	return func(asp *implCachingAppStorageProvider) {
+		if budget.RebalanceInterval == 0 {
+			budget.RebalanceInterval = DefaultRebalanceInterval
+		}
+		asp.budget = budget
 	}
 }
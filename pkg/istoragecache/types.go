@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragecache
+
+import (
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	imetrics "github.com/voedger/voedger/pkg/metrics"
+)
+
+// Budget limits the total size of the caches of all apps
+// App caches are resized proportionally to the app lookups (Get, GetBatch and TTLGet items) since the previous rebalance
+// Resized app cache loses its content, so the app cache is resized only if its new size is confirmed by several consecutive rebalances
+type Budget struct {
+	// 0 -> budget is disabled, each app cache is limited by maxBytes
+	// each app gets minAppCacheBytes at least, so the budget could be exceeded if there are too many apps
+	Bytes int
+
+	// 0 -> DefaultRebalanceInterval
+	// app caches are not rebalanced more often than statsUpdateInterval
+	RebalanceInterval time.Duration
+}
+
+type OptFunc func(asp *implCachingAppStorageProvider)
+
+// AppStats is the snapshot of the app cache
+type AppStats struct {
+	MaxBytes uint64
+	Bytes    uint64
+	Entries  uint64
+
+	// approximate, entries dropped to free space for new ones since the VVM start
+	Evictions uint64
+
+	// since the VVM start
+	Tables map[appdef.QName]TableStats
+}
+
+type TableStats struct {
+	// including NegativeHits
+	Hits uint64
+
+	// not-found results served from the cache
+	NegativeHits uint64
+
+	Misses uint64
+}
+
+type tableMetrics struct {
+	hits         *imetrics.MetricValue
+	negativeHits *imetrics.MetricValue
+	misses       *imetrics.MetricValue
+}
@@ -0,0 +1,20 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragefaults
+
+// IAppStorage methods the faults could be injected into
+const (
+	Method_Put               Method = "Put"
+	Method_PutBatch          Method = "PutBatch"
+	Method_Get               Method = "Get"
+	Method_GetBatch          Method = "GetBatch"
+	Method_Read              Method = "Read"
+	Method_InsertIfNotExists Method = "InsertIfNotExists"
+	Method_CompareAndSwap    Method = "CompareAndSwap"
+	Method_CompareAndDelete  Method = "CompareAndDelete"
+	Method_TTLGet            Method = "TTLGet"
+	Method_TTLRead           Method = "TTLRead"
+	Method_QueryTTL          Method = "QueryTTL"
+)
@@ -0,0 +1,9 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragefaults
+
+import "errors"
+
+var ErrInjectedFault = errors.New("injected storage fault")
@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragefaults
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/istorage"
+)
+
+var notSafeAppNameChars = regexp.MustCompile("[^a-z0-9]+")
+
+func (fi *implIFaultInjector) SetRules(rules ...Rule) {
+	states := make([]*ruleState, len(rules))
+	for i, r := range rules {
+		states[i] = &ruleState{Rule: r}
+	}
+	fi.lock.Lock()
+	fi.rules = states
+	fi.injected.Store(0)
+	fi.lock.Unlock()
+}
+
+func (fi *implIFaultInjector) Injected() uint64 {
+	return fi.injected.Load()
+}
+
+// sleeps if the latency is configured for the matched rule
+// returns nil if the call must not fail
+func (fi *implIFaultInjector) inject(app string, method Method, pKeys ...[]byte) *fault {
+	fi.lock.RLock()
+	rules := fi.rules
+	fi.lock.RUnlock()
+
+	for _, r := range rules {
+		if !r.matches(app, method, pKeys) {
+			continue
+		}
+		if r.calls.Add(1) <= r.SkipFirst {
+			return nil
+		}
+		if delay := r.delay(); delay > 0 {
+			time.Sleep(delay)
+		}
+		if !r.shouldFail() {
+			return nil
+		}
+		fi.injected.Add(1)
+		err := r.Err
+		if err == nil {
+			err = fmt.Errorf("%w: %s %s", ErrInjectedFault, app, method)
+		}
+		return &fault{rule: r, err: err}
+	}
+	return nil
+}
+
+func (r *ruleState) matches(app string, method Method, pKeys [][]byte) bool {
+	if len(r.Methods) > 0 && !slices.Contains(r.Methods, method) {
+		return false
+	}
+	if len(r.Apps) > 0 && !slices.ContainsFunc(r.Apps, func(appQName appdef.AppQName) bool {
+		return strings.HasPrefix(app, safeAppNamePrefix(appQName.String()))
+	}) {
+		return false
+	}
+	if r.PKeyFilter != nil && !slices.ContainsFunc(pKeys, r.PKeyFilter) {
+		return false
+	}
+	return true
+}
+
+func (r *ruleState) delay() time.Duration {
+	res := r.Latency
+	if r.LatencyJitter > 0 {
+		res += rand.N(r.LatencyJitter) // nolint G404: not a security-sensitive context
+	}
+	return res
+}
+
+func (r *ruleState) shouldFail() bool {
+	if r.ErrorRate <= 0 || (r.ErrorRate < 1 && rand.Float64() >= r.ErrorRate) { // nolint G404: not a security-sensitive context
+		return false
+	}
+	if r.MaxFaults > 0 && r.faults.Add(1) > r.MaxFaults {
+		return false
+	}
+	return true
+}
+
+// the same transformation as istorage.NewSafeAppName() does
+func safeAppNamePrefix(appQName string) string {
+	return notSafeAppNameChars.ReplaceAllString(strings.ToLower(appQName), "")
+}
+
+func (f *implIAppStorageFactory) AppStorage(appName istorage.SafeAppName) (istorage.IAppStorage, error) {
+	storage, err := f.IAppStorageFactory.AppStorage(appName)
+	if err != nil {
+		return nil, err
+	}
+	return &faultyAppStorage{
+		storage:  storage,
+		injector: f.injector,
+		app:      appName.String(),
+	}, nil
+}
+
+// performs the call if there is no fault or if the fault must be returned after the call
+func (s *faultyAppStorage) do(f *fault, call func() error) error {
+	if f == nil {
+		return call()
+	}
+	if f.rule.ApplyBeforeFail {
+		if err := call(); err != nil {
+			return err
+		}
+	}
+	return f.err
+}
+
+func (s *faultyAppStorage) Put(pKey []byte, cCols []byte, value []byte) (err error) {
+	return s.do(s.injector.inject(s.app, Method_Put, pKey), func() error {
+		return s.storage.Put(pKey, cCols, value)
+	})
+}
+
+func (s *faultyAppStorage) PutBatch(items []istorage.BatchItem) (err error) {
+	pKeys := make([][]byte, len(items))
+	for i, item := range items {
+		pKeys[i] = item.PKey
+	}
+	f := s.injector.inject(s.app, Method_PutBatch, pKeys...)
+	if f != nil && f.rule.PartialBatch && len(items) > 1 {
+		if err := s.storage.PutBatch(items[:rand.IntN(len(items))]); err != nil { // nolint G404: not a security-sensitive context
+			return err
+		}
+		return f.err
+	}
+	return s.do(f, func() error {
+		return s.storage.PutBatch(items)
+	})
+}
+
+func (s *faultyAppStorage) Get(pKey []byte, cCols []byte, data *[]byte) (ok bool, err error) {
+	err = s.do(s.injector.inject(s.app, Method_Get, pKey), func() (err error) {
+		ok, err = s.storage.Get(pKey, cCols, data)
+		return err
+	})
+	return ok, err
+}
+
+func (s *faultyAppStorage) GetBatch(pKey []byte, items []istorage.GetBatchItem) (err error) {
+	return s.do(s.injector.inject(s.app, Method_GetBatch, pKey), func() error {
+		return s.storage.GetBatch(pKey, items)
+	})
+}
+
+func (s *faultyAppStorage) Read(ctx context.Context, pKey []byte, startCCols, finishCCols []byte, cb istorage.ReadCallback) (err error) {
+	return s.do(s.injector.inject(s.app, Method_Read, pKey), func() error {
+		return s.storage.Read(ctx, pKey, startCCols, finishCCols, cb)
+	})
+}
+
+func (s *faultyAppStorage) InsertIfNotExists(pKey []byte, cCols []byte, value []byte, ttlSeconds int) (ok bool, err error) {
+	err = s.do(s.injector.inject(s.app, Method_InsertIfNotExists, pKey), func() (err error) {
+		ok, err = s.storage.InsertIfNotExists(pKey, cCols, value, ttlSeconds)
+		return err
+	})
+	return ok, err
+}
+
+func (s *faultyAppStorage) CompareAndSwap(pKey []byte, cCols []byte, oldValue, newValue []byte, ttlSeconds int) (ok bool, err error) {
+	err = s.do(s.injector.inject(s.app, Method_CompareAndSwap, pKey), func() (err error) {
+		ok, err = s.storage.CompareAndSwap(pKey, cCols, oldValue, newValue, ttlSeconds)
+		return err
+	})
+	return ok, err
+}
+
+func (s *faultyAppStorage) CompareAndDelete(pKey []byte, cCols []byte, expectedValue []byte) (ok bool, err error) {
+	err = s.do(s.injector.inject(s.app, Method_CompareAndDelete, pKey), func() (err error) {
+		ok, err = s.storage.CompareAndDelete(pKey, cCols, expectedValue)
+		return err
+	})
+	return ok, err
+}
+
+func (s *faultyAppStorage) TTLGet(pKey []byte, cCols []byte, data *[]byte) (ok bool, err error) {
+	err = s.do(s.injector.inject(s.app, Method_TTLGet, pKey), func() (err error) {
+		ok, err = s.storage.TTLGet(pKey, cCols, data)
+		return err
+	})
+	return ok, err
+}
+
+func (s *faultyAppStorage) TTLRead(ctx context.Context, pKey []byte, startCCols, finishCCols []byte, cb istorage.ReadCallback) (err error) {
+	return s.do(s.injector.inject(s.app, Method_TTLRead, pKey), func() error {
+		return s.storage.TTLRead(ctx, pKey, startCCols, finishCCols, cb)
+	})
+}
+
+func (s *faultyAppStorage) QueryTTL(pKey []byte, cCols []byte) (ttlInSeconds int, ok bool, err error) {
+	err = s.do(s.injector.inject(s.app, Method_QueryTTL, pKey), func() (err error) {
+		ttlInSeconds, ok, err = s.storage.QueryTTL(pKey, cCols)
+		return err
+	})
+	return ttlInSeconds, ok, err
+}
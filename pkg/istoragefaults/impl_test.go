@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragefaults
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/goutils/testingu"
+	"github.com/voedger/voedger/pkg/istorage"
+	"github.com/voedger/voedger/pkg/istorage/mem"
+)
+
+func TestTCK(t *testing.T) {
+	// no rules -> transparent
+	istorage.TechnologyCompatibilityKit(t, Provide(mem.Provide(testingu.MockTime), New()))
+}
+
+func TestBasicUsage(t *testing.T) {
+	require := require.New(t)
+	injector := New()
+	asf := Provide(mem.Provide(testingu.MockTime), injector)
+	app1 := appStorage(t, asf, appdef.NewAppQName("test", "app1"))
+	app2 := appStorage(t, asf, appdef.NewAppQName("test", "app2"))
+
+	injector.SetRules(Rule{
+		Methods:   []Method{Method_Put},
+		Apps:      []appdef.AppQName{appdef.NewAppQName("test", "app1")},
+		ErrorRate: 1,
+	})
+
+	t.Run("matched call fails", func(t *testing.T) {
+		err := app1.Put([]byte{1}, []byte{1}, []byte{1})
+		require.ErrorIs(err, ErrInjectedFault)
+		require.Equal(uint64(1), injector.Injected())
+		data := []byte{}
+		ok, err := app1.Get([]byte{1}, []byte{1}, &data)
+		require.NoError(err)
+		require.False(ok)
+	})
+
+	t.Run("other method or app is not affected", func(t *testing.T) {
+		require.NoError(app2.Put([]byte{1}, []byte{1}, []byte{1}))
+		require.NoError(app1.PutBatch([]istorage.BatchItem{{PKey: []byte{1}, CCols: []byte{1}, Value: []byte{1}}}))
+		require.Equal(uint64(1), injector.Injected())
+	})
+
+	t.Run("no rules -> no faults", func(t *testing.T) {
+		injector.SetRules()
+		require.Zero(injector.Injected())
+		require.NoError(app1.Put([]byte{1}, []byte{1}, []byte{1}))
+	})
+}
+
+func TestRules(t *testing.T) {
+	injector := New()
+	asf := Provide(mem.Provide(testingu.MockTime), injector)
+	storage := appStorage(t, asf, appdef.NewAppQName("test", "app"))
+	put := func(pk byte) error { return storage.Put([]byte{pk}, []byte{1}, []byte{1}) }
+
+	t.Run("fail after N", func(t *testing.T) {
+		require := require.New(t)
+		injector.SetRules(Rule{SkipFirst: 2, ErrorRate: 1})
+		require.NoError(put(1))
+		require.NoError(put(1))
+		require.ErrorIs(put(1), ErrInjectedFault)
+		require.ErrorIs(put(1), ErrInjectedFault)
+	})
+
+	t.Run("max faults", func(t *testing.T) {
+		require := require.New(t)
+		injector.SetRules(Rule{MaxFaults: 1, ErrorRate: 1})
+		require.ErrorIs(put(1), ErrInjectedFault)
+		require.NoError(put(1))
+		require.Equal(uint64(1), injector.Injected())
+	})
+
+	t.Run("partition key filter", func(t *testing.T) {
+		require := require.New(t)
+		injector.SetRules(Rule{ErrorRate: 1, PKeyFilter: func(pKey []byte) bool { return bytes.Equal(pKey, []byte{2}) }})
+		require.NoError(put(1))
+		require.ErrorIs(put(2), ErrInjectedFault)
+		err := storage.PutBatch([]istorage.BatchItem{
+			{PKey: []byte{1}, CCols: []byte{1}, Value: []byte{1}},
+			{PKey: []byte{2}, CCols: []byte{1}, Value: []byte{1}},
+		})
+		require.ErrorIs(err, ErrInjectedFault)
+	})
+
+	t.Run("the first matched rule is applied", func(t *testing.T) {
+		require := require.New(t)
+		testErr := errors.New("test error")
+		injector.SetRules(
+			Rule{Methods: []Method{Method_Get}},
+			Rule{ErrorRate: 1, Err: testErr},
+		)
+		data := []byte{}
+		_, err := storage.Get([]byte{1}, []byte{1}, &data)
+		require.NoError(err)
+		require.ErrorIs(put(1), testErr)
+	})
+
+	t.Run("write timeout: applied then failed", func(t *testing.T) {
+		require := require.New(t)
+		injector.SetRules(Rule{ErrorRate: 1, ApplyBeforeFail: true, Methods: []Method{Method_Put}})
+		require.ErrorIs(storage.Put([]byte{3}, []byte{1}, []byte{42}), ErrInjectedFault)
+		data := []byte{}
+		ok, err := storage.Get([]byte{3}, []byte{1}, &data)
+		require.NoError(err)
+		require.True(ok)
+		require.Equal([]byte{42}, data)
+	})
+
+	t.Run("partial batch", func(t *testing.T) {
+		require := require.New(t)
+		injector.SetRules(Rule{ErrorRate: 1, PartialBatch: true, Methods: []Method{Method_PutBatch}})
+		items := []istorage.BatchItem{}
+		for i := byte(0); i < 10; i++ {
+			items = append(items, istorage.BatchItem{PKey: []byte{4}, CCols: []byte{i}, Value: []byte{i}})
+		}
+		require.ErrorIs(storage.PutBatch(items), ErrInjectedFault)
+		injector.SetRules()
+		stored := 0
+		require.NoError(storage.Read(context.Background(), []byte{4}, nil, nil, func([]byte, []byte) error {
+			stored++
+			return nil
+		}))
+		require.Less(stored, len(items))
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		require := require.New(t)
+		injector.SetRules(Rule{Latency: 10 * time.Millisecond, LatencyJitter: time.Millisecond, Methods: []Method{Method_Get}})
+		start := time.Now()
+		data := []byte{}
+		_, err := storage.Get([]byte{1}, []byte{1}, &data)
+		require.NoError(err)
+		require.GreaterOrEqual(time.Since(start), 10*time.Millisecond)
+		require.Zero(injector.Injected())
+	})
+
+	t.Run("error rate", func(t *testing.T) {
+		require := require.New(t)
+		injector.SetRules(Rule{ErrorRate: 0.5})
+		for range 1000 {
+			_ = put(1)
+		}
+		require.InDelta(500, injector.Injected(), 150)
+	})
+}
+
+func appStorage(t *testing.T, asf istorage.IAppStorageFactory, appQName appdef.AppQName) istorage.IAppStorage {
+	san, err := istorage.NewSafeAppName(appQName, func(string) (bool, error) { return true, nil })
+	require.NoError(t, err)
+	require.NoError(t, asf.Init(san))
+	storage, err := asf.AppStorage(san)
+	require.NoError(t, err)
+	return storage
+}
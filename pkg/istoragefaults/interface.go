@@ -0,0 +1,16 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragefaults
+
+// Decides which IAppStorage calls fail or slow down, see Provide()
+// @ConcurrentAccess
+type IFaultInjector interface {
+	// replaces the current rules and resets the counters of the calls and of the injected faults
+	// no rules -> no faults
+	SetRules(rules ...Rule)
+
+	// amount of the faults injected since the last SetRules()
+	Injected() uint64
+}
@@ -0,0 +1,27 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragefaults
+
+import (
+	"github.com/voedger/voedger/pkg/istorage"
+)
+
+// New creates the fault injector that could be shared among few storage factories
+// Rules could be changed at any time, see IFaultInjector.SetRules()
+func New(rules ...Rule) IFaultInjector {
+	res := &implIFaultInjector{}
+	res.SetRules(rules...)
+	return res
+}
+
+// Provide wraps the storage factory to inject faults and latencies into IAppStorage calls according to the injector rules
+// Intended for chaos testing only: VIT configs and VVM dev mode
+// injector must be created by New()
+func Provide(asf istorage.IAppStorageFactory, injector IFaultInjector) istorage.IAppStorageFactory {
+	return &implIAppStorageFactory{
+		IAppStorageFactory: asf,
+		injector:           injector.(*implIFaultInjector),
+	}
+}
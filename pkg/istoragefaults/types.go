@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragefaults
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/istorage"
+)
+
+type Method string
+
+// The first rule matched by the method, app and partition key is applied to the call, other rules are ignored
+type Rule struct {
+	// empty -> any method
+	Methods []Method
+
+	// empty -> any app
+	// matched by the prefix of the storage name, so works with keyspace isolation suffixes
+	Apps []appdef.AppQName
+
+	// nil -> any partition key
+	// used to make a certain partition or workspace fail only
+	// PutBatch is matched if any of the items is matched
+	PKeyFilter func(pKey []byte) bool
+
+	// the first SkipFirst matched calls are not affected
+	// SkipFirst: N, ErrorRate: 1 -> fail after N calls
+	SkipFirst uint64
+
+	// probability [0..1] of the matched call to fail
+	ErrorRate float64
+
+	// 0 -> unlimited
+	// MaxFaults: 1, ErrorRate: 1 -> fail once then recover
+	MaxFaults uint64
+
+	// the matched calls are delayed by Latency + random [0..LatencyJitter)
+	Latency       time.Duration
+	LatencyJitter time.Duration
+
+	// the failed call is performed, then the error is returned. Simulates the timeout of the actually applied write
+	ApplyBeforeFail bool
+
+	// the failed PutBatch applies the random part of the items, then the error is returned
+	PartialBatch bool
+
+	// nil -> ErrInjectedFault
+	Err error
+}
+
+type ruleState struct {
+	Rule
+	calls  atomic.Uint64
+	faults atomic.Uint64
+}
+
+type implIFaultInjector struct {
+	lock     sync.RWMutex
+	rules    []*ruleState
+	injected atomic.Uint64
+}
+
+type implIAppStorageFactory struct {
+	istorage.IAppStorageFactory
+	injector *implIFaultInjector
+}
+
+type faultyAppStorage struct {
+	storage  istorage.IAppStorage
+	injector *implIFaultInjector
+	app      string
+}
+
+// decision made for a certain call
+type fault struct {
+	rule *ruleState
+	err  error
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragemetrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/istorage"
+	imetrics "github.com/voedger/voedger/pkg/metrics"
+)
+
+type implMetricsAppStorageProvider struct {
+	storageProvider istorage.IAppStorageProvider
+	metrics         imetrics.IMetrics
+	vvmName         string
+}
+
+type metricsAppStorage struct {
+	storage            istorage.IAppStorage
+	mPut               *imetrics.Histogram
+	mPutBatch          *imetrics.Histogram
+	mGet               *imetrics.Histogram
+	mGetBatch          *imetrics.Histogram
+	mRead              *imetrics.Histogram
+	mInsertIfNotExists *imetrics.Histogram
+	mCompareAndSwap    *imetrics.Histogram
+	mCompareAndDelete  *imetrics.Histogram
+	mTTLGet            *imetrics.Histogram
+	mTTLRead           *imetrics.Histogram
+	mQueryTTL          *imetrics.Histogram
+}
+
+func (asp *implMetricsAppStorageProvider) Prepare(work any) error {
+	return asp.storageProvider.Prepare(work)
+}
+
+func (asp *implMetricsAppStorageProvider) Run(ctx context.Context) {
+	asp.storageProvider.Run(ctx)
+}
+
+func (asp *implMetricsAppStorageProvider) Stop() {
+	asp.storageProvider.Stop()
+}
+
+func (asp *implMetricsAppStorageProvider) AppStorage(appQName appdef.AppQName) (istorage.IAppStorage, error) {
+	storage, err := asp.storageProvider.AppStorage(appQName)
+	if err != nil {
+		return nil, err
+	}
+	histogram := func(metricName string) *imetrics.Histogram {
+		return asp.metrics.Histogram(metricName, asp.vvmName, appQName, appdef.NullQName, nil)
+	}
+	return &metricsAppStorage{
+		storage:            storage,
+		mPut:               histogram(putSeconds),
+		mPutBatch:          histogram(putBatchSeconds),
+		mGet:               histogram(getSeconds),
+		mGetBatch:          histogram(getBatchSeconds),
+		mRead:              histogram(readSeconds),
+		mInsertIfNotExists: histogram(insertIfNotExistsSeconds),
+		mCompareAndSwap:    histogram(compareAndSwapSeconds),
+		mCompareAndDelete:  histogram(compareAndDeleteSeconds),
+		mTTLGet:            histogram(ttlGetSeconds),
+		mTTLRead:           histogram(ttlReadSeconds),
+		mQueryTTL:          histogram(queryTTLSeconds),
+	}, nil
+}
+
+func (s *metricsAppStorage) Put(pKey []byte, cCols []byte, value []byte) (err error) {
+	defer s.mPut.ObserveSince(time.Now())
+	return s.storage.Put(pKey, cCols, value)
+}
+
+func (s *metricsAppStorage) PutBatch(items []istorage.BatchItem) (err error) {
+	defer s.mPutBatch.ObserveSince(time.Now())
+	return s.storage.PutBatch(items)
+}
+
+func (s *metricsAppStorage) Get(pKey []byte, cCols []byte, data *[]byte) (ok bool, err error) {
+	defer s.mGet.ObserveSince(time.Now())
+	return s.storage.Get(pKey, cCols, data)
+}
+
+func (s *metricsAppStorage) GetBatch(pKey []byte, items []istorage.GetBatchItem) (err error) {
+	defer s.mGetBatch.ObserveSince(time.Now())
+	return s.storage.GetBatch(pKey, items)
+}
+
+// the duration includes the time spent in cb
+func (s *metricsAppStorage) Read(ctx context.Context, pKey []byte, startCCols, finishCCols []byte, cb istorage.ReadCallback) (err error) {
+	defer s.mRead.ObserveSince(time.Now())
+	return s.storage.Read(ctx, pKey, startCCols, finishCCols, cb)
+}
+
+func (s *metricsAppStorage) InsertIfNotExists(pKey []byte, cCols []byte, value []byte, ttlSeconds int) (ok bool, err error) {
+	defer s.mInsertIfNotExists.ObserveSince(time.Now())
+	return s.storage.InsertIfNotExists(pKey, cCols, value, ttlSeconds)
+}
+
+func (s *metricsAppStorage) CompareAndSwap(pKey []byte, cCols []byte, oldValue, newValue []byte, ttlSeconds int) (ok bool, err error) {
+	defer s.mCompareAndSwap.ObserveSince(time.Now())
+	return s.storage.CompareAndSwap(pKey, cCols, oldValue, newValue, ttlSeconds)
+}
+
+func (s *metricsAppStorage) CompareAndDelete(pKey []byte, cCols []byte, expectedValue []byte) (ok bool, err error) {
+	defer s.mCompareAndDelete.ObserveSince(time.Now())
+	return s.storage.CompareAndDelete(pKey, cCols, expectedValue)
+}
+
+func (s *metricsAppStorage) TTLGet(pKey []byte, cCols []byte, data *[]byte) (ok bool, err error) {
+	defer s.mTTLGet.ObserveSince(time.Now())
+	return s.storage.TTLGet(pKey, cCols, data)
+}
+
+// the duration includes the time spent in cb
+func (s *metricsAppStorage) TTLRead(ctx context.Context, pKey []byte, startCCols, finishCCols []byte, cb istorage.ReadCallback) (err error) {
+	defer s.mTTLRead.ObserveSince(time.Now())
+	return s.storage.TTLRead(ctx, pKey, startCCols, finishCCols, cb)
+}
+
+func (s *metricsAppStorage) QueryTTL(pKey []byte, cCols []byte) (ttlInSeconds int, ok bool, err error) {
+	defer s.mQueryTTL.ObserveSince(time.Now())
+	return s.storage.QueryTTL(pKey, cCols)
+}
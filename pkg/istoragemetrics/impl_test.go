@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragemetrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/goutils/testingu"
+	"github.com/voedger/voedger/pkg/istorage"
+	"github.com/voedger/voedger/pkg/istorage/mem"
+	istorageimpl "github.com/voedger/voedger/pkg/istorage/provider"
+	"github.com/voedger/voedger/pkg/istructs"
+	imetrics "github.com/voedger/voedger/pkg/metrics"
+)
+
+func TestTechnologyCompatibilityKit(t *testing.T) {
+	require := require.New(t)
+	asf := mem.Provide(testingu.MockTime)
+	metrics := imetrics.Provide()
+	storageProvider := Provide(istorageimpl.Provide(asf), metrics, "vvm")
+	storage, err := storageProvider.AppStorage(istructs.AppQName_test1_app1)
+	require.NoError(err)
+	istorage.TechnologyCompatibilityKit_Storage(t, storage, asf.Time())
+
+	counts := map[string]uint64{}
+	require.NoError(metrics.ListHistograms(func(metric imetrics.IMetric, value imetrics.HistogramValue) (err error) {
+		require.Equal(istructs.AppQName_test1_app1, metric.App())
+		require.Equal("vvm", metric.Vvm())
+		counts[metric.Name()] = value.Count
+		return nil
+	}))
+	for _, metricName := range []string{putSeconds, putBatchSeconds, getSeconds, getBatchSeconds, readSeconds,
+		insertIfNotExistsSeconds, compareAndSwapSeconds, compareAndDeleteSeconds, ttlGetSeconds, ttlReadSeconds, queryTTLSeconds} {
+		require.Positive(counts[metricName], metricName)
+	}
+}
@@ -0,0 +1,20 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragemetrics
+
+// histograms, labels: app, vvm
+const (
+	putSeconds               = "voedger_istorage_put_duration_seconds"
+	putBatchSeconds          = "voedger_istorage_putbatch_duration_seconds"
+	getSeconds               = "voedger_istorage_get_duration_seconds"
+	getBatchSeconds          = "voedger_istorage_getbatch_duration_seconds"
+	readSeconds              = "voedger_istorage_read_duration_seconds"
+	insertIfNotExistsSeconds = "voedger_istorage_insertifnotexists_duration_seconds"
+	compareAndSwapSeconds    = "voedger_istorage_compareandswap_duration_seconds"
+	compareAndDeleteSeconds  = "voedger_istorage_compareanddelete_duration_seconds"
+	ttlGetSeconds            = "voedger_istorage_ttlget_duration_seconds"
+	ttlReadSeconds           = "voedger_istorage_ttlread_duration_seconds"
+	queryTTLSeconds          = "voedger_istorage_queryttl_duration_seconds"
+)
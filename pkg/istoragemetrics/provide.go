@@ -0,0 +1,20 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragemetrics
+
+import (
+	"github.com/voedger/voedger/pkg/istorage"
+	imetrics "github.com/voedger/voedger/pkg/metrics"
+)
+
+// Provide wraps the storage provider to observe the duration of each IAppStorage call
+// Durations are observed by per-app histograms with the default buckets
+func Provide(storageProvider istorage.IAppStorageProvider, metrics imetrics.IMetrics, vvmName string) istorage.IAppStorageProvider {
+	return &implMetricsAppStorageProvider{
+		storageProvider: storageProvider,
+		metrics:         metrics,
+		vvmName:         vvmName,
+	}
+}
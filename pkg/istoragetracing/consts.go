@@ -0,0 +1,13 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragetracing
+
+const (
+	spanNamePrefix  = "storage."
+	spanAttrApp     = "storage.app"
+	spanAttrItems   = "storage.items"
+	spanAttrFound   = "storage.found"
+	spanAttrApplied = "storage.applied"
+)
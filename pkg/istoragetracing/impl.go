@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragetracing
+
+import (
+	"context"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/istorage"
+	"github.com/voedger/voedger/pkg/tracing"
+)
+
+type implTracingAppStorageProvider struct {
+	storageProvider istorage.IAppStorageProvider
+	tracer          tracing.ITracer
+}
+
+type tracingAppStorage struct {
+	storage istorage.IAppStorage
+	tracer  tracing.ITracer
+	app     string
+}
+
+func (asp *implTracingAppStorageProvider) Prepare(work any) error {
+	return asp.storageProvider.Prepare(work)
+}
+
+func (asp *implTracingAppStorageProvider) Run(ctx context.Context) {
+	asp.storageProvider.Run(ctx)
+}
+
+func (asp *implTracingAppStorageProvider) Stop() {
+	asp.storageProvider.Stop()
+}
+
+func (asp *implTracingAppStorageProvider) AppStorage(appQName appdef.AppQName) (istorage.IAppStorage, error) {
+	storage, err := asp.storageProvider.AppStorage(appQName)
+	if err != nil {
+		return nil, err
+	}
+	return &tracingAppStorage{
+		storage: storage,
+		tracer:  asp.tracer,
+		app:     appQName.String(),
+	}, nil
+}
+
+func (s *tracingAppStorage) start(ctx context.Context, op string) (context.Context, tracing.ISpan) {
+	return s.tracer.Start(ctx, spanNamePrefix+op, tracing.WithKind(tracing.SpanKind_Client), tracing.WithAttr(spanAttrApp, s.app))
+}
+
+func (s *tracingAppStorage) Put(pKey []byte, cCols []byte, value []byte) (err error) {
+	_, span := s.start(context.Background(), "Put")
+	err = s.storage.Put(pKey, cCols, value)
+	span.End(err)
+	return err
+}
+
+func (s *tracingAppStorage) PutBatch(items []istorage.BatchItem) (err error) {
+	_, span := s.start(context.Background(), "PutBatch")
+	span.SetAttr(spanAttrItems, len(items))
+	err = s.storage.PutBatch(items)
+	span.End(err)
+	return err
+}
+
+func (s *tracingAppStorage) Get(pKey []byte, cCols []byte, data *[]byte) (ok bool, err error) {
+	_, span := s.start(context.Background(), "Get")
+	ok, err = s.storage.Get(pKey, cCols, data)
+	span.SetAttr(spanAttrFound, ok)
+	span.End(err)
+	return ok, err
+}
+
+func (s *tracingAppStorage) GetBatch(pKey []byte, items []istorage.GetBatchItem) (err error) {
+	_, span := s.start(context.Background(), "GetBatch")
+	span.SetAttr(spanAttrItems, len(items))
+	err = s.storage.GetBatch(pKey, items)
+	span.End(err)
+	return err
+}
+
+func (s *tracingAppStorage) Read(ctx context.Context, pKey []byte, startCCols, finishCCols []byte, cb istorage.ReadCallback) (err error) {
+	ctx, span := s.start(ctx, "Read")
+	err = s.storage.Read(ctx, pKey, startCCols, finishCCols, cb)
+	span.End(err)
+	return err
+}
+
+func (s *tracingAppStorage) InsertIfNotExists(pKey []byte, cCols []byte, value []byte, ttlSeconds int) (ok bool, err error) {
+	_, span := s.start(context.Background(), "InsertIfNotExists")
+	ok, err = s.storage.InsertIfNotExists(pKey, cCols, value, ttlSeconds)
+	span.SetAttr(spanAttrApplied, ok)
+	span.End(err)
+	return ok, err
+}
+
+func (s *tracingAppStorage) CompareAndSwap(pKey []byte, cCols []byte, oldValue, newValue []byte, ttlSeconds int) (ok bool, err error) {
+	_, span := s.start(context.Background(), "CompareAndSwap")
+	ok, err = s.storage.CompareAndSwap(pKey, cCols, oldValue, newValue, ttlSeconds)
+	span.SetAttr(spanAttrApplied, ok)
+	span.End(err)
+	return ok, err
+}
+
+func (s *tracingAppStorage) CompareAndDelete(pKey []byte, cCols []byte, expectedValue []byte) (ok bool, err error) {
+	_, span := s.start(context.Background(), "CompareAndDelete")
+	ok, err = s.storage.CompareAndDelete(pKey, cCols, expectedValue)
+	span.SetAttr(spanAttrApplied, ok)
+	span.End(err)
+	return ok, err
+}
+
+func (s *tracingAppStorage) TTLGet(pKey []byte, cCols []byte, data *[]byte) (ok bool, err error) {
+	_, span := s.start(context.Background(), "TTLGet")
+	ok, err = s.storage.TTLGet(pKey, cCols, data)
+	span.SetAttr(spanAttrFound, ok)
+	span.End(err)
+	return ok, err
+}
+
+func (s *tracingAppStorage) TTLRead(ctx context.Context, pKey []byte, startCCols, finishCCols []byte, cb istorage.ReadCallback) (err error) {
+	ctx, span := s.start(ctx, "TTLRead")
+	err = s.storage.TTLRead(ctx, pKey, startCCols, finishCCols, cb)
+	span.End(err)
+	return err
+}
+
+func (s *tracingAppStorage) QueryTTL(pKey []byte, cCols []byte) (ttlInSeconds int, ok bool, err error) {
+	_, span := s.start(context.Background(), "QueryTTL")
+	ttlInSeconds, ok, err = s.storage.QueryTTL(pKey, cCols)
+	span.SetAttr(spanAttrFound, ok)
+	span.End(err)
+	return ttlInSeconds, ok, err
+}
@@ -0,0 +1,24 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istoragetracing
+
+import (
+	"github.com/voedger/voedger/pkg/istorage"
+	"github.com/voedger/voedger/pkg/tracing"
+)
+
+// Provide wraps the storage provider to start a span per IAppStorage call
+// Read and TTLRead spans are the children of the span from ctx
+// Other methods have no ctx, so their spans are the root ones and are subject to the sampling
+// Disabled tracer -> storageProvider is returned as is
+func Provide(storageProvider istorage.IAppStorageProvider, tracer tracing.ITracer) istorage.IAppStorageProvider {
+	if !tracer.Enabled() {
+		return storageProvider
+	}
+	return &implTracingAppStorageProvider{
+		storageProvider: storageProvider,
+		tracer:          tracer,
+	}
+}
@@ -33,6 +33,9 @@ var (
 
 	QNameCommandCUD = appdef.NewQName(appdef.SysPackage, "CUD")
 
+	// QNameCommandBatch is the event of the commands executed by the batch endpoint as a whole
+	QNameCommandBatch = appdef.NewQName(appdef.SysPackage, "Batch")
+
 	// QNameRaw denotes that Function argument comes as a JSON object
 	QNameRaw = appdef.NewQName(appdef.SysPackage, "Raw")
 
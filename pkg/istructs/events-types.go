@@ -75,6 +75,12 @@ type ICUDRow interface {
 
 	// Returns if previously deactivated record is activated (reactivated).
 	IsActivated() bool
+
+	// Returns record values before update.
+	//
+	// Returns nil if row is new or if values before update are not loaded,
+	// e.g. for event, which is read from storage and is not applied to records.
+	Origin() IRowReader
 }
 
 type IIDGenerator interface {
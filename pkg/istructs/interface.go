@@ -91,6 +91,14 @@ type IAppStructs interface {
 
 	// AppTTLStorage returns application-level TTL storage
 	AppTTLStorage() IAppTTLStorage
+
+	// Physically deletes records, view records and WLog events of the workspace.
+	// PLog events of the workspace are redacted: arguments and CUDs are removed, headers are kept.
+	//
+	// PLog and WLog events of the specified referencing workspaces, which refer to the purged workspace, are redacted too.
+	//
+	// Purge is idempotent: WLog events are deleted last, so purge can be repeated after a failure
+	PurgeWorkspace(ctx context.Context, ws WSID, refs ...WSID) (WorkspacePurgeStats, error)
 }
 
 // IAppTTLStorage provides application-level key-value storage with TTL support
@@ -230,6 +238,10 @@ type IViewRecords interface {
 	// - The fullness of the required view value fields is not checked
 	PutJSON(WSID, map[appdef.FieldName]any) error
 
+	// All key fields must be specified (panic).
+	// If key is not found then nothing happens
+	Delete(workspace WSID, key IKeyBuilder) (err error)
+
 	// All fields must be filled in the key (panic otherwise).
 	// If key is not found then null value (with NullQName) and ErrRecordNotFound returned
 	Get(WSID, IKeyBuilder) (IValue, error)
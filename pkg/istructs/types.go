@@ -8,8 +8,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 )
 
 type SubjectLogin string
@@ -51,20 +55,40 @@ type IRowReader interface {
 	AsInt8(appdef.FieldName) int8   // #3435 [~server.vsql.smallints/cmp.istructs~impl]
 	AsInt16(appdef.FieldName) int16 // #3435 [~server.vsql.smallints/cmp.istructs~impl]
 	AsInt32(appdef.FieldName) int32
+
+	// Returns int64 or RecordID field value, or stored value of date, time or timestamp field
 	AsInt64(appdef.FieldName) int64
+
 	AsFloat32(appdef.FieldName) float32
 	AsFloat64(appdef.FieldName) float64
 
-	// Returns bytes or raw field value
+	// Returns bytes or raw field value, or stored value of uuid field
 	AsBytes(appdef.FieldName) []byte
 
-	// Returns string or raw field value
+	// Returns string or raw field value, or text of json field
 	AsString(appdef.FieldName) string
 
 	AsQName(appdef.FieldName) appdef.QName
 	AsBool(appdef.FieldName) bool
 	AsRecordID(appdef.FieldName) RecordID
 
+	// Returns decimal field value rescaled to the field scale
+	AsDecimal(appdef.FieldName) decimal.Decimal
+
+	// Returns date, time or timestamp field value in UTC.
+	//
+	// Date value is returned as midnight, time value is returned as time of 1970-01-01
+	AsTime(appdef.FieldName) time.Time
+
+	// Returns uuid field value
+	AsUUID(appdef.FieldName) uuid.UUID
+
+	// Returns value addressed by path in json field, like `address.city` or `phones[0].number`.
+	//
+	// Objects are returned as map[string]any, arrays as []any and numbers as float64.
+	// Returns nil if field is empty or path does not exist
+	AsJSONPath(name appdef.FieldName, path string) any
+
 	// consts.NullRecord will be returned as null-values
 	RecordIDs(includeNulls bool) func(func(appdef.FieldName, RecordID) bool)
 	Fields(func(appdef.IField) bool)
@@ -84,19 +108,32 @@ type IRowWriter interface {
 	// Puts value into bytes or raw data field.
 	PutBytes(appdef.FieldName, []byte)
 
-	// Puts value into string or raw data field.
+	// Puts value into string, json or raw data field.
 	PutString(appdef.FieldName, string)
 
 	PutQName(appdef.FieldName, appdef.QName)
 	PutBool(appdef.FieldName, bool)
 	PutRecordID(appdef.FieldName, RecordID)
 
+	// Puts value into decimal field.
+	//
+	// Value is rescaled to the field scale, error occurs if it is impossible without rounding
+	PutDecimal(appdef.FieldName, decimal.Decimal)
+
+	// Puts value into date, time or timestamp field.
+	//
+	// Calendar date of value is put into date field, clock of value is put into time field
+	PutTime(appdef.FieldName, time.Time)
+
+	// Puts value into uuid field.
+	PutUUID(appdef.FieldName, uuid.UUID)
+
 	// Puts underlying json.Number value into field of int32, int64, float32 or float64
 	//
 	// Tries to make conversion from value to a name type
 	PutNumber(appdef.FieldName, json.Number)
 
-	// Puts value into string, bytes or QName data type field.
+	// Puts value into string, bytes, QName, decimal, date, time, timestamp, uuid or json data type field.
 	//
 	// Tries to make conversion from value to a name type
 	PutChars(appdef.FieldName, string)
@@ -106,6 +143,7 @@ type IRowWriter interface {
 	// joson.Number value type is allowed for number fields
 	//
 	// Calls PutNumber for numbers and RecordIDs, PutChars for strings, bytes and QNames.
+	// Objects and arrays are put into json fields as json text.
 	PutFromJSON(map[appdef.FieldName]any)
 }
 
@@ -148,3 +186,11 @@ func CollectRowBuilder(b RowBuilder) bool {
 }
 
 var builders []RowBuilder
+
+// Result of IAppStructs.PurgeWorkspace
+type WorkspacePurgeStats struct {
+	Records        int // deleted records
+	ViewRecords    int // deleted view records
+	Events         int // deleted WLog events, the same number of PLog events are redacted
+	RedactedEvents int // redacted events of the referencing workspaces
+}
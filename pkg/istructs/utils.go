@@ -10,7 +10,10 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/goutils/strconvu"
 )
 
@@ -63,6 +66,12 @@ func (*NullRowReader) AsString(string) string      { return "" }
 func (*NullRowReader) AsRecordID(string) RecordID  { return NullRecordID }
 func (*NullRowReader) AsQName(string) appdef.QName { return appdef.NullQName }
 func (*NullRowReader) AsBool(string) bool          { return false }
+func (*NullRowReader) AsDecimal(string) decimal.Decimal {
+	return decimal.Decimal{}
+}
+func (*NullRowReader) AsTime(string) time.Time       { return time.Time{} }
+func (*NullRowReader) AsUUID(string) uuid.UUID       { return uuid.Nil }
+func (*NullRowReader) AsJSONPath(string, string) any { return nil }
 func (*NullRowReader) RecordIDs(bool) func(func(string, RecordID) bool) {
 	return func(func(string, RecordID) bool) {}
 }
@@ -85,20 +94,23 @@ func (no *NullObject) SpecifiedValues(func(appdef.IField, any) bool) {}
 // Implements IRowWriter
 type NullRowWriter struct{}
 
-func (*NullRowWriter) PutInt8(string, int8)          {} // #3435 [~server.vsql.smallints/cmp.istructs~impl]
-func (*NullRowWriter) PutInt16(string, int16)        {} // #3435 [~server.vsql.smallints/cmp.istructs~impl]
-func (*NullRowWriter) PutInt32(string, int32)        {}
-func (*NullRowWriter) PutInt64(string, int64)        {}
-func (*NullRowWriter) PutFloat32(string, float32)    {}
-func (*NullRowWriter) PutFloat64(string, float64)    {}
-func (*NullRowWriter) PutBytes(string, []byte)       {}
-func (*NullRowWriter) PutString(string, string)      {}
-func (*NullRowWriter) PutQName(string, appdef.QName) {}
-func (*NullRowWriter) PutBool(string, bool)          {}
-func (*NullRowWriter) PutRecordID(string, RecordID)  {}
-func (*NullRowWriter) PutNumber(string, json.Number) {}
-func (*NullRowWriter) PutChars(string, string)       {}
-func (*NullRowWriter) PutFromJSON(map[string]any)    {}
+func (*NullRowWriter) PutInt8(string, int8)               {} // #3435 [~server.vsql.smallints/cmp.istructs~impl]
+func (*NullRowWriter) PutInt16(string, int16)             {} // #3435 [~server.vsql.smallints/cmp.istructs~impl]
+func (*NullRowWriter) PutInt32(string, int32)             {}
+func (*NullRowWriter) PutInt64(string, int64)             {}
+func (*NullRowWriter) PutFloat32(string, float32)         {}
+func (*NullRowWriter) PutFloat64(string, float64)         {}
+func (*NullRowWriter) PutBytes(string, []byte)            {}
+func (*NullRowWriter) PutString(string, string)           {}
+func (*NullRowWriter) PutQName(string, appdef.QName)      {}
+func (*NullRowWriter) PutBool(string, bool)               {}
+func (*NullRowWriter) PutRecordID(string, RecordID)       {}
+func (*NullRowWriter) PutDecimal(string, decimal.Decimal) {}
+func (*NullRowWriter) PutTime(string, time.Time)          {}
+func (*NullRowWriter) PutUUID(string, uuid.UUID)          {}
+func (*NullRowWriter) PutNumber(string, json.Number)      {}
+func (*NullRowWriter) PutChars(string, string)            {}
+func (*NullRowWriter) PutFromJSON(map[string]any)         {}
 
 // Implements IObjectBuilder
 type NullObjectBuilder struct{ NullRowWriter }
@@ -6,9 +6,11 @@
 package istructsmem
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/isecrets"
 	"github.com/voedger/voedger/pkg/isequencer"
 	"github.com/voedger/voedger/pkg/istorage"
 	"github.com/voedger/voedger/pkg/istructs"
@@ -76,6 +78,8 @@ type AppConfigType struct {
 	numAppWorkspaces istructs.NumAppWorkspaces
 	jobs             []BuiltinJob
 	seqTypes         map[isequencer.WSKind]map[isequencer.SeqID]isequencer.Number
+	dataKeysReader   isecrets.ISecretReader
+	dataKeys         *dataKeys // will be initialized on prepare(), if application has encrypted fields
 }
 
 func newAppConfig(name appdef.AppQName, id istructs.ClusterAppID, def appdef.IAppDef, wsCount istructs.NumAppWorkspaces) *AppConfigType {
@@ -161,6 +165,11 @@ func (cfg *AppConfigType) prepare(appStorage istorage.IAppStorage) error {
 		return err
 	}
 
+	// prepare data encryption keys
+	if err := cfg.prepareDataKeys(); err != nil {
+		return err
+	}
+
 	if cfg.numAppWorkspaces <= 0 {
 		return ErrNumAppWorkspacesNotSet(cfg.Name)
 	}
@@ -259,6 +268,36 @@ func (cfg *AppConfigType) SetNumAppWorkspaces(naw istructs.NumAppWorkspaces) {
 	cfg.numAppWorkspaces = naw
 }
 
+// Sets reader for application data encryption keys.
+//
+// If application has encrypted fields, then keys are read from secret DataKeysSecretName() on first IAppStructsProvider.AppStructs() call.
+func (cfg *AppConfigType) SetDataKeysReader(r isecrets.ISecretReader) {
+	if cfg.prepared {
+		panic("must not set data keys reader after first IAppStructsProvider.AppStructs() call because the app is considered working")
+	}
+	cfg.dataKeysReader = r
+}
+
+// Reads data encryption keys, if application has encrypted fields
+func (cfg *AppConfigType) prepareDataKeys() error {
+	if !hasEncryptedFields(cfg.AppDef) {
+		return nil
+	}
+	if cfg.dataKeysReader == nil {
+		return ErrDataKeysNotFound(cfg.Name, errors.New("data keys reader is not set"))
+	}
+	data, err := cfg.dataKeysReader.ReadSecret(DataKeysSecretName(cfg.Name))
+	if err != nil {
+		return ErrDataKeysNotFound(cfg.Name, err)
+	}
+	dk, err := parseDataKeys(data)
+	if err != nil {
+		return enrichError(err, cfg.Name)
+	}
+	cfg.dataKeys = dk
+	return nil
+}
+
 func (cfg *AppConfigType) QNameID(qName appdef.QName) (istructs.QNameID, error) {
 	return cfg.qNames.ID(qName)
 }
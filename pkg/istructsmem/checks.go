@@ -0,0 +1,310 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package istructsmem
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+)
+
+// Checks row by structure CHECK constraints. Returns error if some check violated.
+//
+// Expressions evaluating to TRUE or UNKNOWN (NULL) succeed.
+func checkRowChecks(row *rowType) (err error) {
+	cc, ok := row.typ.(appdef.IWithChecks)
+	if !ok {
+		return nil
+	}
+	for _, c := range cc.Checks() {
+		v, e := evalExpr(row, c.Expr())
+		switch {
+		case e != nil:
+			err = errors.Join(err, ErrCheckFailed(row, c, e))
+		case v == nil, v == true:
+			// ok
+		case v == false:
+			err = errors.Join(err, ErrCheckViolated(row, c))
+		default:
+			err = errors.Join(err, ErrCheckFailed(row, c, fmt.Errorf("%w: result is %v, expected boolean", ErrWrongTypeError, v)))
+		}
+	}
+	return err
+}
+
+// Date, time or timestamp value to evaluate
+type dateTimeValue struct {
+	kind  appdef.DataKind
+	value int64
+}
+
+// Expression evaluation environment
+type exprEnv struct {
+	row *rowType
+	// request subject name, nil if unknown
+	subject any
+}
+
+// Evaluates expression for row.
+//
+// Request subject is unknown, so CURRENT_SUBJECT is evaluated as NULL.
+func evalExpr(row *rowType, e appdef.IExpr) (any, error) {
+	return exprEnv{row: row}.eval(e)
+}
+
+// Evaluates expression.
+//
+// # Returns:
+//   - nil for NULL (UNKNOWN) values,
+//   - *big.Rat for numbers,
+//   - string for strings, QNames and uuids,
+//   - bool for booleans,
+//   - dateTimeValue for dates, times and timestamps.
+func (env exprEnv) eval(e appdef.IExpr) (any, error) {
+	switch k := e.Kind(); k {
+	case appdef.ExprKind_Value:
+		return evalLiteral(e.Value())
+	case appdef.ExprKind_Field:
+		return evalField(env.row, e.Field())
+	case appdef.ExprKind_Subject:
+		return env.subject, nil
+	case appdef.ExprKind_Not:
+		x, err := env.evalBool(e.Args()[0])
+		if (err != nil) || (x == nil) {
+			return nil, err
+		}
+		return !x.(bool), nil
+	case appdef.ExprKind_And, appdef.ExprKind_Or:
+		x, err := env.evalBool(e.Args()[0])
+		if err != nil {
+			return nil, err
+		}
+		y, err := env.evalBool(e.Args()[1])
+		if err != nil {
+			return nil, err
+		}
+		return evalLogical(k, x, y), nil
+	case appdef.ExprKind_IsNull:
+		x, err := env.eval(e.Args()[0])
+		return x == nil, err
+	}
+
+	x, err := env.eval(e.Args()[0])
+	if err != nil {
+		return nil, err
+	}
+	y, err := env.eval(e.Args()[1])
+	if (err != nil) || (x == nil) || (y == nil) {
+		return nil, err
+	}
+
+	switch k := e.Kind(); k {
+	case appdef.ExprKind_Eq, appdef.ExprKind_Ne, appdef.ExprKind_Lt, appdef.ExprKind_Le, appdef.ExprKind_Gt, appdef.ExprKind_Ge:
+		c, err := compareValues(x, y)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", e, err)
+		}
+		return compareResult(k, c), nil
+	default: // arithmetic
+		res, err := evalArithmetic(k, x, y)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", e, err)
+		}
+		return res, nil
+	}
+}
+
+func (env exprEnv) evalBool(e appdef.IExpr) (any, error) {
+	v, err := env.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	switch v.(type) {
+	case nil, bool:
+		return v, nil
+	}
+	return nil, fmt.Errorf("%w: %v is not boolean", ErrWrongTypeError, e)
+}
+
+// Evaluates three-valued AND or OR logic
+func evalLogical(k appdef.ExprKind, x, y any) any {
+	if k == appdef.ExprKind_And {
+		if (x == false) || (y == false) {
+			return false
+		}
+		if (x == nil) || (y == nil) {
+			return nil
+		}
+		return true
+	}
+	if (x == true) || (y == true) {
+		return true
+	}
+	if (x == nil) || (y == nil) {
+		return nil
+	}
+	return false
+}
+
+func evalLiteral(v any) (any, error) {
+	switch v := v.(type) {
+	case int64:
+		return new(big.Rat).SetInt64(v), nil
+	case float64:
+		return floatToRat(v, 64)
+	}
+	return v, nil
+}
+
+func evalField(row *rowType, name appdef.FieldName) (any, error) {
+	fld := row.fieldDef(name)
+	if fld == nil {
+		// notest: fields are checked by appdef
+		return nil, ErrFieldNotFound(name, row)
+	}
+	if !row.HasValue(name) {
+		return nil, nil
+	}
+	switch k := fld.DataKind(); k {
+	case appdef.DataKind_int8:
+		return new(big.Rat).SetInt64(int64(row.AsInt8(name))), nil
+	case appdef.DataKind_int16:
+		return new(big.Rat).SetInt64(int64(row.AsInt16(name))), nil
+	case appdef.DataKind_int32:
+		return new(big.Rat).SetInt64(int64(row.AsInt32(name))), nil
+	case appdef.DataKind_int64:
+		return new(big.Rat).SetInt64(row.AsInt64(name)), nil
+	case appdef.DataKind_RecordID:
+		return new(big.Rat).SetUint64(uint64(row.AsRecordID(name))), nil
+	case appdef.DataKind_float32:
+		return floatToRat(float64(row.AsFloat32(name)), 32)
+	case appdef.DataKind_float64:
+		return floatToRat(row.AsFloat64(name), 64)
+	case appdef.DataKind_decimal:
+		d := row.AsDecimal(name)
+		return new(big.Rat).SetFrac(big.NewInt(d.Unscaled()), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.Scale())), nil)), nil
+	case appdef.DataKind_string, appdef.DataKind_json:
+		return row.AsString(name), nil
+	case appdef.DataKind_QName:
+		return row.AsQName(name).String(), nil
+	case appdef.DataKind_uuid:
+		return row.AsUUID(name).String(), nil
+	case appdef.DataKind_bool:
+		return row.AsBool(name), nil
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		return dateTimeValue{k, coreutils.DateTimeValue(k, row.AsTime(name))}, nil
+	}
+	return nil, fmt.Errorf("%w: %v is not supported in check expressions", ErrWrongTypeError, fld)
+}
+
+// Converts float to exact decimal representation, so 0.1 is equal to 0.1 literal
+func floatToRat(f float64, bitSize int) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, bitSize))
+	if !ok {
+		return nil, fmt.Errorf("%w: %v is not a number", ErrWrongTypeError, f)
+	}
+	return r, nil
+}
+
+// Compares two not null values. Returns -1, 0 or +1
+func compareValues(x, y any) (int, error) {
+	switch x := x.(type) {
+	case *big.Rat:
+		if y, ok := y.(*big.Rat); ok {
+			return x.Cmp(y), nil
+		}
+	case string:
+		switch y := y.(type) {
+		case string:
+			return strings.Compare(x, y), nil
+		case dateTimeValue:
+			c, err := compareValues(y, x)
+			return -c, err
+		}
+	case bool:
+		if y, ok := y.(bool); ok {
+			switch {
+			case x == y:
+				return 0, nil
+			case y:
+				return -1, nil
+			}
+			return 1, nil
+		}
+	case dateTimeValue:
+		switch y := y.(type) {
+		case dateTimeValue:
+			return compareInt64(x.value, y.value), nil
+		case string:
+			v, err := coreutils.ParseDateTime(x.kind, y)
+			if err != nil {
+				return 0, err
+			}
+			return compareInt64(x.value, v), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: can not compare %v and %v", ErrWrongTypeError, x, y)
+}
+
+func compareInt64(x, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func compareResult(k appdef.ExprKind, c int) bool {
+	switch k {
+	case appdef.ExprKind_Eq:
+		return c == 0
+	case appdef.ExprKind_Ne:
+		return c != 0
+	case appdef.ExprKind_Lt:
+		return c < 0
+	case appdef.ExprKind_Le:
+		return c <= 0
+	case appdef.ExprKind_Gt:
+		return c > 0
+	default: // ExprKind_Ge
+		return c >= 0
+	}
+}
+
+func evalArithmetic(k appdef.ExprKind, x, y any) (any, error) {
+	a, okA := x.(*big.Rat)
+	b, okB := y.(*big.Rat)
+	if !okA || !okB {
+		return nil, fmt.Errorf("%w: %v and %v are not numbers", ErrWrongTypeError, x, y)
+	}
+	switch k {
+	case appdef.ExprKind_Add:
+		return new(big.Rat).Add(a, b), nil
+	case appdef.ExprKind_Sub:
+		return new(big.Rat).Sub(a, b), nil
+	case appdef.ExprKind_Mul:
+		return new(big.Rat).Mul(a, b), nil
+	}
+
+	if b.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+	if k == appdef.ExprKind_Div {
+		return new(big.Rat).Quo(a, b), nil
+	}
+
+	// ExprKind_Mod
+	if !a.IsInt() || !b.IsInt() {
+		return nil, fmt.Errorf("%w: %v and %v are not integers", ErrWrongTypeError, x, y)
+	}
+	return new(big.Rat).SetInt(new(big.Int).Rem(a.Num(), b.Num())), nil
+}
@@ -0,0 +1,256 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package istructsmem
+
+import (
+	"testing"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/appdef/constraints"
+	"github.com/voedger/voedger/pkg/appdef/exprs"
+	"github.com/voedger/voedger/pkg/decimal"
+	"github.com/voedger/voedger/pkg/goutils/testingu/require"
+	"github.com/voedger/voedger/pkg/isequencer"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+func Test_Checks(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+
+	docName := appdef.NewQName("test", "doc")
+	objName := appdef.NewQName("test", "obj")
+	datesCheck := appdef.CheckQName(docName, "Dates")
+	totalCheck := appdef.CheckQName(objName, "Total")
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+	wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+
+	doc := wsb.AddCDoc(docName)
+	doc.
+		AddField("Name", appdef.DataKind_string, false).
+		AddField("Start", appdef.DataKind_date, false).
+		AddField("End", appdef.DataKind_date, false)
+	doc.AddCheck(datesCheck, exprs.Gt(exprs.Field("End"), exprs.Field("Start")))
+
+	obj := wsb.AddObject(objName)
+	obj.
+		AddField("Qty", appdef.DataKind_int32, false).
+		AddField("Price", appdef.DataKind_decimal, false, constraints.Precision(10), constraints.Scale(2)).
+		AddField("Total", appdef.DataKind_float64, false)
+	obj.AddCheck(totalCheck, exprs.Eq(exprs.Mul(exprs.Field("Qty"), exprs.Field("Price")), exprs.Field("Total")))
+
+	cfgs := make(AppConfigsType, 1)
+	cfg := cfgs.AddBuiltInAppConfig(appName, adb)
+	cfg.SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+
+	provider := Provide(cfgs, testTokensFactory(), simpleStorageProvider(), isequencer.SequencesTrustLevel_0, nil)
+
+	app, err := provider.BuiltIn(appName)
+	require.NoError(err)
+
+	cudEvent := func() istructs.IRawEventBuilder {
+		return app.Events().GetNewRawEventBuilder(
+			istructs.NewRawEventBuilderParams{
+				GenericRawEventBuilderParams: istructs.GenericRawEventBuilderParams{
+					HandlingPartition: 25,
+					PLogOffset:        100500,
+					Workspace:         1,
+					WLogOffset:        1050,
+					QName:             istructs.QNameCommandCUD,
+					RegisteredAt:      123456789,
+				},
+			})
+	}
+
+	t.Run("should be ok to create CUD if check passed", func(t *testing.T) {
+		e := cudEvent()
+		c := e.CUDBuilder().Create(docName)
+		c.PutRecordID(appdef.SystemField_ID, 1)
+		c.PutChars("Start", "2025-01-01")
+		c.PutChars("End", "2025-01-02")
+		_, err := e.BuildRawEvent()
+		require.NoError(err)
+	})
+
+	t.Run("should be ok to create CUD if check result is unknown", func(t *testing.T) {
+		e := cudEvent()
+		c := e.CUDBuilder().Create(docName)
+		c.PutRecordID(appdef.SystemField_ID, 1)
+		c.PutChars("Start", "2025-01-01")
+		_, err := e.BuildRawEvent()
+		require.NoError(err)
+	})
+
+	t.Run("should be error to create CUD if check violated", func(t *testing.T) {
+		e := cudEvent()
+		c := e.CUDBuilder().Create(docName)
+		c.PutRecordID(appdef.SystemField_ID, 1)
+		c.PutChars("Start", "2025-01-02")
+		c.PutChars("End", "2025-01-01")
+		_, err := e.BuildRawEvent()
+		require.Error(err, require.Is(ErrCheckViolatedError),
+			require.HasAll(datesCheck, "fields «End», «Start»", "End > Start"))
+
+		var vErr ValidateError
+		require.ErrorAs(err, &vErr)
+		require.Equal(ECode_CheckViolated, vErr.Code())
+	})
+
+	t.Run("should be error to update CUD if check violated by merged record", func(t *testing.T) {
+		const recID = istructs.RecordID(100500)
+		require.NoError(app.Records().PutJSON(1, map[appdef.FieldName]any{
+			appdef.SystemField_QName: docName.String(),
+			appdef.SystemField_ID:    recID,
+			"Start":                  "2025-01-01",
+			"End":                    "2025-01-10",
+		}))
+		rec, err := app.Records().Get(1, true, recID)
+		require.NoError(err)
+
+		e := cudEvent()
+		u := e.CUDBuilder().Update(rec)
+		u.PutChars("Start", "2025-01-20")
+		_, err = e.BuildRawEvent()
+		require.Error(err, require.Is(ErrCheckViolatedError), require.Has(datesCheck))
+
+		e = cudEvent()
+		u = e.CUDBuilder().Update(rec)
+		u.PutString("Name", "other fields do not matter")
+		_, err = e.BuildRawEvent()
+		require.NoError(err)
+	})
+
+	t.Run("should be checked argument objects", func(t *testing.T) {
+		build := func(qty int32, price string, total float64) error {
+			o := newObject(cfg, objName, nil)
+			o.PutInt32("Qty", qty)
+			o.PutDecimal("Price", decimal.MustParse(price))
+			o.PutFloat64("Total", total)
+			_, err := o.Build()
+			return err
+		}
+		require.NoError(build(3, "0.10", 0.3))
+		require.Error(build(3, "0.10", 0.31), require.Is(ErrCheckViolatedError),
+			require.HasAll(totalCheck, "fields «Price», «Qty», «Total»", "(Qty * Price) = Total"))
+	})
+}
+
+func Test_evalExpr(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	objName := appdef.NewQName("test", "obj")
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+	wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+	wsb.AddObject(objName).
+		AddField("i8", appdef.DataKind_int8, false).
+		AddField("i16", appdef.DataKind_int16, false).
+		AddField("i64", appdef.DataKind_int64, false).
+		AddField("f32", appdef.DataKind_float32, false).
+		AddField("s", appdef.DataKind_string, false).
+		AddField("q", appdef.DataKind_QName, false).
+		AddField("b", appdef.DataKind_bool, false).
+		AddField("ts", appdef.DataKind_timestamp, false).
+		AddField("u", appdef.DataKind_uuid, false).
+		AddField("bytes", appdef.DataKind_bytes, false).
+		AddField("null", appdef.DataKind_int32, false)
+
+	cfgs := make(AppConfigsType, 1)
+	cfg := cfgs.AddBuiltInAppConfig(appName, adb)
+	cfg.SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+	provider := Provide(cfgs, testTokensFactory(), simpleStorageProvider(), isequencer.SequencesTrustLevel_0, nil)
+	_, err := provider.BuiltIn(appName)
+	require.NoError(err)
+
+	o := newObject(cfg, objName, nil)
+	o.PutInt8("i8", 8)
+	o.PutInt16("i16", -16)
+	o.PutInt64("i64", 64)
+	o.PutFloat32("f32", 0.1)
+	o.PutString("s", "abc")
+	o.PutQName("q", objName)
+	o.PutBool("b", true)
+	o.PutChars("ts", "2025-01-02T03:04:05Z")
+	o.PutChars("u", "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	o.PutBytes("bytes", []byte{1})
+	require.NoError(o.build())
+
+	f := exprs.Field
+	v := exprs.Value
+
+	t.Run("should be ok to evaluate", func(t *testing.T) {
+		tests := []struct {
+			e    appdef.IExpr
+			want any
+		}{
+			{exprs.Eq(f("i8"), v(8)), true},
+			{exprs.Lt(f("i16"), v(-15)), true},
+			{exprs.Eq(exprs.Mod(f("i64"), v(10)), v(4)), true},
+			{exprs.Eq(exprs.Div(f("i64"), v(128)), v(0.5)), true},
+			{exprs.Eq(exprs.Sub(f("i8"), f("i16")), v(24)), true},
+			{exprs.Eq(exprs.Add(f("f32"), v(0.2)), v(0.3)), true},
+			{exprs.Ge(f("s"), v("abc")), true},
+			{exprs.Ne(f("s"), v("abc")), false},
+			{exprs.Eq(f("q"), v("test.obj")), true},
+			{exprs.Eq(f("u"), v("6ba7b810-9dad-11d1-80b4-00c04fd430c8")), true},
+			{exprs.Gt(f("b"), v(false)), true},
+			{exprs.Le(f("b"), v(false)), false},
+			{exprs.Gt(f("ts"), v("2025-01-01T00:00:00Z")), true},
+			{exprs.Lt(v("2025-01-01T00:00:00Z"), f("ts")), true},
+			{exprs.Eq(f("ts"), f("ts")), true},
+			{exprs.IsNull(f("null")), true},
+			{exprs.IsNotNull(f("null")), false},
+			{exprs.Eq(f("null"), v(1)), nil},
+			{exprs.Add(f("null"), v(1)), nil},
+			{exprs.Not(exprs.Eq(f("null"), v(1))), nil},
+			{exprs.And(exprs.Eq(f("null"), v(1)), v(false)), false},
+			{exprs.And(exprs.Eq(f("null"), v(1)), v(true)), nil},
+			{exprs.And(v(true), v(true)), true},
+			{exprs.Or(exprs.Eq(f("null"), v(1)), v(true)), true},
+			{exprs.Or(exprs.Eq(f("null"), v(1)), v(false)), nil},
+			{exprs.Or(v(false), v(false)), false},
+		}
+		for _, test := range tests {
+			t.Run(test.e.String(), func(t *testing.T) {
+				got, err := evalExpr(&o.rowType, test.e)
+				require.NoError(err)
+				require.Equal(test.want, got)
+			})
+		}
+	})
+
+	t.Run("should be error to evaluate", func(t *testing.T) {
+		tests := []struct {
+			e   appdef.IExpr
+			err error
+			has string
+		}{
+			{exprs.Eq(f("s"), v(1)), ErrWrongTypeError, "can not compare"},
+			{exprs.Add(f("s"), v(1)), ErrWrongTypeError, "are not numbers"},
+			{exprs.Div(f("i8"), v(0)), ErrDivisionByZero, "i8 / 0"},
+			{exprs.Mod(f("i8"), v(0)), ErrDivisionByZero, "i8 % 0"},
+			{exprs.Mod(f("i8"), v(0.5)), ErrWrongTypeError, "are not integers"},
+			{exprs.Not(f("s")), ErrWrongTypeError, "s is not boolean"},
+			{exprs.And(v(true), f("i8")), ErrWrongTypeError, "i8 is not boolean"},
+			{exprs.Eq(f("bytes"), v("a")), ErrWrongTypeError, "bytes"},
+			{exprs.Eq(f("ts"), v("yesterday")), nil, "yesterday"},
+		}
+		for _, test := range tests {
+			t.Run(test.e.String(), func(t *testing.T) {
+				_, err := evalExpr(&o.rowType, test.e)
+				require.Error(err, require.Has(test.has))
+				if test.err != nil {
+					require.ErrorIs(err, test.err)
+				}
+			})
+		}
+	})
+}
@@ -12,7 +12,11 @@ import (
 	"slices"
 	"sort"
 
+	"github.com/google/uuid"
+
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/datetime"
+	"github.com/voedger/voedger/pkg/decimal"
 )
 
 // Checks value by field constraints. Return error if constraints violated
@@ -34,6 +38,16 @@ func checkConstraints(fld appdef.IField, value any) (err error) {
 		err = checkNumberConstraints(fld, value.(float32))
 	case appdef.DataKind_float64:
 		err = checkNumberConstraints(fld, value.(float64))
+	case appdef.DataKind_decimal:
+		err = checkDecimalConstraints(fld, value.(decimal.Decimal))
+	case appdef.DataKind_date, appdef.DataKind_timestamp:
+		err = checkNumberConstraints(fld, value.(int64))
+	case appdef.DataKind_time:
+		err = checkTimeConstraints(fld, value.(int64))
+	case appdef.DataKind_uuid:
+		err = checkUUIDConstraints(fld, value.([]byte))
+	case appdef.DataKind_json:
+		err = checkCharsConstraints(fld, value.(string))
 	}
 	return err
 }
@@ -80,8 +94,12 @@ func checkCharsConstraints[T chars](fld appdef.IField, value T) (err error) {
 	}
 
 	if !maxLenChecked {
-		if len(value) > int(appdef.DefaultFieldMaxLength) {
-			err = errors.Join(err, ErrDataConstraintViolation(fld, fmt.Sprintf("default MaxLen: %d", appdef.DefaultFieldMaxLength)))
+		maxLen := appdef.DefaultFieldMaxLength
+		if fld.DataKind() == appdef.DataKind_json {
+			maxLen = appdef.DefaultJSONFieldMaxLength
+		}
+		if len(value) > int(maxLen) {
+			err = errors.Join(err, ErrDataConstraintViolation(fld, fmt.Sprintf("default MaxLen: %d", maxLen)))
 		}
 	}
 
@@ -133,3 +151,64 @@ func checkNumberConstraints[T number](fld appdef.IField, value T) (err error) {
 
 	return err
 }
+
+// Checks decimal value by field constraints. Return error if constraints violated.
+//
+// Value should be already rescaled to the field scale, see [rescaleDecimal]
+func checkDecimalConstraints(fld appdef.IField, value decimal.Decimal) (err error) {
+	precision, _ := appdef.DecimalPrecisionScale(fld.Data())
+	if value.Digits() > int(precision) {
+		err = errors.Join(err, ErrDataConstraintViolation(fld, fmt.Sprintf("Precision: %d", precision)))
+	}
+
+	for k, c := range fld.Constraints() {
+		switch k {
+		case appdef.ConstraintKind_MinIncl:
+			if value.CmpFloat64(c.Value().(float64)) < 0 {
+				err = errors.Join(err, ErrDataConstraintViolation(fld, c))
+			}
+		case appdef.ConstraintKind_MinExcl:
+			if value.CmpFloat64(c.Value().(float64)) <= 0 {
+				err = errors.Join(err, ErrDataConstraintViolation(fld, c))
+			}
+		case appdef.ConstraintKind_MaxIncl:
+			if value.CmpFloat64(c.Value().(float64)) > 0 {
+				err = errors.Join(err, ErrDataConstraintViolation(fld, c))
+			}
+		case appdef.ConstraintKind_MaxExcl:
+			if value.CmpFloat64(c.Value().(float64)) >= 0 {
+				err = errors.Join(err, ErrDataConstraintViolation(fld, c))
+			}
+		}
+	}
+
+	return err
+}
+
+// Checks time value (milliseconds since midnight) by field constraints. Return error if constraints violated.
+func checkTimeConstraints(fld appdef.IField, value int64) (err error) {
+	if e := datetime.CheckTime(value); e != nil {
+		err = errors.Join(err, ErrDataConstraintViolation(fld, e))
+	}
+	return errors.Join(err, checkNumberConstraints(fld, value))
+}
+
+// Checks uuid value length. Return error if value is not sixteen bytes long.
+func checkUUIDConstraints(fld appdef.IField, value []byte) (err error) {
+	if l := len(value); l != len(uuid.UUID{}) {
+		err = ErrDataConstraintViolation(fld, fmt.Sprintf("Length: %d, but %d bytes passed", len(uuid.UUID{}), l))
+	}
+	return err
+}
+
+// Rescales decimal value to the field scale.
+//
+// Returns error if value can not be rescaled without rounding
+func rescaleDecimal(fld appdef.IField, value decimal.Decimal) (decimal.Decimal, error) {
+	_, scale := appdef.DecimalPrecisionScale(fld.Data())
+	d, err := value.Rescale(scale)
+	if err != nil {
+		return d, errors.Join(err, ErrDataConstraintViolation(fld, fmt.Sprintf("Scale: %d", scale)))
+	}
+	return d, nil
+}
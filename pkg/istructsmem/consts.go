@@ -41,6 +41,25 @@ const (
 	partitionRecordCount = 1 << partitionBits
 )
 
+// dataKeyLength is length of data encryption key, AES-256 is used
+const dataKeyLength = 32
+
+// Encrypted field value starts with signature and format version.
+// Value without signature is plain value, stored before the field became encrypted
+const (
+	encryptedValueSignature = "\x00enc"
+	encryptedValueVersion   = byte(1)
+)
+
+// redactedEventErrStr replaces build error message of the redacted invalid event, see [eventType.redacted]
+const redactedEventErrStr = "event data is purged"
+
+// viewPartitionValue is value of the registered view partition of workspace, see [viewPartitionKey]
+var viewPartitionValue = []byte{1}
+
+// maxRegisteredViewPartitions is maximum count of registered view partitions remembered by app structs, see [viewPartitions]
+const maxRegisteredViewPartitions = 64 * 1024
+
 // maxGetBatchRecordCount is maximum records that can be retrieved by ReadBatch GetBatch
 const maxGetBatchRecordCount = 256
 
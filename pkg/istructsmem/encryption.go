@@ -0,0 +1,255 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package istructsmem
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/untillpro/dynobuffers"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/istructsmem/internal/utils"
+)
+
+// Returns name of secret with data encryption keys for specified application.
+//
+// Secret is JSON with current key ID and base64 encoded AES-256 keys, e.g.:
+//
+//	{"current": 2, "keys": {"1": "…", "2": "…"}}
+//
+// New values are encrypted with current key. Values, encrypted with previous keys, are decrypted
+// by their key ID and re-encrypted with current key on next update. To rotate key,
+// add new key to secret and set it as current. Previous keys should be kept while encrypted
+// by them values exist.
+//
+// Plain values, stored before the field became ENCRYPTED, are read as is and encrypted on next update.
+func DataKeysSecretName(app appdef.AppQName) string {
+	return fmt.Sprintf("dataKeys.%s.%s.json", app.Owner(), app.Name())
+}
+
+// Data encryption key ID
+type dataKeyID = uint16
+
+// Data encryption keys ring
+type dataKeys struct {
+	current dataKeyID
+	keys    map[dataKeyID]cipher.AEAD
+}
+
+// Parses data encryption keys from secret JSON
+func parseDataKeys(data []byte) (*dataKeys, error) {
+	secret := struct {
+		Current dataKeyID         `json:"current"`
+		Keys    map[string]string `json:"keys"`
+	}{}
+	if err := json.Unmarshal(data, &secret); err != nil {
+		return nil, ErrDataKeysInvalid("%v", err)
+	}
+
+	dk := &dataKeys{
+		current: secret.Current,
+		keys:    make(map[dataKeyID]cipher.AEAD, len(secret.Keys)),
+	}
+	for id, k := range secret.Keys {
+		keyID, err := strconv.ParseUint(id, 10, 16)
+		if err != nil {
+			return nil, ErrDataKeysInvalid("key ID «%s»: %v", id, err)
+		}
+		key, err := base64.StdEncoding.DecodeString(k)
+		if err != nil {
+			return nil, ErrDataKeysInvalid("key «%s»: %v", id, err)
+		}
+		if len(key) != dataKeyLength {
+			return nil, ErrDataKeysInvalid("key «%s» length is %d bytes, expected %d", id, len(key), dataKeyLength)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			// notest: key length is checked above
+			return nil, ErrDataKeysInvalid("key «%s»: %v", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			// notest
+			return nil, ErrDataKeysInvalid("key «%s»: %v", id, err)
+		}
+		dk.keys[dataKeyID(keyID)] = aead
+	}
+
+	if _, ok := dk.keys[dk.current]; !ok {
+		return nil, ErrDataKeysInvalid("current key «%d» not found", dk.current)
+	}
+
+	return dk, nil
+}
+
+// Encrypts value by current key.
+//
+// Result contains signature, format version, key ID, nonce and sealed value.
+// Additional data binds the value to its place, see [rowType.encryptionAD]
+func (dk *dataKeys) encrypt(value []byte, ad []byte) []byte {
+	aead := dk.keys[dk.current]
+
+	buf := new(bytes.Buffer)
+	utils.SafeWriteBuf(buf, encryptedValueSignature)
+	utils.WriteByte(buf, encryptedValueVersion)
+	utils.WriteUint16(buf, dk.current)
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		// notest
+		panic(err)
+	}
+	utils.SafeWriteBuf(buf, nonce)
+
+	return aead.Seal(buf.Bytes(), nonce, value, ad)
+}
+
+// Returns is value encrypted by encrypt. Other values are plain
+func isEncrypted(value []byte) bool {
+	return bytes.HasPrefix(value, []byte(encryptedValueSignature))
+}
+
+// Decrypts value, encrypted by encrypt. Field name is used for error messages
+func (dk *dataKeys) decrypt(value []byte, ad []byte, field appdef.FieldName) ([]byte, error) {
+	const headerSize = len(encryptedValueSignature) + 1 + 2 // signature, version, key ID
+	if len(value) < headerSize {
+		return nil, ErrDecryptionFailed(field, "value is too short")
+	}
+	if v := value[len(encryptedValueSignature)]; v != encryptedValueVersion {
+		return nil, ErrDecryptionFailed(field, "unsupported format version «%d»", v)
+	}
+	value = value[len(encryptedValueSignature)+1:]
+	id := binary.BigEndian.Uint16(value)
+	aead, ok := dk.keys[id]
+	if !ok {
+		return nil, ErrDecryptionFailed(field, "key «%d» not found", id)
+	}
+	value = value[2:]
+	if len(value) < aead.NonceSize() {
+		return nil, ErrDecryptionFailed(field, "value is too short")
+	}
+	res, err := aead.Open(nil, value[:aead.NonceSize()], value[aead.NonceSize():], ad)
+	if err != nil {
+		return nil, ErrDecryptionFailed(field, "%v", err)
+	}
+	return res, nil
+}
+
+// Returns is application has encrypted fields
+func hasEncryptedFields(app appdef.IAppDef) bool {
+	for _, t := range app.Types() {
+		if ff, ok := t.(appdef.IWithFields); ok {
+			for _, f := range ff.Fields() {
+				if f.Encrypted() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Returns encrypted fields of row
+func (row *rowType) encryptedFields() []appdef.IField {
+	if row.appCfg.dataKeys == nil {
+		return nil
+	}
+	var ff []appdef.IField
+	for _, f := range row.fields.Fields() {
+		if f.Encrypted() {
+			ff = append(ff, f)
+		}
+	}
+	return ff
+}
+
+// Returns additional data to encrypt field value: table QName, record ID and field name.
+//
+// Encrypted value could not be moved to another record or field
+func (row *rowType) encryptionAD(field appdef.FieldName) []byte {
+	return fmt.Appendf(nil, "%v/%d/%s", row.QName(), row.ID(), field)
+}
+
+// Returns dynobuffer bytes with encrypted values of encrypted fields.
+//
+// Row dynobuffer itself is not changed.
+func (row *rowType) encryptBytes(data []byte) ([]byte, error) {
+	ff := row.encryptedFields()
+	if len(ff) == 0 || len(data) == 0 {
+		return data, nil
+	}
+
+	dyB := dynobuffers.ReadBuffer(data, row.dyB.Scheme)
+	defer dyB.Release()
+
+	for _, f := range ff {
+		switch f.DataKind() {
+		case appdef.DataKind_string:
+			if v, ok := dyB.GetString(f.Name()); ok {
+				dyB.Set(f.Name(), string(row.appCfg.dataKeys.encrypt([]byte(v), row.encryptionAD(f.Name()))))
+			}
+		case appdef.DataKind_bytes:
+			if a := dyB.GetByteArray(f.Name()); a != nil {
+				dyB.Set(f.Name(), row.appCfg.dataKeys.encrypt(a.Bytes(), row.encryptionAD(f.Name())))
+			}
+		}
+	}
+
+	res, err := dyB.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return utils.CopyBytes(res), nil
+}
+
+// Decrypts values of encrypted fields in row dynobuffer.
+//
+// Plain values are kept as is, see [isEncrypted]
+func (row *rowType) decryptFields() error {
+	ff := row.encryptedFields()
+	if len(ff) == 0 {
+		return nil
+	}
+
+	for _, f := range ff {
+		switch f.DataKind() {
+		case appdef.DataKind_string:
+			if v, ok := row.dyB.GetString(f.Name()); ok && isEncrypted([]byte(v)) {
+				dec, err := row.appCfg.dataKeys.decrypt([]byte(v), row.encryptionAD(f.Name()), f.Name())
+				if err != nil {
+					return err
+				}
+				row.dyB.Set(f.Name(), string(dec))
+			}
+		case appdef.DataKind_bytes:
+			if a := row.dyB.GetByteArray(f.Name()); a != nil && isEncrypted(a.Bytes()) {
+				dec, err := row.appCfg.dataKeys.decrypt(a.Bytes(), row.encryptionAD(f.Name()), f.Name())
+				if err != nil {
+					return err
+				}
+				row.dyB.Set(f.Name(), dec)
+			}
+		}
+	}
+
+	if row.dyB.IsModified() {
+		bytes, err := row.dyB.ToBytes()
+		if err != nil {
+			// notest
+			return err
+		}
+		row.dyB.Reset(utils.CopyBytes(bytes))
+	}
+
+	return nil
+}
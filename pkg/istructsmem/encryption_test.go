@@ -0,0 +1,303 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package istructsmem
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/goutils/testingu/require"
+	"github.com/voedger/voedger/pkg/isequencer"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+// test secrets reader
+type testSecrets map[string][]byte
+
+func (s testSecrets) ReadSecret(name string) ([]byte, error) {
+	if v, ok := s[name]; ok {
+		return v, nil
+	}
+	return nil, fs.ErrNotExist
+}
+
+func testDataKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, dataKeyLength))
+}
+
+func Test_parseDataKeys(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("should be ok to parse keys", func(t *testing.T) {
+		dk, err := parseDataKeys(fmt.Appendf(nil, `{"current": 2, "keys": {"1": %q, "2": %q}}`, testDataKey(1), testDataKey(2)))
+		require.NoError(err)
+		require.EqualValues(2, dk.current)
+		require.Len(dk.keys, 2)
+	})
+
+	t.Run("should be error", func(t *testing.T) {
+		tests := []struct {
+			name string
+			data string
+			has  string
+		}{
+			{"if not JSON", `keys`, "invalid character"},
+			{"if key ID is not a number", fmt.Sprintf(`{"current": 1, "keys": {"a": %q}}`, testDataKey(1)), "key ID «a»"},
+			{"if key is not base64", `{"current": 1, "keys": {"1": "!!!"}}`, "key «1»"},
+			{"if key length is wrong", `{"current": 1, "keys": {"1": "AAAA"}}`, "expected 32"},
+			{"if current key not found", fmt.Sprintf(`{"current": 2, "keys": {"1": %q}}`, testDataKey(1)), "current key «2» not found"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := parseDataKeys([]byte(tt.data))
+				require.Error(err, require.Is(ErrDataKeysInvalidError), require.Has(tt.has))
+			})
+		}
+	})
+}
+
+func Test_dataKeysEncryption(t *testing.T) {
+	require := require.New(t)
+
+	key1, err := parseDataKeys(fmt.Appendf(nil, `{"current": 1, "keys": {"1": %q}}`, testDataKey(1)))
+	require.NoError(err)
+	key2, err := parseDataKeys(fmt.Appendf(nil, `{"current": 2, "keys": {"1": %q, "2": %q}}`, testDataKey(1), testDataKey(2)))
+	require.NoError(err)
+
+	plain := []byte("secret value")
+	ad := []byte("test.doc/1/f")
+
+	t.Run("should be ok to encrypt and decrypt", func(t *testing.T) {
+		enc := key1.encrypt(plain, ad)
+		require.NotContains(string(enc), string(plain))
+		require.NotEqual(enc, key1.encrypt(plain, ad), "nonce should be random")
+		require.True(isEncrypted(enc))
+		require.False(isEncrypted(plain))
+
+		dec, err := key1.decrypt(enc, ad, "f")
+		require.NoError(err)
+		require.Equal(plain, dec)
+	})
+
+	t.Run("should be ok to decrypt by rotated keys", func(t *testing.T) {
+		enc := key1.encrypt(plain, ad)
+		dec, err := key2.decrypt(enc, ad, "f")
+		require.NoError(err)
+		require.Equal(plain, dec)
+
+		enc = key2.encrypt(plain, ad)
+		header := append([]byte(encryptedValueSignature), encryptedValueVersion, 0, 2)
+		require.EqualValues(header, enc[:len(header)])
+	})
+
+	t.Run("should be error to decrypt", func(t *testing.T) {
+		t.Run("if key not found", func(t *testing.T) {
+			_, err := key1.decrypt(key2.encrypt(plain, ad), ad, "f")
+			require.Error(err, require.Is(ErrDecryptionFailedError), require.Has("key «2» not found"))
+		})
+		t.Run("if additional data is other", func(t *testing.T) {
+			_, err := key1.decrypt(key1.encrypt(plain, ad), []byte("test.doc/2/f"), "f")
+			require.Error(err, require.Is(ErrDecryptionFailedError), require.Has("f"))
+		})
+		t.Run("if format version is unsupported", func(t *testing.T) {
+			enc := key1.encrypt(plain, ad)
+			enc[len(encryptedValueSignature)] = encryptedValueVersion + 1
+			_, err := key1.decrypt(enc, ad, "f")
+			require.Error(err, require.Is(ErrDecryptionFailedError), require.Has("unsupported format version"))
+		})
+		t.Run("if value is too short", func(t *testing.T) {
+			_, err := key1.decrypt([]byte(encryptedValueSignature), ad, "f")
+			require.Error(err, require.Is(ErrDecryptionFailedError), require.Has("too short"))
+			_, err = key1.decrypt(append([]byte(encryptedValueSignature), encryptedValueVersion, 0, 1, 2), ad, "f")
+			require.Error(err, require.Is(ErrDecryptionFailedError), require.Has("too short"))
+		})
+	})
+}
+
+func Test_EncryptedFields(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	docName := appdef.NewQName("test", "doc")
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+	wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+	wsb.AddCDoc(docName).
+		AddField("Name", appdef.DataKind_string, false).
+		SetFieldEncrypted("Name").
+		AddField("Photo", appdef.DataKind_bytes, false).
+		SetFieldEncrypted("Photo").
+		AddField("Note", appdef.DataKind_string, false)
+
+	secrets := testSecrets{
+		DataKeysSecretName(appName): fmt.Appendf(nil, `{"current": 1, "keys": {"1": %q}}`, testDataKey(1)),
+	}
+
+	cfgs := make(AppConfigsType, 1)
+	cfg := cfgs.AddBuiltInAppConfig(appName, adb)
+	cfg.SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+	cfg.SetDataKeysReader(secrets)
+
+	provider := Provide(cfgs, testTokensFactory(), simpleStorageProvider(), isequencer.SequencesTrustLevel_0, nil)
+	app, err := provider.BuiltIn(appName)
+	require.NoError(err)
+
+	ws := istructs.WSID(1)
+	id := istructs.RecordID(100500)
+
+	t.Run("should be ok to put record with encrypted fields", func(t *testing.T) {
+		require.NoError(app.Records().PutJSON(ws, map[appdef.FieldName]any{
+			appdef.SystemField_QName: docName.String(),
+			appdef.SystemField_ID:    id,
+			"Name":                   "John Doe",
+			"Photo":                  []byte("photo bytes"),
+			"Note":                   "plain note",
+		}))
+	})
+
+	t.Run("should be encrypted in storage", func(t *testing.T) {
+		pk, cc := recordKey(ws, id)
+		data := make([]byte, 0)
+		ok, err := cfg.storage.Get(pk, cc, &data)
+		require.NoError(err)
+		require.True(ok)
+		require.NotContains(string(data), "John Doe")
+		require.NotContains(string(data), "photo bytes")
+		require.Contains(string(data), "plain note")
+	})
+
+	t.Run("should be decrypted on read", func(t *testing.T) {
+		rec, err := app.Records().Get(ws, true, id)
+		require.NoError(err)
+		require.Equal("John Doe", rec.AsString("Name"))
+		require.Equal([]byte("photo bytes"), rec.AsBytes("Photo"))
+		require.Equal("plain note", rec.AsString("Note"))
+	})
+
+	t.Run("should be error to read value moved to another record", func(t *testing.T) {
+		rec := newRecord(cfg)
+		rec.setQName(docName)
+		rec.setID(id)
+		rec.PutString("Name", "John Doe")
+		require.NoError(rec.build())
+		data, err := rec.dyB.ToBytes()
+		require.NoError(err)
+		enc, err := rec.encryptBytes(data)
+		require.NoError(err)
+
+		same := newRecord(cfg)
+		same.setQName(docName)
+		same.setID(id)
+		same.dyB.Reset(enc)
+		require.NoError(same.decryptFields())
+		require.Equal("John Doe", same.AsString("Name"))
+
+		moved := newRecord(cfg)
+		moved.setQName(docName)
+		moved.setID(id + 1)
+		moved.dyB.Reset(enc)
+		require.Error(moved.decryptFields(), require.Is(ErrDecryptionFailedError), require.Has("Name"))
+	})
+
+	t.Run("should be ok to read plain value stored before field became encrypted", func(t *testing.T) {
+		plainID := id + 2
+		cfgs := make(AppConfigsType, 1)
+		plainCfg := cfgs.AddAppConfig(appName, cfg.ClusterAppID, cfg.AppDef, istructs.DefaultNumAppWorkspaces)
+		plainCfg.SetDataKeysReader(secrets)
+		require.NoError(plainCfg.prepare(cfg.storage))
+		plainCfg.dataKeys = nil // encryption is off, as if the field is not encrypted yet
+
+		rec := newRecord(plainCfg)
+		rec.setQName(docName)
+		rec.setID(plainID)
+		rec.PutString("Name", "Jane Roe")
+		rec.PutBytes("Photo", []byte("plain photo"))
+		require.NoError(rec.build())
+		data := rec.storeToBytes()
+		require.Contains(string(data), "Jane Roe")
+
+		loaded := newRecord(cfg)
+		require.NoError(loaded.loadFromBytes(data))
+		require.Equal("Jane Roe", loaded.AsString("Name"))
+		require.Equal([]byte("plain photo"), loaded.AsBytes("Photo"))
+
+		reEncrypted := loaded.storeToBytes()
+		require.NotContains(string(reEncrypted), "Jane Roe")
+		require.NotContains(string(reEncrypted), "plain photo")
+	})
+
+	t.Run("should be ok to read after key rotation", func(t *testing.T) {
+		secrets[DataKeysSecretName(appName)] = fmt.Appendf(nil, `{"current": 2, "keys": {"1": %q, "2": %q}}`, testDataKey(1), testDataKey(2))
+
+		cfgs := make(AppConfigsType, 1)
+		cfg2 := cfgs.AddAppConfig(appName, cfg.ClusterAppID, cfg.AppDef, istructs.DefaultNumAppWorkspaces)
+		cfg2.SetDataKeysReader(secrets)
+		require.NoError(cfg2.prepare(cfg.storage))
+		require.EqualValues(2, cfg2.dataKeys.current)
+
+		rec := newRecord(cfg2)
+		pk, cc := recordKey(ws, id)
+		data := make([]byte, 0)
+		_, err := cfg.storage.Get(pk, cc, &data)
+		require.NoError(err)
+		require.NoError(rec.loadFromBytes(data))
+		require.Equal("John Doe", rec.AsString("Name"))
+
+		reEncrypted := newRecord(cfg2)
+		require.NoError(reEncrypted.loadFromBytes(rec.storeToBytes()))
+		require.Equal("John Doe", reEncrypted.AsString("Name"))
+	})
+
+	t.Run("should be error to read if key is lost", func(t *testing.T) {
+		secrets[DataKeysSecretName(appName)] = fmt.Appendf(nil, `{"current": 3, "keys": {"3": %q}}`, testDataKey(3))
+
+		cfgs := make(AppConfigsType, 1)
+		cfg3 := cfgs.AddAppConfig(appName, cfg.ClusterAppID, cfg.AppDef, istructs.DefaultNumAppWorkspaces)
+		cfg3.SetDataKeysReader(secrets)
+		require.NoError(cfg3.prepare(cfg.storage))
+
+		rec := newRecord(cfg3)
+		pk, cc := recordKey(ws, id)
+		data := make([]byte, 0)
+		_, err := cfg.storage.Get(pk, cc, &data)
+		require.NoError(err)
+		require.Error(rec.loadFromBytes(data), require.Is(ErrDecryptionFailedError), require.Has("key «1» not found"))
+	})
+
+	t.Run("should be error to prepare app with encrypted fields", func(t *testing.T) {
+		t.Run("if data keys reader is not set", func(t *testing.T) {
+			cfgs := make(AppConfigsType, 1)
+			cfg := cfgs.AddAppConfig(appName, cfg.ClusterAppID, cfg.AppDef, istructs.DefaultNumAppWorkspaces)
+			require.Error(cfg.prepare(app.(*appStructsType).config.storage),
+				require.Is(ErrDataKeysNotFoundError), require.Has(appName))
+		})
+
+		t.Run("if data keys secret not found", func(t *testing.T) {
+			cfgs := make(AppConfigsType, 1)
+			cfg := cfgs.AddAppConfig(appName, cfg.ClusterAppID, cfg.AppDef, istructs.DefaultNumAppWorkspaces)
+			cfg.SetDataKeysReader(testSecrets{})
+			require.Error(cfg.prepare(app.(*appStructsType).config.storage),
+				require.Is(ErrDataKeysNotFoundError), require.Is(fs.ErrNotExist))
+		})
+
+		t.Run("if data keys are invalid", func(t *testing.T) {
+			cfgs := make(AppConfigsType, 1)
+			cfg := cfgs.AddAppConfig(appName, cfg.ClusterAppID, cfg.AppDef, istructs.DefaultNumAppWorkspaces)
+			cfg.SetDataKeysReader(testSecrets{DataKeysSecretName(appName): []byte(`{}`)})
+			require.Error(cfg.prepare(app.(*appStructsType).config.storage),
+				require.Is(ErrDataKeysInvalidError), require.Has(appName))
+		})
+	})
+
+	t.Run("should be panic to set data keys reader after prepare", func(t *testing.T) {
+		require.Panics(func() { cfg.SetDataKeysReader(secrets) })
+	})
+}
@@ -126,6 +126,42 @@ func ErrFieldMissed(t, name any) error {
 	return enrichError(ErrFieldIsEmptyError, "%v %v", t, name)
 }
 
+var ErrInvalidJSONError = errors.New("invalid json")
+
+func ErrInvalidJSON(t, name any) error {
+	return enrichError(ErrInvalidJSONError, "%v %v", t, name)
+}
+
+var ErrCheckViolatedError = errors.New("check violated")
+
+// Returns error for violated check. Error text contains check fields and expression, like
+// `check violated: CDoc «test.doc» check «test.doc$checks$Dates» fields «End», «Start»: End > Start`
+func ErrCheckViolated(t any, c appdef.ICheck) error {
+	return enrichError(ErrCheckViolatedError, "%v %v %s: %v", t, c, checkFieldNames(c), c.Expr())
+}
+
+// Returns error for check which can not be evaluated
+func ErrCheckFailed(t any, c appdef.ICheck, err error) error {
+	return enrichError(ErrCheckViolatedError, "%v %v %s: %v", t, c, checkFieldNames(c), err)
+}
+
+func checkFieldNames(c appdef.ICheck) string {
+	ff := make([]string, 0, len(c.Fields()))
+	for _, f := range c.Fields() {
+		ff = append(ff, "«"+f.Name()+"»")
+	}
+	return "fields " + strings.Join(ff, ", ")
+}
+
+var ErrDivisionByZero = errors.New("division by zero")
+
+var ErrRowPolicyFailedError = errors.New("row policy failed")
+
+// Returns error for row policy which can not be evaluated
+func ErrRowPolicyFailed(t any, p appdef.IRowPolicy, err error) error {
+	return enrichError(ErrRowPolicyFailedError, "%v %v: %v", t, p.QName(), err)
+}
+
 var ErrInvalidVerificationKindError = errors.New("invalid verification kind")
 
 func ErrInvalidVerificationKind(t, f any, k appdef.VerificationKind) error {
@@ -222,6 +258,24 @@ func ErrNumAppWorkspacesNotSet(app any) error {
 
 var ErrCorruptedData = errors.New("corrupted data")
 
+var ErrDataKeysNotFoundError = errors.New("data encryption keys not found")
+
+func ErrDataKeysNotFound(app any, err error) error {
+	return fmt.Errorf("%w: application «%v» has encrypted fields: %w", ErrDataKeysNotFoundError, app, err)
+}
+
+var ErrDataKeysInvalidError = errors.New("invalid data encryption keys")
+
+func ErrDataKeysInvalid(argOrMsg any, args ...any) error {
+	return enrichError(ErrDataKeysInvalidError, argOrMsg, args...)
+}
+
+var ErrDecryptionFailedError = errors.New("decryption failed")
+
+func ErrDecryptionFailed(field appdef.FieldName, msg string, args ...any) error {
+	return enrichError(ErrDecryptionFailedError, "field «%s»: %s", field, fmt.Sprintf(msg, args...))
+}
+
 // ValidateError: an interface for describing errors that occurred during validation
 //   - methods:
 //     — Code(): returns error code, see ECode_××× constants
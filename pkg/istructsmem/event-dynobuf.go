@@ -289,6 +289,7 @@ func loadEventCUDs(ev *eventType, codecVer byte, buf *bytes.Buffer) (err error)
 		// — upd.originRec is partially constructed, not full filled!
 		// — upd.result is null record, not applicable to store!
 		// it is very important for calling code to reread upd.originRec and recall upd.build() to obtain correct upd.result
+		upd.changes.origin = &upd.originRec
 		ev.cud.updates[id] = &upd
 	}
 
@@ -185,6 +185,29 @@ func (ev *eventType) QNameID() (id istructs.QNameID) {
 	return istructs.QNameIDForError
 }
 
+// Returns the new stored event with the same create params and name, but without arguments and CUDs.
+//
+// Build error message and source bytes of the invalid event are removed too, since they can contain the purged data
+func (ev *eventType) redacted() *eventType {
+	r := newEvent(ev.appCfg)
+	r.partition = ev.partition
+	r.pLogOffs = ev.pLogOffs
+	r.ws = ev.ws
+	r.wLogOffs = ev.wLogOffs
+	r.name = ev.name
+	r.regTime = ev.regTime
+	r.sync = ev.sync
+	r.device = ev.device
+	r.syncTime = ev.syncTime
+	if !ev.buildErr.validEvent {
+		r.buildErr.validEvent = false
+		r.buildErr.errStr = redactedEventErrStr
+		r.buildErr.qName = ev.buildErr.qName
+	}
+	r.isStored = true
+	return r
+}
+
 // Regenerates all raw IDs in event arguments and CUDs using specified generator
 func (ev *eventType) regenerateIDs(generator istructs.IIDGenerator) (err error) {
 	if (ev.argObject.QName() != appdef.NullQName) && ev.argObject.isDocument() {
@@ -580,6 +603,7 @@ func (cud *cudType) Update(record istructs.IRecord) istructs.IRowWriter {
 	if !ok {
 		r := newUpdateRec(cud.appCfg, record)
 		rec = &r
+		rec.changes.origin = &rec.originRec
 		cud.updates[id] = rec
 	}
 
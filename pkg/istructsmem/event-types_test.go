@@ -416,6 +416,16 @@ func testEventBuilderCore(t *testing.T, cachedPLog bool) {
 			err := app.Records().Apply(pLogEvent)
 			require.NoError(err)
 		})
+
+		t.Run("test CUDs origin values", func(t *testing.T) {
+			for rec := range pLogEvent.CUDs {
+				if rec.QName() == test.tablePhotos {
+					require.NotNil(rec.Origin())
+					require.Equal(test.heightValue, rec.Origin().AsFloat32(test.heightIdent))
+					require.Equal(test.photoValue, rec.Origin().AsBytes(test.photoIdent))
+				}
+			}
+		})
 	})
 
 	t.Run("VIII. Read event from PLog & WLog and reads CUD", func(t *testing.T) {
@@ -433,6 +443,10 @@ func testEventBuilderCore(t *testing.T, cachedPLog bool) {
 						require.False(rec.IsDeactivated())
 						require.Equal(changedHeights, rec.AsFloat32(test.heightIdent))
 						require.Equal(changedPhoto, rec.AsBytes(test.photoIdent))
+						if o := rec.Origin(); o != nil {
+							// origin values are loaded if event is read from cache after apply
+							require.Equal(test.heightValue, o.AsFloat32(test.heightIdent))
+						}
 					}
 					if rec.QName() == test.tablePhotoRems {
 						require.False(rec.IsNew())
@@ -595,6 +609,13 @@ func testEventBuilderCore(t *testing.T, cachedPLog bool) {
 				})
 				require.NoError(err)
 				require.Equal(2, recCnt)
+
+				for rec := range pLogEvent.CUDs {
+					if rec.QName() == test.tablePhotos {
+						require.NotNil(rec.Origin(), "origin values should be loaded by reApply")
+						require.Equal(test.heightValue, rec.Origin().AsFloat32(test.heightIdent))
+					}
+				}
 			})
 		})
 
@@ -99,6 +99,7 @@ func Example() {
 
 		queryName := appdef.NewQName("test", "query")
 		wsb.AddQuery(queryName).
+			SetCacheTTL(10 * time.Second).
 			SetParam(objName).
 			SetResult(appdef.QNameANY).
 			SetTag(tags[1])
@@ -481,7 +482,8 @@ func Example() {
 	//                 "Name": "query",
 	//                 "Engine": "BuiltIn",
 	//                 "Arg": "test.obj",
-	//                 "Result": "sys.ANY"
+	//                 "Result": "sys.ANY",
+	//                 "CacheTTL": "10s"
 	//               }
 	//             },
 	//             "Projectors": {
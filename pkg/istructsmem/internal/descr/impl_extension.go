@@ -79,6 +79,13 @@ func (f *CommandFunction) read(fn appdef.ICommand) {
 	}
 }
 
+func (q *QueryFunction) read(query appdef.IQuery) {
+	q.Function.read(query)
+	if ttl := query.CacheTTL(); ttl > 0 {
+		q.CacheTTL = ttl.String()
+	}
+}
+
 func (p *Projector) read(prj appdef.IProjector) {
 	p.Extension.read(prj)
 	for _, ev := range prj.Events() {
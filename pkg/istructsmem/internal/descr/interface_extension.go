@@ -35,6 +35,7 @@ type CommandFunction struct {
 
 type QueryFunction struct {
 	Function
+	CacheTTL string `json:",omitempty"`
 }
 
 type Projector struct {
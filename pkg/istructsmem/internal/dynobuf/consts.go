@@ -11,20 +11,26 @@ import (
 )
 
 var dataKindToDynoFieldType = map[appdef.DataKind]dynobuffers.FieldType{
-	appdef.DataKind_null:     dynobuffers.FieldTypeUnspecified,
-	appdef.DataKind_int8:     dynobuffers.FieldTypeByte,  // #3434 [small integers : int8]
-	appdef.DataKind_int16:    dynobuffers.FieldTypeInt16, // #3434 [small integers : int16]
-	appdef.DataKind_int32:    dynobuffers.FieldTypeInt32,
-	appdef.DataKind_int64:    dynobuffers.FieldTypeInt64,
-	appdef.DataKind_float32:  dynobuffers.FieldTypeFloat32,
-	appdef.DataKind_float64:  dynobuffers.FieldTypeFloat64,
-	appdef.DataKind_bytes:    dynobuffers.FieldTypeByte,
-	appdef.DataKind_string:   dynobuffers.FieldTypeString,
-	appdef.DataKind_QName:    dynobuffers.FieldTypeByte, // two fixed bytes LittleEndian
-	appdef.DataKind_bool:     dynobuffers.FieldTypeBool,
-	appdef.DataKind_RecordID: dynobuffers.FieldTypeInt64,
-	appdef.DataKind_Record:   dynobuffers.FieldTypeByte,
-	appdef.DataKind_Event:    dynobuffers.FieldTypeByte,
+	appdef.DataKind_null:      dynobuffers.FieldTypeUnspecified,
+	appdef.DataKind_int8:      dynobuffers.FieldTypeByte,  // #3434 [small integers : int8]
+	appdef.DataKind_int16:     dynobuffers.FieldTypeInt16, // #3434 [small integers : int16]
+	appdef.DataKind_int32:     dynobuffers.FieldTypeInt32,
+	appdef.DataKind_int64:     dynobuffers.FieldTypeInt64,
+	appdef.DataKind_float32:   dynobuffers.FieldTypeFloat32,
+	appdef.DataKind_float64:   dynobuffers.FieldTypeFloat64,
+	appdef.DataKind_bytes:     dynobuffers.FieldTypeByte,
+	appdef.DataKind_string:    dynobuffers.FieldTypeString,
+	appdef.DataKind_QName:     dynobuffers.FieldTypeByte, // two fixed bytes LittleEndian
+	appdef.DataKind_bool:      dynobuffers.FieldTypeBool,
+	appdef.DataKind_RecordID:  dynobuffers.FieldTypeInt64,
+	appdef.DataKind_decimal:   dynobuffers.FieldTypeInt64, // unscaled value, scale is taken from field data type
+	appdef.DataKind_date:      dynobuffers.FieldTypeInt64, // days since 1970-01-01
+	appdef.DataKind_time:      dynobuffers.FieldTypeInt64, // milliseconds since midnight
+	appdef.DataKind_timestamp: dynobuffers.FieldTypeInt64, // milliseconds since Unix epoch
+	appdef.DataKind_uuid:      dynobuffers.FieldTypeByte,  // sixteen fixed bytes
+	appdef.DataKind_json:      dynobuffers.FieldTypeString, // json text
+	appdef.DataKind_Record:    dynobuffers.FieldTypeByte,
+	appdef.DataKind_Event:     dynobuffers.FieldTypeByte,
 }
 
 const (
@@ -29,6 +29,8 @@ func NewFieldsScheme(name string, fields appdef.IWithFields) *dynobuffers.Scheme
 					db.AddField(f.Name(), ft, false)
 				case appdef.DataKind_QName:
 					db.AddArray(f.Name(), ft, false) // two fixed bytes LittleEndian
+				case appdef.DataKind_uuid:
+					db.AddArray(f.Name(), ft, false) // sixteen fixed bytes
 				default: // bytes, record, event
 					db.AddArray(f.Name(), ft, false) // variable length
 				}
@@ -81,6 +81,16 @@ func WriteUint64(buf *bytes.Buffer, value uint64) {
 	buf.Write(s)
 }
 
+// Sign bit of 64-bit integer
+const signBit64 uint64 = 1 << 63
+
+// Write int64 to buf with sign bit flipped.
+//
+// Written bytes are ordered the same way as int64 values, including negative ones
+func WriteOrderedInt64(buf *bytes.Buffer, value int64) {
+	WriteUint64(buf, uint64(value)^signBit64) // nolint G115 : bits are kept
+}
+
 // Write float32 to buf
 func WriteFloat32(buf *bytes.Buffer, value float32) {
 	s := []byte{0, 0, 0, 0}
@@ -244,6 +254,15 @@ func ReadUInt64(buf *bytes.Buffer) (uint64, error) {
 	return binary.BigEndian.Uint64(buf.Next(size)), nil
 }
 
+// Reads int64 written by WriteOrderedInt64 from buf
+func ReadOrderedInt64(buf *bytes.Buffer) (int64, error) {
+	v, err := ReadUInt64(buf)
+	if err != nil {
+		return 0, err
+	}
+	return int64(v ^ signBit64), nil // nolint G115 : bits are kept
+}
+
 // Reads float32 from buf
 func ReadFloat32(buf *bytes.Buffer) (float32, error) {
 	const size = 4
@@ -8,6 +8,7 @@ package utils
 import (
 	"bytes"
 	"io"
+	"math"
 	"reflect"
 	"strings"
 	"testing"
@@ -330,6 +331,31 @@ func TestReadXXXerrors(t *testing.T) {
 	require.ErrorIs(e, io.ErrUnexpectedEOF)
 }
 
+func TestReadWriteOrderedInt64(t *testing.T) {
+	require := require.New(t)
+
+	values := []int64{math.MinInt64, -1000, -1, 0, 1, 1000, math.MaxInt64}
+
+	var prev []byte
+	for _, v := range values {
+		b := bytes.NewBuffer(nil)
+		WriteOrderedInt64(b, v)
+		require.Equal(8, b.Len())
+
+		if prev != nil {
+			require.Negative(bytes.Compare(prev, b.Bytes()), "bytes of %d should be greater than previous", v)
+		}
+		prev = bytes.Clone(b.Bytes())
+
+		r, err := ReadOrderedInt64(b)
+		require.NoError(err)
+		require.Equal(v, r)
+	}
+
+	_, err := ReadOrderedInt64(bytes.NewBuffer([]byte{1, 2, 3}))
+	require.ErrorIs(err, io.ErrUnexpectedEOF)
+}
+
 func TestCopyBytes(t *testing.T) {
 	type args struct {
 		src []byte
@@ -0,0 +1,277 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istructsmem
+
+import (
+	"context"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/istorage"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/istructsmem/internal/utils"
+)
+
+// workspace purge, see [appStructsType.PurgeWorkspace]
+type workspacePurge struct {
+	app    *appStructsType
+	ws     istructs.WSID
+	refs   []istructs.WSID
+	events []purgedEvent
+	recs   []istructs.RecordID
+	stats  istructs.WorkspacePurgeStats
+}
+
+// WLog event of the purged or referencing workspace
+type purgedEvent struct {
+	ws        istructs.WSID
+	wLogOffs  istructs.Offset
+	partition istructs.PartitionID
+	pLogOffs  istructs.Offset
+}
+
+// istructs.IAppStructs.PurgeWorkspace
+func (app *appStructsType) PurgeWorkspace(ctx context.Context, ws istructs.WSID, refs ...istructs.WSID) (istructs.WorkspacePurgeStats, error) {
+	p := &workspacePurge{app: app, ws: ws, refs: refs}
+
+	for _, step := range []func(context.Context) error{
+		p.readWLog,
+		p.deleteRecords,
+		p.deleteViewRecords,
+		p.redactPLog,
+		p.redactReferences,
+		p.deleteWLog,
+	} {
+		if err := step(ctx); err != nil {
+			return p.stats, enrichError(err, "purge workspace %d", ws)
+		}
+	}
+
+	return p.stats, nil
+}
+
+// Collects WLog events and IDs of the records created by them
+func (p *workspacePurge) readWLog(ctx context.Context) error {
+	return p.app.events.ReadWLog(ctx, p.ws, istructs.FirstOffset, istructs.ReadToTheEnd, func(ofs istructs.Offset, event istructs.IWLogEvent) error {
+		defer event.Release()
+		ev := event.(*eventType)
+		p.events = append(p.events, purgedEvent{
+			ws:        p.ws,
+			wLogOffs:  ofs,
+			partition: ev.partition,
+			pLogOffs:  ev.pLogOffs,
+		})
+		for _, rec := range ev.cud.creates {
+			p.recs = append(p.recs, rec.ID())
+		}
+		return nil
+	})
+}
+
+func (p *workspacePurge) deleteRecords(context.Context) error {
+	for _, id := range p.recs {
+		deleted, err := p.delete(recordKey(p.ws, id))
+		if err != nil {
+			return err
+		}
+		if deleted {
+			p.stats.Records++
+		}
+	}
+	return nil
+}
+
+// Deletes all view records in partitions registered for the workspace, then deletes the registry itself
+func (p *workspacePurge) deleteViewRecords(ctx context.Context) error {
+	regPKey, _ := viewPartitionKey(p.ws, nil)
+	partitions, err := p.readPartition(ctx, regPKey)
+	if err != nil {
+		return err
+	}
+
+	for _, partition := range partitions {
+		viewPKey := partition.cCols
+		recs, err := p.readPartition(ctx, viewPKey)
+		if err != nil {
+			return err
+		}
+		for _, rec := range recs {
+			deleted, err := p.app.config.storage.CompareAndDelete(viewPKey, rec.cCols, rec.data)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				p.stats.ViewRecords++
+			}
+		}
+		p.app.viewRecords.partitions.remove(viewPKey)
+		if _, err := p.app.config.storage.CompareAndDelete(regPKey, viewPKey, partition.data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Replaces PLog events of the workspace with redacted ones
+func (p *workspacePurge) redactPLog(context.Context) error {
+	for _, e := range p.events {
+		if _, err := p.redact(e, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Replaces PLog and WLog events of the referencing workspaces, which refer to the purged workspace, with redacted ones.
+//
+// Event refers to the purged workspace if its arguments or CUDs contain int64 field with the purged workspace ID,
+// or if its CUDs change the record, which contains such field, e.g. cdoc.sys.WorkspaceID or cdoc.sys.ChildWorkspace
+func (p *workspacePurge) redactReferences(ctx context.Context) error {
+	for _, ref := range p.refs {
+		if ref == p.ws {
+			continue
+		}
+
+		recs := make(map[istructs.RecordID]bool)
+		err := p.app.events.ReadWLog(ctx, ref, istructs.FirstOffset, istructs.ReadToTheEnd, func(_ istructs.Offset, event istructs.IWLogEvent) error {
+			defer event.Release()
+			ev := event.(*eventType)
+			for _, rec := range ev.cud.creates {
+				if p.refersRow(&rec.rowType) {
+					recs[rec.ID()] = true
+				}
+			}
+			for id, rec := range ev.cud.updates {
+				if p.refersRow(&rec.changes.rowType) {
+					recs[id] = true
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		events := []purgedEvent{}
+		err = p.app.events.ReadWLog(ctx, ref, istructs.FirstOffset, istructs.ReadToTheEnd, func(ofs istructs.Offset, event istructs.IWLogEvent) error {
+			defer event.Release()
+			ev := event.(*eventType)
+			refers := p.refersObject(&ev.argObject)
+			for _, rec := range ev.cud.creates {
+				refers = refers || recs[rec.ID()] || p.refersRow(&rec.rowType)
+			}
+			for id, rec := range ev.cud.updates {
+				refers = refers || recs[id] || p.refersRow(&rec.changes.rowType)
+			}
+			if refers {
+				events = append(events, purgedEvent{
+					ws:        ref,
+					wLogOffs:  ofs,
+					partition: ev.partition,
+					pLogOffs:  ev.pLogOffs,
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			redacted, err := p.redact(e, true)
+			if err != nil {
+				return err
+			}
+			if redacted {
+				p.stats.RedactedEvents++
+			}
+		}
+	}
+	return nil
+}
+
+// Replaces PLog event with the redacted one. If withWLog is specified, then WLog event is replaced too.
+//
+// Returns false if PLog event is not found
+func (p *workspacePurge) redact(e purgedEvent, withWLog bool) (bool, error) {
+	pKey, cCols := plogKey(e.partition, e.pLogOffs)
+	data := make([]byte, 0)
+	ok, err := p.app.config.storage.Get(pKey, cCols, &data)
+	if err != nil || !ok {
+		return false, err
+	}
+	event := newEvent(p.app.config)
+	if err := event.loadFromBytes(data); err != nil {
+		return false, enrichError(err, "PLog partition %d offset %d", e.partition, e.pLogOffs)
+	}
+	redacted := event.redacted()
+	event.Release()
+	items := []istorage.BatchItem{{PKey: pKey, CCols: cCols, Value: redacted.storeToBytes()}}
+	if withWLog {
+		wPKey, wCCols := wlogKey(e.ws, e.wLogOffs)
+		items = append(items, istorage.BatchItem{PKey: wPKey, CCols: wCCols, Value: redacted.storeToBytes()})
+	}
+	if err := p.app.config.storage.PutBatch(items); err != nil {
+		return false, err
+	}
+	p.app.events.plogCache.Put(e.partition, e.pLogOffs, redacted)
+	return true, nil
+}
+
+// Returns is object or any of its children contains int64 field with the purged workspace ID
+func (p *workspacePurge) refersObject(obj *objectType) bool {
+	if p.refersRow(&obj.rowType) {
+		return true
+	}
+	for _, c := range obj.child {
+		if p.refersObject(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns is row contains int64 field with the purged workspace ID
+func (p *workspacePurge) refersRow(row *rowType) bool {
+	for f := range row.Fields {
+		if f.DataKind() == appdef.DataKind_int64 && row.AsInt64(f.Name()) == int64(p.ws) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *workspacePurge) deleteWLog(context.Context) error {
+	for _, e := range p.events {
+		deleted, err := p.delete(wlogKey(p.ws, e.wLogOffs))
+		if err != nil {
+			return err
+		}
+		if deleted {
+			p.stats.Events++
+		}
+	}
+	return nil
+}
+
+// Deletes the storage item if it exists
+func (p *workspacePurge) delete(pKey, cCols []byte) (deleted bool, err error) {
+	return deleteStorageItem(p.app.config.storage, pKey, cCols)
+}
+
+// Storage item read by [workspacePurge.readPartition]
+type purgedItem struct {
+	cCols, data []byte
+}
+
+// Returns copies of all items of the storage partition.
+//
+// Items are not deleted while reading because storage drivers do not allow to change partition during reading
+func (p *workspacePurge) readPartition(ctx context.Context, pKey []byte) (items []purgedItem, err error) {
+	err = p.app.config.storage.Read(ctx, pKey, nil, nil, func(cCols, data []byte) error {
+		items = append(items, purgedItem{cCols: utils.CopyBytes(cCols), data: utils.CopyBytes(data)})
+		return nil
+	})
+	return items, err
+}
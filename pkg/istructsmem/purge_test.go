@@ -0,0 +1,248 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package istructsmem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/goutils/testingu/require"
+	"github.com/voedger/voedger/pkg/isequencer"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+func TestPurgeWorkspace(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	docName := appdef.NewQName("test", "doc")
+	refDocName := appdef.NewQName("test", "ref")
+	cmdName := appdef.NewQName("test", "cmd")
+	argName := appdef.NewQName("test", "arg")
+	viewName := appdef.NewQName("test", "view")
+
+	appConfigs := func() AppConfigsType {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+		wsb.AddCDoc(appdef.NewQName("test", "WSDesc"))
+		wsb.SetDescriptor(appdef.NewQName("test", "WSDesc"))
+		wsb.AddCDoc(docName).
+			AddField("Name", appdef.DataKind_string, true)
+		wsb.AddCDoc(refDocName).
+			AddField("WSID", appdef.DataKind_int64, false)
+		wsb.AddObject(argName).
+			AddField("Email", appdef.DataKind_string, true)
+		wsb.AddCommand(cmdName).SetParam(argName)
+		view := wsb.AddView(viewName)
+		view.Key().PartKey().AddField("pk", appdef.DataKind_int32)
+		view.Key().ClustCols().AddField("cc", appdef.DataKind_int32)
+		view.Value().AddField("Name", appdef.DataKind_string, true)
+
+		cfgs := make(AppConfigsType, 1)
+		cfg := cfgs.AddBuiltInAppConfig(appName, adb)
+		cfg.SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+		cfg.Resources.Add(NewCommandFunction(cmdName, NullCommandExec))
+		return cfgs
+	}
+
+	provider := Provide(appConfigs(), testTokensFactory(), simpleStorageProvider(), isequencer.SequencesTrustLevel_0, nil)
+	app, err := provider.BuiltIn(appName)
+	require.NoError(err)
+
+	const (
+		partition  = istructs.PartitionID(1)
+		purgedWS   = istructs.WSID(1001)
+		anotherWS  = istructs.WSID(1002)
+		refWS      = istructs.WSID(1003)
+		eventCount = 3
+	)
+
+	pLogOffset := istructs.FirstOffset
+	docIDs := map[istructs.WSID][]istructs.RecordID{}
+	idGens := map[istructs.WSID]istructs.IIDGenerator{purgedWS: NewIDGenerator(), anotherWS: NewIDGenerator(), refWS: NewIDGenerator()}
+	putRawEvent := func(ws istructs.WSID, wLogOffset istructs.Offset, cud func(istructs.ICUD)) {
+		bld := app.Events().GetNewRawEventBuilder(istructs.NewRawEventBuilderParams{
+			GenericRawEventBuilderParams: istructs.GenericRawEventBuilderParams{
+				HandlingPartition: partition,
+				PLogOffset:        pLogOffset,
+				Workspace:         ws,
+				WLogOffset:        wLogOffset,
+				QName:             cmdName,
+				RegisteredAt:      istructs.UnixMilli(100500),
+			},
+		})
+		bld.ArgumentObjectBuilder().PutString("Email", "john@doe.com")
+		cud(bld.CUDBuilder())
+		rawEvent, buildErr := bld.BuildRawEvent()
+		require.NoError(buildErr)
+
+		pLogEvent, err := app.Events().PutPlog(rawEvent, buildErr, idGens[ws])
+		require.NoError(err)
+		defer pLogEvent.Release()
+		require.NoError(app.Events().PutWlog(pLogEvent))
+		require.NoError(app.Records().Apply(pLogEvent))
+		for rec := range pLogEvent.CUDs {
+			if rec.IsNew() {
+				docIDs[ws] = append(docIDs[ws], rec.ID())
+			}
+		}
+		pLogOffset++
+	}
+	putEvent := func(ws istructs.WSID, wLogOffset istructs.Offset) {
+		putRawEvent(ws, wLogOffset, func(cud istructs.ICUD) {
+			rec := cud.Create(docName)
+			rec.PutRecordID(appdef.SystemField_ID, 1)
+			rec.PutString("Name", "John Doe")
+		})
+
+		kb := app.ViewRecords().KeyBuilder(viewName)
+		kb.PutInt32("pk", int32(wLogOffset)) // nolint G115
+		kb.PutInt32("cc", 1)
+		vb := app.ViewRecords().NewValueBuilder(viewName)
+		vb.PutString("Name", "John Doe")
+		require.NoError(app.ViewRecords().Put(ws, kb, vb))
+	}
+	for wLogOffset := istructs.FirstOffset; wLogOffset <= eventCount; wLogOffset++ {
+		putEvent(purgedWS, wLogOffset)
+		putEvent(anotherWS, wLogOffset)
+	}
+
+	// referencing workspace: the record is created, then the purged workspace ID is stored in it, then unrelated record is created
+	putRawEvent(refWS, 1, func(cud istructs.ICUD) {
+		cud.Create(refDocName).PutRecordID(appdef.SystemField_ID, 1)
+	})
+	refDoc, err := app.Records().Get(refWS, true, docIDs[refWS][0])
+	require.NoError(err)
+	putRawEvent(refWS, 2, func(cud istructs.ICUD) {
+		cud.Update(refDoc).PutInt64("WSID", int64(purgedWS))
+	})
+	putEvent(refWS, 3)
+
+	// view record written before the view partitions registry was introduced is registered on the first read
+	const unregisteredPK = int32(eventCount + 1)
+	{
+		kb := app.ViewRecords().KeyBuilder(viewName)
+		kb.PutInt32("pk", unregisteredPK)
+		kb.PutInt32("cc", 1)
+		vb := app.ViewRecords().NewValueBuilder(viewName)
+		vb.PutString("Name", "John Doe")
+		as := app.(*appStructsType)
+		pKey, cCols, data, err := as.viewRecords.storeViewRecord(purgedWS, kb, vb)
+		require.NoError(err)
+		require.NoError(as.config.storage.Put(pKey, cCols, data))
+
+		_, err = app.ViewRecords().Get(purgedWS, kb)
+		require.NoError(err)
+	}
+
+	viewRecordsCount := func(ws istructs.WSID) (cnt int) {
+		for pk := int32(1); pk <= unregisteredPK; pk++ {
+			kb := app.ViewRecords().KeyBuilder(viewName)
+			kb.PutInt32("pk", pk)
+			require.NoError(app.ViewRecords().Read(context.Background(), ws, kb, func(istructs.IKey, istructs.IValue) error {
+				cnt++
+				return nil
+			}))
+		}
+		return cnt
+	}
+
+	wLogEventsCount := func(ws istructs.WSID) (cnt int) {
+		require.NoError(app.Events().ReadWLog(context.Background(), ws, istructs.FirstOffset, istructs.ReadToTheEnd,
+			func(istructs.Offset, istructs.IWLogEvent) error {
+				cnt++
+				return nil
+			}))
+		return cnt
+	}
+
+	recordsCount := func(ws istructs.WSID) (cnt int) {
+		for _, id := range docIDs[ws] {
+			rec, err := app.Records().Get(ws, true, id)
+			require.NoError(err)
+			if rec.QName() != appdef.NullQName {
+				cnt++
+			}
+		}
+		return cnt
+	}
+
+	stats, err := app.PurgeWorkspace(context.Background(), purgedWS, refWS)
+	require.NoError(err)
+	require.Equal(istructs.WorkspacePurgeStats{Records: eventCount, ViewRecords: eventCount + 1, Events: eventCount, RedactedEvents: 2}, stats)
+
+	t.Run("data of the purged workspace should be deleted", func(t *testing.T) {
+		require.Zero(recordsCount(purgedWS))
+		require.Zero(viewRecordsCount(purgedWS))
+		require.Zero(wLogEventsCount(purgedWS))
+	})
+
+	t.Run("PLog events of the purged workspace should be redacted", func(t *testing.T) {
+		checked := 0
+		require.NoError(app.Events().ReadPLog(context.Background(), partition, istructs.FirstOffset, istructs.ReadToTheEnd,
+			func(_ istructs.Offset, event istructs.IPLogEvent) error {
+				require.Equal(cmdName, event.QName())
+				if event.Workspace() == refWS {
+					return nil // see referencing workspace test below
+				}
+				if event.Workspace() != purgedWS {
+					require.Equal("john@doe.com", event.ArgumentObject().AsString("Email"))
+					return nil
+				}
+				checked++
+				require.Equal(appdef.NullQName, event.ArgumentObject().QName())
+				for range event.CUDs {
+					require.Fail("CUDs of the redacted event should be empty")
+				}
+				return nil
+			}))
+		require.Equal(eventCount, checked)
+
+		// cached event is redacted too
+		require.NoError(app.Events().ReadPLog(context.Background(), partition, istructs.FirstOffset, 1,
+			func(_ istructs.Offset, event istructs.IPLogEvent) error {
+				require.Equal(purgedWS, event.Workspace())
+				require.Equal(appdef.NullQName, event.ArgumentObject().QName())
+				return nil
+			}))
+	})
+
+	t.Run("events of the referencing workspace, which refer to the purged workspace, should be redacted", func(t *testing.T) {
+		redacted := 0
+		require.NoError(app.Events().ReadWLog(context.Background(), refWS, istructs.FirstOffset, istructs.ReadToTheEnd,
+			func(ofs istructs.Offset, event istructs.IWLogEvent) error {
+				if ofs == 3 {
+					require.Equal("john@doe.com", event.ArgumentObject().AsString("Email"))
+					return nil
+				}
+				redacted++
+				require.Equal(appdef.NullQName, event.ArgumentObject().QName())
+				for range event.CUDs {
+					require.Fail("CUDs of the redacted event should be empty")
+				}
+				return nil
+			}))
+		require.Equal(2, redacted)
+
+		rec, err := app.Records().Get(refWS, true, docIDs[refWS][0])
+		require.NoError(err)
+		require.EqualValues(purgedWS, rec.AsInt64("WSID"), "records of the referencing workspace should be kept")
+	})
+
+	t.Run("data of another workspace should be kept", func(t *testing.T) {
+		require.Equal(eventCount, recordsCount(anotherWS))
+		require.Equal(eventCount, viewRecordsCount(anotherWS))
+		require.Equal(eventCount, wLogEventsCount(anotherWS))
+	})
+
+	t.Run("repeated purge should do nothing", func(t *testing.T) {
+		stats, err := app.PurgeWorkspace(context.Background(), purgedWS, refWS)
+		require.NoError(err)
+		require.Zero(stats)
+	})
+}
@@ -19,9 +19,11 @@ type IStatelessResources interface {
 	Commands(func(path string, cmd istructs.ICommandFunction) bool)
 	Queries(func(path string, qry istructs.IQueryFunction) bool)
 	Projectors(func(path string, projector istructs.Projector) bool)
+	Jobs(func(path string, job BuiltinJob) bool)
 	AddCommands(path string, cmds ...istructs.ICommandFunction)
 	AddQueries(path string, queries ...istructs.IQueryFunction)
 	AddProjectors(path string, projectors ...istructs.Projector)
+	AddJobs(path string, jobs ...BuiltinJob)
 }
 
 func NewStatelessResources() IStatelessResources {
@@ -29,6 +31,7 @@ func NewStatelessResources() IStatelessResources {
 		cmds:       map[string][]istructs.ICommandFunction{},
 		queries:    map[string][]istructs.IQueryFunction{},
 		projectors: map[string][]istructs.Projector{},
+		jobs:       map[string][]BuiltinJob{},
 	}
 }
 
@@ -36,6 +39,7 @@ type implIStatelessResources struct {
 	cmds       map[string][]istructs.ICommandFunction
 	queries    map[string][]istructs.IQueryFunction
 	projectors map[string][]istructs.Projector
+	jobs       map[string][]BuiltinJob
 }
 
 func (sr *implIStatelessResources) Commands(cb func(path string, cmd istructs.ICommandFunction) bool) {
@@ -68,6 +72,16 @@ func (sr *implIStatelessResources) Projectors(cb func(path string, projector ist
 	}
 }
 
+func (sr *implIStatelessResources) Jobs(cb func(path string, job BuiltinJob) bool) {
+	for path, jobs := range sr.jobs {
+		for _, job := range jobs {
+			if !cb(path, job) {
+				return
+			}
+		}
+	}
+}
+
 func (sr *implIStatelessResources) AddCommands(path string, cmds ...istructs.ICommandFunction) {
 	sr.cmds[path] = append(sr.cmds[path], cmds...)
 }
@@ -80,6 +94,10 @@ func (sr *implIStatelessResources) AddProjectors(path string, projectors ...istr
 	sr.projectors[path] = append(sr.projectors[path], projectors...)
 }
 
+func (sr *implIStatelessResources) AddJobs(path string, jobs ...BuiltinJob) {
+	sr.jobs[path] = append(sr.jobs[path], jobs...)
+}
+
 // Implements istructs.IResources
 type Resources map[appdef.QName]istructs.IResource
 
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package istructsmem
+
+import (
+	"fmt"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/acl"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+// Returns is row accessible by row-level security policies.
+//
+// Policies should be obtained by acl.RowPolicies() for the operation, table and request roles.
+// Subject is the request subject name, empty subject is evaluated as NULL.
+//
+// If policies are empty, then row is accessible.
+// Otherwise row is accessible if expression of any policy is TRUE, FALSE and UNKNOWN (NULL) results deny access.
+func IsRowAllowed(row istructs.IRowReader, policies []appdef.IRowPolicy, subject string) (bool, error) {
+	if len(policies) == 0 {
+		return true, nil
+	}
+
+	env := exprEnv{}
+	switch r := row.(type) {
+	case *recordType:
+		env.row = &r.rowType
+	case *objectType:
+		env.row = &r.rowType
+	case *rowType:
+		env.row = r
+	default:
+		return false, fmt.Errorf("%w: %T can not be checked by row policies", ErrWrongTypeError, row)
+	}
+	if subject != "" {
+		env.subject = subject
+	}
+
+	for _, p := range policies {
+		v, err := env.eval(p.Expr())
+		if err != nil {
+			return false, ErrRowPolicyFailed(env.row, p, err)
+		}
+		if v == true {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Row-level security policies, which restrict records selected by the request.
+//
+// Policies of each table are obtained by acl.RowPolicies() once and cached.
+type SelectRowPolicies struct {
+	ws       appdef.IWorkspace
+	roles    []appdef.QName
+	subject  string
+	policies map[appdef.QName][]appdef.IRowPolicy
+}
+
+// Returns SELECT row-level security policies of the workspace for specified request roles and subject name.
+//
+// If workspace is nil, then records are not restricted.
+func NewSelectRowPolicies(ws appdef.IWorkspace, roles []appdef.QName, subject string) *SelectRowPolicies {
+	return &SelectRowPolicies{
+		ws:       ws,
+		roles:    roles,
+		subject:  subject,
+		policies: map[appdef.QName][]appdef.IRowPolicy{},
+	}
+}
+
+// Returns is record accessible by policies of the record table.
+//
+// CUD rows of updated records contain changed fields only,
+// so they are denied by policies, which refer to unchanged fields.
+func (p *SelectRowPolicies) IsAllowed(rec istructs.IRowReader, table appdef.QName) (bool, error) {
+	if p.ws == nil {
+		return true, nil
+	}
+	policies, ok := p.policies[table]
+	if !ok {
+		policies = acl.RowPolicies(p.ws, appdef.OperationKind_Select, table, p.roles)
+		p.policies[table] = policies
+	}
+	return IsRowAllowed(rec, policies, p.subject)
+}
+
+// Enumerates CUD rows, which are accessible by policies.
+//
+// CUD rows, which evaluation is failed, are skipped.
+func (p *SelectRowPolicies) CUDs(cuds func(func(istructs.ICUDRow) bool)) func(func(istructs.ICUDRow) bool) {
+	return func(cb func(istructs.ICUDRow) bool) {
+		for rec := range cuds {
+			if ok, _ := p.IsAllowed(rec, rec.QName()); !ok {
+				continue
+			}
+			if !cb(rec) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package istructsmem
+
+import (
+	"testing"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/appdef/exprs"
+	"github.com/voedger/voedger/pkg/goutils/testingu/require"
+	"github.com/voedger/voedger/pkg/isequencer"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+func TestIsRowAllowed(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	wsName := appdef.NewQName("test", "workspace")
+	docName := appdef.NewQName("test", "doc")
+	ownName := appdef.NewQName("test", "own")
+	depName := appdef.NewQName("test", "dep")
+	nullName := appdef.NewQName("test", "null")
+	divName := appdef.NewQName("test", "div")
+
+	ops := []appdef.OperationKind{appdef.OperationKind_Select}
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+	wsb := adb.AddWorkspace(wsName)
+	wsb.AddCDoc(docName).
+		AddField("Owner", appdef.DataKind_string, false).
+		AddField("Dep", appdef.DataKind_int32, false).
+		AddField("Null", appdef.DataKind_int32, false)
+	wsb.AddRowPolicy(ownName, ops, docName, nil, exprs.Eq(exprs.Field("Owner"), exprs.Subject()))
+	wsb.AddRowPolicy(depName, ops, docName, nil, exprs.Eq(exprs.Field("Dep"), exprs.Value(1)))
+	wsb.AddRowPolicy(nullName, ops, docName, nil, exprs.Eq(exprs.Field("Null"), exprs.Value(1)))
+	wsb.AddRowPolicy(divName, ops, docName, nil, exprs.Eq(exprs.Div(exprs.Field("Dep"), exprs.Value(0)), exprs.Value(1)))
+
+	cfgs := make(AppConfigsType, 1)
+	cfg := cfgs.AddBuiltInAppConfig(appName, adb)
+	cfg.SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+	provider := Provide(cfgs, testTokensFactory(), simpleStorageProvider(), isequencer.SequencesTrustLevel_0, nil)
+	app, err := provider.BuiltIn(appName)
+	require.NoError(err)
+
+	ws := app.AppDef().Workspace(wsName)
+	policies := func(names ...appdef.QName) []appdef.IRowPolicy {
+		pp := make([]appdef.IRowPolicy, 0, len(names))
+		for _, n := range names {
+			pp = append(pp, ws.RowPolicy(n))
+		}
+		return pp
+	}
+
+	rec := newRecord(cfg)
+	rec.setQName(docName)
+	rec.PutRecordID(appdef.SystemField_ID, 100500)
+	rec.PutString("Owner", "alice")
+	rec.PutInt32("Dep", 2)
+	require.NoError(rec.build())
+
+	t.Run("should be allowed if no policies", func(t *testing.T) {
+		ok, err := IsRowAllowed(rec, nil, "bob")
+		require.NoError(err)
+		require.True(ok)
+	})
+
+	t.Run("should check policies", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			policies []appdef.QName
+			subject  string
+			want     bool
+		}{
+			{"owner is subject", []appdef.QName{ownName}, "alice", true},
+			{"owner is not subject", []appdef.QName{ownName}, "bob", false},
+			{"empty subject is NULL", []appdef.QName{ownName}, "", false},
+			{"false", []appdef.QName{depName}, "alice", false},
+			{"NULL denies", []appdef.QName{nullName}, "alice", false},
+			{"any policy allows", []appdef.QName{depName, nullName, ownName}, "alice", true},
+			{"all policies deny", []appdef.QName{depName, nullName, ownName}, "bob", false},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ok, err := IsRowAllowed(rec, policies(tt.policies...), tt.subject)
+				require.NoError(err)
+				require.Equal(tt.want, ok)
+			})
+		}
+	})
+
+	t.Run("should be error", func(t *testing.T) {
+		t.Run("if policy evaluation failed", func(t *testing.T) {
+			ok, err := IsRowAllowed(rec, policies(divName), "alice")
+			require.Error(err, require.Is(ErrRowPolicyFailedError), require.Has(divName), require.Has("division by zero"))
+			require.False(ok)
+		})
+
+		t.Run("if row type is unknown", func(t *testing.T) {
+			ok, err := IsRowAllowed(istructs.NewNullObject(), policies(ownName), "alice")
+			require.Error(err, require.Is(ErrWrongTypeError))
+			require.False(ok)
+		})
+	})
+}
+
+func TestSelectRowPolicies(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	wsName := appdef.NewQName("test", "workspace")
+	docName := appdef.NewQName("test", "doc")
+	freeName := appdef.NewQName("test", "free")
+	roleName := appdef.NewQName("test", "role")
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+	wsb := adb.AddWorkspace(wsName)
+	wsb.AddCDoc(docName).
+		AddField("Owner", appdef.DataKind_string, false)
+	wsb.AddCDoc(freeName)
+	wsb.AddRole(roleName)
+	wsb.AddRowPolicy(appdef.NewQName("test", "own"), []appdef.OperationKind{appdef.OperationKind_Select}, docName, []appdef.QName{roleName},
+		exprs.Eq(exprs.Field("Owner"), exprs.Subject()))
+
+	cfgs := make(AppConfigsType, 1)
+	cfg := cfgs.AddBuiltInAppConfig(appName, adb)
+	cfg.SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+	provider := Provide(cfgs, testTokensFactory(), simpleStorageProvider(), isequencer.SequencesTrustLevel_0, nil)
+	app, err := provider.BuiltIn(appName)
+	require.NoError(err)
+
+	ws := app.AppDef().Workspace(wsName)
+
+	rec := newRecord(cfg)
+	rec.setQName(docName)
+	rec.PutRecordID(appdef.SystemField_ID, 100500)
+	rec.PutString("Owner", "alice")
+	require.NoError(rec.build())
+
+	cuds := func(cb func(istructs.ICUDRow) bool) { cb(rec) }
+	countCUDs := func(p *SelectRowPolicies) (cnt int) {
+		for range p.CUDs(cuds) {
+			cnt++
+		}
+		return cnt
+	}
+
+	t.Run("should be allowed", func(t *testing.T) {
+		for name, p := range map[string]*SelectRowPolicies{
+			"if subject is owner":       NewSelectRowPolicies(ws, []appdef.QName{roleName}, "alice"),
+			"if role is not restricted": NewSelectRowPolicies(ws, []appdef.QName{appdef.NewQName("test", "other")}, "bob"),
+			"if system role":            NewSelectRowPolicies(ws, []appdef.QName{roleName, appdef.QNameRoleSystem}, "bob"),
+			"if workspace is not known": NewSelectRowPolicies(nil, []appdef.QName{roleName}, "bob"),
+		} {
+			t.Run(name, func(t *testing.T) {
+				ok, err := p.IsAllowed(rec, docName)
+				require.NoError(err)
+				require.True(ok)
+				require.Equal(1, countCUDs(p))
+			})
+		}
+
+		t.Run("if table has no policies", func(t *testing.T) {
+			ok, err := NewSelectRowPolicies(ws, []appdef.QName{roleName}, "bob").IsAllowed(rec, freeName)
+			require.NoError(err)
+			require.True(ok)
+		})
+	})
+
+	t.Run("should be denied if subject is not owner", func(t *testing.T) {
+		p := NewSelectRowPolicies(ws, []appdef.QName{roleName}, "bob")
+		ok, err := p.IsAllowed(rec, docName)
+		require.NoError(err)
+		require.False(ok)
+		require.Zero(countCUDs(p))
+	})
+}
@@ -9,7 +9,11 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 )
 
@@ -24,7 +28,8 @@ import (
 //	— istructs.ICUDRow
 type recordType struct {
 	rowType
-	isNew bool
+	isNew  bool
+	origin *recordType // record values before update, used for update CUD rows only
 }
 
 // makeRecord makes null (appdef.NullQName) record
@@ -81,7 +86,7 @@ func (row *rowType) fieldValue(f appdef.IField) any {
 			}
 		}
 		return v
-	case appdef.DataKind_string:
+	case appdef.DataKind_string, appdef.DataKind_json:
 		return row.AsString(n)
 	case appdef.DataKind_QName:
 		return row.AsQName(n)
@@ -89,6 +94,12 @@ func (row *rowType) fieldValue(f appdef.IField) any {
 		return row.AsBool(n)
 	case appdef.DataKind_RecordID:
 		return row.AsRecordID(n)
+	case appdef.DataKind_decimal:
+		return row.AsDecimal(n)
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		return row.AsTime(n)
+	case appdef.DataKind_uuid:
+		return row.AsUUID(n)
 	case appdef.DataKind_Record:
 		return row.AsRecord(n)
 	case appdef.DataKind_Event:
@@ -113,6 +124,14 @@ func (rec *recordType) IsNew() bool {
 	return rec.isNew
 }
 
+// istructs.ICUDRow.Origin
+func (rec *recordType) Origin() istructs.IRowReader {
+	if rec.origin == nil || rec.origin.empty() {
+		return nil
+	}
+	return rec.origin
+}
+
 func (row *rowType) SpecifiedValues(cb func(appdef.IField, any) bool) {
 	if row.QName() != appdef.NullQName {
 		if !cb(row.fieldDef(appdef.SystemField_QName), row.QName()) {
@@ -159,6 +178,13 @@ func (row *rowType) SpecifiedValues(cb func(appdef.IField, any) bool) {
 				panic(err)
 			}
 			value = qName
+		case appdef.DataKind_decimal:
+			_, scale := appdef.DecimalPrecisionScale(field.Data())
+			value = decimal.New(value.(int64), scale)
+		case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+			value = coreutils.DateTimeFromValue(field.DataKind(), value.(int64))
+		case appdef.DataKind_uuid:
+			value = uuid.UUID(value.([]byte))
 		}
 		if field.DataKind() == appdef.DataKind_int8 { // #3435 [~server.vsql.smallints/cmp.istructs~impl]
 			value = int8(value.(byte)) // nolint G115 : dynobuffers uses byte to store int8
@@ -192,7 +218,7 @@ func nilToZeroValue(kind appdef.DataKind) any {
 		return float32(0)
 	case appdef.DataKind_float64:
 		return float64(0)
-	case appdef.DataKind_string:
+	case appdef.DataKind_string, appdef.DataKind_json:
 		return ""
 	case appdef.DataKind_RecordID:
 		return istructs.RecordID(0)
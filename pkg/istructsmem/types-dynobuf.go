@@ -11,11 +11,14 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"io"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/untillpro/dynobuffers"
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem/internal/containers"
 	"github.com/voedger/voedger/pkg/istructsmem/internal/utils"
@@ -29,8 +32,12 @@ import (
 //	— json.Number can be converted to all numeric kinds (int32, int64, float32, float64, RecordID)
 //	  — overflowing is checked
 //	— string value can be converted to QName and []byte kinds
+//	— json.Number and string values can be converted to decimal kind
+//	— json.Number, ISO-8601 string and time.Time values can be converted to date, time and timestamp kinds
+//	— canonical string and uuid.UUID values can be converted to uuid kind
+//	— object and array values can be converted to json kind as json text
 //
-// QName values, record- and event- values returned as []byte
+// QName values, uuid values, record- and event- values returned as []byte
 func (row *rowType) clarifyJSONValue(value any, kind appdef.DataKind) (res any, err error) {
 	switch kind {
 	case appdef.DataKind_int8: // #3435 [~server.vsql.smallints/cmp.istructs~impl]
@@ -75,6 +82,39 @@ func (row *rowType) clarifyJSONValue(value any, kind appdef.DataKind) (res any,
 		case json.Number:
 			return coreutils.ClarifyJSONNumber(v, kind)
 		}
+	case appdef.DataKind_decimal:
+		switch v := value.(type) {
+		case decimal.Decimal:
+			return v, nil
+		case json.Number:
+			return decimal.Parse(string(v))
+		case string:
+			return decimal.Parse(v)
+		}
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case time.Time:
+			return coreutils.DateTimeValue(kind, v), nil
+		case json.Number:
+			return coreutils.ClarifyJSONNumber(v, kind)
+		case string:
+			return coreutils.ParseDateTime(kind, v)
+		}
+	case appdef.DataKind_uuid:
+		switch v := value.(type) {
+		case uuid.UUID:
+			return v[:], nil
+		case string:
+			u, err := uuid.Parse(v)
+			if err != nil {
+				return nil, err
+			}
+			return u[:], nil
+		case []byte:
+			return v, nil
+		}
 	case appdef.DataKind_RecordID:
 		switch v := value.(type) {
 		case int64:
@@ -102,6 +142,19 @@ func (row *rowType) clarifyJSONValue(value any, kind appdef.DataKind) (res any,
 		if v, ok := value.(string); ok {
 			return v, nil
 		}
+	case appdef.DataKind_json:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case json.RawMessage:
+			return string(v), nil
+		case map[string]any, []any:
+			bytes, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			return string(bytes), nil
+		}
 	case appdef.DataKind_QName:
 		switch v := value.(type) {
 		case string:
@@ -169,6 +222,10 @@ func storeRow(row *rowType, buf *bytes.Buffer) {
 		// no test
 		panic(enrichError(err, row))
 	}
+	if b, err = row.encryptBytes(b); err != nil {
+		// no test
+		panic(enrichError(err, row))
+	}
 	length := uint32(len(b)) // nolint G115 considering int32 is enough to store the event
 	utils.WriteUint32(buf, length)
 	utils.SafeWriteBuf(buf, b)
@@ -237,6 +294,10 @@ func loadRow(row *rowType, codecVer byte, buf *bytes.Buffer) (err error) {
 	}
 	row.dyB.Reset(buf.Next(int(length)))
 
+	if err := row.decryptFields(); err != nil {
+		return enrichError(err, row)
+	}
+
 	return nil
 }
 
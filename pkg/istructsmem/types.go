@@ -13,15 +13,20 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/untillpro/dynobuffers"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem/internal/containers"
 	"github.com/voedger/voedger/pkg/istructsmem/internal/dynobuf"
 	"github.com/voedger/voedger/pkg/istructsmem/internal/utils"
 	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
+	"github.com/voedger/voedger/pkg/jsonpath"
 )
 
 // # Implements:
@@ -98,7 +103,7 @@ func (row *rowType) checkPutNil(field appdef.IField, value any) {
 	isNil := false
 
 	switch field.DataKind() {
-	case appdef.DataKind_string:
+	case appdef.DataKind_string, appdef.DataKind_json:
 		if value == nil || len(value.(string)) == 0 {
 			isNil = true
 		}
@@ -306,6 +311,33 @@ func (row *rowType) putValue(name appdef.FieldName, kind appdef.DataKind, value
 		}
 	}
 
+	if d, ok := fieldValue.(decimal.Decimal); ok {
+		rescaled, err := rescaleDecimal(fld, d)
+		if err != nil {
+			row.collectError(err)
+			return
+		}
+		fieldValue = rescaled
+	}
+
+	if t, ok := fieldValue.(time.Time); ok {
+		switch k := fld.DataKind(); k {
+		case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+			fieldValue = coreutils.DateTimeValue(k, t)
+		default:
+			row.collectError(ErrWrongFieldType("can not put time to %v", fld))
+			return
+		}
+	}
+
+	if u, ok := fieldValue.(uuid.UUID); ok {
+		if fld.DataKind() != appdef.DataKind_uuid {
+			row.collectError(ErrWrongFieldType("can not put uuid to %v", fld))
+			return
+		}
+		fieldValue = u[:]
+	}
+
 	if err := checkConstraints(fld, fieldValue); err != nil {
 		row.collectError(err)
 		return
@@ -316,6 +348,8 @@ func (row *rowType) putValue(name appdef.FieldName, kind appdef.DataKind, value
 	switch fld.DataKind() {
 	case appdef.DataKind_int8: // #3435 [~server.vsql.smallints/cmp.istructsmem~impl]
 		row.dyB.Set(name, byte(fieldValue.(int8))) // nolint G115 : dynobuffers uses byte to store int8
+	case appdef.DataKind_decimal:
+		row.dyB.Set(name, fieldValue.(decimal.Decimal).Unscaled())
 	default:
 		row.dyB.Set(name, fieldValue)
 	}
@@ -559,7 +593,8 @@ func (row *rowType) AsInt32(name appdef.FieldName) (value int32) {
 
 // istructs.IRowReader.AsInt64
 func (row *rowType) AsInt64(name appdef.FieldName) (value int64) {
-	fld := row.fieldMustExists(name, appdef.DataKind_int64, appdef.DataKind_RecordID)
+	fld := row.fieldMustExists(name, appdef.DataKind_int64, appdef.DataKind_RecordID,
+		appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp)
 
 	if fld.DataKind() == appdef.DataKind_RecordID {
 		switch name {
@@ -589,7 +624,8 @@ func (row *rowType) AsFloat32(name appdef.FieldName) (value float32) {
 func (row *rowType) AsFloat64(name appdef.FieldName) (value float64) {
 	fld := row.fieldMustExists(name, appdef.DataKind_float64,
 		appdef.DataKind_int8, appdef.DataKind_int16, // #3435 [~server.vsql.smallints/cmp.istructsmem~impl]
-		appdef.DataKind_int32, appdef.DataKind_int64, appdef.DataKind_float32, appdef.DataKind_RecordID)
+		appdef.DataKind_int32, appdef.DataKind_int64, appdef.DataKind_float32, appdef.DataKind_RecordID,
+		appdef.DataKind_decimal)
 	switch fld.DataKind() {
 	case appdef.DataKind_int8: // #3435 [~server.vsql.smallints/cmp.istructsmem~impl]
 		if value, ok := row.dyB.GetByte(name); ok {
@@ -625,13 +661,15 @@ func (row *rowType) AsFloat64(name appdef.FieldName) (value float64) {
 		if value, ok := row.dyB.GetFloat64(name); ok {
 			return value
 		}
+	case appdef.DataKind_decimal:
+		return row.AsDecimal(name).Float64()
 	}
 	return 0
 }
 
 // istructs.IRowReader.AsBytes
 func (row *rowType) AsBytes(name appdef.FieldName) (value []byte) {
-	_ = row.fieldMustExists(name, appdef.DataKind_bytes)
+	_ = row.fieldMustExists(name, appdef.DataKind_bytes, appdef.DataKind_uuid)
 	if bytes := row.dyB.GetByteArray(name); bytes != nil {
 		return bytes.Bytes()
 	}
@@ -645,7 +683,7 @@ func (row *rowType) AsString(name appdef.FieldName) (value string) {
 		return row.container
 	}
 
-	_ = row.fieldMustExists(name, appdef.DataKind_string)
+	_ = row.fieldMustExists(name, appdef.DataKind_string, appdef.DataKind_json)
 
 	if value, ok := row.dyB.GetString(name); ok {
 		return value
@@ -654,6 +692,22 @@ func (row *rowType) AsString(name appdef.FieldName) (value string) {
 	return ""
 }
 
+// istructs.IRowReader.AsJSONPath
+func (row *rowType) AsJSONPath(name appdef.FieldName, path string) any {
+	fld := row.fieldMustExists(name, appdef.DataKind_json)
+
+	data, ok := row.dyB.GetString(name)
+	if !ok {
+		return nil
+	}
+
+	value, _, err := jsonpath.Get(data, path)
+	if err != nil {
+		panic(enrichError(err, "%v", fld))
+	}
+	return value
+}
+
 // istructs.IRowReader.AsQName
 func (row *rowType) AsQName(name appdef.FieldName) appdef.QName {
 	if name == appdef.SystemField_QName {
@@ -708,6 +762,38 @@ func (row *rowType) AsRecordID(name appdef.FieldName) istructs.RecordID {
 	return istructs.NullRecordID
 }
 
+// istructs.IRowReader.AsDecimal
+func (row *rowType) AsDecimal(name appdef.FieldName) decimal.Decimal {
+	fld := row.fieldMustExists(name, appdef.DataKind_decimal)
+
+	_, scale := appdef.DecimalPrecisionScale(fld.Data())
+	if value, ok := row.dyB.GetInt64(name); ok {
+		return decimal.New(value, scale)
+	}
+
+	return decimal.New(0, scale)
+}
+
+// istructs.IRowReader.AsTime
+func (row *rowType) AsTime(name appdef.FieldName) time.Time {
+	fld := row.fieldMustExists(name, appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp)
+
+	value, _ := row.dyB.GetInt64(name)
+	return coreutils.DateTimeFromValue(fld.DataKind(), value)
+}
+
+// istructs.IRowReader.AsUUID
+func (row *rowType) AsUUID(name appdef.FieldName) uuid.UUID {
+	_ = row.fieldMustExists(name, appdef.DataKind_uuid)
+
+	if bytes := row.dyB.GetByteArray(name); bytes != nil {
+		if u, err := uuid.FromBytes(bytes.Bytes()); err == nil {
+			return u
+		}
+	}
+	return uuid.Nil
+}
+
 // IValue.AsRecord
 func (row *rowType) AsRecord(name appdef.FieldName) istructs.IRecord {
 	_ = row.fieldMustExists(name, appdef.DataKind_Record)
@@ -899,6 +985,20 @@ func (row *rowType) PutFromJSON(j map[appdef.FieldName]any) {
 			row.PutChars(n, fv)
 		case bool:
 			row.PutBool(n, fv)
+		case decimal.Decimal:
+			row.PutDecimal(n, fv)
+		case time.Time:
+			row.PutTime(n, fv)
+		case uuid.UUID:
+			row.PutUUID(n, fv)
+		case map[string]any, []any, json.RawMessage:
+			// happens if json field value is passed as object or array
+			data, err := row.clarifyJSONValue(fv, appdef.DataKind_json)
+			if err != nil {
+				row.collectError(enrichError(err, "can not put value for field «%s»", n))
+				continue
+			}
+			row.putValue(n, appdef.DataKind_json, data)
 		case []byte:
 			// happens e.g. on IRowWriter.PutJSON() after read from the storage
 			row.PutBytes(n, fv)
@@ -940,6 +1040,10 @@ func (row *rowType) PutNumber(name appdef.FieldName, value json.Number) {
 		row.PutFloat64(name, clarifiedVal.(float64))
 	case appdef.DataKind_RecordID:
 		row.PutRecordID(name, clarifiedVal.(istructs.RecordID))
+	case appdef.DataKind_decimal:
+		row.PutDecimal(name, clarifiedVal.(decimal.Decimal))
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		row.putValue(name, fld.DataKind(), clarifiedVal)
 	default:
 		// notest: avoided already by row.clarifyJSONValue()
 		panic(ErrWrongFieldType("can not put json.Number to %v", fld))
@@ -999,7 +1103,7 @@ func (row *rowType) PutChars(name appdef.FieldName, value string) {
 			return
 		}
 		row.PutBytes(name, bytes)
-	case appdef.DataKind_string:
+	case appdef.DataKind_string, appdef.DataKind_json:
 		row.PutString(name, value)
 	case appdef.DataKind_QName:
 		qName, err := appdef.ParseQName(value)
@@ -1008,6 +1112,27 @@ func (row *rowType) PutChars(name appdef.FieldName, value string) {
 			return
 		}
 		row.PutQName(name, qName)
+	case appdef.DataKind_decimal:
+		d, err := decimal.Parse(value)
+		if err != nil {
+			row.collectError(enrichError(err, "can not parse value for %v", fld))
+			return
+		}
+		row.PutDecimal(name, d)
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		v, err := coreutils.ParseDateTime(k, value)
+		if err != nil {
+			row.collectError(enrichError(err, "can not parse value for %v", fld))
+			return
+		}
+		row.putValue(name, k, v)
+	case appdef.DataKind_uuid:
+		u, err := uuid.Parse(value)
+		if err != nil {
+			row.collectError(enrichError(err, "can not parse value for %v", fld))
+			return
+		}
+		row.PutUUID(name, u)
 	default:
 		row.collectError(ErrWrongFieldType("can not put string to %v", fld))
 	}
@@ -1029,6 +1154,21 @@ func (row *rowType) PutRecordID(name appdef.FieldName, value istructs.RecordID)
 	row.putValue(name, appdef.DataKind_RecordID, int64(value)) // nolint G115
 }
 
+// istructs.IRowWriter.PutDecimal
+func (row *rowType) PutDecimal(name appdef.FieldName, value decimal.Decimal) {
+	row.putValue(name, appdef.DataKind_decimal, value)
+}
+
+// istructs.IRowWriter.PutTime
+func (row *rowType) PutTime(name appdef.FieldName, value time.Time) {
+	row.putValue(name, appdef.DataKind_timestamp, value)
+}
+
+// istructs.IRowWriter.PutUUID
+func (row *rowType) PutUUID(name appdef.FieldName, value uuid.UUID) {
+	row.putValue(name, appdef.DataKind_uuid, value)
+}
+
 // istructs.IValueBuilder.PutRecord
 func (row *rowType) PutRecord(name appdef.FieldName, record istructs.IRecord) {
 	if rec, ok := record.(*recordType); ok {
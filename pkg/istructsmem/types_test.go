@@ -15,13 +15,24 @@ import (
 	"math"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/appdef/constraints"
 	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/datetime"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/goutils/testingu/require"
+	"github.com/voedger/voedger/pkg/isequencer"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem/internal/qnames"
+	"github.com/voedger/voedger/pkg/istructsmem/internal/teststore"
+	"github.com/voedger/voedger/pkg/jsonpath"
 )
 
 func Test_rowNullType(t *testing.T) {
@@ -1040,3 +1051,387 @@ func TestWrongRecordIDs(t *testing.T) {
 	require.NoError(err)
 	require.EqualValues(istructs.RecordID(1), row.AsRecordID("RecordID"))
 }
+
+func Test_rowType_Decimal(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	objName := appdef.NewQName("test", "obj")
+
+	appStructs := func() istructs.IAppStructs {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+		wsb.AddObject(objName).
+			AddField("price", appdef.DataKind_decimal, false, constraints.Precision(6), constraints.Scale(2)).
+			AddField("qty", appdef.DataKind_decimal, false).
+			AddField("big", appdef.DataKind_decimal, false, constraints.MinExcl(0), constraints.MaxIncl(1e17))
+
+		cfgs := make(AppConfigsType)
+		cfgs.AddBuiltInAppConfig(appName, adb).SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+		_, storageProvider := teststore.New(appName)
+		provider := Provide(cfgs, testTokensFactory(), storageProvider, isequencer.SequencesTrustLevel_0, nil)
+		as, err := provider.BuiltIn(appName)
+		require.NoError(err)
+		return as
+	}()
+
+	t.Run("should be ok to put and read decimal values", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			put   func(istructs.IObjectBuilder)
+			price string
+			qty   string
+		}{
+			{"PutDecimal", func(b istructs.IObjectBuilder) {
+				b.PutDecimal("price", decimal.MustParse("12.5"))
+				b.PutDecimal("qty", decimal.MustParse("-3"))
+			}, "12.50", "-3"},
+			{"PutChars", func(b istructs.IObjectBuilder) {
+				b.PutChars("price", "-0.01")
+				b.PutChars("qty", "100")
+			}, "-0.01", "100"},
+			{"PutNumber", func(b istructs.IObjectBuilder) {
+				b.PutNumber("price", json.Number("9999.99"))
+				b.PutNumber("qty", json.Number("0"))
+			}, "9999.99", "0"},
+			{"PutFromJSON", func(b istructs.IObjectBuilder) {
+				b.PutFromJSON(map[appdef.FieldName]any{"price": "1.2", "qty": json.Number("7")})
+			}, "1.20", "7"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				b := appStructs.ObjectBuilder(objName)
+				tt.put(b)
+				obj, err := b.Build()
+				require.NoError(err)
+
+				require.Equal(tt.price, obj.AsDecimal("price").String())
+				require.Equal(tt.qty, obj.AsDecimal("qty").String())
+				require.EqualValues(2, obj.AsDecimal("price").Scale())
+
+				o := obj.(*objectType)
+				row := newRow(nil)
+				row.copyFrom(&o.rowType)
+				require.Equal(tt.price, row.AsDecimal("price").String())
+			})
+		}
+	})
+
+	t.Run("should be error to put decimal value", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			value string
+			err   string
+		}{
+			{"with scale loss", "1.234", "Scale: 2"},
+			{"with precision overflow", "12345.6", "Precision: 6"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				b := appStructs.ObjectBuilder(objName)
+				b.PutChars("price", tt.value)
+				_, err := b.Build()
+				require.Error(err, require.Is(ErrDataConstraintViolationError), require.Has(tt.err))
+			})
+		}
+
+		t.Run("if value violates min or max constraints", func(t *testing.T) {
+			for v, c := range map[string]string{
+				"0":                  "MinExcl: 0",
+				"100000000000000001": "MaxIncl: 1e+17", // float64(v) == 1e17, so should be compared exactly
+			} {
+				b := appStructs.ObjectBuilder(objName)
+				b.PutChars("big", v)
+				_, err := b.Build()
+				require.Error(err, require.Is(ErrDataConstraintViolationError), require.Has(c))
+			}
+
+			b := appStructs.ObjectBuilder(objName)
+			b.PutChars("big", "100000000000000000")
+			_, err := b.Build()
+			require.NoError(err)
+		})
+
+		t.Run("if value is not a decimal", func(t *testing.T) {
+			b := appStructs.ObjectBuilder(objName)
+			b.PutChars("price", "1.2.3")
+			_, err := b.Build()
+			require.Error(err, require.Is(decimal.ErrInvalidDecimal))
+		})
+	})
+}
+
+func Test_rowType_DateTime(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	objName := appdef.NewQName("test", "obj")
+
+	minDay := datetime.Date(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC))
+	maxDay := datetime.Date(time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	appStructs := func() istructs.IAppStructs {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+		wsb.AddObject(objName).
+			AddField("day", appdef.DataKind_date, false,
+				constraints.MinIncl(float64(minDay)), constraints.MaxIncl(float64(maxDay))).
+			AddField("at", appdef.DataKind_time, false).
+			AddField("stamp", appdef.DataKind_timestamp, false)
+
+		cfgs := make(AppConfigsType)
+		cfgs.AddBuiltInAppConfig(appName, adb).SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+		_, storageProvider := teststore.New(appName)
+		provider := Provide(cfgs, testTokensFactory(), storageProvider, isequencer.SequencesTrustLevel_0, nil)
+		as, err := provider.BuiltIn(appName)
+		require.NoError(err)
+		return as
+	}()
+
+	tm := time.Date(2026, 1, 1, 1, 30, 15, 500_000_000, time.FixedZone("UTC+2", 2*60*60))
+
+	t.Run("should be ok to put and read date and time values", func(t *testing.T) {
+		tests := []struct {
+			name string
+			put  func(istructs.IObjectBuilder)
+		}{
+			{"PutTime", func(b istructs.IObjectBuilder) {
+				b.PutTime("day", tm)
+				b.PutTime("at", tm)
+				b.PutTime("stamp", tm)
+			}},
+			{"PutChars", func(b istructs.IObjectBuilder) {
+				b.PutChars("day", "2026-01-01")
+				b.PutChars("at", "01:30:15.5")
+				b.PutChars("stamp", "2025-12-31T23:30:15.5Z")
+			}},
+			{"PutNumber", func(b istructs.IObjectBuilder) {
+				b.PutNumber("day", json.Number("20454"))
+				b.PutNumber("at", json.Number("5415500"))
+				b.PutNumber("stamp", json.Number("1767223815500"))
+			}},
+			{"PutInt64", func(b istructs.IObjectBuilder) {
+				b.PutInt64("day", 20454)
+				b.PutInt64("at", 5415500)
+				b.PutInt64("stamp", 1767223815500)
+			}},
+			{"PutFromJSON", func(b istructs.IObjectBuilder) {
+				b.PutFromJSON(map[appdef.FieldName]any{"day": "2026-01-01", "at": tm, "stamp": json.Number("1767223815500")})
+			}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				b := appStructs.ObjectBuilder(objName)
+				tt.put(b)
+				obj, err := b.Build()
+				require.NoError(err)
+
+				require.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), obj.AsTime("day"))
+				require.Equal(time.Date(1970, 1, 1, 1, 30, 15, 500_000_000, time.UTC), obj.AsTime("at"))
+				require.True(tm.Equal(obj.AsTime("stamp")))
+				require.EqualValues(20454, obj.AsInt64("day"))
+
+				o := obj.(*objectType)
+				row := newRow(nil)
+				row.copyFrom(&o.rowType)
+				require.Equal(obj.AsTime("stamp"), row.AsTime("stamp"))
+			})
+		}
+	})
+
+	t.Run("should be error to put date or time value", func(t *testing.T) {
+		tests := []struct {
+			name string
+			put  func(istructs.IObjectBuilder)
+			err  error
+		}{
+			{"if date is less than min", func(b istructs.IObjectBuilder) { b.PutChars("day", "1899-12-31") }, ErrDataConstraintViolationError},
+			{"if date is greater than max", func(b istructs.IObjectBuilder) { b.PutChars("day", "2100-01-01") }, ErrDataConstraintViolationError},
+			{"if time is out of day", func(b istructs.IObjectBuilder) { b.PutInt64("at", datetime.MillisPerDay) }, ErrDataConstraintViolationError},
+			{"if date is not a date", func(b istructs.IObjectBuilder) { b.PutChars("day", "31.12.2025") }, datetime.ErrInvalidDate},
+			{"if timestamp is not a timestamp", func(b istructs.IObjectBuilder) { b.PutChars("stamp", "2025-12-31") }, datetime.ErrInvalidTimestamp},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				b := appStructs.ObjectBuilder(objName)
+				tt.put(b)
+				_, err := b.Build()
+				require.Error(err, require.Is(tt.err))
+			})
+		}
+	})
+}
+
+func Test_rowType_UUID(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	objName := appdef.NewQName("test", "obj")
+
+	appStructs := func() istructs.IAppStructs {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+		wsb.AddObject(objName).
+			AddField("id", appdef.DataKind_uuid, true).
+			AddField("ref", appdef.DataKind_uuid, false).
+			AddField("name", appdef.DataKind_string, false)
+
+		cfgs := make(AppConfigsType)
+		cfgs.AddBuiltInAppConfig(appName, adb).SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+		_, storageProvider := teststore.New(appName)
+		provider := Provide(cfgs, testTokensFactory(), storageProvider, isequencer.SequencesTrustLevel_0, nil)
+		as, err := provider.BuiltIn(appName)
+		require.NoError(err)
+		return as
+	}()
+
+	id := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+
+	t.Run("should be ok to put and read uuid values", func(t *testing.T) {
+		tests := []struct {
+			name string
+			put  func(istructs.IObjectBuilder)
+		}{
+			{"PutUUID", func(b istructs.IObjectBuilder) { b.PutUUID("id", id) }},
+			{"PutChars", func(b istructs.IObjectBuilder) { b.PutChars("id", "F47AC10B-58CC-4372-A567-0E02B2C3D479") }},
+			{"PutBytes", func(b istructs.IObjectBuilder) { b.PutBytes("id", id[:]) }},
+			{"PutFromJSON", func(b istructs.IObjectBuilder) {
+				b.PutFromJSON(map[appdef.FieldName]any{"id": id.String()})
+			}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				b := appStructs.ObjectBuilder(objName)
+				tt.put(b)
+				obj, err := b.Build()
+				require.NoError(err)
+
+				require.Equal(id, obj.AsUUID("id"))
+				require.Equal(id[:], obj.AsBytes("id"))
+				require.Equal(uuid.Nil, obj.AsUUID("ref"))
+
+				values := map[appdef.FieldName]any{}
+				for f, v := range obj.SpecifiedValues {
+					values[f.Name()] = v
+				}
+				require.Equal(id, values["id"])
+			})
+		}
+	})
+
+	t.Run("should be error to put uuid value", func(t *testing.T) {
+		build := func(put func(istructs.IObjectBuilder)) error {
+			b := appStructs.ObjectBuilder(objName)
+			b.PutUUID("id", id)
+			put(b)
+			_, err := b.Build()
+			return err
+		}
+
+		t.Run("if string is not a uuid", func(t *testing.T) {
+			err := build(func(b istructs.IObjectBuilder) { b.PutChars("id", "not-a-uuid") })
+			require.Error(err, require.Has("invalid UUID"))
+		})
+
+		t.Run("if bytes length is not 16", func(t *testing.T) {
+			err := build(func(b istructs.IObjectBuilder) { b.PutBytes("id", []byte{1, 2, 3}) })
+			require.Error(err, require.Is(ErrDataConstraintViolationError))
+		})
+
+		t.Run("if uuid is put to string field", func(t *testing.T) {
+			err := build(func(b istructs.IObjectBuilder) { b.PutUUID("name", id) })
+			require.Error(err, require.Is(ErrWrongFieldTypeError))
+		})
+	})
+}
+
+func Test_rowType_JSON(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	objName := appdef.NewQName("test", "obj")
+
+	appStructs := func() istructs.IAppStructs {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+		wsb.AddObject(objName).
+			AddField("settings", appdef.DataKind_json, false).
+			AddField("short", appdef.DataKind_json, false, constraints.MaxLen(8))
+
+		cfgs := make(AppConfigsType)
+		cfgs.AddBuiltInAppConfig(appName, adb).SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+		_, storageProvider := teststore.New(appName)
+		provider := Provide(cfgs, testTokensFactory(), storageProvider, isequencer.SequencesTrustLevel_0, nil)
+		as, err := provider.BuiltIn(appName)
+		require.NoError(err)
+		return as
+	}()
+
+	const doc = `{"theme":"dark","sizes":[10,20],"font":{"name":"Arial"}}`
+
+	t.Run("should be ok to put and read json values", func(t *testing.T) {
+		tests := []struct {
+			name string
+			put  func(istructs.IObjectBuilder)
+		}{
+			{"PutString", func(b istructs.IObjectBuilder) { b.PutString("settings", doc) }},
+			{"PutChars", func(b istructs.IObjectBuilder) { b.PutChars("settings", doc) }},
+			{"PutFromJSON string", func(b istructs.IObjectBuilder) {
+				b.PutFromJSON(map[appdef.FieldName]any{"settings": doc})
+			}},
+			{"PutFromJSON object", func(b istructs.IObjectBuilder) {
+				b.PutFromJSON(map[appdef.FieldName]any{"settings": map[string]any{
+					"theme": "dark",
+					"sizes": []any{10, 20},
+					"font":  map[string]any{"name": "Arial"},
+				}})
+			}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				b := appStructs.ObjectBuilder(objName)
+				tt.put(b)
+				obj, err := b.Build()
+				require.NoError(err)
+
+				require.JSONEq(doc, obj.AsString("settings"))
+				require.Equal("dark", obj.AsJSONPath("settings", "theme"))
+				require.Equal(float64(20), obj.AsJSONPath("settings", "sizes[1]"))
+				require.Equal("Arial", obj.AsJSONPath("settings", "$.font.name"))
+				require.Nil(obj.AsJSONPath("settings", "unknown"))
+				require.Nil(obj.AsJSONPath("short", "theme"))
+
+				require.Panics(func() { obj.AsJSONPath("settings", "a..b") }, require.Is(jsonpath.ErrInvalidPath))
+			})
+		}
+	})
+
+	t.Run("should be error to put json value", func(t *testing.T) {
+		build := func(put func(istructs.IObjectBuilder)) error {
+			b := appStructs.ObjectBuilder(objName)
+			put(b)
+			_, err := b.Build()
+			return err
+		}
+
+		t.Run("if json is not valid", func(t *testing.T) {
+			err := build(func(b istructs.IObjectBuilder) { b.PutString("settings", `{"theme":`) })
+			require.Error(err, require.Is(ErrInvalidJSONError), require.Has("settings"))
+		})
+
+		t.Run("if json is too long", func(t *testing.T) {
+			err := build(func(b istructs.IObjectBuilder) { b.PutString("short", `{"theme":"dark"}`) })
+			require.Error(err, require.Is(ErrDataConstraintViolationError))
+		})
+
+		t.Run("if json is longer than default max length", func(t *testing.T) {
+			long := `"` + strings.Repeat("a", int(appdef.DefaultJSONFieldMaxLength)) + `"`
+			err := build(func(b istructs.IObjectBuilder) { b.PutString("settings", long) })
+			require.Error(err, require.Is(ErrDataConstraintViolationError), require.Has("default MaxLen"))
+		})
+	})
+}
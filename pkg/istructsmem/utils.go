@@ -89,6 +89,25 @@ func plogKey(partition istructs.PartitionID, offset istructs.Offset) (pkey, ccol
 	return pkey, uint16bytes(lo)
 }
 
+// Returns partition key and clustering columns bytes to register specified view partition key of specified workspace
+func viewPartitionKey(ws istructs.WSID, viewPKey []byte) (pkey, ccols []byte) {
+	pkey = make([]byte, uint16len+uint64len)
+	binary.BigEndian.PutUint16(pkey, consts.SysView_ViewPartitions)
+	binary.BigEndian.PutUint64(pkey[uint16len:], uint64(ws))
+
+	return pkey, viewPKey
+}
+
+// Deletes the storage item if it exists
+func deleteStorageItem(storage istorage.IAppStorage, pKey, cCols []byte) (deleted bool, err error) {
+	data := make([]byte, 0)
+	ok, err := storage.Get(pKey, cCols, &data)
+	if err != nil || !ok {
+		return false, err
+	}
+	return storage.CompareAndDelete(pKey, cCols, data)
+}
+
 // Returns partition key and clustering columns bytes for specified wlog workspace and offset
 func wlogKey(ws istructs.WSID, offset istructs.Offset) (pkey, ccols []byte) {
 	hi, lo := crackLogOffset(offset)
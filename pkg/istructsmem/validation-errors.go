@@ -29,6 +29,10 @@ const (
 	ECode_TooManyCreates
 	ECode_TooManyUpdates
 	ECode_TooManyChildren
+
+	ECode_InvalidJSON
+
+	ECode_CheckViolated
 )
 
 type validateErrorType struct {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/jsonpath"
 )
 
 // # Validates specified event.
@@ -339,8 +340,17 @@ func validateObject(o *objectType) (err error) {
 //
 // Checks that all required fields are filled.
 // For required ref fields checks that they are filled with non null IDs.
+// For json fields checks that they are filled with valid json documents.
+// Checks that structure CHECK constraints are not violated.
 func validateRow(row *rowType) (err error) {
 	for _, f := range row.fields.Fields() {
+		if f.DataKind() == appdef.DataKind_json {
+			if data := row.AsString(f.Name()); (len(data) > 0) && !jsonpath.Valid(data) {
+				err = errors.Join(err,
+					// invalid json: CDoc «test.doc» json-field «Settings»
+					validateError(ECode_InvalidJSON, ErrInvalidJSON(row, f)))
+			}
+		}
 		if f.Required() {
 			if !row.HasValue(f.Name()) {
 				err = errors.Join(err,
@@ -358,6 +368,11 @@ func validateRow(row *rowType) (err error) {
 			}
 		}
 	}
+	if e := checkRowChecks(row); e != nil {
+		err = errors.Join(err,
+			// check violated: CDoc «test.doc» check «test.doc$checks$Dates» fields «End», «Start»: End > Start
+			validateError(ECode_CheckViolated, e))
+	}
 	return err
 }
 
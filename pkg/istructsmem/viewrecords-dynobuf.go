@@ -8,7 +8,10 @@ import (
 	"bytes"
 	"io"
 
+	"github.com/google/uuid"
+
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem/internal/utils"
 )
@@ -63,11 +66,22 @@ func (key *keyType) storeViewPartKey(ws istructs.WSID) []byte {
 }
 
 // Stores clustering columns to bytes. Must be called only if valid key
+//
+// Date, time, timestamp and decimal values are stored with flipped sign bit to keep ordering of negative values.
+// Decimal values of the same field have the same scale, so unscaled values are ordered as decimals
 func (key *keyType) storeViewClustKey() []byte {
 	buf := new(bytes.Buffer)
 
 	for _, f := range key.ccolsRow.fields.Fields() {
-		utils.SafeWriteBuf(buf, key.ccolsRow.dyB.Get(f.Name()))
+		v := key.ccolsRow.dyB.Get(f.Name())
+		switch f.DataKind() {
+		case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp, appdef.DataKind_decimal:
+			if i, ok := v.(int64); ok {
+				utils.WriteOrderedInt64(buf, i)
+			}
+		default:
+			utils.SafeWriteBuf(buf, v)
+		}
 	}
 
 	return buf.Bytes()
@@ -157,9 +171,25 @@ func loadClustFieldFromBuffer_00(key *keyType, field appdef.IField, buf *bytes.B
 		if v, err = utils.ReadInt64(buf); err == nil {
 			key.ccolsRow.PutRecordID(field.Name(), istructs.RecordID(v)) // nolint G115
 		}
+	case appdef.DataKind_decimal:
+		v := int64(0)
+		if v, err = utils.ReadOrderedInt64(buf); err == nil {
+			_, scale := appdef.DecimalPrecisionScale(field.Data())
+			key.ccolsRow.PutDecimal(field.Name(), decimal.New(v, scale))
+		}
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		v := int64(0)
+		if v, err = utils.ReadOrderedInt64(buf); err == nil {
+			key.ccolsRow.putValue(field.Name(), field.DataKind(), v)
+		}
+	case appdef.DataKind_uuid:
+		v := uuid.UUID{}
+		if _, err = io.ReadFull(buf, v[:]); err == nil {
+			key.ccolsRow.PutUUID(field.Name(), v)
+		}
 	case appdef.DataKind_bytes:
 		key.ccolsRow.PutBytes(field.Name(), buf.Bytes())
-	case appdef.DataKind_string:
+	case appdef.DataKind_string, appdef.DataKind_json:
 		key.ccolsRow.PutString(field.Name(), buf.String())
 	default:
 		// no test
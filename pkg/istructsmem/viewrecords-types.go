@@ -10,8 +10,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istorage"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem/internal/utils"
@@ -19,15 +24,70 @@ import (
 
 // Implements IViewRecords interface
 type appViewRecords struct {
-	app *appStructsType
+	app        *appStructsType
+	partitions *viewPartitions
 }
 
 func newAppViewRecords(app *appStructsType) appViewRecords {
 	return appViewRecords{
-		app: app,
+		app:        app,
+		partitions: newViewPartitions(),
 	}
 }
 
+// View partitions of workspaces, which are registered to be found on the workspace purge, see [viewPartitionKey].
+//
+// Registry item is put once per view partition while the app structs are alive.
+// Partitions written before the registry was introduced are registered on the first read of their data.
+type viewPartitions struct {
+	mu   sync.RWMutex
+	keys map[string]struct{}
+}
+
+func newViewPartitions() *viewPartitions {
+	return &viewPartitions{keys: make(map[string]struct{})}
+}
+
+// Returns is view partition with specified partition key registered
+func (vp *viewPartitions) registered(pKey []byte) bool {
+	vp.mu.RLock()
+	defer vp.mu.RUnlock()
+	_, ok := vp.keys[string(pKey)]
+	return ok
+}
+
+// Marks view partition with specified partition key as registered.
+//
+// If count of registered partitions exceeds maxRegisteredViewPartitions, then all marks are cleared
+func (vp *viewPartitions) add(pKey []byte) {
+	vp.mu.Lock()
+	defer vp.mu.Unlock()
+	if len(vp.keys) >= maxRegisteredViewPartitions {
+		clear(vp.keys)
+	}
+	vp.keys[string(pKey)] = struct{}{}
+}
+
+// Removes mark of the deleted view partition
+func (vp *viewPartitions) remove(pKey []byte) {
+	vp.mu.Lock()
+	defer vp.mu.Unlock()
+	delete(vp.keys, string(pKey))
+}
+
+// Registers the workspace view partition with the existing data if it is not registered yet
+func (vr *appViewRecords) registerPartition(workspace istructs.WSID, pKey []byte) error {
+	if vr.partitions.registered(pKey) {
+		return nil
+	}
+	regPKey, regCCols := viewPartitionKey(workspace, pKey)
+	if err := vr.app.config.storage.Put(regPKey, regCCols, viewPartitionValue); err != nil {
+		return err
+	}
+	vr.partitions.add(pKey)
+	return nil
+}
+
 // istructs.IViewRecords.KeyBuilder
 func (vr *appViewRecords) KeyBuilder(view appdef.QName) istructs.IKeyBuilder {
 	return newKey(vr.app.config, view)
@@ -72,11 +132,11 @@ func (vr *appViewRecords) Get(workspace istructs.WSID, key istructs.IKeyBuilder)
 	}
 
 	valRow := newValue(k.appCfg, k.viewName)
-	if err = valRow.loadFromBytes(data); err == nil {
-		value = valRow // all is fine
+	if err = valRow.loadFromBytes(data); err != nil {
+		return value, err
 	}
 
-	return value, err
+	return valRow, vr.registerPartition(workspace, pKey)
 }
 
 // istructs.IViewRecords.GetBatch
@@ -114,6 +174,14 @@ func (vr *appViewRecords) GetBatch(workspace istructs.WSID, kv []istructs.ViewRe
 		if err := vr.app.config.storage.GetBatch([]byte(pKey), batch); err != nil {
 			return err
 		}
+		for _, b := range batch {
+			if b.Ok {
+				if err := vr.registerPartition(workspace, []byte(pKey)); err != nil {
+					return err
+				}
+				break
+			}
+		}
 	}
 	for i := range batches {
 		b := batches[i]
@@ -130,24 +198,66 @@ func (vr *appViewRecords) GetBatch(workspace istructs.WSID, kv []istructs.ViewRe
 }
 
 // istructs.IViewRecords.Put
+//
+// View partition of the workspace is registered in the same batch, if it is not registered yet
 func (vr *appViewRecords) Put(workspace istructs.WSID, key istructs.IKeyBuilder, value istructs.IValueBuilder) (err error) {
 	var partKey, ccolsCols, data []byte
-	if partKey, ccolsCols, data, err = vr.storeViewRecord(workspace, key, value); err == nil {
+	if partKey, ccolsCols, data, err = vr.storeViewRecord(workspace, key, value); err != nil {
+		return err
+	}
+	if vr.partitions.registered(partKey) {
 		return vr.app.config.storage.Put(partKey, ccolsCols, data)
 	}
+	regPKey, regCCols := viewPartitionKey(workspace, partKey)
+	err = vr.app.config.storage.PutBatch([]istorage.BatchItem{
+		{PKey: partKey, CCols: ccolsCols, Value: data},
+		{PKey: regPKey, CCols: regCCols, Value: viewPartitionValue},
+	})
+	if err == nil {
+		vr.partitions.add(partKey)
+	}
 	return err
 }
 
 // istructs.IViewRecords.PutBatch
+//
+// Unregistered view partitions of the workspace are registered in the same batch
 func (vr *appViewRecords) PutBatch(workspace istructs.WSID, recs []istructs.ViewKV) (err error) {
-	batch := make([]istorage.BatchItem, len(recs))
+	batch := make([]istorage.BatchItem, len(recs), len(recs)+1)
+	unregistered := make(map[string]struct{})
 
 	for i, kv := range recs {
 		if batch[i].PKey, batch[i].CCols, batch[i].Value, err = vr.storeViewRecord(workspace, kv.Key, kv.Value); err != nil {
 			return err
 		}
+		if _, ok := unregistered[string(batch[i].PKey)]; !ok && !vr.partitions.registered(batch[i].PKey) {
+			unregistered[string(batch[i].PKey)] = struct{}{}
+			regPKey, regCCols := viewPartitionKey(workspace, batch[i].PKey)
+			batch = append(batch, istorage.BatchItem{PKey: regPKey, CCols: regCCols, Value: viewPartitionValue})
+		}
+	}
+	if err = vr.app.config.storage.PutBatch(batch); err != nil {
+		return err
+	}
+	for pKey := range unregistered {
+		vr.partitions.add([]byte(pKey))
+	}
+	return nil
+}
+
+// istructs.IViewRecords.Delete
+func (vr *appViewRecords) Delete(workspace istructs.WSID, key istructs.IKeyBuilder) error {
+	k := key.(*keyType)
+	if err := k.build(); err != nil {
+		return err
+	}
+	if err := validateViewKey(k, false); err != nil {
+		return err
 	}
-	return vr.app.config.storage.PutBatch(batch)
+
+	pKey, cKey := k.storeToBytes(workspace)
+	_, err := deleteStorageItem(vr.app.config.storage, pKey, cKey)
+	return err
 }
 
 func (vr *appViewRecords) PutJSON(ws istructs.WSID, j map[appdef.FieldName]any) error {
@@ -229,7 +339,15 @@ func (vr *appViewRecords) Read(ctx context.Context, workspace istructs.WSID, key
 	}
 
 	pKey, cKey := k.storeToBytes(workspace)
-	return vr.app.config.storage.Read(ctx, pKey, cKey, utils.IncBytes(cKey), readRecord)
+	found := false
+	err = vr.app.config.storage.Read(ctx, pKey, cKey, utils.IncBytes(cKey), func(ccols, value []byte) error {
+		found = true
+		return readRecord(ccols, value)
+	})
+	if err != nil || !found {
+		return err
+	}
+	return vr.registerPartition(workspace, pKey)
 }
 
 // keyType is complex key from two parts (partition key and clustering key)
@@ -306,6 +424,9 @@ func (key *keyType) AsBool(name appdef.FieldName) bool {
 
 // istructs.IRowReader.AsBytes
 func (key *keyType) AsBytes(name appdef.FieldName) []byte {
+	if key.partRow.fieldDef(name) != nil {
+		return key.partRow.AsBytes(name)
+	}
 	return key.ccolsRow.AsBytes(name)
 }
 
@@ -381,11 +502,40 @@ func (key *keyType) AsRecordID(name appdef.FieldName) istructs.RecordID {
 	return key.ccolsRow.AsRecordID(name)
 }
 
+// istructs.IRowReader.AsDecimal
+func (key *keyType) AsDecimal(name appdef.FieldName) decimal.Decimal {
+	if key.partRow.fieldDef(name) != nil {
+		return key.partRow.AsDecimal(name)
+	}
+	return key.ccolsRow.AsDecimal(name)
+}
+
+// istructs.IRowReader.AsTime
+func (key *keyType) AsTime(name appdef.FieldName) time.Time {
+	if key.partRow.fieldDef(name) != nil {
+		return key.partRow.AsTime(name)
+	}
+	return key.ccolsRow.AsTime(name)
+}
+
+// istructs.IRowReader.AsUUID
+func (key *keyType) AsUUID(name appdef.FieldName) uuid.UUID {
+	if key.partRow.fieldDef(name) != nil {
+		return key.partRow.AsUUID(name)
+	}
+	return key.ccolsRow.AsUUID(name)
+}
+
 // istructs.IRowReader.AsString
 func (key *keyType) AsString(name appdef.FieldName) string {
 	return key.ccolsRow.AsString(name)
 }
 
+// istructs.IRowReader.AsJSONPath
+func (key *keyType) AsJSONPath(name appdef.FieldName, path string) any {
+	return key.ccolsRow.AsJSONPath(name, path)
+}
+
 // istructs.IKeyBuilder.ClusteringColumns
 func (key *keyType) ClusteringColumns() istructs.IRowWriter {
 	return &key.ccolsRow
@@ -461,7 +611,11 @@ func (key *keyType) PutBool(name appdef.FieldName, value bool) {
 
 // istructs.IRowWriter.PutBytes
 func (key *keyType) PutBytes(name appdef.FieldName, value []byte) {
-	key.ccolsRow.PutBytes(name, value)
+	if key.partRow.fieldDef(name) != nil {
+		key.partRow.PutBytes(name, value)
+	} else {
+		key.ccolsRow.PutBytes(name, value)
+	}
 }
 
 // istructs.IRowWriter.PutChars
@@ -575,6 +729,33 @@ func (key *keyType) PutRecordID(name appdef.FieldName, value istructs.RecordID)
 	}
 }
 
+// istructs.IRowWriter.PutDecimal
+func (key *keyType) PutDecimal(name appdef.FieldName, value decimal.Decimal) {
+	if key.partRow.fieldDef(name) != nil {
+		key.partRow.PutDecimal(name, value)
+	} else {
+		key.ccolsRow.PutDecimal(name, value)
+	}
+}
+
+// istructs.IRowWriter.PutTime
+func (key *keyType) PutTime(name appdef.FieldName, value time.Time) {
+	if key.partRow.fieldDef(name) != nil {
+		key.partRow.PutTime(name, value)
+	} else {
+		key.ccolsRow.PutTime(name, value)
+	}
+}
+
+// istructs.IRowWriter.PutUUID
+func (key *keyType) PutUUID(name appdef.FieldName, value uuid.UUID) {
+	if key.partRow.fieldDef(name) != nil {
+		key.partRow.PutUUID(name, value)
+	} else {
+		key.ccolsRow.PutUUID(name, value)
+	}
+}
+
 // istructs.IRowWriter.PutString
 func (key *keyType) PutString(name appdef.FieldName, value string) {
 	key.ccolsRow.PutString(name, value)
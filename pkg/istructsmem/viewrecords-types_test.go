@@ -11,9 +11,13 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef/builder"
 	"github.com/voedger/voedger/pkg/appdef/constraints"
+	"github.com/voedger/voedger/pkg/datetime"
 	"github.com/voedger/voedger/pkg/goutils/testingu/require"
 	"github.com/voedger/voedger/pkg/isequencer"
 
@@ -1234,6 +1238,248 @@ func Test_ViewRecords_ClustColumnsQName(t *testing.T) {
 	})
 }
 
+func Test_ViewRecords_ClustColumnsDateTime(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	viewName := appdef.NewQName("test", "viewEvents")
+
+	ws := istructs.WSID(1234)
+
+	appConfigs := func() AppConfigsType {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+		wsb.AddCDoc(appdef.NewQName("test", "WSDesc"))
+		wsb.SetDescriptor(appdef.NewQName("test", "WSDesc"))
+
+		v := wsb.AddView(viewName)
+		v.Key().PartKey().
+			AddField("day", appdef.DataKind_date)
+		v.Key().ClustCols().
+			AddField("stamp", appdef.DataKind_timestamp).
+			AddField("at", appdef.DataKind_time)
+		v.Value().
+			AddField("name", appdef.DataKind_string, true)
+
+		cfgs := make(AppConfigsType, 1)
+		cfg := cfgs.AddBuiltInAppConfig(appName, adb)
+		cfg.SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+
+		return cfgs
+	}
+
+	p := Provide(appConfigs(), testTokensFactory(), simpleStorageProvider(), isequencer.SequencesTrustLevel_0, nil)
+	as, err := p.BuiltIn(appName)
+	require.NoError(err)
+	viewRecords := as.ViewRecords()
+
+	day := time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)
+	stamps := []string{
+		"1969-12-31T23:59:59.999Z",
+		"1900-01-01T00:00:00.000Z",
+		"2025-12-31T23:59:59.999Z",
+		"1970-01-01T00:00:00.000Z",
+		"1969-12-31T00:00:00.000Z",
+	}
+
+	for _, s := range stamps {
+		kb := viewRecords.KeyBuilder(viewName)
+		kb.PutTime("day", day)
+		kb.PutChars("stamp", s)
+		kb.PutChars("at", "12:00")
+		vb := viewRecords.NewValueBuilder(viewName)
+		vb.PutString("name", s)
+		require.NoError(viewRecords.Put(ws, kb, vb))
+	}
+
+	t.Run("should be ordered by clustering columns including negative values", func(t *testing.T) {
+		kb := viewRecords.KeyBuilder(viewName)
+		kb.PutChars("day", "1969-12-31")
+
+		got := []string{}
+		err := viewRecords.Read(context.Background(), ws, kb, func(key istructs.IKey, value istructs.IValue) error {
+			require.Equal(day, key.AsTime("day"))
+			require.EqualValues(-1, key.AsInt64("day"))
+			require.Equal(time.Date(1970, 1, 1, 12, 0, 0, 0, time.UTC), key.AsTime("at"))
+			require.Equal(value.AsString("name"), key.AsTime("stamp").Format(datetime.TimestampLayout))
+			got = append(got, value.AsString("name"))
+			return nil
+		})
+		require.NoError(err)
+		require.Equal([]string{
+			"1900-01-01T00:00:00.000Z",
+			"1969-12-31T00:00:00.000Z",
+			"1969-12-31T23:59:59.999Z",
+			"1970-01-01T00:00:00.000Z",
+			"2025-12-31T23:59:59.999Z",
+		}, got)
+	})
+
+	t.Run("should be ok to read by full key", func(t *testing.T) {
+		kb := viewRecords.KeyBuilder(viewName)
+		kb.PutInt64("day", -1)
+		kb.PutTime("stamp", time.Date(1970, 1, 1, 1, 0, 0, 0, time.FixedZone("UTC+1", 60*60)))
+		kb.PutNumber("at", gojson.Number("43200000"))
+
+		value, err := viewRecords.Get(ws, kb)
+		require.NoError(err)
+		require.Equal("1970-01-01T00:00:00.000Z", value.AsString("name"))
+	})
+}
+
+func Test_ViewRecords_ClustColumnsDecimal(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	viewName := appdef.NewQName("test", "viewAmounts")
+
+	ws := istructs.WSID(1234)
+
+	appConfigs := func() AppConfigsType {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+		wsb.AddCDoc(appdef.NewQName("test", "WSDesc"))
+		wsb.SetDescriptor(appdef.NewQName("test", "WSDesc"))
+
+		v := wsb.AddView(viewName)
+		v.Key().PartKey().
+			AddField("account", appdef.DataKind_int32)
+		v.Key().ClustCols().
+			AddField("amount", appdef.DataKind_decimal, constraints.Precision(6), constraints.Scale(2))
+		v.Value().
+			AddField("name", appdef.DataKind_string, true)
+
+		cfgs := make(AppConfigsType, 1)
+		cfg := cfgs.AddBuiltInAppConfig(appName, adb)
+		cfg.SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+
+		return cfgs
+	}
+
+	p := Provide(appConfigs(), testTokensFactory(), simpleStorageProvider(), isequencer.SequencesTrustLevel_0, nil)
+	as, err := p.BuiltIn(appName)
+	require.NoError(err)
+	viewRecords := as.ViewRecords()
+
+	amounts := []string{"-0.01", "3.20", "-100.00", "0.00", "-10.50", "1000.00"}
+
+	for _, a := range amounts {
+		kb := viewRecords.KeyBuilder(viewName)
+		kb.PutInt32("account", 1)
+		kb.PutChars("amount", a)
+		vb := viewRecords.NewValueBuilder(viewName)
+		vb.PutString("name", a)
+		require.NoError(viewRecords.Put(ws, kb, vb))
+	}
+
+	t.Run("should be ordered by clustering columns including negative values", func(t *testing.T) {
+		kb := viewRecords.KeyBuilder(viewName)
+		kb.PutInt32("account", 1)
+
+		got := []string{}
+		err := viewRecords.Read(context.Background(), ws, kb, func(key istructs.IKey, value istructs.IValue) error {
+			require.Equal(value.AsString("name"), key.AsDecimal("amount").String())
+			got = append(got, value.AsString("name"))
+			return nil
+		})
+		require.NoError(err)
+		require.Equal([]string{"-100.00", "-10.50", "-0.01", "0.00", "3.20", "1000.00"}, got)
+	})
+
+	t.Run("should be ok to read by full key", func(t *testing.T) {
+		kb := viewRecords.KeyBuilder(viewName)
+		kb.PutInt32("account", 1)
+		kb.PutChars("amount", "-10.5")
+
+		value, err := viewRecords.Get(ws, kb)
+		require.NoError(err)
+		require.Equal("-10.50", value.AsString("name"))
+	})
+}
+
+func Test_ViewRecords_UUIDKeys(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	viewName := appdef.NewQName("test", "viewItems")
+
+	ws := istructs.WSID(1234)
+
+	appConfigs := func() AppConfigsType {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+		wsb.AddCDoc(appdef.NewQName("test", "WSDesc"))
+		wsb.SetDescriptor(appdef.NewQName("test", "WSDesc"))
+
+		v := wsb.AddView(viewName)
+		v.Key().PartKey().
+			AddField("owner", appdef.DataKind_uuid)
+		v.Key().ClustCols().
+			AddField("item", appdef.DataKind_uuid).
+			AddField("name", appdef.DataKind_string)
+		v.Value().
+			AddField("ref", appdef.DataKind_uuid, true)
+
+		cfgs := make(AppConfigsType, 1)
+		cfg := cfgs.AddBuiltInAppConfig(appName, adb)
+		cfg.SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+
+		return cfgs
+	}
+
+	p := Provide(appConfigs(), testTokensFactory(), simpleStorageProvider(), isequencer.SequencesTrustLevel_0, nil)
+	as, err := p.BuiltIn(appName)
+	require.NoError(err)
+	viewRecords := as.ViewRecords()
+
+	owner := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	items := []uuid.UUID{
+		uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+		uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		uuid.MustParse("7c9e6679-7425-40de-944b-e07fc1f90ae7"),
+	}
+
+	for _, item := range items {
+		kb := viewRecords.KeyBuilder(viewName)
+		kb.PutUUID("owner", owner)
+		kb.PutChars("item", item.String())
+		kb.PutString("name", "name-"+item.String())
+		vb := viewRecords.NewValueBuilder(viewName)
+		vb.PutUUID("ref", item)
+		require.NoError(viewRecords.Put(ws, kb, vb))
+	}
+
+	t.Run("should be ordered by uuid bytes", func(t *testing.T) {
+		kb := viewRecords.KeyBuilder(viewName)
+		kb.PutChars("owner", owner.String())
+
+		got := []uuid.UUID{}
+		err := viewRecords.Read(context.Background(), ws, kb, func(key istructs.IKey, value istructs.IValue) error {
+			require.Equal(owner, key.AsUUID("owner"))
+			require.Equal(key.AsUUID("item"), value.AsUUID("ref"))
+			require.Equal("name-"+key.AsUUID("item").String(), key.AsString("name"))
+			got = append(got, key.AsUUID("item"))
+			return nil
+		})
+		require.NoError(err)
+		require.Equal([]uuid.UUID{items[1], items[2], items[0]}, got)
+	})
+
+	t.Run("should be ok to read by full key", func(t *testing.T) {
+		kb := viewRecords.KeyBuilder(viewName)
+		kb.PutBytes("owner", owner[:])
+		kb.PutUUID("item", items[0])
+		kb.PutString("name", "name-"+items[0].String())
+
+		value, err := viewRecords.Get(ws, kb)
+		require.NoError(err)
+		require.Equal(items[0], value.AsUUID("ref"))
+	})
+}
+
 func Test_ViewRecord_GetBatch(t *testing.T) {
 	require := require.New(t)
 
@@ -1584,3 +1830,70 @@ func Test_ViewRecordStructure(t *testing.T) {
 	v := v1.storeToBytes()
 	fmt.Printf("%#x\n", v)
 }
+
+func Test_ViewRecords_Delete(t *testing.T) {
+	require := require.New(t)
+
+	appName := istructs.AppQName_test1_app1
+	viewName := appdef.NewQName("test", "view")
+
+	ws := istructs.WSID(1234)
+
+	appConfigs := func() AppConfigsType {
+		adb := builder.New()
+		adb.AddPackage("test", "test.com/test")
+		wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+		wsb.AddCDoc(appdef.NewQName("test", "WSDesc"))
+		wsb.SetDescriptor(appdef.NewQName("test", "WSDesc"))
+
+		v := wsb.AddView(viewName)
+		v.Key().PartKey().AddField("pk", appdef.DataKind_int32)
+		v.Key().ClustCols().AddField("cc", appdef.DataKind_int32)
+		v.Value().AddField("name", appdef.DataKind_string, true)
+
+		cfgs := make(AppConfigsType, 1)
+		cfg := cfgs.AddBuiltInAppConfig(appName, adb)
+		cfg.SetNumAppWorkspaces(istructs.DefaultNumAppWorkspaces)
+
+		return cfgs
+	}
+
+	p := Provide(appConfigs(), testTokensFactory(), simpleStorageProvider(), isequencer.SequencesTrustLevel_0, nil)
+	as, err := p.BuiltIn(appName)
+	require.NoError(err)
+	viewRecords := as.ViewRecords()
+
+	key := func(cc int32) istructs.IKeyBuilder {
+		kb := viewRecords.KeyBuilder(viewName)
+		kb.PutInt32("pk", 1)
+		kb.PutInt32("cc", cc)
+		return kb
+	}
+
+	for cc := int32(1); cc <= 2; cc++ {
+		vb := viewRecords.NewValueBuilder(viewName)
+		vb.PutString("name", "test")
+		require.NoError(viewRecords.Put(ws, key(cc), vb))
+	}
+
+	t.Run("should delete view record", func(t *testing.T) {
+		require.NoError(viewRecords.Delete(ws, key(1)))
+
+		_, err := viewRecords.Get(ws, key(1))
+		require.ErrorIs(err, istructs.ErrRecordNotFound)
+
+		_, err = viewRecords.Get(ws, key(2))
+		require.NoError(err, "another view record should be kept")
+	})
+
+	t.Run("should be ok to delete unknown view record", func(t *testing.T) {
+		require.NoError(viewRecords.Delete(ws, key(1)))
+		require.NoError(viewRecords.Delete(ws+1, key(2)))
+	})
+
+	t.Run("should be error if key is not full", func(t *testing.T) {
+		kb := viewRecords.KeyBuilder(viewName)
+		kb.PutInt32("pk", 1)
+		require.Error(viewRecords.Delete(ws, kb))
+	})
+}
@@ -51,6 +51,20 @@ type VerifiedValuePayload struct {
 	Value            interface{}
 }
 
+// Issued on sign in by login and password if TOTP is enabled for the login
+// Exchanged for the principal token by the TOTP code
+type TOTPChallengePayload struct {
+	Login string
+}
+
+// Long-lived API key of the workspace, see cdoc.sys.APIKey
+// Roles are not kept in the key, they are read from the CDoc on each request so the key is revocable
+type APIKeyPayload struct {
+	WSID istructs.WSID
+	// makes keys issued at the same time different
+	Nonce string
+}
+
 type VerificationPayload struct {
 	VerifiedValuePayload
 	Hash256 [32]byte
@@ -0,0 +1,14 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package jsonpath
+
+// Optional root element of path, like `$.address.city`
+const Root = "$"
+
+const (
+	keySeparator = '.'
+	indexOpen    = '['
+	indexClose   = ']'
+)
@@ -0,0 +1,11 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package jsonpath
+
+import "errors"
+
+var ErrInvalidPath = errors.New("invalid json path")
+
+var ErrInvalidDocument = errors.New("invalid json document")
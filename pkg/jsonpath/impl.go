@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Parses json path, like `address.city`, `phones[0]` or `$.phones[0].number`.
+//
+// Empty path and `$` address the whole document.
+func Parse(path string) (Path, error) {
+	s := path
+	if s == Root || strings.HasPrefix(s, Root+string(keySeparator)) || strings.HasPrefix(s, Root+string(indexOpen)) {
+		s = s[len(Root):]
+	}
+	s = strings.TrimPrefix(s, string(keySeparator))
+
+	p := Path{}
+	for len(s) > 0 {
+		switch s[0] {
+		case indexOpen:
+			end := strings.IndexByte(s, indexClose)
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unclosed index in «%s»", ErrInvalidPath, path)
+			}
+			idx, err := strconv.Atoi(s[1:end])
+			if err != nil || idx < 0 {
+				return nil, fmt.Errorf("%w: wrong index «%s» in «%s»", ErrInvalidPath, s[1:end], path)
+			}
+			p = append(p, step{index: idx, isIdx: true})
+			s = s[end+1:]
+		case keySeparator:
+			s = s[1:]
+			if len(s) == 0 || s[0] == keySeparator || s[0] == indexOpen {
+				return nil, fmt.Errorf("%w: empty key in «%s»", ErrInvalidPath, path)
+			}
+		default:
+			end := strings.IndexAny(s, string([]byte{keySeparator, indexOpen}))
+			if end < 0 {
+				end = len(s)
+			}
+			if strings.IndexByte(s[:end], indexClose) >= 0 {
+				return nil, fmt.Errorf("%w: unexpected «%c» in «%s»", ErrInvalidPath, indexClose, path)
+			}
+			p = append(p, step{key: s[:end]})
+			s = s[end:]
+		}
+	}
+	return p, nil
+}
+
+// Returns value addressed by path in decoded json document.
+//
+// Returns false if path does not exist in document.
+func (p Path) Value(doc any) (any, bool) {
+	v := doc
+	for _, s := range p {
+		if s.isIdx {
+			arr, ok := v.([]any)
+			if !ok || s.index >= len(arr) {
+				return nil, false
+			}
+			v = arr[s.index]
+			continue
+		}
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if v, ok = obj[s.key]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// Renders path in canonical form, like `$.phones[0].number`
+func (p Path) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(Root)
+	for _, s := range p {
+		if s.isIdx {
+			fmt.Fprintf(&sb, "%c%d%c", indexOpen, s.index, indexClose)
+			continue
+		}
+		sb.WriteByte(keySeparator)
+		sb.WriteString(s.key)
+	}
+	return sb.String()
+}
+
+// Returns value addressed by path in json document.
+//
+// Objects are returned as map[string]any, arrays as []any and numbers as float64.
+// Returns false if document is empty or path does not exist in document.
+func Get(data string, path string) (any, bool, error) {
+	p, err := Parse(path)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(data) == 0 {
+		return nil, false, nil
+	}
+	var doc any
+	if err := json.Unmarshal([]byte(data), &doc); err != nil {
+		return nil, false, fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+	v, ok := p.Value(doc)
+	return v, ok, nil
+}
+
+// Returns is specified string is a valid json document
+func Valid(data string) bool {
+	return json.Valid([]byte(data))
+}
+
+// Returns is decoded json value equals to specified value.
+//
+// Numbers are compared as float64, so json.Number and any Go numeric value can be passed.
+func Equal(value, to any) bool {
+	if v, ok := toFloat64(value); ok {
+		t, ok := toFloat64(to)
+		return ok && v == t
+	}
+	return reflect.DeepEqual(value, to)
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
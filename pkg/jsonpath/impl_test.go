@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package jsonpath
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"", "$"},
+		{"$", "$"},
+		{"a", "$.a"},
+		{"$.a", "$.a"},
+		{"a.b.c", "$.a.b.c"},
+		{"a[0]", "$.a[0]"},
+		{"$[1].b", "$[1].b"},
+		{"a[0][12].b", "$.a[0][12].b"},
+		{"$a", "$.$a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := Parse(tt.path)
+			require.NoError(err)
+			require.Equal(tt.want, p.String())
+		})
+	}
+
+	t.Run("should be error if path is invalid", func(t *testing.T) {
+		for _, path := range []string{"a..b", "a.", "a[", "a[-1]", "a[x]", "a]", "a.[0]"} {
+			_, err := Parse(path)
+			require.ErrorIs(err, ErrInvalidPath, path)
+		}
+	})
+}
+
+func TestGet(t *testing.T) {
+	require := require.New(t)
+
+	const doc = `{"name":"Alice","address":{"city":"Paris","zip":"75001"},"phones":[{"number":"123"},{"number":"456"}],"age":42,"vip":true,"note":null}`
+
+	tests := []struct {
+		path string
+		want any
+		ok   bool
+	}{
+		{"name", "Alice", true},
+		{"address.city", "Paris", true},
+		{"phones[1].number", "456", true},
+		{"age", float64(42), true},
+		{"vip", true, true},
+		{"note", nil, true},
+		{"address", map[string]any{"city": "Paris", "zip": "75001"}, true},
+		{"unknown", nil, false},
+		{"address.unknown", nil, false},
+		{"phones[2]", nil, false},
+		{"name[0]", nil, false},
+		{"phones.number", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			v, ok, err := Get(doc, tt.path)
+			require.NoError(err)
+			require.Equal(tt.ok, ok)
+			require.Equal(tt.want, v)
+		})
+	}
+
+	t.Run("should be not found if document is empty", func(t *testing.T) {
+		v, ok, err := Get("", "a")
+		require.NoError(err)
+		require.False(ok)
+		require.Nil(v)
+	})
+
+	t.Run("should be error if document is invalid", func(t *testing.T) {
+		_, _, err := Get(`{"a":`, "a")
+		require.ErrorIs(err, ErrInvalidDocument)
+	})
+
+	t.Run("should be error if path is invalid", func(t *testing.T) {
+		_, _, err := Get(doc, "a..b")
+		require.ErrorIs(err, ErrInvalidPath)
+	})
+}
+
+func TestEqual(t *testing.T) {
+	require := require.New(t)
+
+	require.True(Equal(float64(42), 42))
+	require.True(Equal(float64(42), int64(42)))
+	require.True(Equal(float64(42), json.Number("42")))
+	require.True(Equal(float64(4.2), float64(4.2)))
+	require.False(Equal(float64(42), "42"))
+	require.False(Equal(float64(42), json.Number("x")))
+	require.True(Equal("a", "a"))
+	require.False(Equal("a", "b"))
+	require.True(Equal(true, true))
+	require.True(Equal(nil, nil))
+	require.True(Equal([]any{"a", float64(1)}, []any{"a", float64(1)}))
+	require.False(Equal("a", nil))
+
+	require.True(Valid(`{"a":[1,2]}`))
+	require.False(Valid(`{"a":`))
+}
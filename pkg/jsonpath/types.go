@@ -0,0 +1,15 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package jsonpath
+
+// Parsed json path, see [Parse]
+type Path []step
+
+// Path step: object key or array index
+type step struct {
+	key   string
+	index int
+	isIdx bool
+}
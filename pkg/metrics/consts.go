@@ -5,6 +5,28 @@
 
 package imetrics
 
+import "github.com/voedger/voedger/pkg/appdef"
+
 const (
 	bitSize = 64
+
+	// summary quantiles are calculated over the last summaryWindowSize observations
+	summaryWindowSize = 1024
+
+	promSuffixBucket  = "_bucket"
+	promSuffixSum     = "_sum"
+	promSuffixCount   = "_count"
+	promLabelLe       = "le"
+	promLabelQuantile = "quantile"
+	promInf           = "+Inf"
 )
+
+// DefaultLatencyBuckets are upper inclusive bounds of histogram buckets in seconds, from 1ms to 10s
+var DefaultLatencyBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// DefaultSummaryQuantiles are quantiles of summaries
+var DefaultSummaryQuantiles = []float64{0.5, 0.9, 0.95, 0.99}
+
+// QNameUnresolved labels observations of the requests to unknown functions,
+// so the labels cardinality does not depend on the requests
+var QNameUnresolved = appdef.NewQName(appdef.SysPackage, "Unresolved")
@@ -7,8 +7,11 @@ package imetrics
 
 import (
 	"bytes"
+	"cmp"
 	"math"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"unsafe"
@@ -18,9 +21,10 @@ import (
 )
 
 type metric struct {
-	name string
-	app  appdef.AppQName
-	vvm  string
+	name  string
+	app   appdef.AppQName
+	vvm   string
+	qName appdef.QName
 }
 
 func (m *metric) Name() string {
@@ -35,15 +39,29 @@ func (m *metric) App() appdef.AppQName {
 	return m.app
 }
 
+func (m *metric) QName() appdef.QName {
+	return m.qName
+}
+
 type mapMetrics struct {
-	metrics map[metric]*MetricValue
-	lock    sync.Mutex
+	metrics        map[metric]*MetricValue
+	histograms     map[metric]*Histogram
+	summaries      map[metric]*Summary
+	defaultBuckets []float64
+	lock           sync.Mutex
 }
 
-func newMetrics() IMetrics {
-	return &mapMetrics{
-		metrics: make(map[metric]*MetricValue),
+func newMetrics(opts ...OptFunc) IMetrics {
+	m := &mapMetrics{
+		metrics:        make(map[metric]*MetricValue),
+		histograms:     make(map[metric]*Histogram),
+		summaries:      make(map[metric]*Summary),
+		defaultBuckets: DefaultLatencyBuckets,
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
 func (m *mapMetrics) AppMetricAddr(metricName string, vvm string, app appdef.AppQName) *MetricValue {
@@ -54,6 +72,15 @@ func (m *mapMetrics) AppMetricAddr(metricName string, vvm string, app appdef.App
 	})
 }
 
+func (m *mapMetrics) AppQNameMetricAddr(metricName string, vvm string, app appdef.AppQName, qName appdef.QName) *MetricValue {
+	return m.get(metric{
+		name:  metricName,
+		app:   app,
+		vvm:   vvm,
+		qName: qName,
+	})
+}
+
 func (m *mapMetrics) MetricAddr(metricName string, vvmName string) *MetricValue {
 	return m.get(metric{
 		name: metricName,
@@ -94,28 +121,165 @@ func (m *mapMetrics) List(cb func(metric IMetric, metricValue float64) (err erro
 	return err
 }
 
+func (m *mapMetrics) Histogram(metricName string, vvmName string, app appdef.AppQName, qName appdef.QName, buckets []float64) *Histogram {
+	key := metric{
+		name:  metricName,
+		app:   app,
+		vvm:   vvmName,
+		qName: qName,
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if h, ok := m.histograms[key]; ok {
+		return h
+	}
+	if buckets == nil {
+		buckets = m.defaultBuckets
+	}
+	h := newHistogram(buckets)
+	m.histograms[key] = h
+	return h
+}
+
+func (m *mapMetrics) Summary(metricName string, vvmName string, app appdef.AppQName, qName appdef.QName) *Summary {
+	key := metric{
+		name:  metricName,
+		app:   app,
+		vvm:   vvmName,
+		qName: qName,
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if s, ok := m.summaries[key]; ok {
+		return s
+	}
+	s := newSummary(DefaultSummaryQuantiles)
+	m.summaries[key] = s
+	return s
+}
+
+func (m *mapMetrics) ListHistograms(cb func(metric IMetric, value HistogramValue) (err error)) (err error) {
+	m.lock.Lock()
+	keys := sortedKeys(m.histograms)
+	histograms := make([]*Histogram, 0, len(keys))
+	for _, key := range keys {
+		histograms = append(histograms, m.histograms[key])
+	}
+	m.lock.Unlock()
+
+	for i, h := range histograms {
+		if err = cb(&keys[i], h.Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mapMetrics) ListSummaries(cb func(metric IMetric, value SummaryValue) (err error)) (err error) {
+	m.lock.Lock()
+	keys := sortedKeys(m.summaries)
+	summaries := make([]*Summary, 0, len(keys))
+	for _, key := range keys {
+		summaries = append(summaries, m.summaries[key])
+	}
+	m.lock.Unlock()
+
+	for i, s := range summaries {
+		if err = cb(&keys[i], s.Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[metric]V) []metric {
+	keys := make([]metric, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b metric) int {
+		return cmp.Or(
+			strings.Compare(a.name, b.name),
+			strings.Compare(a.vvm, b.vvm),
+			strings.Compare(a.app.String(), b.app.String()),
+			strings.Compare(a.qName.String(), b.qName.String()),
+		)
+	})
+	return keys
+}
+
 func ToPrometheus(metric IMetric, metricValue float64) []byte {
 	bb := bytes.Buffer{}
-	bb.WriteString(metric.Name())
-	if metric.App() != appdef.NullAppQName || metric.Vvm() != "" {
-		bb.WriteRune('{')
-		if metric.App() != appdef.NullAppQName {
-			bb.WriteString(`app="`)
-			bb.WriteString(metric.App().String())
-			bb.WriteRune('"')
+	writePrometheusSample(&bb, metric.Name(), metric, "", "", metricValue)
+	return bb.Bytes()
+}
+
+// HistogramToPrometheus returns `_bucket`, `_sum` and `_count` samples of the histogram
+func HistogramToPrometheus(metric IMetric, value HistogramValue) []byte {
+	bb := bytes.Buffer{}
+	for i, count := range value.Counts {
+		le := promInf
+		if i < len(value.Buckets) {
+			le = formatFloat(value.Buckets[i])
 		}
-		if metric.Vvm() != "" {
-			if metric.App() != appdef.NullAppQName {
-				bb.WriteRune(',')
-			}
-			bb.WriteString(`vvm="`)
-			bb.WriteString(metric.Vvm())
-			bb.WriteRune('"')
+		writePrometheusSample(&bb, metric.Name()+promSuffixBucket, metric, promLabelLe, le, float64(count))
+	}
+	writePrometheusSample(&bb, metric.Name()+promSuffixSum, metric, "", "", value.Sum)
+	writePrometheusSample(&bb, metric.Name()+promSuffixCount, metric, "", "", float64(value.Count))
+	return bb.Bytes()
+}
+
+// SummaryToPrometheus returns quantiles, `_sum` and `_count` samples of the summary
+func SummaryToPrometheus(metric IMetric, value SummaryValue) []byte {
+	bb := bytes.Buffer{}
+	for i, q := range value.Quantiles {
+		writePrometheusSample(&bb, metric.Name(), metric, promLabelQuantile, formatFloat(q), value.Values[i])
+	}
+	writePrometheusSample(&bb, metric.Name()+promSuffixSum, metric, "", "", value.Sum)
+	writePrometheusSample(&bb, metric.Name()+promSuffixCount, metric, "", "", float64(value.Count))
+	return bb.Bytes()
+}
+
+// TypeToPrometheus returns `# TYPE` line, e.g. `# TYPE voedger_cp_command_duration_seconds histogram`
+func TypeToPrometheus(metricName string, metricType string) []byte {
+	return []byte("# TYPE " + metricName + " " + metricType + "\n")
+}
+
+func writePrometheusSample(bb *bytes.Buffer, name string, metric IMetric, extraLabel, extraLabelValue string, value float64) {
+	bb.WriteString(name)
+	labels := 0
+	writeLabel := func(label, labelValue string) {
+		if labels == 0 {
+			bb.WriteRune('{')
+		} else {
+			bb.WriteRune(',')
 		}
+		labels++
+		bb.WriteString(label)
+		bb.WriteString(`="`)
+		bb.WriteString(labelValue)
+		bb.WriteRune('"')
+	}
+	if metric.App() != appdef.NullAppQName {
+		writeLabel("app", metric.App().String())
+	}
+	if metric.Vvm() != "" {
+		writeLabel("vvm", metric.Vvm())
+	}
+	if metric.QName() != appdef.NullQName {
+		writeLabel("qname", metric.QName().String())
+	}
+	if extraLabel != "" {
+		writeLabel(extraLabel, extraLabelValue)
+	}
+	if labels > 0 {
 		bb.WriteRune('}')
 	}
 	bb.WriteRune(' ')
-	bb.WriteString(strconv.FormatFloat(metricValue, 'f', -1, bitSize))
+	bb.WriteString(formatFloat(value))
 	bb.WriteRune('\n')
-	return bb.Bytes()
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, bitSize)
 }
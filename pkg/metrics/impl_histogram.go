@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package imetrics
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+func newHistogram(buckets []float64) *Histogram {
+	for i := 1; i < len(buckets); i++ {
+		if buckets[i] <= buckets[i-1] {
+			// notest
+			panic(fmt.Sprintf("histogram buckets must be in strictly ascending order: %v", buckets))
+		}
+	}
+	return &Histogram{
+		buckets: slices.Clone(buckets),
+		counts:  make([]atomic.Uint64, len(buckets)+1),
+	}
+}
+
+// Observe adds the value to the histogram
+//
+// @ConcurrentAccess
+func (h *Histogram) Observe(value float64) {
+	h.counts[sort.SearchFloat64s(h.buckets, value)].Add(1)
+	h.sum.Increase(value)
+}
+
+// ObserveSince adds seconds elapsed since start to the histogram
+//
+// @ConcurrentAccess
+func (h *Histogram) ObserveSince(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+// Value returns the snapshot of the histogram
+//
+// @ConcurrentAccess
+func (h *Histogram) Value() HistogramValue {
+	res := HistogramValue{
+		Buckets: h.buckets,
+		Counts:  make([]uint64, len(h.counts)),
+		Sum:     h.sum.Value(),
+	}
+	for i := range h.counts {
+		res.Count += h.counts[i].Load()
+		res.Counts[i] = res.Count
+	}
+	return res
+}
+
+func newSummary(quantiles []float64) *Summary {
+	return &Summary{
+		quantiles: quantiles,
+	}
+}
+
+// Observe adds the value to the summary
+//
+// @ConcurrentAccess
+func (s *Summary) Observe(value float64) {
+	s.Lock()
+	s.window[s.pos] = value
+	s.pos = (s.pos + 1) % summaryWindowSize
+	if s.filled < summaryWindowSize {
+		s.filled++
+	}
+	s.count++
+	s.sum += value
+	s.Unlock()
+}
+
+// ObserveSince adds seconds elapsed since start to the summary
+//
+// @ConcurrentAccess
+func (s *Summary) ObserveSince(start time.Time) {
+	s.Observe(time.Since(start).Seconds())
+}
+
+// Value returns the snapshot of the summary
+//
+// @ConcurrentAccess
+func (s *Summary) Value() SummaryValue {
+	s.Lock()
+	observations := slices.Clone(s.window[:s.filled])
+	res := SummaryValue{
+		Quantiles: s.quantiles,
+		Values:    make([]float64, len(s.quantiles)),
+		Sum:       s.sum,
+		Count:     s.count,
+	}
+	s.Unlock()
+
+	slices.Sort(observations)
+	for i, q := range s.quantiles {
+		if len(observations) == 0 {
+			res.Values[i] = math.NaN()
+			continue
+		}
+		idx := int(math.Ceil(q*float64(len(observations)))) - 1
+		res.Values[i] = observations[max(idx, 0)]
+	}
+	return res
+}
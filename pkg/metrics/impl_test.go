@@ -7,6 +7,7 @@ package imetrics
 
 import (
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -36,6 +37,26 @@ func TestBasicUsage(t *testing.T) {
 	require.True(collection["somecounter_total{vvm=\"host1\"} 7\n"])
 }
 
+func TestAppQNameMetricAddr(t *testing.T) {
+	require := require.New(t)
+
+	metrics := Provide()
+	qName := appdef.NewQName("sys", "Records")
+
+	mv := metrics.AppQNameMetricAddr("somecounter_total", "host1", istructs.AppQName_test1_app1, qName)
+	mv.Increase(2)
+	require.Same(mv, metrics.AppQNameMetricAddr("somecounter_total", "host1", istructs.AppQName_test1_app1, qName))
+	require.NotSame(mv, metrics.AppMetricAddr("somecounter_total", "host1", istructs.AppQName_test1_app1))
+	require.Equal(2.0, mv.Value())
+
+	collection := make(map[string]bool)
+	_ = metrics.List(func(metric IMetric, metricValue float64) (err error) {
+		collection[string(ToPrometheus(metric, metricValue))] = true
+		return err
+	})
+	require.True(collection["somecounter_total{app=\"test1/app1\",vvm=\"host1\",qname=\"sys.Records\"} 2\n"])
+}
+
 func TestMetrics_List(t *testing.T) {
 	require := require.New(t)
 
@@ -114,3 +135,103 @@ func TestToPrometheus(t *testing.T) {
 		})
 	}
 }
+
+func TestHistogram(t *testing.T) {
+	require := require.New(t)
+
+	metrics := Provide()
+	qName := appdef.NewQName("test", "cmd")
+
+	h := metrics.Histogram("something_seconds", "host", istructs.AppQName_test1_app1, qName, []float64{0.1, 1})
+	require.Same(h, metrics.Histogram("something_seconds", "host", istructs.AppQName_test1_app1, qName, nil))
+	h.Observe(0.05)
+	h.Observe(0.1)
+	h.Observe(0.5)
+	h.Observe(5)
+
+	metrics.Histogram("another_seconds", "host", appdef.NullAppQName, appdef.NullQName, nil).Observe(0.003)
+
+	names := []string{}
+	collection := map[string][]byte{}
+	require.NoError(metrics.ListHistograms(func(metric IMetric, value HistogramValue) (err error) {
+		names = append(names, metric.Name())
+		collection[metric.Name()] = HistogramToPrometheus(metric, value)
+		return nil
+	}))
+	require.Equal([]string{"another_seconds", "something_seconds"}, names)
+
+	labels := `app="test1/app1",vvm="host",qname="test.cmd"`
+	require.Equal(`something_seconds_bucket{`+labels+`,le="0.1"} 2
+something_seconds_bucket{`+labels+`,le="1"} 3
+something_seconds_bucket{`+labels+`,le="+Inf"} 4
+something_seconds_sum{`+labels+`} 5.65
+something_seconds_count{`+labels+`} 4
+`, string(collection["something_seconds"]))
+
+	another := string(collection["another_seconds"])
+	require.Contains(another, `another_seconds_bucket{vvm="host",le="0.001"} 0`+"\n")
+	require.Contains(another, `another_seconds_bucket{vvm="host",le="0.005"} 1`+"\n")
+	require.Contains(another, `another_seconds_bucket{vvm="host",le="+Inf"} 1`+"\n")
+	require.Contains(another, `another_seconds_count{vvm="host"} 1`+"\n")
+
+	t.Run("default buckets", func(t *testing.T) {
+		metrics := Provide(WithDefaultBuckets([]float64{1, 2}))
+		require.Equal([]float64{1, 2}, metrics.Histogram("h", "", appdef.NullAppQName, appdef.NullQName, nil).Value().Buckets)
+	})
+
+	t.Run("panic on wrong buckets", func(t *testing.T) {
+		require.Panics(func() {
+			metrics.Histogram("wrong", "", appdef.NullAppQName, appdef.NullQName, []float64{1, 1})
+		})
+	})
+
+	t.Run("error from callback", func(t *testing.T) {
+		testErr := errors.New("boom")
+		require.ErrorIs(metrics.ListHistograms(func(IMetric, HistogramValue) error { return testErr }), testErr)
+	})
+}
+
+func TestSummary(t *testing.T) {
+	require := require.New(t)
+
+	metrics := Provide()
+	s := metrics.Summary("something_seconds", "host", istructs.AppQName_test1_app1, appdef.NullQName)
+	require.Same(s, metrics.Summary("something_seconds", "host", istructs.AppQName_test1_app1, appdef.NullQName))
+
+	t.Run("no observations", func(t *testing.T) {
+		value := s.Value()
+		require.Zero(value.Count)
+		require.True(math.IsNaN(value.Values[0]))
+	})
+
+	for i := 1; i <= 100; i++ {
+		s.Observe(float64(i))
+	}
+
+	var res []byte
+	require.NoError(metrics.ListSummaries(func(metric IMetric, value SummaryValue) (err error) {
+		res = SummaryToPrometheus(metric, value)
+		return nil
+	}))
+	labels := `app="test1/app1",vvm="host"`
+	require.Equal(`something_seconds{`+labels+`,quantile="0.5"} 50
+something_seconds{`+labels+`,quantile="0.9"} 90
+something_seconds{`+labels+`,quantile="0.95"} 95
+something_seconds{`+labels+`,quantile="0.99"} 99
+something_seconds_sum{`+labels+`} 5050
+something_seconds_count{`+labels+`} 100
+`, string(res))
+
+	t.Run("sliding window", func(t *testing.T) {
+		for range summaryWindowSize {
+			s.Observe(1000)
+		}
+		value := s.Value()
+		require.Equal(uint64(100+summaryWindowSize), value.Count)
+		require.Equal(float64(1000), value.Values[0])
+	})
+}
+
+func TestTypeToPrometheus(t *testing.T) {
+	require.Equal(t, "# TYPE something_seconds histogram\n", string(TypeToPrometheus("something_seconds", "histogram")))
+}
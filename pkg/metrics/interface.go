@@ -15,6 +15,9 @@ type IMetric interface {
 
 	// App returns appdef.NullAppQName when not specified
 	App() appdef.AppQName
+
+	// QName returns appdef.NullQName when not specified
+	QName() appdef.QName
 }
 
 type IMetrics interface {
@@ -44,8 +47,41 @@ type IMetrics interface {
 	// @ConcurrentAccess
 	AppMetricAddr(metricName string, vvmName string, app appdef.AppQName) *MetricValue
 
+	// Returns address of metric value labeled with app and qName.
+	// Only use atomic operations with that address!
+	//
+	// @ConcurrentAccess
+	AppQNameMetricAddr(metricName string, vvmName string, app appdef.AppQName, qName appdef.QName) *MetricValue
+
 	// GetAll lists current values of all metrics
 	//
 	// @ConcurrentAccess
 	List(cb func(metric IMetric, metricValue float64) (err error)) (err error)
+
+	// Returns the histogram, creates it on the first call.
+	// app and qName are optional labels, use appdef.NullAppQName and appdef.NullQName to omit them.
+	// buckets are upper inclusive bounds in ascending order, +Inf bucket is added automatically.
+	// nil buckets -> default buckets are used, see WithDefaultBuckets.
+	// Buckets are applied on the histogram creation only.
+	// Panics if buckets are not in strictly ascending order.
+	//
+	// @ConcurrentAccess
+	Histogram(metricName string, vvmName string, app appdef.AppQName, qName appdef.QName, buckets []float64) *Histogram
+
+	// Returns the summary, creates it on the first call.
+	// app and qName are optional labels, use appdef.NullAppQName and appdef.NullQName to omit them.
+	// Quantiles are calculated over the last observations, DefaultSummaryQuantiles are used.
+	//
+	// @ConcurrentAccess
+	Summary(metricName string, vvmName string, app appdef.AppQName, qName appdef.QName) *Summary
+
+	// Lists current values of all histograms ordered by metric name
+	//
+	// @ConcurrentAccess
+	ListHistograms(cb func(metric IMetric, value HistogramValue) (err error)) (err error)
+
+	// Lists current values of all summaries ordered by metric name
+	//
+	// @ConcurrentAccess
+	ListSummaries(cb func(metric IMetric, value SummaryValue) (err error)) (err error)
 }
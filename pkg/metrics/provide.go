@@ -7,6 +7,14 @@
 package imetrics
 
 // Provide s.e.
-func Provide() IMetrics {
-	return newMetrics()
+func Provide(opts ...OptFunc) IMetrics {
+	return newMetrics(opts...)
+}
+
+// WithDefaultBuckets sets buckets of histograms created with nil buckets
+// DefaultLatencyBuckets are used by default
+func WithDefaultBuckets(buckets []float64) OptFunc {
+	return func(m *mapMetrics) {
+		m.defaultBuckets = buckets
+	}
 }
@@ -8,6 +8,7 @@ package imetrics
 
 import (
 	"math"
+	"sync"
 	"sync/atomic"
 	"unsafe"
 )
@@ -29,3 +30,51 @@ func (m *MetricValue) Increase(delta float64) {
 		)
 	}
 }
+
+// Value returns the current metric value
+func (m *MetricValue) Value() float64 {
+	return math.Float64frombits(atomic.LoadUint64((*uint64)(unsafe.Pointer(m))))
+}
+
+type OptFunc func(m *mapMetrics)
+
+// Histogram counts observations into buckets
+type Histogram struct {
+	buckets []float64       // upper inclusive bounds, ascending
+	counts  []atomic.Uint64 // len(buckets)+1, the last is +Inf
+	sum     MetricValue
+}
+
+// HistogramValue is the snapshot of the histogram
+type HistogramValue struct {
+	// upper inclusive bounds, +Inf bucket is not included
+	Buckets []float64
+
+	// cumulative counts per bucket, the last is for +Inf bucket
+	Counts []uint64
+
+	Sum   float64
+	Count uint64
+}
+
+// Summary calculates quantiles over the sliding window of the last observations
+type Summary struct {
+	sync.Mutex
+	quantiles []float64
+	window    [summaryWindowSize]float64
+	pos       int // next position in window
+	filled    int
+	count     uint64
+	sum       float64
+}
+
+// SummaryValue is the snapshot of the summary
+type SummaryValue struct {
+	Quantiles []float64
+
+	// value per quantile, NaN if there are no observations
+	Values []float64
+
+	Sum   float64
+	Count uint64
+}
@@ -15,6 +15,9 @@ const (
 
 const rootWorkspaceName = appdef.SysWorkspaceName // "Workspace"
 
+// Request subject reference in POLICY expressions
+const currentSubject Ident = "CURRENT_SUBJECT"
+
 var QNameWDocBLOB = appdef.NewQName(appdef.SysPackage, "BLOB")
 
 const ExportedAppsFile = "apps.yaml"
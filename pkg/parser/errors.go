@@ -11,6 +11,7 @@ import (
 	"github.com/alecthomas/participle/v2/lexer"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 )
 
 var ErrDirContainsNoSchemaFiles = errors.New("no schema files in directory")
@@ -34,9 +35,13 @@ var ErrInheritanceFromSysWorkspaceNotAllowed = errors.New("explicit inheritance
 var ErrScheduledProjectorDeprecated = errors.New("scheduled projector deprecated; use jobs instead")
 
 var ErrMustBeNotNull = errors.New("field has to be NOT NULL")
+var ErrCurrentSubjectNotAllowed = errors.New("CURRENT_SUBJECT is allowed in POLICY expressions only")
 var ErrCircularReferenceInInherits = errors.New("circular reference in INHERITS")
 var ErrRegexpCheckOnlyForVarcharField = errors.New("regexp CHECK only available for varchar field")
+var ErrEncryptedOnlyForVarcharOrBytesField = errors.New("ENCRYPTED only available for varchar or bytes field")
 var ErrMaxFieldLengthTooLarge = fmt.Errorf("maximum field length is %d", appdef.MaxFieldLength)
+var ErrDecimalPrecisionOutOfRange = fmt.Errorf("decimal precision must be between 1 and %d", decimal.MaxPrecision)
+var ErrDecimalScaleGreaterThanPrecision = errors.New("decimal scale must not be greater than precision")
 var ErrOnlyInsertForOdocOrORecord = errors.New("only INSERT allowed for ODoc or ORecord")
 var ErrPackageWithSameNameAlreadyIncludedInApp = errors.New("package with the same name already included in application")
 var ErrStorageDeclaredOnlyInSys = errors.New("storages are only declared in sys package")
@@ -48,6 +53,10 @@ var ErrPositiveValueOnly = errors.New("positive value only allowed")
 var ErrBlobFieldOnlyInTable = errors.New("BLOB field only allowed in table")
 var ErrJobWithoutCronSchedule = errors.New("job without cron schedule is not allowed")
 var ErrQueryMustHaveReturn = errors.New("query must have a return type")
+var ErrN10nPayloadOnlyForViews = errors.New("N10nPayload is only available for views")
+var ErrAuditOnlyForCAndWTables = errors.New("AUDIT is only available for CDoc, CRecord, WDoc and WRecord tables")
+var ErrCacheOnlyForQueries = errors.New("CACHE is only available for queries")
+var ErrCacheTTLMustBePositive = errors.New("CACHE time to live must be positive")
 
 func ErrInvalidLocalPackageName(name string) error {
 	return fmt.Errorf("invalid local package name %s", name)
@@ -186,6 +195,10 @@ func ErrUndefinedField(name string) error {
 	return fmt.Errorf("undefined field %s", name)
 }
 
+func ErrExprFunctionNotSupported(name, stmt string) error {
+	return fmt.Errorf("function %s not supported in %s expression", name, stmt)
+}
+
 func ErrFieldAlreadyInUnique(name string) error {
 	return fmt.Errorf("field %s already in unique constraint", name)
 }
@@ -226,6 +239,18 @@ func ErrViewFieldBytes(name string) error {
 	return fmt.Errorf("bytes field %s not supported in partition key", name)
 }
 
+func ErrViewFieldJSON(name string) error {
+	return fmt.Errorf("json field %s not supported in view key", name)
+}
+
+func ErrViewFieldEncrypted(name string) error {
+	return fmt.Errorf("encrypted field %s not supported in view key", name)
+}
+
+func ErrUniqueFieldEncrypted(name string) error {
+	return fmt.Errorf("encrypted field %s not supported in unique constraint", name)
+}
+
 func ErrVarcharFieldInCC(name string) error {
 	return fmt.Errorf("varchar field %s can only be the last one in clustering key", name)
 }
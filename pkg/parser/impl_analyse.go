@@ -14,6 +14,7 @@ import (
 	"github.com/robfig/cron/v3"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/goutils/set"
 	"github.com/voedger/voedger/pkg/istructs"
 )
@@ -108,6 +109,8 @@ func analyse(c *basicContext, packages []*PackageSchemaAST) {
 				analyzeRate(v, ictx)
 			case *LimitStmt:
 				analyzeLimit(v, ictx)
+			case *PolicyStmt:
+				analyzePolicy(v, ictx)
 			}
 		})
 	}
@@ -533,6 +536,37 @@ func analyzeRate(r *RateStmt, c *iterateCtx) {
 	r.workspace = c.mustCurrentWorkspace()
 }
 
+func analyzePolicy(policy *PolicyStmt, c *iterateCtx) {
+	err := resolveInCtx(policy.Table, c, func(t *TableStmt, schema *PackageSchemaAST) error {
+		policy.Table.qName = schema.NewQName(t.Name)
+		return nil
+	})
+	if err != nil {
+		c.stmtErr(&policy.Table.Pos, err)
+	}
+	for i := range policy.Roles {
+		role := &policy.Roles[i]
+		if err := resolveInCtx(*role, c, func(r *RoleStmt, schema *PackageSchemaAST) error {
+			role.qName = schema.NewQName(r.Name)
+			return nil
+		}); err != nil {
+			c.stmtErr(&role.Pos, err)
+		}
+	}
+	if len(policy.Actions) == 0 {
+		policy.ops = []appdef.OperationKind{appdef.OperationKind_Select, appdef.OperationKind_Update}
+	}
+	for _, a := range policy.Actions {
+		if a.Select {
+			policy.ops = append(policy.ops, appdef.OperationKind_Select)
+		}
+		if a.Update {
+			policy.ops = append(policy.ops, appdef.OperationKind_Update)
+		}
+	}
+	policy.workspace = c.mustCurrentWorkspace()
+}
+
 func analyzeLimit(limit *LimitStmt, c *iterateCtx) {
 	err := resolveInCtx(limit.RateName, c, func(l *RateStmt, schema *PackageSchemaAST) error {
 		limit.RateName.qName = schema.NewQName(l.Name)
@@ -686,6 +720,20 @@ func analyzeDatatype(dt *DataType, c *iterateCtx, isTable bool) {
 			c.stmtErr(&bb.Pos, ErrMaxFieldLengthTooLarge)
 		}
 	}
+	js := dt.JSON
+	if js != nil && js.MaxLen != nil {
+		if *js.MaxLen > uint64(appdef.MaxFieldLength) {
+			c.stmtErr(&js.Pos, ErrMaxFieldLengthTooLarge)
+		}
+	}
+	if dec := dt.Decimal; dec != nil {
+		p, s := dec.precisionScale()
+		if p == 0 || p > decimal.MaxPrecision {
+			c.stmtErr(&dec.Pos, ErrDecimalPrecisionOutOfRange)
+		} else if s > p {
+			c.stmtErr(&dec.Pos, ErrDecimalScaleGreaterThanPrecision)
+		}
+	}
 }
 
 func analyzeView(view *ViewStmt, c *iterateCtx) {
@@ -707,6 +755,9 @@ func analyzeView(view *ViewStmt, c *iterateCtx) {
 			} else {
 				fields[string(f.Name.Value)] = i
 			}
+			if f.Encrypted && f.Type.Varchar == nil && f.Type.Bytes == nil {
+				c.stmtErr(&f.Pos, ErrEncryptedOnlyForVarcharOrBytesField)
+			}
 			analyzeDatatype(&fe.Field.Type, c, false)
 		} else if fe.RefField != nil {
 			rf := fe.RefField
@@ -744,6 +795,12 @@ func analyzeView(view *ViewStmt, c *iterateCtx) {
 			if fld.Type.Bytes != nil {
 				c.stmtErr(&pkf.Pos, ErrViewFieldBytes(string(pkf.Value)))
 			}
+			if fld.Type.JSON != nil {
+				c.stmtErr(&pkf.Pos, ErrViewFieldJSON(string(pkf.Value)))
+			}
+			if fld.Encrypted {
+				c.stmtErr(&pkf.Pos, ErrViewFieldEncrypted(string(pkf.Value)))
+			}
 		}
 	}
 
@@ -769,6 +826,12 @@ func analyzeView(view *ViewStmt, c *iterateCtx) {
 			if fld.Type.Bytes != nil && !last {
 				c.stmtErr(&ccf.Pos, ErrBytesFieldInCC(string(ccf.Value)))
 			}
+			if fld.Type.JSON != nil {
+				c.stmtErr(&ccf.Pos, ErrViewFieldJSON(string(ccf.Value)))
+			}
+			if fld.Encrypted {
+				c.stmtErr(&ccf.Pos, ErrViewFieldEncrypted(string(ccf.Value)))
+			}
 		}
 	}
 
@@ -1151,6 +1214,25 @@ func analyseWith(with *[]WithItem, statement IStatement, c *iterateCtx) {
 		if item.Comment != nil {
 			comment = item
 		}
+		if item.N10nPayload {
+			if _, ok := statement.(*ViewStmt); !ok {
+				c.stmtErr(statement.GetPos(), ErrN10nPayloadOnlyForViews)
+			}
+		}
+		if item.Audit {
+			t, ok := statement.(*TableStmt)
+			if !ok || (t.tableTypeKind != appdef.TypeKind_CDoc && t.tableTypeKind != appdef.TypeKind_CRecord &&
+				t.tableTypeKind != appdef.TypeKind_WDoc && t.tableTypeKind != appdef.TypeKind_WRecord) {
+				c.stmtErr(statement.GetPos(), ErrAuditOnlyForCAndWTables)
+			}
+		}
+		if item.Cache != nil {
+			if _, ok := statement.(*QueryStmt); !ok {
+				c.stmtErr(statement.GetPos(), ErrCacheOnlyForQueries)
+			} else if item.Cache.TimeUnitAmounts == 0 {
+				c.stmtErr(statement.GetPos(), ErrCacheTTLMustBePositive)
+			}
+		}
 		for j := range item.Tags {
 			tag := item.Tags[j]
 			if err := resolveInCtx(tag, c, func(t *TagStmt, tPkg *PackageSchemaAST) error {
@@ -1424,6 +1506,24 @@ func lookupField(items []TableItemExpr, name Ident, c *iterateCtx) (found bool)
 	return found
 }
 
+// returns is the field defined in items or in their field sets is encrypted.
+// Unresolved field sets are reported by lookupField
+func lookupEncryptedField(items []TableItemExpr, name Ident, c *iterateCtx) (encrypted bool) {
+	for i := range items {
+		item := items[i]
+		if item.Field != nil && item.Field.Name == name {
+			return item.Field.Encrypted
+		}
+		if item.FieldSet != nil {
+			_ = resolveInCtx(item.FieldSet.Type, c, func(t *TypeStmt, _ *PackageSchemaAST) error {
+				encrypted = encrypted || lookupEncryptedField(t.Items, name, c)
+				return nil
+			})
+		}
+	}
+	return encrypted
+}
+
 func analyseFields(items []TableItemExpr, c *iterateCtx, isTable bool) {
 	fieldsInUniques := make([]Ident, 0)
 	constraintNames := make(map[string]bool)
@@ -1441,6 +1541,11 @@ func analyseFields(items []TableItemExpr, c *iterateCtx, isTable bool) {
 					c.stmtErr(&field.CheckRegexp.Pos, ErrRegexpCheckOnlyForVarcharField)
 				}
 			}
+			if field.Encrypted {
+				if field.Type.DataType == nil || (field.Type.DataType.Varchar == nil && field.Type.DataType.Bytes == nil) {
+					c.stmtErr(&field.Pos, ErrEncryptedOnlyForVarcharOrBytesField)
+				}
+			}
 			if field.Type.DataType != nil {
 				analyzeDatatype(field.Type.DataType, c, isTable)
 			} else {
@@ -1495,6 +1600,9 @@ func analyseFields(items []TableItemExpr, c *iterateCtx, isTable bool) {
 					c.stmtErr(&item.Constraint.Pos, ErrUndefinedField(string(item.Constraint.UniqueField.Field)))
 					continue
 				}
+				if lookupEncryptedField(items, item.Constraint.UniqueField.Field, c) {
+					c.stmtErr(&item.Constraint.Pos, ErrUniqueFieldEncrypted(string(item.Constraint.UniqueField.Field)))
+				}
 			} else if item.Constraint.Unique != nil {
 				for _, field := range item.Constraint.Unique.Fields {
 					for _, f := range fieldsInUniques {
@@ -1507,6 +1615,9 @@ func analyseFields(items []TableItemExpr, c *iterateCtx, isTable bool) {
 						c.stmtErr(&item.Constraint.Pos, ErrUndefinedField(string(field)))
 						continue
 					}
+					if lookupEncryptedField(items, field, c) {
+						c.stmtErr(&item.Constraint.Pos, ErrUniqueFieldEncrypted(string(field)))
+					}
 					fieldsInUniques = append(fieldsInUniques, field)
 				}
 			}
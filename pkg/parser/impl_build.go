@@ -10,6 +10,8 @@ import (
 	"slices"
 	"time"
 
+	"github.com/alecthomas/participle/v2/lexer"
+
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appdef/constraints"
 	"github.com/voedger/voedger/pkg/appdef/filter"
@@ -53,6 +55,7 @@ func (c *buildContext) build() error {
 		c.grantsAndRevokes,
 		c.packages,
 		c.limits,
+		c.policies,
 	}
 	for _, step := range steps {
 		if err := step(); err != nil {
@@ -95,17 +98,7 @@ func (c *buildContext) rates() error {
 			if rate.Value.TimeUnitAmounts != nil {
 				timeUnitAmount = *rate.Value.TimeUnitAmounts
 			}
-			if rate.Value.TimeUnit.Second {
-				period = time.Duration(timeUnitAmount) * time.Second
-			} else if rate.Value.TimeUnit.Minute {
-				period = time.Duration(timeUnitAmount) * time.Minute
-			} else if rate.Value.TimeUnit.Hour {
-				period = time.Duration(timeUnitAmount) * time.Hour
-			} else if rate.Value.TimeUnit.Day {
-				period = time.Duration(timeUnitAmount) * 24 * time.Hour
-			} else if rate.Value.TimeUnit.Year {
-				period = time.Duration(timeUnitAmount) * 365 * 24 * time.Hour
-			}
+			period = time.Duration(timeUnitAmount) * rate.Value.TimeUnit.duration()
 			wsb := rate.workspace.mustBuilder(c)
 			if rate.ObjectScope != nil {
 				if rate.ObjectScope.PerAppPartition {
@@ -192,6 +185,29 @@ func (c *buildContext) limits() error {
 	return nil
 }
 
+func (c *buildContext) policies() error {
+	for _, schema := range c.app.Packages {
+		iteratePackageStmt(schema, &c.basicContext, func(policy *PolicyStmt, _ *iterateCtx) {
+			wsb := policy.workspace.mustBuilder(c)
+			tab, ok := c.adb.AppDef().Type(policy.Table.qName).(appdef.IWithFields)
+			if !ok {
+				return // table is not resolved, error is reported on the analysis stage
+			}
+			expr, err := checkExpression(policy.Using, exprScope{stmt: "POLICY", fields: tab, subject: true})
+			if err != nil {
+				c.stmtErr(&policy.Pos, err)
+				return
+			}
+			roles := make([]appdef.QName, 0, len(policy.Roles))
+			for _, r := range policy.Roles {
+				roles = append(roles, r.qName)
+			}
+			wsb.AddRowPolicy(schema.NewQName(policy.Name), policy.ops, policy.Table.qName, roles, expr, policy.Comments...)
+		})
+	}
+	return nil
+}
+
 type wsBuilder struct {
 	w   *WorkspaceStmt
 	bld appdef.IWorkspaceBuilder
@@ -489,6 +505,11 @@ func (c *buildContext) views() error {
 			}
 			c.addComments(view, vb())
 			c.applyTags(view.With, c.defCtx().defBuilder.(appdef.ITagger))
+			for _, item := range view.With {
+				if item.N10nPayload {
+					vb().SetN10nPayload(true)
+				}
+			}
 
 			resolveConstraints := func(f *ViewField) []appdef.IConstraint {
 				cc := []appdef.IConstraint{}
@@ -501,6 +522,12 @@ func (c *buildContext) views() error {
 					if (f.Type.Varchar != nil) && (f.Type.Varchar.MaxLen != nil) {
 						cc = append(cc, constraints.MaxLen(uint16(*f.Type.Varchar.MaxLen))) // nolint G115: checked in [analyseFields]
 					}
+				case appdef.DataKind_json:
+					if f.Type.JSON.MaxLen != nil {
+						cc = append(cc, constraints.MaxLen(uint16(*f.Type.JSON.MaxLen))) // nolint G115: checked in [analyseFields]
+					}
+				case appdef.DataKind_decimal:
+					cc = append(cc, f.Type.Decimal.constraints()...)
 				}
 				return cc
 			}
@@ -549,6 +576,9 @@ func (c *buildContext) views() error {
 				if f.Field != nil {
 					k := dataTypeToDataKind(f.Field.Type)
 					vb().Value().AddDataField(string(f.Field.Name.Value), appdef.SysDataName(k), f.Field.NotNull, resolveConstraints(f.Field)...)
+					if f.Field.Encrypted {
+						vb().Value().SetFieldEncrypted(string(f.Field.Name.Value))
+					}
 					comment(f.Field.Name.Value, f.Field.Statement)
 					return
 				}
@@ -620,6 +650,22 @@ func (c *buildContext) applyTags(with []WithItem, t appdef.ITagger) {
 	}
 }
 
+func (c *buildContext) applyAudit(with []WithItem, r appdef.IRecordBuilder) {
+	for _, item := range with {
+		if item.Audit {
+			r.SetAudit()
+		}
+	}
+}
+
+func (c *buildContext) applyQueryCache(with []WithItem, q appdef.IQueryBuilder) {
+	for _, item := range with {
+		if item.Cache != nil {
+			q.SetCacheTTL(time.Duration(item.Cache.TimeUnitAmounts) * item.Cache.TimeUnit.duration())
+		}
+	}
+}
+
 func (c *buildContext) queries() error {
 	for _, schema := range c.app.Packages {
 		iteratePackageStmt(schema, &c.basicContext, func(q *QueryStmt, ictx *iterateCtx) {
@@ -646,6 +692,7 @@ func (c *buildContext) queries() error {
 				b.States().Add(state.storageQName, state.entityQNames...)
 			}
 			c.applyTags(q.With, b)
+			c.applyQueryCache(q.With, b)
 		})
 	}
 	return nil
@@ -699,6 +746,7 @@ func (c *buildContext) table(schema *PackageSchemaAST, table *TableStmt) {
 		c.defCtx().defBuilder.(appdef.IWithAbstractBuilder).SetAbstract()
 	}
 	c.applyTags(table.With, c.defCtx().defBuilder.(appdef.ITagger))
+	c.applyAudit(table.With, c.defCtx().defBuilder.(appdef.IRecordBuilder))
 	c.popDef()
 }
 
@@ -741,6 +789,14 @@ func (c *buildContext) addDataTypeField(field *FieldExpr) {
 			cc = append(cc, constraints.Pattern(field.CheckRegexp.Regexp))
 		}
 		bld.AddField(fieldName, appdef.DataKind_string, field.NotNull, cc...)
+	} else if field.Type.DataType.JSON != nil {
+		cc := make([]appdef.IConstraint, 0)
+		if field.Type.DataType.JSON.MaxLen != nil {
+			cc = append(cc, constraints.MaxLen(uint16(*field.Type.DataType.JSON.MaxLen))) // nolint G115: checked in [analyseFields]
+		}
+		bld.AddField(fieldName, appdef.DataKind_json, field.NotNull, cc...)
+	} else if field.Type.DataType.Decimal != nil {
+		bld.AddField(fieldName, appdef.DataKind_decimal, field.NotNull, field.Type.DataType.Decimal.constraints()...)
 	} else if field.Type.DataType.Blob {
 		bld.AddRefField(fieldName, field.NotNull, QNameWDocBLOB)
 	} else {
@@ -752,6 +808,10 @@ func (c *buildContext) addDataTypeField(field *FieldExpr) {
 		// TODO: Support different verification kindsbuilder, &c
 	}
 
+	if field.Encrypted {
+		bld.SetFieldEncrypted(fieldName)
+	}
+
 	comments := field.GetComments()
 	if len(comments) > 0 {
 		bld.SetFieldComment(fieldName, comments...)
@@ -845,6 +905,23 @@ func (c *buildContext) addConstraintToDef(constraint *TableConstraint) {
 	}
 }
 
+func (c *buildContext) addCheckToDef(name Ident, pos *lexer.Position, expression *Expression) {
+	tabName := c.defCtx().qname
+	tab := c.adb.AppDef().Type(tabName).(appdef.IWithFields)
+	expr, err := checkExpression(expression, exprScope{stmt: "CHECK", fields: tab})
+	if err != nil {
+		c.stmtErr(pos, err)
+		return
+	}
+	if name == "" {
+		// generate check name if empty
+		const nameFmt = "%02d"
+		c.defCtx().checks++
+		name = Ident(fmt.Sprintf(nameFmt, c.defCtx().checks))
+	}
+	c.defCtx().defBuilder.(appdef.IChecksBuilder).AddCheck(appdef.CheckQName(tabName, string(name)), expr)
+}
+
 func (c *buildContext) addNestedTableToDef(schema *PackageSchemaAST, nested *NestedTableStmt) {
 	nestedTable := &nested.Table
 	if nestedTable.tableTypeKind == appdef.TypeKind_null {
@@ -863,6 +940,7 @@ func (c *buildContext) addNestedTableToDef(schema *PackageSchemaAST, nested *Nes
 		c.pushDef(contQName, nestedTable.tableTypeKind, nestedTable.workspace)
 		c.addTableItems(schema, nestedTable.Items)
 		c.applyTags(nestedTable.With, c.defCtx().defBuilder.(appdef.ITagger))
+		c.applyAudit(nestedTable.With, c.defCtx().defBuilder.(appdef.IRecordBuilder))
 		c.popDef()
 	}
 
@@ -897,6 +975,15 @@ func (c *buildContext) addTableItems(schema *PackageSchemaAST, items []TableItem
 			c.addTableItems(schema, item.FieldSet.typ.Items)
 		}
 	}
+
+	// checks may refer to any fields, so they are added after all fields
+	for _, item := range items {
+		if item.Field != nil && item.Field.CheckExpression != nil && item.Field.CheckExpression.Enforced {
+			c.addCheckToDef("", &item.Field.Pos, &item.Field.CheckExpression.Expression)
+		} else if item.Constraint != nil && item.Constraint.Check != nil && item.Constraint.Check.Enforced {
+			c.addCheckToDef(item.Constraint.ConstraintName, &item.Constraint.Pos, &item.Constraint.Check.Expression)
+		}
+	}
 }
 
 type defBuildContext struct {
@@ -904,6 +991,7 @@ type defBuildContext struct {
 	qname      appdef.QName
 	kind       appdef.TypeKind
 	names      map[string]bool
+	checks     int // unnamed checks counter
 }
 
 func (c *defBuildContext) checkName(name string) error {
@@ -17,6 +17,7 @@ import (
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appdef/builder"
 	"github.com/voedger/voedger/pkg/appparts"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/goutils/testingu"
 	"github.com/voedger/voedger/pkg/iextengine"
 	"github.com/voedger/voedger/pkg/iratesce"
@@ -151,6 +152,12 @@ func Test_BasicUsage(t *testing.T) {
 		require.Equal(1, cnt)
 	})
 
+	t.Run("checks", func(t *testing.T) {
+		require.Equal(2, cdoc.CheckCount())
+		require.Equal("Int1 <= (TableNumber * 10)", cdoc.CheckByName(appdef.MustParseQName("main.TablePlan$checks$01")).Expr().String())
+		require.Equal("((FState = 0) OR (FState = 1)) OR (FState = 2)", cdoc.CheckByName(appdef.MustParseQName("main.TablePlan$checks$FStateRange")).Expr().String())
+	})
+
 	// child table
 	crec := appdef.CRecord(app.Type, appdef.NewQName("main", "TablePlanItem"))
 	require.NotNil(crec)
@@ -760,6 +767,551 @@ func Test_Varchar(t *testing.T) {
 
 }
 
+func Test_Decimal(t *testing.T) {
+	require := require.New(t)
+
+	fs, err := ParseFile("file1.vsql", `APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE t1 INHERITS sys.CDoc (
+		Zero decimal(0),
+		TooLarge decimal(19,2),
+		ScaleTooLarge decimal(4,5),
+		Ok decimal(18,18)
+	););
+	`)
+	require.NoError(err)
+	pkg, err := BuildPackageSchema("pkg/test", []*FileSchemaAST{fs})
+	require.NoError(err)
+
+	_, err = BuildAppSchema([]*PackageSchemaAST{
+		getSysPackageAST(),
+		pkg,
+	})
+	require.EqualError(err, strings.Join([]string{
+		fmt.Sprintf("file1.vsql:3:8: decimal precision must be between 1 and %d", decimal.MaxPrecision),
+		fmt.Sprintf("file1.vsql:4:12: decimal precision must be between 1 and %d", decimal.MaxPrecision),
+		"file1.vsql:5:17: decimal scale must not be greater than precision",
+	}, "\n"))
+}
+
+func Test_DateTime(t *testing.T) {
+	require := require.New(t)
+
+	fs, err := ParseFile("file1.vsql", `APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE t1 INHERITS sys.CDoc (
+		Birthday date,
+		Alarm time NOT NULL,
+		Created datetime,
+		Modified timestamp
+	);
+	VIEW v1(
+		Day date,
+		At time,
+		Stamp datetime,
+		PRIMARY KEY((Day), At, Stamp)
+	) AS RESULT OF Proj1;
+	EXTENSION ENGINE BUILTIN (
+		PROJECTOR Proj1 AFTER EXECUTE ON (Orders) INTENTS (sys.View(v1));
+		COMMAND Orders()
+	);
+	);
+	`)
+	require.NoError(err)
+	pkg, err := BuildPackageSchema("test", []*FileSchemaAST{fs})
+	require.NoError(err)
+
+	packages, err := BuildAppSchema([]*PackageSchemaAST{
+		getSysPackageAST(),
+		pkg,
+	})
+	require.NoError(err)
+
+	adb := builder.New()
+	require.NoError(BuildAppDefs(packages, adb))
+
+	app, err := adb.Build()
+	require.NoError(err)
+
+	t.Run("table fields", func(t *testing.T) {
+		doc := appdef.CDoc(app.Type, appdef.NewQName("test", "t1"))
+		require.NotNil(doc)
+		require.Equal(appdef.DataKind_date, doc.Field("Birthday").DataKind())
+		require.Equal(appdef.DataKind_time, doc.Field("Alarm").DataKind())
+		require.True(doc.Field("Alarm").Required())
+		require.Equal(appdef.DataKind_timestamp, doc.Field("Created").DataKind())
+		require.Equal(appdef.DataKind_int64, doc.Field("Modified").DataKind(), "timestamp must be kept as int64 for compatibility")
+	})
+
+	t.Run("view fields", func(t *testing.T) {
+		view := appdef.View(app.Type, appdef.NewQName("test", "v1"))
+		require.NotNil(view)
+		require.Equal(appdef.DataKind_date, view.Key().PartKey().Field("Day").DataKind())
+		require.Equal(appdef.DataKind_time, view.Key().ClustCols().Field("At").DataKind())
+		require.Equal(appdef.DataKind_timestamp, view.Key().ClustCols().Field("Stamp").DataKind())
+	})
+}
+
+func Test_EncryptedFields(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("should be ok to build encrypted fields", func(t *testing.T) {
+		fs, err := ParseFile("file1.vsql", `APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE Profile INHERITS sys.CDoc (
+		Email varchar NOT NULL VERIFIABLE ENCRYPTED,
+		Phone varchar(20) ENCRYPTED,
+		Passport bytes ENCRYPTED,
+		Nick varchar
+	);
+	);
+	`)
+		require.NoError(err)
+		pkg, err := BuildPackageSchema("test", []*FileSchemaAST{fs})
+		require.NoError(err)
+
+		packages, err := BuildAppSchema([]*PackageSchemaAST{
+			getSysPackageAST(),
+			pkg,
+		})
+		require.NoError(err)
+
+		adb := builder.New()
+		require.NoError(BuildAppDefs(packages, adb))
+
+		app, err := adb.Build()
+		require.NoError(err)
+
+		doc := appdef.CDoc(app.Type, appdef.NewQName("test", "Profile"))
+		require.NotNil(doc)
+		require.True(doc.Field("Email").Encrypted())
+		require.True(doc.Field("Email").Verifiable())
+		require.True(doc.Field("Phone").Encrypted())
+		require.True(doc.Field("Passport").Encrypted())
+		require.False(doc.Field("Nick").Encrypted())
+	})
+
+	t.Run("should be error if encrypted field is not varchar or bytes", func(t *testing.T) {
+		require := assertions(t)
+		require.AppSchemaError(`APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE Profile INHERITS sys.CDoc (
+		Age int32 ENCRYPTED,
+		Photo blob ENCRYPTED
+	);
+	);`,
+			"file.vsql:3:3: ENCRYPTED only available for varchar or bytes field",
+			"file.vsql:4:3: ENCRYPTED only available for varchar or bytes field")
+	})
+
+	t.Run("should be error if encrypted field is in unique constraint", func(t *testing.T) {
+		require := assertions(t)
+		require.AppSchemaError(`APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE Profile INHERITS sys.CDoc (
+		Email varchar ENCRYPTED,
+		Phone varchar ENCRYPTED,
+		UNIQUEFIELD Email,
+		UNIQUE (Phone)
+	);
+	);`,
+			"file.vsql:5:3: encrypted field Email not supported in unique constraint",
+			"file.vsql:6:3: encrypted field Phone not supported in unique constraint")
+	})
+
+	t.Run("should be ok to build encrypted view value fields", func(t *testing.T) {
+		fs, err := ParseFile("file1.vsql", `APPLICATION test(); WORKSPACE MyWorkspace(
+	VIEW ProfileView(
+		ID int64,
+		Offs int64,
+		Email varchar ENCRYPTED,
+		PRIMARY KEY ((ID), Offs)
+	) AS RESULT OF Proj;
+	EXTENSION ENGINE BUILTIN (
+		PROJECTOR Proj AFTER EXECUTE ON (Cmd) INTENTS(sys.View(ProfileView));
+		COMMAND Cmd();
+	);
+	);
+	`)
+		require.NoError(err)
+		pkg, err := BuildPackageSchema("test", []*FileSchemaAST{fs})
+		require.NoError(err)
+
+		packages, err := BuildAppSchema([]*PackageSchemaAST{
+			getSysPackageAST(),
+			pkg,
+		})
+		require.NoError(err)
+
+		adb := builder.New()
+		require.NoError(BuildAppDefs(packages, adb))
+
+		app, err := adb.Build()
+		require.NoError(err)
+
+		view := appdef.View(app.Type, appdef.NewQName("test", "ProfileView"))
+		require.NotNil(view)
+		require.True(view.Value().Field("Email").Encrypted())
+		require.False(view.Key().Field("Offs").Encrypted())
+	})
+
+	t.Run("should be error if encrypted field is in view key", func(t *testing.T) {
+		require := assertions(t)
+		require.AppSchemaError(`APPLICATION test(); WORKSPACE MyWorkspace(
+	VIEW ProfileView(
+		ID int64,
+		Email varchar ENCRYPTED,
+		Age int32 ENCRYPTED,
+		PRIMARY KEY ((ID), Email)
+	) AS RESULT OF Proj;
+	EXTENSION ENGINE BUILTIN (
+		PROJECTOR Proj AFTER EXECUTE ON (Cmd) INTENTS(sys.View(ProfileView));
+		COMMAND Cmd();
+	);
+	);`,
+			"file.vsql:5:3: ENCRYPTED only available for varchar or bytes field",
+			"file.vsql:6:22: encrypted field Email not supported in view key")
+	})
+}
+
+func Test_Checks(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("should be ok to build checks", func(t *testing.T) {
+		fs, err := ParseFile("file1.vsql", `APPLICATION test(); WORKSPACE MyWorkspace(
+	TYPE Order (
+		Qty int32,
+		Price float64,
+		Total float64,
+		CHECK (Qty * Price = Total) ENFORCED
+	);
+	TABLE t1 INHERITS sys.CDoc (
+		StartDate date CHECK (StartDate IS NOT NULL) ENFORCED,
+		EndDate date,
+		Kind varchar,
+		Percent int32 CHECK (Percent BETWEEN 0 AND 100) ENFORCED,
+		Active bool,
+		CONSTRAINT Dates CHECK (EndDate > StartDate) ENFORCED,
+		CHECK (NOT Active OR Kind IN ('a', 'b') AND Percent % 10 <> -1 + 2 / 3.5) ENFORCED
+	);
+	);
+	`)
+		require.NoError(err)
+		pkg, err := BuildPackageSchema("test", []*FileSchemaAST{fs})
+		require.NoError(err)
+
+		packages, err := BuildAppSchema([]*PackageSchemaAST{
+			getSysPackageAST(),
+			pkg,
+		})
+		require.NoError(err)
+
+		adb := builder.New()
+		require.NoError(BuildAppDefs(packages, adb))
+
+		app, err := adb.Build()
+		require.NoError(err)
+
+		obj := appdef.Object(app.Type, appdef.NewQName("test", "Order"))
+		require.NotNil(obj)
+		require.Equal(1, obj.CheckCount())
+		require.Equal("(Qty * Price) = Total", obj.CheckByName(appdef.NewQName("test", "Order$checks$01")).Expr().String())
+
+		doc := appdef.CDoc(app.Type, appdef.NewQName("test", "t1"))
+		require.NotNil(doc)
+		expected := map[string]string{
+			"t1$checks$01":    "NOT (StartDate IS NULL)",
+			"t1$checks$02":    "(Percent >= 0) AND (Percent <= 100)",
+			"t1$checks$Dates": "EndDate > StartDate",
+			"t1$checks$03":    "(NOT Active) OR (((Kind = 'a') OR (Kind = 'b')) AND ((Percent % 10) != (-1 + (2 / 3.5))))",
+		}
+		require.Equal(len(expected), doc.CheckCount())
+		for n, e := range expected {
+			c := doc.CheckByName(appdef.NewQName("test", n))
+			require.NotNil(c, n)
+			require.Equal(e, c.Expr().String())
+		}
+	})
+
+	t.Run("should be ignored if not enforced", func(t *testing.T) {
+		fs, err := ParseFile("file1.vsql", `APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE t1 INHERITS sys.CDoc (
+		Int1 int32 CHECK (Int1 > Int2),
+		CHECK (ValidateRow(this)),
+		CONSTRAINT c1 CHECK (sys.ID > 0)
+	);
+	);`)
+		require.NoError(err)
+		pkg, err := BuildPackageSchema("test", []*FileSchemaAST{fs})
+		require.NoError(err)
+
+		packages, err := BuildAppSchema([]*PackageSchemaAST{
+			getSysPackageAST(),
+			pkg,
+		})
+		require.NoError(err)
+
+		adb := builder.New()
+		require.NoError(BuildAppDefs(packages, adb))
+
+		app, err := adb.Build()
+		require.NoError(err)
+
+		doc := appdef.CDoc(app.Type, appdef.NewQName("test", "t1"))
+		require.NotNil(doc)
+		require.Zero(doc.CheckCount())
+	})
+
+	t.Run("should be build errors", func(t *testing.T) {
+		fs, err := ParseFile("file1.vsql", `APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE t1 INHERITS sys.CDoc (
+		Int1 int32 CHECK (Int1 > Int2) ENFORCED,
+		CHECK (ValidateRow(this)) ENFORCED,
+		CONSTRAINT c1 CHECK (sys.ID > 0) ENFORCED
+	);
+	);`)
+		require.NoError(err)
+		pkg, err := BuildPackageSchema("test", []*FileSchemaAST{fs})
+		require.NoError(err)
+
+		packages, err := BuildAppSchema([]*PackageSchemaAST{
+			getSysPackageAST(),
+			pkg,
+		})
+		require.NoError(err)
+
+		err = BuildAppDefs(packages, builder.New())
+		require.EqualError(err, strings.Join([]string{
+			"file1.vsql:3:3: undefined field Int2",
+			"file1.vsql:4:3: function ValidateRow not supported in CHECK expression",
+			"file1.vsql:5:3: undefined field sys.ID",
+		}, "\n"))
+	})
+}
+
+func Test_Policies(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("should be ok to build policies", func(t *testing.T) {
+		fs, err := ParseFile("file1.vsql", `APPLICATION test(); WORKSPACE MyWorkspace(
+	ROLE Waiter;
+	ROLE Manager;
+	TABLE Orders INHERITS sys.CDoc (
+		CreatedBy varchar,
+		Department int32
+	);
+	-- waiter sees and updates own orders only
+	POLICY OwnOrders ON TABLE Orders TO Waiter USING (CreatedBy = CURRENT_SUBJECT);
+	POLICY DepOrders ON TABLE Orders FOR SELECT TO Manager, Waiter USING (Department IN (1, 2) AND CreatedBy IS NOT NULL);
+	);
+	`)
+		require.NoError(err)
+		pkg, err := BuildPackageSchema("test", []*FileSchemaAST{fs})
+		require.NoError(err)
+
+		packages, err := BuildAppSchema([]*PackageSchemaAST{
+			getSysPackageAST(),
+			pkg,
+		})
+		require.NoError(err)
+
+		adb := builder.New()
+		require.NoError(BuildAppDefs(packages, adb))
+
+		app, err := adb.Build()
+		require.NoError(err)
+
+		ws := app.Workspace(appdef.NewQName("test", "MyWorkspace"))
+		require.Len(ws.RowPolicies(), 2)
+
+		p := ws.RowPolicy(appdef.NewQName("test", "OwnOrders"))
+		require.NotNil(p)
+		require.Equal("waiter sees and updates own orders only", p.Comment())
+		require.Equal(appdef.NewQName("test", "Orders"), p.Table().QName())
+		require.Equal([]appdef.OperationKind{appdef.OperationKind_Update, appdef.OperationKind_Select}, p.Ops())
+		require.Len(p.Roles(), 1)
+		require.Equal(appdef.NewQName("test", "Waiter"), p.Roles()[0].QName())
+		require.Equal("CreatedBy = CURRENT_SUBJECT", p.Expr().String())
+
+		p = ws.RowPolicy(appdef.NewQName("test", "DepOrders"))
+		require.NotNil(p)
+		require.Equal([]appdef.OperationKind{appdef.OperationKind_Select}, p.Ops())
+		require.Len(p.Roles(), 2)
+		require.Equal("((Department = 1) OR (Department = 2)) AND (NOT (CreatedBy IS NULL))", p.Expr().String())
+	})
+
+	t.Run("should be analyse errors", func(t *testing.T) {
+		require := assertions(t)
+		require.AppSchemaError(`APPLICATION test(); WORKSPACE MyWorkspace(
+	POLICY p1 ON TABLE Unknown TO UnknownRole USING (TRUE);
+	);`, "file.vsql:2:21: undefined table: Unknown", "file.vsql:2:32: undefined role: UnknownRole")
+	})
+
+	build := func(sql string) error {
+		fs, err := ParseFile("file1.vsql", sql)
+		require.NoError(err)
+		pkg, err := BuildPackageSchema("test", []*FileSchemaAST{fs})
+		require.NoError(err)
+
+		packages, err := BuildAppSchema([]*PackageSchemaAST{
+			getSysPackageAST(),
+			pkg,
+		})
+		require.NoError(err)
+
+		return BuildAppDefs(packages, builder.New())
+	}
+
+	t.Run("should be build error if CURRENT_SUBJECT used in CHECK", func(t *testing.T) {
+		err := build(`APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE t1 INHERITS sys.CDoc (
+		Owner varchar,
+		CHECK (Owner = CURRENT_SUBJECT) ENFORCED
+	);
+	);`)
+		require.EqualError(err, "file1.vsql:4:3: CURRENT_SUBJECT is allowed in POLICY expressions only")
+	})
+
+	t.Run("should be build errors", func(t *testing.T) {
+		err := build(`APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE t1 INHERITS sys.CDoc (
+		Owner varchar
+	);
+	POLICY p1 ON TABLE t1 USING (Creator = CURRENT_SUBJECT);
+	POLICY p2 ON TABLE t1 USING (IsOwner(Owner));
+	);`)
+		require.EqualError(err, strings.Join([]string{
+			"file1.vsql:5:2: undefined field Creator",
+			"file1.vsql:6:2: function IsOwner not supported in POLICY expression",
+		}, "\n"))
+	})
+}
+
+func Test_JSON(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("should be ok to build json fields", func(t *testing.T) {
+		fs, err := ParseFile("file1.vsql", `APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE t1 INHERITS sys.CDoc (
+		Settings json NOT NULL,
+		Short json(100)
+	);
+	VIEW v1(
+		Owner int64,
+		Item int32,
+		Settings json(1000),
+		PRIMARY KEY((Owner), Item)
+	) AS RESULT OF Proj1;
+	EXTENSION ENGINE BUILTIN (
+		PROJECTOR Proj1 AFTER EXECUTE ON (Orders) INTENTS (sys.View(v1));
+		COMMAND Orders()
+	);
+	);
+	`)
+		require.NoError(err)
+		pkg, err := BuildPackageSchema("test", []*FileSchemaAST{fs})
+		require.NoError(err)
+
+		packages, err := BuildAppSchema([]*PackageSchemaAST{
+			getSysPackageAST(),
+			pkg,
+		})
+		require.NoError(err)
+
+		adb := builder.New()
+		require.NoError(BuildAppDefs(packages, adb))
+
+		app, err := adb.Build()
+		require.NoError(err)
+
+		doc := appdef.CDoc(app.Type, appdef.NewQName("test", "t1"))
+		require.NotNil(doc)
+		require.Equal(appdef.DataKind_json, doc.Field("Settings").DataKind())
+		require.True(doc.Field("Settings").Required())
+		require.Empty(doc.Field("Settings").Constraints())
+		require.Equal(appdef.DataKind_json, doc.Field("Short").DataKind())
+		require.EqualValues(100, doc.Field("Short").Constraints()[appdef.ConstraintKind_MaxLen].Value())
+
+		view := appdef.View(app.Type, appdef.NewQName("test", "v1"))
+		require.NotNil(view)
+		require.Equal(appdef.DataKind_json, view.Value().Field("Settings").DataKind())
+		require.EqualValues(1000, view.Value().Field("Settings").Constraints()[appdef.ConstraintKind_MaxLen].Value())
+	})
+
+	t.Run("should be errors", func(t *testing.T) {
+		require := assertions(t)
+		require.AppSchemaError(`APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE t1 INHERITS sys.CDoc (
+		Settings json(65536)
+	);
+	VIEW v1(
+		Owner json,
+		Item int32,
+		PRIMARY KEY((Owner), Item)
+	) AS RESULT OF Proj1;
+	VIEW v2(
+		Owner int64,
+		Item json,
+		PRIMARY KEY((Owner), Item)
+	) AS RESULT OF Proj1;
+	EXTENSION ENGINE BUILTIN (
+		PROJECTOR Proj1 AFTER EXECUTE ON (Orders) INTENTS (sys.View(v1), sys.View(v2));
+		COMMAND Orders()
+	);
+	);`, "file.vsql:3:12: maximum field length is 65535",
+			"file.vsql:8:16: json field Owner not supported in view key",
+			"file.vsql:13:24: json field Item not supported in view key")
+	})
+}
+
+func Test_UUID(t *testing.T) {
+	require := require.New(t)
+
+	fs, err := ParseFile("file1.vsql", `APPLICATION test(); WORKSPACE MyWorkspace(
+	TABLE t1 INHERITS sys.CDoc (
+		ClientID uuid NOT NULL,
+		Ref uuid,
+		UNIQUE (ClientID)
+	);
+	VIEW v1(
+		Owner uuid,
+		Item uuid,
+		PRIMARY KEY((Owner), Item)
+	) AS RESULT OF Proj1;
+	EXTENSION ENGINE BUILTIN (
+		PROJECTOR Proj1 AFTER EXECUTE ON (Orders) INTENTS (sys.View(v1));
+		COMMAND Orders()
+	);
+	);
+	`)
+	require.NoError(err)
+	pkg, err := BuildPackageSchema("test", []*FileSchemaAST{fs})
+	require.NoError(err)
+
+	packages, err := BuildAppSchema([]*PackageSchemaAST{
+		getSysPackageAST(),
+		pkg,
+	})
+	require.NoError(err)
+
+	adb := builder.New()
+	require.NoError(BuildAppDefs(packages, adb))
+
+	app, err := adb.Build()
+	require.NoError(err)
+
+	t.Run("table fields", func(t *testing.T) {
+		doc := appdef.CDoc(app.Type, appdef.NewQName("test", "t1"))
+		require.NotNil(doc)
+		require.Equal(appdef.DataKind_uuid, doc.Field("ClientID").DataKind())
+		require.True(doc.Field("ClientID").Required())
+		require.Equal(appdef.DataKind_uuid, doc.Field("Ref").DataKind())
+		require.Len(doc.Uniques(), 1)
+	})
+
+	t.Run("view fields", func(t *testing.T) {
+		view := appdef.View(app.Type, appdef.NewQName("test", "v1"))
+		require.NotNil(view)
+		require.Equal(appdef.DataKind_uuid, view.Key().PartKey().Field("Owner").DataKind())
+		require.Equal(appdef.DataKind_uuid, view.Key().ClustCols().Field("Item").DataKind())
+	})
+}
+
 func Test_DupFieldsInTables(t *testing.T) {
 	require := require.New(t)
 
@@ -1220,6 +1772,112 @@ func Test_Views(t *testing.T) {
 	})
 }
 
+func Test_ViewN10nPayload(t *testing.T) {
+	require := assertions(t)
+
+	t.Run("N10nPayload view", func(t *testing.T) {
+		appDef := require.Build(`APPLICATION test(); WORKSPACE Workspace (
+			VIEW WithPayload(
+				field1 int,
+				field2 int,
+				PRIMARY KEY((field1),field2)
+			) AS RESULT OF Proj1 WITH N10nPayload, Comment='with payload';
+			VIEW WithoutPayload(
+				field1 int,
+				field2 int,
+				PRIMARY KEY((field1),field2)
+			) AS RESULT OF Proj1;
+			EXTENSION ENGINE BUILTIN (
+				PROJECTOR Proj1 AFTER EXECUTE ON (Orders) INTENTS (sys.View(WithPayload, WithoutPayload));
+				COMMAND Orders()
+			);
+		)`)
+
+		require.True(appdef.View(appDef.Type, appdef.NewQName("pkg", "WithPayload")).N10nPayload())
+		require.False(appdef.View(appDef.Type, appdef.NewQName("pkg", "WithoutPayload")).N10nPayload())
+	})
+
+	t.Run("N10nPayload is only for views", func(t *testing.T) {
+		require.AppSchemaError(`APPLICATION test(); WORKSPACE Workspace (
+			TABLE t1 INHERITS sys.CDoc (
+				field1 int
+			) WITH N10nPayload;
+		)`, "file.vsql:2:4: N10nPayload is only available for views")
+	})
+}
+
+func Test_TableAudit(t *testing.T) {
+	require := assertions(t)
+
+	t.Run("audited tables", func(t *testing.T) {
+		appDef := require.Build(`APPLICATION test(); WORKSPACE Workspace (
+			TABLE Audited INHERITS sys.CDoc (
+				field1 int,
+				Items TABLE AuditedItems (
+					field2 int
+				) WITH AUDIT
+			) WITH AUDIT, Comment='audited';
+			TABLE AuditedW INHERITS sys.WDoc (
+				field1 int
+			) WITH AUDIT;
+			TABLE NotAudited INHERITS sys.CDoc (
+				field1 int
+			);
+		)`)
+
+		require.True(appdef.CDoc(appDef.Type, appdef.NewQName("pkg", "Audited")).Audit())
+		require.True(appdef.CRecord(appDef.Type, appdef.NewQName("pkg", "AuditedItems")).Audit())
+		require.True(appdef.WDoc(appDef.Type, appdef.NewQName("pkg", "AuditedW")).Audit())
+		require.False(appdef.CDoc(appDef.Type, appdef.NewQName("pkg", "NotAudited")).Audit())
+	})
+
+	t.Run("AUDIT is only for CDoc, CRecord, WDoc and WRecord tables", func(t *testing.T) {
+		require.AppSchemaError(`APPLICATION test(); WORKSPACE Workspace (
+			TABLE t1 INHERITS sys.ODoc (
+				field1 int
+			) WITH AUDIT;
+			VIEW v1(
+				field1 int,
+				field2 int,
+				PRIMARY KEY((field1),field2)
+			) AS RESULT OF Proj1 WITH AUDIT;
+			EXTENSION ENGINE BUILTIN (
+				PROJECTOR Proj1 AFTER EXECUTE ON (Orders) INTENTS (sys.View(v1));
+				COMMAND Orders()
+			);
+		)`, "file.vsql:2:4: AUDIT is only available for CDoc, CRecord, WDoc and WRecord tables",
+			"file.vsql:5:4: AUDIT is only available for CDoc, CRecord, WDoc and WRecord tables")
+	})
+}
+
+func Test_QueryCache(t *testing.T) {
+	require := assertions(t)
+
+	t.Run("cached queries", func(t *testing.T) {
+		appDef := require.Build(`APPLICATION test(); WORKSPACE Workspace (
+			EXTENSION ENGINE BUILTIN (
+				QUERY Cached1() RETURNS void WITH CACHE 10 SECONDS;
+				QUERY Cached2() RETURNS void WITH Comment='cached', CACHE 1 MINUTE;
+				QUERY NotCached() RETURNS void;
+			);
+		)`)
+
+		require.Equal(10*time.Second, appdef.Query(appDef.Type, appdef.NewQName("pkg", "Cached1")).CacheTTL())
+		require.Equal(time.Minute, appdef.Query(appDef.Type, appdef.NewQName("pkg", "Cached2")).CacheTTL())
+		require.Zero(appdef.Query(appDef.Type, appdef.NewQName("pkg", "NotCached")).CacheTTL())
+	})
+
+	t.Run("CACHE is only for queries", func(t *testing.T) {
+		require.AppSchemaError(`APPLICATION test(); WORKSPACE Workspace (
+			EXTENSION ENGINE BUILTIN (
+				COMMAND Cmd() WITH CACHE 10 SECONDS;
+				QUERY Qry() RETURNS void WITH CACHE 0 SECONDS;
+			);
+		)`, "file.vsql:3:5: CACHE is only available for queries",
+			"file.vsql:4:5: CACHE time to live must be positive")
+	})
+}
+
 func Test_Views2(t *testing.T) {
 	require := require.New(t)
 
@@ -2938,7 +3596,10 @@ func Test_Jobs(t *testing.T) {
 func Test_DataTypes(t *testing.T) {
 	t.Run("String", func(t *testing.T) {
 		varcharMaxLen := uint64(10)
+		jsonLen := uint64(1000)
 		bytesMaxLen := uint64(20)
+		decimalPrecision := uint64(10)
+		decimalScale := uint64(2)
 
 		cases := []struct {
 			name string
@@ -2958,8 +3619,16 @@ func Test_DataTypes(t *testing.T) {
 			{name: "bytes with max len", typ: DataType{Bytes: &TypeBytes{MaxLen: &bytesMaxLen}}, want: "bytes[20]"},
 			{name: "bytes default max len", typ: DataType{Bytes: &TypeBytes{}}, want: fmt.Sprintf("bytes[%d]", appdef.DefaultFieldMaxLength)},
 			{name: "blob", typ: DataType{Blob: true}, want: "blob"},
+			{name: "date", typ: DataType{Date: true}, want: "date"},
+			{name: "time", typ: DataType{Time: true}, want: "time"},
 			{name: "timestamp", typ: DataType{Timestamp: true}, want: "timestamp"},
+			{name: "datetime", typ: DataType{Datetime: true}, want: "datetime"},
+			{name: "uuid", typ: DataType{UUID: true}, want: "uuid"},
+			{name: "json", typ: DataType{JSON: &TypeJSON{}}, want: "json"},
+			{name: "json(1000)", typ: DataType{JSON: &TypeJSON{MaxLen: &jsonLen}}, want: "json[1000]"},
 			{name: "currency", typ: DataType{Currency: true}, want: "currency"},
+			{name: "decimal with precision and scale", typ: DataType{Decimal: &TypeDecimal{Precision: &decimalPrecision, Scale: &decimalScale}}, want: "decimal(10,2)"},
+			{name: "decimal default precision and scale", typ: DataType{Decimal: &TypeDecimal{}}, want: "decimal(18,0)"},
 			{name: "unknown", typ: DataType{}, want: "?"},
 		}
 
@@ -3020,7 +3689,11 @@ ALTER WORKSPACE sys.AppWorkspaceWS (
 		s11_2 smallint,
 
 		s12_1 int8,
-		s12_2 tinyint
+		s12_2 tinyint,
+
+		s13_1 decimal(18,4),
+		s13_2 decimal(10),
+		s13_3 numeric
 	);
 );`)
 	require.NoError(err)
@@ -3033,6 +3706,14 @@ ALTER WORKSPACE sys.AppWorkspaceWS (
 	ws := app.Workspace(appdef.NewQName("sys", "AppWorkspaceWS"))
 	tbl := ws.Type(appdef.NewQName("pkg", "t1")).(appdef.IWDoc)
 
+	// decimal
+	for n, ps := range map[string][2]uint8{"s13_1": {18, 4}, "s13_2": {10, 0}, "s13_3": {18, 0}} {
+		f := tbl.Field(n)
+		require.Equal(appdef.DataKind_decimal, f.DataKind(), n)
+		p, s := appdef.DecimalPrecisionScale(f.Data())
+		require.Equal(ps, [2]uint8{p, s}, n)
+	}
+
 	// [~server.vsql.smallints/it.SmallIntegers~impl]
 	// smallint
 	require.Equal(appdef.DataKind_int16, tbl.Field("s11_1").DataKind())
@@ -8,6 +8,7 @@ package parser
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/alecthomas/participle/v2/lexer"
 
@@ -146,6 +147,7 @@ type WorkspaceStatement struct {
 	// Sequence  *sequenceStmt  `parser:"| @@"`
 	Grant  *GrantStmt  `parser:"| @@"`
 	Revoke *RevokeStmt `parser:"| @@"`
+	Policy *PolicyStmt `parser:"| @@"`
 
 	stmt interface{}
 }
@@ -317,6 +319,17 @@ type TypeBytes struct {
 	MaxLen *uint64 `parser:"(('binary' 'varying') | 'varbinary' | 'bytes') ( '(' @Int ')' )?"`
 }
 
+type TypeJSON struct {
+	Pos    lexer.Position
+	MaxLen *uint64 `parser:"'json' ( '(' @Int ')' )?"`
+}
+
+type TypeDecimal struct {
+	Pos       lexer.Position
+	Precision *uint64 `parser:"('decimal' | 'numeric') ( '(' @Int"`
+	Scale     *uint64 `parser:"( ',' @Int )? ')' )?"`
+}
+
 type VoidOrDataType struct {
 	Void     bool           `parser:"( @'void'"`
 	DataType *DataTypeOrDef `parser:"| @@)"`
@@ -337,8 +350,14 @@ type DataType struct {
 	Int64     bool         `parser:"| @('bigint' | 'int64')"`
 	Float32   bool         `parser:"| @('real' | 'float' | 'float32')"`
 	Float64   bool         `parser:"| @(('double' 'precision') | 'float64')"`
+	Date      bool         `parser:"| @'date'"`
+	Time      bool         `parser:"| @'time'"`
 	Timestamp bool         `parser:"| @'timestamp'"`
+	Datetime  bool         `parser:"| @'datetime'"`
+	UUID      bool         `parser:"| @'uuid'"`
+	JSON      *TypeJSON    `parser:"| @@"`
 	Currency  bool         `parser:"| @('money' | 'currency')"`
+	Decimal   *TypeDecimal `parser:"| @@"`
 	Bool      bool         `parser:"| @('boolean' | 'bool')"`
 	Blob      bool         `parser:"| @(('binary' 'large' 'object') | 'blob')"`
 	QName     bool         `parser:"| @(('qualified' 'name') | 'qname')  )"`
@@ -374,10 +393,26 @@ func (q DataType) String() (s string) {
 		return fmt.Sprintf("bytes[%d]", appdef.DefaultFieldMaxLength)
 	case q.Blob:
 		return "blob"
+	case q.Date:
+		return "date"
+	case q.Time:
+		return "time"
 	case q.Timestamp:
 		return "timestamp"
+	case q.Datetime:
+		return "datetime"
+	case q.UUID:
+		return "uuid"
+	case q.JSON != nil:
+		if q.JSON.MaxLen != nil {
+			return fmt.Sprintf("json[%d]", *q.JSON.MaxLen)
+		}
+		return "json"
 	case q.Currency:
 		return "currency"
+	case q.Decimal != nil:
+		p, s := q.Decimal.precisionScale()
+		return fmt.Sprintf("decimal(%d,%d)", p, s)
 	}
 
 	return "?"
@@ -573,6 +608,22 @@ type RateValueTimeUnit struct {
 	Year   bool `parser:"| @('YEAR' | 'YEARS')"`
 }
 
+func (u RateValueTimeUnit) duration() time.Duration {
+	switch {
+	case u.Second:
+		return time.Second
+	case u.Minute:
+		return time.Minute
+	case u.Hour:
+		return time.Hour
+	case u.Day:
+		return 24 * time.Hour
+	case u.Year:
+		return 365 * 24 * time.Hour
+	}
+	return 0
+}
+
 type RateValue struct {
 	Count           *uint32           `parser:"(@Int"`
 	Variable        *DefQName         `parser:"| @@) 'PER'"`
@@ -651,6 +702,28 @@ type LimitStmt struct {
 
 func (s LimitStmt) GetName() string { return string(s.Name) }
 
+type PolicyAction struct {
+	Pos    lexer.Position
+	Select bool `parser:"( @'SELECT'"`
+	Update bool `parser:"| @'UPDATE' )"`
+}
+
+// Row-level security policy:
+//
+//	POLICY name ON TABLE table [FOR SELECT|UPDATE [, ...]] [TO role [, ...]] USING (expression)
+type PolicyStmt struct {
+	Statement
+	Name      Ident                  `parser:"'POLICY' @Ident"`
+	Table     DefQName               `parser:"ONTABLE @@"`
+	Actions   []PolicyAction         `parser:"( 'FOR' @@ (',' @@)* )?"`
+	Roles     []DefQName             `parser:"( 'TO' @@ (',' @@)* )?"`
+	Using     *Expression            `parser:"'USING' '(' @@ ')'"`
+	workspace workspaceAddr          // filled on the analysis stage
+	ops       []appdef.OperationKind // filled on the analysis stage
+}
+
+func (s PolicyStmt) GetName() string { return string(s.Name) }
+
 type GrantColumn struct {
 	Pos     lexer.Position
 	SysName string      `parser:"@(('sys' '.' 'ID') | 'sys' '.' 'ParentID' | 'sys' '.' 'IsActive' | 'sys' '.' 'QName' | 'sys' '.' 'Container')"`
@@ -858,9 +931,17 @@ func (s *CommandStmt) GetName() string            { return string(s.Name) }
 func (s *CommandStmt) SetEngineType(e EngineType) { s.Engine = e }
 
 type WithItem struct {
-	Comment *string        `parser:"('Comment' '=' @String)"`
-	Tags    []DefQName     `parser:"| ('Tags' '=' '(' @@ (',' @@)* ')')"`
-	tags    []appdef.QName // filled on the analysis stage
+	Comment     *string        `parser:"('Comment' '=' @String)"`
+	Tags        []DefQName     `parser:"| ('Tags' '=' '(' @@ (',' @@)* ')')"`
+	N10nPayload bool           `parser:"| @'N10nPayload'"` // views only
+	Audit       bool           `parser:"| @'AUDIT'"`       // CDoc, CRecord, WDoc and WRecord tables only
+	Cache       *QueryCache    `parser:"| ('CACHE' @@)"`   // queries only
+	tags        []appdef.QName // filled on the analysis stage
+}
+
+type QueryCache struct {
+	TimeUnitAmounts uint32            `parser:"@Int"`
+	TimeUnit        RateValueTimeUnit `parser:"@@"`
 }
 
 type AnyOrVoidOrDef struct {
@@ -984,8 +1065,11 @@ type TableConstraint struct {
 	Check          *TableCheckExpr  `parser:"| @@)"`
 }
 
+// CHECK expression is compiled and enforced only if declared as `ENFORCED`.
+// Not enforced CHECK expressions are parsed and ignored for backward compatibility.
 type TableCheckExpr struct {
 	Expression Expression `parser:"'CHECK' '(' @@ ')'"`
+	Enforced   bool       `parser:"@('ENFORCED')?"`
 }
 
 type UniqueFieldExpr struct {
@@ -1017,11 +1101,12 @@ type FieldExpr struct {
 	Type               DataTypeOrDef `parser:"@@"`
 	NotNull            bool          `parser:"@(NOTNULL)?"`
 	Verifiable         bool          `parser:"@('VERIFIABLE')?"`
+	Encrypted          bool          `parser:"@('ENCRYPTED')?"`
 	DefaultIntValue    *int          `parser:"('DEFAULT' @Int)?"`
 	DefaultStringValue *string       `parser:"('DEFAULT' @String)?"`
 	//	DefaultNextVal     *string       `parser:"(DEFAULTNEXTVAL  '(' @String ')')?"`
-	CheckRegexp     *CheckRegExp `parser:"('CHECK' @@ )?"`
-	CheckExpression *Expression  `parser:"('CHECK' '(' @@ ')')? "`
+	CheckRegexp     *CheckRegExp    `parser:"('CHECK' @@ )?"`
+	CheckExpression *TableCheckExpr `parser:"@@?"`
 }
 
 type ViewStmt struct {
@@ -1129,9 +1214,10 @@ type ViewRefField struct {
 
 type ViewField struct {
 	Statement
-	Name    Identifier `parser:"@@"`
-	Type    DataType   `parser:"@@"`
-	NotNull bool       `parser:"@(NOTNULL)?"`
+	Name      Identifier `parser:"@@"`
+	Type      DataType   `parser:"@@"`
+	NotNull   bool       `parser:"@(NOTNULL)?"`
+	Encrypted bool       `parser:"@('ENCRYPTED')?"`
 }
 
 type ViewRecordField struct {
@@ -20,8 +20,8 @@ type OrCondition struct {
 }
 
 type Condition struct {
-	Operand *ConditionOperand `parser:"  @@"`
-	Not     *Condition        `parser:"| 'NOT' @@"`
+	Not     *Condition        `parser:"  'NOT' @@"`
+	Operand *ConditionOperand `parser:"| @@"`
 }
 
 type ConditionOperand struct {
@@ -47,8 +47,8 @@ type Like struct {
 }
 
 type Is struct {
-	Not  bool `parser:"[ @'NOT' ]"`
-	Null bool `parser:"@'NULL'"`
+	Not  bool `parser:"( @NOTNULL"` // `NOT NULL` is a single token
+	Null bool `parser:"| @'NULL' )"`
 }
 
 type Between struct {
@@ -13,6 +13,9 @@ import (
 	"strings"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/constraints"
+	"github.com/voedger/voedger/pkg/appdef/exprs"
+	"github.com/voedger/voedger/pkg/decimal"
 )
 
 func extractStatement(s any) interface{} {
@@ -253,7 +256,7 @@ func iteratePackage(pkg *PackageSchemaAST, ctx *basicContext, callback func(stmt
 
 func iteratePackageStmt[stmtType *TableStmt | *TypeStmt | *ViewStmt | *CommandStmt | *QueryStmt |
 	*WorkspaceStmt | *AlterWorkspaceStmt | *ProjectorStmt | *JobStmt | *RateStmt | *GrantStmt |
-	*RevokeStmt | *RoleStmt | *TagStmt | *LimitStmt](pkg *PackageSchemaAST, ctx *basicContext, callback func(stmt stmtType, ctx *iterateCtx)) {
+	*RevokeStmt | *RoleStmt | *TagStmt | *LimitStmt | *PolicyStmt](pkg *PackageSchemaAST, ctx *basicContext, callback func(stmt stmtType, ctx *iterateCtx)) {
 	iteratePackage(pkg, ctx, func(stmt interface{}, ctx *iterateCtx) {
 		if s, ok := stmt.(stmtType); ok {
 			callback(s, ctx)
@@ -391,12 +394,52 @@ func dataTypeToDataKind(t DataType) appdef.DataKind {
 	if t.Varchar != nil {
 		return appdef.DataKind_string
 	}
+	if t.Date {
+		return appdef.DataKind_date
+	}
+	if t.Time {
+		return appdef.DataKind_time
+	}
 	if t.Timestamp {
+		// Unix milliseconds, kept as int64 for compatibility with the deployed apps
 		return appdef.DataKind_int64
 	}
+	if t.Datetime {
+		return appdef.DataKind_timestamp
+	}
+	if t.UUID {
+		return appdef.DataKind_uuid
+	}
+	if t.JSON != nil {
+		return appdef.DataKind_json
+	}
+	if t.Decimal != nil {
+		return appdef.DataKind_decimal
+	}
 	return appdef.DataKind_null
 }
 
+// Returns declared precision and scale or defaults if not declared
+func (t TypeDecimal) precisionScale() (precision, scale uint64) {
+	precision, scale = decimal.DefaultPrecision, decimal.DefaultScale
+	if t.Precision != nil {
+		precision = *t.Precision
+	}
+	if t.Scale != nil {
+		scale = *t.Scale
+	}
+	return precision, scale
+}
+
+// Returns precision and scale constraints for decimal data type
+func (t TypeDecimal) constraints() []appdef.IConstraint {
+	p, s := t.precisionScale()
+	return []appdef.IConstraint{
+		constraints.Precision(uint8(p)), // nolint G115: checked in [analyzeDatatype]
+		constraints.Scale(uint8(s)),     // nolint G115: checked in [analyzeDatatype]
+	}
+}
+
 func buildQname(ctx *iterateCtx, pkg Ident, name Ident) appdef.QName {
 	if pkg == "" {
 		pkg = Ident(ctx.pkg.Name)
@@ -412,3 +455,175 @@ func contains(s []Identifier, e Ident) bool {
 	}
 	return false
 }
+
+// Scope to resolve symbols in CHECK and POLICY expressions
+type exprScope struct {
+	// statement kind, `CHECK` or `POLICY`
+	stmt   string
+	fields appdef.IWithFields
+	// is CURRENT_SUBJECT allowed
+	subject bool
+}
+
+// Converts CHECK or POLICY expression into appdef expression.
+//
+// Referenced fields should exist in scope fields
+func checkExpression(e *Expression, scope exprScope) (res appdef.IExpr, err error) {
+	for _, or := range e.Or {
+		var and appdef.IExpr
+		for _, cond := range or.And {
+			x, err := checkCondition(cond, scope)
+			if err != nil {
+				return nil, err
+			}
+			if and == nil {
+				and = x
+			} else {
+				and = exprs.And(and, x)
+			}
+		}
+		if res == nil {
+			res = and
+		} else {
+			res = exprs.Or(res, and)
+		}
+	}
+	return res, nil
+}
+
+func checkCondition(c *Condition, scope exprScope) (appdef.IExpr, error) {
+	if c.Not != nil {
+		x, err := checkCondition(c.Not, scope)
+		if err != nil {
+			return nil, err
+		}
+		return exprs.Not(x), nil
+	}
+
+	x, err := checkOperand(c.Operand.Operand, scope)
+	if err != nil || c.Operand.ConditionRHS == nil {
+		return x, err
+	}
+
+	rhs := c.Operand.ConditionRHS
+	switch {
+	case rhs.Compare != nil:
+		y, err := checkOperand(rhs.Compare.Operand, scope)
+		if err != nil {
+			return nil, err
+		}
+		switch rhs.Compare.Operator {
+		case "=":
+			return exprs.Eq(x, y), nil
+		case "<>", "!=":
+			return exprs.Ne(x, y), nil
+		case "<":
+			return exprs.Lt(x, y), nil
+		case "<=":
+			return exprs.Le(x, y), nil
+		case ">":
+			return exprs.Gt(x, y), nil
+		default: // ">="
+			return exprs.Ge(x, y), nil
+		}
+	case rhs.Is != nil:
+		if rhs.Is.Not {
+			return exprs.IsNotNull(x), nil
+		}
+		return exprs.IsNull(x), nil
+	case rhs.Between != nil:
+		start, err := checkOperand(rhs.Between.Start, scope)
+		if err != nil {
+			return nil, err
+		}
+		end, err := checkOperand(rhs.Between.End, scope)
+		if err != nil {
+			return nil, err
+		}
+		return exprs.And(exprs.Ge(x, start), exprs.Le(x, end)), nil
+	default: // IN
+		var res appdef.IExpr
+		for _, e := range rhs.In.Expressions {
+			y, err := checkExpression(e, scope)
+			if err != nil {
+				return nil, err
+			}
+			if res == nil {
+				res = exprs.Eq(x, y)
+			} else {
+				res = exprs.Or(res, exprs.Eq(x, y))
+			}
+		}
+		return res, nil
+	}
+}
+
+func checkOperand(o *Operand, scope exprScope) (appdef.IExpr, error) {
+	x, err := checkFactor(o.LHS, scope)
+	if err != nil || o.RHS == nil {
+		return x, err
+	}
+	y, err := checkFactor(o.RHS, scope)
+	if err != nil {
+		return nil, err
+	}
+	if o.Op == "+" {
+		return exprs.Add(x, y), nil
+	}
+	return exprs.Sub(x, y), nil
+}
+
+func checkFactor(f *Factor, scope exprScope) (appdef.IExpr, error) {
+	x, err := checkTerm(f.LHS, scope)
+	if err != nil || f.RHS == nil {
+		return x, err
+	}
+	y, err := checkTerm(f.RHS, scope)
+	if err != nil {
+		return nil, err
+	}
+	switch f.Op {
+	case "*":
+		return exprs.Mul(x, y), nil
+	case "/":
+		return exprs.Div(x, y), nil
+	default: // "%"
+		return exprs.Mod(x, y), nil
+	}
+}
+
+func checkTerm(t *Term, scope exprScope) (appdef.IExpr, error) {
+	switch {
+	case t.Value != nil:
+		v := t.Value
+		switch {
+		case v.Int != nil:
+			return exprs.Value(*v.Int), nil
+		case v.Float != nil:
+			return exprs.Value(*v.Float), nil
+		case v.String != nil:
+			return exprs.Value(*v.String), nil
+		case v.Boolean != nil:
+			return exprs.Value(bool(*v.Boolean)), nil
+		default: // NULL
+			return exprs.Value(nil), nil
+		}
+	case t.SymbolRef != nil:
+		ref := t.SymbolRef
+		if ref.Parameters != nil {
+			return nil, ErrExprFunctionNotSupported(ref.Name.String(), scope.stmt)
+		}
+		if ref.Name.Package == "" && ref.Name.Name == currentSubject {
+			if !scope.subject {
+				return nil, ErrCurrentSubjectNotAllowed
+			}
+			return exprs.Subject(), nil
+		}
+		if ref.Name.Package != "" || scope.fields.Field(string(ref.Name.Name)) == nil {
+			return nil, ErrUndefinedField(ref.Name.String())
+		}
+		return exprs.Field(string(ref.Name.Name)), nil
+	default:
+		return checkExpression(t.SubExpression, scope)
+	}
+}
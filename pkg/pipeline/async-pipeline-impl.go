@@ -45,9 +45,11 @@ func NewAsyncPipeline(ctx context.Context, name string, first *WiredOperator, ot
 	pipeline.stdout = last.Stdout
 	pipeline.wctx = NewWorkpieceContext(name, pstruct.String())
 
+	interceptors := operatorInterceptors(ctx)
 	for _, op := range pipeline.operators {
 		op.ctx = ctx
 		op.wctx = pipeline.wctx
+		op.interceptors = interceptors
 	}
 	for _, op := range pipeline.operators {
 		if _, ok := op.Operator.(IAsyncOperator); !ok {
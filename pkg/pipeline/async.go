@@ -62,7 +62,10 @@ func p_flush(wo *WiredOperator, place string) {
 		return
 	}
 
-	if err := wo.Operator.(IAsyncOperator).Flush(wo.flushCB); err != nil {
+	err := wo.intercept(OperatorMethod_Flush, nil, nil, func() error {
+		return wo.Operator.(IAsyncOperator).Flush(wo.flushCB)
+	})
+	if err != nil {
 		if wo.isActive() {
 			wo.Stdout <- wo.NewError(err, nil, place)
 		}
@@ -6,6 +6,14 @@
 
 package pipeline
 
+// Intercepted operator methods, see IOperatorInterceptor
+const (
+	OperatorMethod_DoSync OperatorMethod = iota
+	OperatorMethod_DoAsync
+	OperatorMethod_Flush
+	OperatorMethod_OnError
+)
+
 // Error places
 const (
 	placeFlushDisassembling   = "flush-disassembling"
@@ -15,3 +23,12 @@ const (
 	placeDoAsyncOutWorkNotNil = "doAsync, outWork!=nil"
 	placeDoSync               = "doSync"
 )
+
+// Built-in interceptors of the pipeline operators, see operatorInterceptors()
+var builtinInterceptors = []IOperatorInterceptor{stagesInterceptor{}, tracingInterceptor{}}
+
+// Tracing
+const (
+	spanNamePrefixOperator = "pipeline.op "
+	spanAttrPipelineName   = "pipeline.name"
+)
@@ -0,0 +1,127 @@
+// Copyright (c) 2021-present Voedger Authors.
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/voedger/voedger/pkg/tracing"
+)
+
+// WithInterceptors returns the copy of ctx the pipelines could be constructed with
+// Such pipelines (including nested ones constructed with the same ctx or with its children) call `interceptors` around each operator call
+// Interceptors already set in ctx are kept and called first
+// Built-in stage timing and tracing interceptors are always called before all of them, see builtinInterceptors
+func WithInterceptors(ctx context.Context, interceptors ...IOperatorInterceptor) context.Context {
+	if len(interceptors) == 0 {
+		return ctx
+	}
+	existing := interceptorsFromCtx(ctx)
+	res := make([]IOperatorInterceptor, 0, len(existing)+len(interceptors))
+	res = append(res, existing...)
+	res = append(res, interceptors...)
+	return context.WithValue(ctx, interceptorsCtxKeyType{}, res)
+}
+
+func interceptorsFromCtx(ctx context.Context) []IOperatorInterceptor {
+	if ctx == nil {
+		return nil
+	}
+	res, _ := ctx.Value(interceptorsCtxKeyType{}).([]IOperatorInterceptor)
+	return res
+}
+
+// returns the interceptors of the pipeline operators: the built-in ones, then ones from ctx
+func operatorInterceptors(ctx context.Context) []IOperatorInterceptor {
+	fromCtx := interceptorsFromCtx(ctx)
+	res := make([]IOperatorInterceptor, 0, len(builtinInterceptors)+len(fromCtx))
+	res = append(res, builtinInterceptors...)
+	return append(res, fromCtx...)
+}
+
+// Adds the time spent in DoSync() and DoAsync() to the work, see IStagesWorkpiece
+type stagesInterceptor struct{}
+
+func (stagesInterceptor) Before(context.Context, OperatorCall) error { return nil }
+
+func (stagesInterceptor) After(_ context.Context, call OperatorCall, duration time.Duration, err error) error {
+	if call.Method == OperatorMethod_DoSync || call.Method == OperatorMethod_DoAsync {
+		if stagesWork, ok := call.Work.(IStagesWorkpiece); ok {
+			stagesWork.AddStage(call.Operator, duration)
+		}
+	}
+	return err
+}
+
+// Traces DoSync() and DoAsync() as the children of the request span.
+// Traced only if the work or the pipeline is bound to the traced request, see IContextWorkpiece
+type tracingInterceptor struct{}
+
+func (tracingInterceptor) Before(context.Context, OperatorCall) error { return nil }
+
+func (tracingInterceptor) After(ctx context.Context, call OperatorCall, duration time.Duration, err error) error {
+	if ctx == nil || (call.Method != OperatorMethod_DoSync && call.Method != OperatorMethod_DoAsync) {
+		return err
+	}
+	opts := []tracing.SpanOptFunc{tracing.WithStartTime(time.Now().Add(-duration))}
+	if call.Pipeline != "" {
+		opts = append(opts, tracing.WithAttr(spanAttrPipelineName, call.Pipeline))
+	}
+	_, span := tracing.StartChild(ctx, spanNamePrefixOperator+call.Operator, opts...)
+	span.End(err)
+	return err
+}
+
+func (m OperatorMethod) String() string {
+	switch m {
+	case OperatorMethod_DoSync:
+		return "DoSync"
+	case OperatorMethod_DoAsync:
+		return "DoAsync"
+	case OperatorMethod_Flush:
+		return "Flush"
+	case OperatorMethod_OnError:
+		return "OnError"
+	default:
+		return fmt.Sprintf("OperatorMethod(%d)", int(m))
+	}
+}
+
+// calls Before() of the interceptors in order, then `call` if no error, then After() in reverse order
+func (wo *WiredOperator) intercept(method OperatorMethod, work IWorkpiece, handledErr error, call func() error) error {
+	if len(wo.interceptors) == 0 {
+		return call()
+	}
+	ctx := wo.workCtx(work)
+	oc := OperatorCall{
+		Operator: wo.name,
+		Method:   method,
+		Work:     work,
+		Err:      handledErr,
+	}
+	if wo.wctx != nil {
+		oc.Pipeline = wo.wctx.GetPipelineName()
+	}
+	var err error
+	called := 0
+	for _, interceptor := range wo.interceptors {
+		called++
+		if err = interceptor.Before(ctx, oc); err != nil {
+			break
+		}
+	}
+	var duration time.Duration
+	if err == nil {
+		start := time.Now()
+		err = call()
+		duration = time.Since(start)
+	}
+	for i := called - 1; i >= 0; i-- {
+		err = wo.interceptors[i].After(ctx, oc, duration, err)
+	}
+	return err
+}
@@ -0,0 +1,279 @@
+// Copyright (c) 2021-present Voedger Authors.
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/tracing"
+)
+
+type recordingInterceptor struct {
+	sync.Mutex
+	name      string
+	calls     []string
+	beforeErr error
+	afterFunc func(call OperatorCall, err error) error
+}
+
+func (i *recordingInterceptor) Before(_ context.Context, call OperatorCall) error {
+	i.Lock()
+	defer i.Unlock()
+	i.calls = append(i.calls, fmt.Sprintf("%s before %s.%s %s", i.name, call.Pipeline, call.Operator, call.Method))
+	return i.beforeErr
+}
+
+func (i *recordingInterceptor) After(_ context.Context, call OperatorCall, _ time.Duration, err error) error {
+	i.Lock()
+	defer i.Unlock()
+	i.calls = append(i.calls, fmt.Sprintf("%s after %s.%s %s: %v", i.name, call.Pipeline, call.Operator, call.Method, err))
+	if i.afterFunc != nil {
+		return i.afterFunc(call, err)
+	}
+	return err
+}
+
+func (i *recordingInterceptor) getCalls() []string {
+	i.Lock()
+	defer i.Unlock()
+	return append([]string{}, i.calls...)
+}
+
+func TestInterceptors_Sync(t *testing.T) {
+	testErr := errors.New("test error")
+
+	t.Run("basic usage", func(t *testing.T) {
+		require := require.New(t)
+		i1 := &recordingInterceptor{name: "i1"}
+		i2 := &recordingInterceptor{name: "i2"}
+		ctx := WithInterceptors(context.Background(), i1)
+		ctx = WithInterceptors(ctx, i2)
+		opCalls := 0
+		p := NewSyncPipeline(ctx, "p",
+			WireFunc("op1", func(context.Context, IWorkpiece) error {
+				opCalls++
+				return nil
+			}),
+			WireFunc("op2", func(context.Context, IWorkpiece) error {
+				opCalls++
+				return nil
+			}),
+		)
+		defer p.Close()
+
+		require.NoError(p.SendSync(newTestWork()))
+		require.Equal(2, opCalls)
+		require.Equal([]string{
+			"i1 before p.op1 DoSync",
+			"i1 after p.op1 DoSync: <nil>",
+			"i1 before p.op2 DoSync",
+			"i1 after p.op2 DoSync: <nil>",
+		}, i1.getCalls())
+		require.Equal([]string{
+			"i2 before p.op1 DoSync",
+			"i2 after p.op1 DoSync: <nil>",
+			"i2 before p.op2 DoSync",
+			"i2 after p.op2 DoSync: <nil>",
+		}, i2.getCalls())
+	})
+
+	t.Run("fault injection in Before", func(t *testing.T) {
+		require := require.New(t)
+		i1 := &recordingInterceptor{name: "i1", beforeErr: testErr}
+		i2 := &recordingInterceptor{name: "i2"}
+		opCalled := false
+		p := NewSyncPipeline(WithInterceptors(context.Background(), i1, i2), "p",
+			WireFunc("op", func(context.Context, IWorkpiece) error {
+				opCalled = true
+				return nil
+			}),
+		)
+		defer p.Close()
+
+		err := p.SendSync(newTestWork())
+		require.ErrorIs(err, testErr)
+		require.False(opCalled)
+		require.Equal([]string{
+			"i1 before p.op DoSync",
+			"i1 after p.op DoSync: test error",
+		}, i1.getCalls())
+		require.Empty(i2.getCalls())
+	})
+
+	t.Run("After replaces the error", func(t *testing.T) {
+		require := require.New(t)
+		i := &recordingInterceptor{name: "i", afterFunc: func(_ OperatorCall, err error) error {
+			if errors.Is(err, testErr) {
+				return nil
+			}
+			return err
+		}}
+		p := NewSyncPipeline(WithInterceptors(context.Background(), i), "p",
+			WireFunc("op", func(context.Context, IWorkpiece) error {
+				return testErr
+			}),
+		)
+		defer p.Close()
+
+		require.NoError(p.SendSync(newTestWork()))
+		require.Equal([]string{
+			"i before p.op DoSync",
+			"i after p.op DoSync: test error",
+		}, i.getCalls())
+	})
+
+	t.Run("nested pipeline", func(t *testing.T) {
+		require := require.New(t)
+		i := &recordingInterceptor{name: "i"}
+		ctx := WithInterceptors(context.Background(), i)
+		p := NewSyncPipeline(ctx, "outer",
+			WireSyncOperator("inner", NewSyncPipeline(ctx, "inner",
+				WireFunc("op", func(context.Context, IWorkpiece) error { return nil }),
+			)),
+		)
+		defer p.Close()
+
+		require.NoError(p.SendSync(newTestWork()))
+		require.Equal([]string{
+			"i before outer.inner DoSync",
+			"i before inner.op DoSync",
+			"i after inner.op DoSync: <nil>",
+			"i after outer.inner DoSync: <nil>",
+		}, i.getCalls())
+	})
+
+	t.Run("no interceptors", func(t *testing.T) {
+		ctx := context.Background()
+		require.Equal(t, ctx, WithInterceptors(ctx))
+		require.Nil(t, interceptorsFromCtx(ctx))
+	})
+}
+
+func TestInterceptors_Async(t *testing.T) {
+	require := require.New(t)
+	testErr := errors.New("test error")
+	i := &recordingInterceptor{name: "i"}
+	errs := make(chan error, 1)
+	p := NewAsyncPipeline(WithInterceptors(context.Background(), i), "p",
+		WireAsyncOperator("op1", mockAsyncOp().
+			doAsync(func(_ context.Context, work IWorkpiece) (IWorkpiece, error) {
+				return work, testErr
+			}).create()),
+		WireAsyncOperator("op2", mockAsyncOp().
+			onError(func(_ context.Context, err error) {
+				errs <- err
+			}).create()),
+	)
+
+	require.NoError(p.SendAsync(newTestWork()))
+	require.ErrorIs(<-errs, testErr)
+	p.Close()
+
+	calls := i.getCalls()
+	require.Contains(calls, "i before p.op1 DoAsync")
+	require.Contains(calls, "i after p.op1 DoAsync: test error")
+	require.Contains(calls, "i before p.op2 OnError")
+	require.Contains(calls, "i after p.op2 OnError: <nil>")
+}
+
+func TestInterceptors_Flush(t *testing.T) {
+	require := require.New(t)
+	i := &recordingInterceptor{name: "i"}
+	operator := WireAsyncOperator("op", mockAsyncOp().
+		flush(func(callback OpFuncFlush) (err error) {
+			callback(testWorkpiece{})
+			return nil
+		}).create())
+	operator.ctx = context.Background()
+	operator.interceptors = []IOperatorInterceptor{i}
+
+	p_flush(operator, "test")
+
+	require.Len(operator.Stdout, 1)
+	require.Equal([]string{
+		"i before .op Flush",
+		"i after .op Flush: <nil>",
+	}, i.getCalls())
+}
+
+func TestOperatorMethod_String(t *testing.T) {
+	require.Equal(t, "Flush", OperatorMethod_Flush.String())
+	require.Equal(t, "OperatorMethod(42)", OperatorMethod(42).String())
+}
+
+type recordingExporter struct {
+	sync.Mutex
+	spans []tracing.SpanData
+}
+
+func (e *recordingExporter) Export(_ string, spans []tracing.SpanData) error {
+	e.Lock()
+	defer e.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *recordingExporter) Close() error { return nil }
+
+func TestBuiltinInterceptors(t *testing.T) {
+	require := require.New(t)
+	exporter := &recordingExporter{}
+	tracer, cleanup := tracing.NewTracer(tracing.Config{SampleRatio: 1}, exporter)
+	reqCtx, reqSpan := tracer.Start(context.Background(), "request")
+
+	i := &recordingInterceptor{name: "i"}
+	testErr := errors.New("test error")
+	pipeline := NewSyncPipeline(WithInterceptors(reqCtx, i), "p",
+		WireFunc("op1", func(context.Context, *stagesWork) error {
+			time.Sleep(time.Millisecond)
+			return nil
+		}),
+		WireFunc("op2", func(context.Context, *stagesWork) error { return testErr }),
+	)
+	defer pipeline.Close()
+
+	work := &stagesWork{}
+	require.ErrorIs(pipeline.SendSync(work), testErr)
+	reqSpan.End(nil)
+	cleanup()
+
+	t.Run("stages should be collected", func(t *testing.T) {
+		require.Equal([]string{"op1", "op2"}, work.stages)
+	})
+
+	t.Run("operators should be traced as the children of the request span", func(t *testing.T) {
+		spans := map[string]tracing.SpanData{}
+		for _, s := range exporter.spans {
+			spans[s.Name] = s
+		}
+		req := spans["request"]
+		for _, op := range []string{"op1", "op2"} {
+			s, ok := spans[spanNamePrefixOperator+op]
+			require.True(ok, op)
+			require.Equal(req.TraceID, s.TraceID)
+			require.Equal(req.SpanID, s.ParentSpanID)
+			require.Equal("p", s.Attrs[spanAttrPipelineName])
+			require.False(s.StartTime.Before(req.StartTime))
+		}
+		require.GreaterOrEqual(spans[spanNamePrefixOperator+"op1"].EndTime.Sub(spans[spanNamePrefixOperator+"op1"].StartTime), time.Millisecond)
+		require.ErrorIs(spans[spanNamePrefixOperator+"op2"].Err, testErr)
+	})
+
+	t.Run("interceptors from ctx should be called too", func(t *testing.T) {
+		require.Equal([]string{
+			"i before p.op1 DoSync",
+			"i after p.op1 DoSync: <nil>",
+			"i before p.op2 DoSync",
+			"i after p.op2 DoSync: test error",
+		}, i.getCalls())
+	})
+}
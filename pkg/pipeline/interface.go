@@ -7,6 +7,7 @@ package pipeline
 
 import (
 	"context"
+	"time"
 )
 
 type StorageID string
@@ -59,6 +60,32 @@ type IWorkpiece interface {
 	Release()
 }
 
+// Workpiece bound to a request
+// Operators are traced as the children of the span from the Context(), see tracing.StartChild()
+type IContextWorkpiece interface {
+	Context() context.Context
+}
+
+// Workpiece that collects the time spent in each operator it passes through
+// AddStage is called after the operator is done, also on error
+type IStagesWorkpiece interface {
+	AddStage(operatorName string, duration time.Duration)
+}
+
+// Called around each operator call of the pipeline, see WithInterceptors()
+// Used to layer tracing, metrics, fault injection, debugging etc on any pipeline without modifying the operators
+type IOperatorInterceptor interface {
+	// Called right before the operator call
+	// If error is returned then the operator is not called and the error is handled as if it was returned by the operator
+	// (OperatorMethod_OnError: the call is just skipped)
+	Before(ctx context.Context, call OperatorCall) error
+
+	// Called after the operator call, also if Before() returned an error
+	// `err` is the error returned by the operator or by Before(), `duration` is the duration of the operator call
+	// The returned error replaces `err`. Ignored for OperatorMethod_OnError
+	After(ctx context.Context, call OperatorCall, duration time.Duration, err error) error
+}
+
 type IWorkpieceContext interface {
 	GetPipelineName() string
 	GetPipelineStruct() string
@@ -36,9 +36,11 @@ func NewSyncPipeline(ctx context.Context, name string, first *WiredOperator, oth
 	}
 	pipeline.wctx = NewWorkpieceContext(name, pstruct.String())
 
+	interceptors := operatorInterceptors(ctx)
 	for _, op := range pipeline.operators {
 		op.ctx = ctx
 		op.wctx = pipeline.wctx
+		op.interceptors = interceptors
 	}
 	return pipeline
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -81,6 +82,34 @@ func TestSyncPipeline_DoSync(t *testing.T) {
 	})
 }
 
+type stagesWork struct {
+	stages []string
+}
+
+func (w *stagesWork) Release() {}
+
+func (w *stagesWork) AddStage(operatorName string, duration time.Duration) {
+	w.stages = append(w.stages, operatorName)
+}
+
+func TestSyncPipeline_Stages(t *testing.T) {
+	nop := func(context.Context, *stagesWork) error { return nil }
+	nested := NewSyncPipeline(context.Background(), "nested",
+		WireFunc("nested-op", nop),
+	)
+	pipeline := NewSyncPipeline(context.Background(), "my-pipeline",
+		WireFunc("first", nop),
+		WireSyncOperator("nested", nested),
+		WireFunc("fail-here", func(context.Context, *stagesWork) error { return errors.New("test failure") }),
+		WireFunc("not-reached", nop),
+	)
+	defer pipeline.Close()
+
+	work := &stagesWork{}
+	require.Error(t, pipeline.SendSync(work))
+	require.Equal(t, []string{"first", "nested-op", "nested", "fail-here"}, work.stages)
+}
+
 func TestSyncPipeline_Close(t *testing.T) {
 	pipeline := &SyncPipeline{}
 
@@ -25,6 +25,23 @@ func NewWorkpieceContext(pName, pStruct string) WorkpieceContext {
 	}
 }
 
+type OperatorMethod int
+
+// Describes the intercepted operator call, see IOperatorInterceptor
+type OperatorCall struct {
+	Pipeline string
+	Operator string
+	Method   OperatorMethod
+
+	// nil for OperatorMethod_Flush and OperatorMethod_OnError
+	Work IWorkpiece
+
+	// the error the operator is notified about, OperatorMethod_OnError only
+	Err error
+}
+
+type interceptorsCtxKeyType struct{}
+
 type BatchItem struct {
 	Key   interface{}
 	Value interface{}
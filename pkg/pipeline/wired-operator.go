@@ -19,6 +19,7 @@ type WiredOperator struct {
 	ctx           context.Context
 	err           IErrorPipeline
 	flushCB       OpFuncFlush
+	interceptors  []IOperatorInterceptor
 }
 
 func WireAsyncOperator(name string, op IAsyncOperator, flushIntvl ...time.Duration) *WiredOperator {
@@ -64,7 +65,10 @@ func (wo *WiredOperator) forwardIfErrorAsync(work IWorkpiece) bool {
 	}
 
 	if err, ok := work.(IErrorPipeline); ok {
-		wo.Operator.(IAsyncOperator).OnError(wo.ctx, err)
+		_ = wo.intercept(OperatorMethod_OnError, nil, err, func() error {
+			wo.Operator.(IAsyncOperator).OnError(wo.ctx, err)
+			return nil
+		})
 		wo.Stdout <- err
 		return true
 	}
@@ -86,8 +90,20 @@ func (wo *WiredOperator) NewError(err error, work IWorkpiece, place string) IErr
 	return &ep
 }
 
+// ctx of the work if it is bound to a request, see IContextWorkpiece, ctx of the pipeline otherwise
+func (wo *WiredOperator) workCtx(work IWorkpiece) context.Context {
+	if ctxWork, ok := work.(IContextWorkpiece); ok {
+		return ctxWork.Context()
+	}
+	return wo.ctx
+}
+
 func (wo *WiredOperator) doAsync(work IWorkpiece) (IWorkpiece, IErrorPipeline) {
-	outWork, e := wo.Operator.(IAsyncOperator).DoAsync(wo.ctx, work)
+	var outWork IWorkpiece
+	e := wo.intercept(OperatorMethod_DoAsync, work, nil, func() (err error) {
+		outWork, err = wo.Operator.(IAsyncOperator).DoAsync(wo.ctx, work)
+		return err
+	})
 	if e != nil {
 		if outWork == nil {
 			return nil, wo.NewError(e, work, placeDoAsyncOutWorkIsNil)
@@ -99,7 +115,9 @@ func (wo *WiredOperator) doAsync(work IWorkpiece) (IWorkpiece, IErrorPipeline) {
 }
 
 func (wo *WiredOperator) doSync(_ context.Context, work IWorkpiece) IErrorPipeline {
-	e := wo.Operator.(ISyncOperator).DoSync(wo.ctx, work)
+	e := wo.intercept(OperatorMethod_DoSync, work, nil, func() error {
+		return wo.Operator.(ISyncOperator).DoSync(wo.ctx, work)
+	})
 	if e != nil {
 		return wo.NewError(e, work, placeDoSync)
 	}
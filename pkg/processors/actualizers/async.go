@@ -172,10 +172,12 @@ func (a *asyncActualizer) init(vvmCtx context.Context) (err error) {
 		iProjector:            prjType,
 		nonBuffered:           nonBuffered,
 		appParts:              a.appParts,
+		logCtx:                vvmCtx,
 	}
 
 	if p.metrics != nil {
 		p.projInErrAddr = p.metrics.AppMetricAddr(ProjectorsInError, a.conf.VvmName, a.conf.AppQName)
+		p.eventDuration = p.metrics.Summary(ProjectorEventDurationSeconds, a.conf.VvmName, a.conf.AppQName, a.projectorQName)
 	}
 
 	a.name = fmt.Sprintf("%v [%d]", p.name, a.conf.PartitionID)
@@ -185,16 +187,18 @@ func (a *asyncActualizer) init(vvmCtx context.Context) (err error) {
 		return err
 	}
 
+	stateOpts := a.conf.StateOpts
+	stateOpts.LogCtxFunc = func() context.Context { return p.logCtx }
 	p.state = stateprovide.ProvideAsyncActualizerStateFactory()(
 		vvmCtx,
 		p.borrowedAppStructs,
 		p.WSIDProvider,
-		func(view appdef.QName, wsid istructs.WSID, offset istructs.Offset) {
-			a.conf.Broker.Update(in10n.ProjectionKey{
+		func(view appdef.QName, wsid istructs.WSID, offset istructs.Offset, payload in10n.Payload) {
+			a.conf.Broker.UpdateWithPayload(in10n.ProjectionKey{
 				App:        a.conf.AppQName,
 				Projection: view,
 				WS:         wsid,
-			}, offset)
+			}, offset, payload)
 		},
 		a.conf.SecretReader,
 		p.EventProvider,
@@ -202,7 +206,7 @@ func (a *asyncActualizer) init(vvmCtx context.Context) (err error) {
 		a.conf.Federation,
 		a.conf.IntentsLimit,
 		a.conf.BundlesLimit,
-		a.conf.StateOpts,
+		stateOpts,
 		a.conf.EmailSender,
 		a.conf.HTTPClient,
 	)
@@ -383,6 +387,7 @@ type asyncProjector struct {
 	aametrics             AsyncActualizerMetrics
 	metrics               imetrics.IMetrics
 	projInErrAddr         *imetrics.MetricValue
+	eventDuration         *imetrics.Summary
 	flushPositionInterval time.Duration
 	acceptedSinceSave     bool
 	lastSave              time.Time
@@ -393,6 +398,7 @@ type asyncProjector struct {
 	nonBuffered           bool
 	appParts              appparts.IAppPartitions
 	borrowedPartition     appparts.IAppPartition
+	logCtx                context.Context // of the event being handled
 }
 
 // DoAsync is executed for every event of a given partition.
@@ -441,6 +447,8 @@ func (p *asyncProjector) DoAsync(ctx context.Context, work pipeline.IWorkpiece)
 		return nil, err
 	}
 
+	p.logCtx = w.logCtx
+	start := time.Now()
 	if err := p.borrowedPartition.Invoke(w.logCtx, p.name, p.state, p.state); err != nil {
 		return nil, err
 	}
@@ -457,6 +465,9 @@ func (p *asyncProjector) DoAsync(ctx context.Context, work pipeline.IWorkpiece)
 			return nil, err
 		}
 	}
+	if p.eventDuration != nil {
+		p.eventDuration.ObserveSince(start)
+	}
 	if logger.IsVerbose() {
 		logger.VerboseCtx(w.logCtx, "ap.success")
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/goutils/logger"
+	"github.com/voedger/voedger/pkg/iauthnz"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/pipeline"
 	"github.com/voedger/voedger/pkg/processors"
@@ -35,6 +36,8 @@ func syncActualizerFactory(conf SyncActualizerConf, projectors istructs.Projecto
 	return pipeline.NewSyncPipeline(conf.VvmCtx, "PartitionSyncActualizer",
 		pipeline.WireFunc("Update event", func(_ context.Context, work processors.IProjectorWorkpiece) (err error) {
 			service.event = work.Event()
+			service.principals = work.GetPrincipals()
+			service.logCtx = work.LogCtx()
 			return nil
 		}),
 		pipeline.WireFunc("Update IAppStructs", func(_ context.Context, work processors.IProjectorWorkpiece) (err error) {
@@ -64,8 +67,9 @@ func newSyncBranch(conf SyncActualizerConf, projector istructs.Projector, servic
 		conf.N10nFunc,
 		conf.SecretReader,
 		service.getEvent,
+		service.getPrincipals,
 		conf.IntentsLimit,
-		state.NullOpts,
+		state.StateOpts{LogCtxFunc: service.getLogCtx},
 	)
 	fn = pipeline.ForkBranch(pipeline.NewSyncPipeline(conf.VvmCtx, pipelineName,
 		pipeline.WireFunc("Projector",
@@ -118,15 +122,21 @@ func (h *syncErrorHandler) OnErr(err error, _ interface{}, _ pipeline.IWorkpiece
 
 type eventService struct {
 	event      istructs.IPLogEvent
+	principals []iauthnz.Principal
 	appStructs istructs.IAppStructs
+	logCtx     context.Context
 }
 
 func (s *eventService) getWSID() istructs.WSID { return s.event.Workspace() }
 
 func (s *eventService) getEvent() istructs.IPLogEvent { return s.event }
 
+func (s *eventService) getPrincipals() []iauthnz.Principal { return s.principals }
+
 func (s *eventService) getIAppStructs() istructs.IAppStructs { return s.appStructs }
 
+func (s *eventService) getLogCtx() context.Context { return s.logCtx }
+
 func provideViewDefImpl(wsb appdef.IWorkspaceBuilder, qname appdef.QName, buildFunc ViewTypeBuilder) {
 	builder := wsb.AddView(qname)
 	if buildFunc != nil {
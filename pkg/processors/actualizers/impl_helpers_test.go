@@ -14,6 +14,7 @@ import (
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appparts"
+	"github.com/voedger/voedger/pkg/iauthnz"
 	"github.com/voedger/voedger/pkg/istructs"
 )
 
@@ -55,6 +56,7 @@ func (w *cmdWorkpieceMock) Release()                             {}
 func (w *cmdWorkpieceMock) Context() context.Context             { return context.Background() }
 func (w *cmdWorkpieceMock) LogCtx() context.Context              { return context.Background() }
 func (w *cmdWorkpieceMock) PLogOffset() istructs.Offset          { return 0 }
+func (w *cmdWorkpieceMock) GetPrincipals() []iauthnz.Principal   { return nil }
 
 type cmdProcMock struct {
 	appParts appparts.IAppPartitions
@@ -18,6 +18,9 @@ import (
 const (
 	ProjectorsInError = "voedger_projectors_in_error"
 
+	// summary, labels: app, vvm, qname (projector)
+	ProjectorEventDurationSeconds = "voedger_aa_event_duration_seconds"
+
 	// internal metrics
 	aaFlushesTotal  = "voedger_aa_flushes_total"
 	aaCurrentOffset = "voedger_aa_current_offset"
@@ -52,12 +52,12 @@ func NewSyncActualizerFactoryFactory(actualizerFactory SyncActualizerFactory, se
 			VvmCtx:       context.Background(), // it is needed for sync pipeline and GMP believes it is enough
 			SecretReader: secretReader,
 			Partition:    partitionID,
-			N10nFunc: func(view appdef.QName, wsid istructs.WSID, offset istructs.Offset) {
-				n10nBroker.Update(in10n.ProjectionKey{
+			N10nFunc: func(view appdef.QName, wsid istructs.WSID, offset istructs.Offset, payload in10n.Payload) {
+				n10nBroker.UpdateWithPayload(in10n.ProjectionKey{
 					App:        appStructs.AppQName(),
 					Projection: view,
 					WS:         wsid,
-				}, offset)
+				}, offset, payload)
 			},
 			IntentsLimit: DefaultIntentsLimit,
 		}
@@ -114,4 +114,16 @@ func (m *implIBLOBMessage_base) Release() {
 	close(m.done)
 }
 
+func (m *implIBLOBMessage_base) Context() context.Context {
+	return m.requestCtx
+}
+
+// pipeline.IContextWorkpiece
+func (b *blobWorkpiece) Context() context.Context {
+	if msg, ok := b.blobMessage.(pipeline.IContextWorkpiece); ok {
+		return msg.Context()
+	}
+	return context.Background()
+}
+
 type IRequestHandlerPtr *IRequestHandler
@@ -5,6 +5,8 @@
 package commandprocessor
 
 import (
+	"time"
+
 	"github.com/voedger/voedger/pkg/appdef"
 )
 
@@ -16,3 +18,18 @@ var (
 const (
 	args = "args"
 )
+
+// how long the result of the command is kept to be returned on the repeated request with the same Idempotency-Key
+const idempotencyKeyTTL = 24 * time.Hour
+
+// how long Idempotency-Key is reserved while the command is executed
+const idempotencyReservationTTL = time.Minute
+
+// max value length of the application TTL storage
+const maxIdempotencyRecordLength = 64 * 1024
+
+const (
+	maxBatchCommands    = 100
+	field_BatchCommand  = "command"
+	field_BatchCommands = "Commands" // field of sys.BatchParams
+)
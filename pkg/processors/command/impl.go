@@ -24,18 +24,21 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/acl"
 	"github.com/voedger/voedger/pkg/appparts"
 	"github.com/voedger/voedger/pkg/coreutils"
 	"github.com/voedger/voedger/pkg/iauthnz"
 	"github.com/voedger/voedger/pkg/in10n"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
+	imetrics "github.com/voedger/voedger/pkg/metrics"
 	"github.com/voedger/voedger/pkg/pipeline"
 	"github.com/voedger/voedger/pkg/processors"
 	"github.com/voedger/voedger/pkg/sys/authnz"
 	"github.com/voedger/voedger/pkg/sys/blobber"
 	"github.com/voedger/voedger/pkg/sys/builtin"
 	workspacemgmt "github.com/voedger/voedger/pkg/sys/workspace"
+	"github.com/voedger/voedger/pkg/tracing"
 )
 
 func (cm *implICommandMessage) Body() []byte                      { return cm.body }
@@ -51,24 +54,26 @@ func (cm *implICommandMessage) APIPath() processors.APIPath       { return cm.ap
 func (cm *implICommandMessage) DocID() istructs.RecordID          { return cm.docID }
 func (cm *implICommandMessage) Method() string                    { return cm.method }
 func (cm *implICommandMessage) Origin() string                    { return cm.origin }
+func (cm *implICommandMessage) IdempotencyKey() string            { return cm.idempotencyKey }
 
 func NewCommandMessage(requestCtx context.Context, body []byte, appQName appdef.AppQName, wsid istructs.WSID,
 	responder bus.IResponder, partitionID istructs.PartitionID, qName appdef.QName, token string, host string, apiPath processors.APIPath,
-	docID istructs.RecordID, method string, origin string) ICommandMessage {
+	docID istructs.RecordID, method string, origin string, idempotencyKey string) ICommandMessage {
 	return &implICommandMessage{
-		body:        body,
-		appQName:    appQName,
-		wsid:        wsid,
-		responder:   responder,
-		partitionID: partitionID,
-		requestCtx:  requestCtx,
-		qName:       qName,
-		token:       token,
-		host:        host,
-		apiPath:     apiPath,
-		docID:       docID,
-		method:      method,
-		origin:      origin,
+		body:           body,
+		appQName:       appQName,
+		wsid:           wsid,
+		responder:      responder,
+		partitionID:    partitionID,
+		requestCtx:     requestCtx,
+		qName:          qName,
+		token:          token,
+		host:           host,
+		apiPath:        apiPath,
+		docID:          docID,
+		method:         method,
+		origin:         origin,
+		idempotencyKey: idempotencyKey,
 	}
 }
 
@@ -92,6 +97,20 @@ func (c *cmdWorkpiece) LogCtx() context.Context {
 	return c.logCtx
 }
 
+// only commands resolved in the workspace are labeled by their QName
+func (c *cmdWorkpiece) metricsQName() appdef.QName {
+	if c.iCommand == nil {
+		return imetrics.QNameUnresolved
+	}
+	return c.iCommand.QName()
+}
+
+func (c *cmdWorkpiece) AddStage(operatorName string, duration time.Duration) {
+	if c.stages != nil {
+		c.stages.Add(operatorName, duration)
+	}
+}
+
 // used in projectors.NewSyncActualizerFactoryFactory
 func (c *cmdWorkpiece) Event() istructs.IPLogEvent {
 	return c.pLogEvent
@@ -209,9 +228,12 @@ func getIWorkspace(_ context.Context, cmd *cmdWorkpiece) (err error) {
 	return nil
 }
 func getCmdQName(_ context.Context, cmd *cmdWorkpiece) (err error) {
-	if cmd.cmdMes.APIPath() == processors.APIPath_Docs {
+	switch cmd.cmdMes.APIPath() {
+	case processors.APIPath_Docs:
 		cmd.cmdQName = istructs.QNameCommandCUD
-	} else {
+	case processors.APIPath_Batch:
+		cmd.cmdQName = istructs.QNameCommandBatch
+	default:
 		cmd.cmdQName = cmd.cmdMes.QName()
 	}
 	return nil
@@ -281,7 +303,7 @@ func newRecoveryCtx(ctx context.Context, partID istructs.PartitionID) context.Co
 	return logger.WithContextAttrs(ctx, map[string]any{
 		logger.LogAttr_VApp:      sys.VApp_SysVoedger,
 		logger.LogAttr_Extension: "sys._Recovery",
-		"partid":                 partID,
+		logger.LogAttr_PartID:    partID,
 	})
 }
 
@@ -371,7 +393,7 @@ func (cmdProc *cmdProc) putPLog(_ context.Context, cmd *cmdWorkpiece) (err error
 	} else {
 		cmd.appPartition.nextPLogOffset++
 	}
-	return nil
+	return err
 }
 
 func logEventAndCUDs(_ context.Context, cmd *cmdWorkpiece) (err error) {
@@ -632,7 +654,8 @@ func (xp xPath) Error(err error) error {
 func execCommand(ctx context.Context, cmd *cmdWorkpiece) (err error) {
 	begin := time.Now()
 
-	err = cmd.appPart.Invoke(ctx, cmd.cmdQName, cmd.eca.State, cmd.eca.Intents)
+	// ctx is the processor context, the request span is taken from the command message
+	err = cmd.appPart.Invoke(tracing.ContextWithSpanOf(ctx, cmd.Context()), cmd.cmdQName, cmd.eca.State, cmd.eca.Intents)
 
 	cmd.metrics.increase(ExecSeconds, time.Since(begin).Seconds())
 	return err
@@ -830,7 +853,8 @@ func parseCUDs(_ context.Context, cmd *cmdWorkpiece) (err error) {
 }
 
 func checkCUDsAllowedInCUDCmdOnly(_ context.Context, cmd *cmdWorkpiece) (err error) {
-	if len(cmd.parsedCUDs) > 0 && cmd.cmdQName != istructs.QNameCommandCUD && cmd.cmdQName != builtin.QNameCommandInit { // nolint SA1019
+	if len(cmd.parsedCUDs) > 0 && cmd.cmdQName != istructs.QNameCommandCUD && cmd.cmdQName != builtin.QNameCommandInit && // nolint SA1019
+		cmd.cmdQName != workspacemgmt.QNameCommandImportWorkspace { // imported records are provided as CUDs
 		return errors.New("CUDs allowed for c.sys.CUD command only")
 	}
 	return nil
@@ -904,6 +928,17 @@ func (cmdProc *cmdProc) authorizeRequestCUDs(_ context.Context, cmd *cmdWorkpiec
 		if !newACLOk && oldACLOk && logger.IsVerbose() {
 			logger.VerboseCtx(cmd.cmdMes.RequestCtx(), "", "newACL not ok, but oldACL ok. ", parsedCUD.opKind, parsedCUD.qName, cmd.roles)
 		}
+		if parsedCUD.existingRecord != nil {
+			// row policies restrict updates of existing rows only
+			policies := acl.RowPolicies(ws, parsedCUD.opKind, parsedCUD.qName, cmd.roles)
+			allowed, err := istructsmem.IsRowAllowed(parsedCUD.existingRecord, policies, iauthnz.SubjectName(cmd.principals))
+			if err != nil {
+				return err
+			}
+			if !allowed {
+				return coreutils.NewHTTPError(http.StatusForbidden, parsedCUD.xPath.Errorf("operation forbidden by row policy"))
+			}
+		}
 	}
 	return nil
 }
@@ -925,6 +960,9 @@ func (cmdProc *cmdProc) writeCUDs(_ context.Context, cmd *cmdWorkpiece) (err err
 }
 
 func (osp *wrongArgsCatcher) OnErr(err error, _ interface{}, _ pipeline.IWorkpieceContext) (newErr error) {
+	if errors.Is(err, errIdempotentReplay) {
+		return err
+	}
 	return coreutils.WrapSysError(err, http.StatusBadRequest)
 }
 
@@ -947,6 +985,15 @@ func sendResponse(cmd *cmdWorkpiece, handlingError error) {
 		bus.ReplyErr(cmd.cmdMes.Responder(), handlingError)
 		return
 	}
+	if cmd.idempotencyRecord != nil {
+		bus.ReplyJSON(cmd.cmdMes.Responder(), cmd.idempotencyRecord.StatusCode, cmd.idempotencyRecord.Response)
+		return
+	}
+	bus.ReplyJSON(cmd.cmdMes.Responder(), cmd.statusCodeOfSuccess, buildResponse(cmd))
+}
+
+// builds the body of the successful command response
+func buildResponse(cmd *cmdWorkpiece) string {
 	body := bytes.NewBufferString(fmt.Sprintf(`{"CurrentWLogOffset":%d`, cmd.pLogEvent.WLogOffset()))
 	if len(cmd.idGeneratorReporter.generatedIDs) > 0 {
 		body.WriteString(`,"NewIDs":{`)
@@ -966,6 +1013,24 @@ func sendResponse(cmd *cmdWorkpiece, handlingError error) {
 		body.WriteString(`,"Result":`)
 		body.Write(cmdResultBytes)
 	}
+	if cmd.batch != nil {
+		// results of the commands of the batch in the order of the request, null if the command has no result
+		results := make([]map[string]interface{}, 0, len(cmd.batch))
+		for _, bc := range cmd.batch {
+			var result map[string]interface{}
+			if bc.cmdResult != nil {
+				result = coreutils.ObjectToMap(bc.cmdResult, cmd.appStructs.AppDef())
+			}
+			results = append(results, result)
+		}
+		resultsBytes, err := json.Marshal(results)
+		if err != nil {
+			// notest: impossible
+			panic("failed to marshal response: " + err.Error())
+		}
+		body.WriteString(`,"Results":`)
+		body.Write(resultsBytes)
+	}
 	body.WriteString("}")
 	res := body.String()
 	if cmd.cmdMes.APIPath() != 0 {
@@ -988,7 +1053,7 @@ func sendResponse(cmd *cmdWorkpiece, handlingError error) {
 		res = string(camelCasedResBytes)
 	}
 	cmd.cmdResToLog = res
-	bus.ReplyJSON(cmd.cmdMes.Responder(), cmd.statusCodeOfSuccess, res)
+	return res
 }
 
 func (idGen *implIDGeneratorReporter) NextID(rawID istructs.RecordID) (storageID istructs.RecordID, err error) {
@@ -0,0 +1,317 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package commandprocessor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+// parseBatch reads the commands sent to the batch endpoint
+//
+// The batch is stored as the single sys.Batch event or is not stored at all. The arguments of the event are the
+// commands with their arguments in the order of the request, the CUDs of the event are the CUDs of all commands and
+// the CUDs made by the commands on the execution. Raw IDs are unique across the batch, so CUDs could refer to the
+// records created by the CUDs of the previous commands. Updates of the records created or updated by the previous
+// commands are merged into the preceding CUD of the record.
+func parseBatch(_ context.Context, cmd *cmdWorkpiece) error {
+	var batchRequest struct {
+		Commands []coreutils.MapObject `json:"commands"`
+	}
+	if err := coreutils.JSONUnmarshal(cmd.cmdMes.Body(), &batchRequest); err != nil {
+		return coreutils.NewHTTPErrorf(http.StatusBadRequest, "failed to unmarshal batch request body: ", err)
+	}
+	if len(batchRequest.Commands) == 0 {
+		return coreutils.NewHTTPErrorf(http.StatusBadRequest, "no commands in the batch")
+	}
+	if len(batchRequest.Commands) > maxBatchCommands {
+		return coreutils.NewHTTPErrorf(http.StatusBadRequest, "too many commands: ", len(batchRequest.Commands), " is in the batch, max is ", maxBatchCommands)
+	}
+
+	merger := &batchCUDsMerger{
+		declaredRawIDs: map[istructs.RecordID]int{},
+		created:        map[istructs.RecordID]coreutils.MapObject{},
+		updated:        map[istructs.RecordID]coreutils.MapObject{},
+	}
+	loggedCommands := make([]coreutils.MapObject, 0, len(batchRequest.Commands))
+	for cmdIdx, cmdData := range batchRequest.Commands {
+		bc, err := newBatchCommand(cmd, cmdIdx, cmdData, merger)
+		if err != nil {
+			return coreutils.NewHTTPError(http.StatusBadRequest, batchCommandXPath(cmdIdx).Error(err))
+		}
+		cmd.batch = append(cmd.batch, bc)
+		loggedCommand := coreutils.MapObject{field_BatchCommand: bc.cmdQName.String()}
+		if cmdArgs, ok := cmdData[args]; ok {
+			loggedCommand[args] = cmdArgs
+		}
+		loggedCommands = append(loggedCommands, loggedCommand)
+	}
+	loggedCommandsJSON, err := json.Marshal(loggedCommands)
+	if err != nil {
+		// notest: unmarshaled just now
+		return err
+	}
+	cmd.requestData = coreutils.MapObject{
+		args: map[string]interface{}{
+			field_BatchCommands: string(loggedCommandsJSON),
+		},
+	}
+	return nil
+}
+
+func newBatchCommand(cmd *cmdWorkpiece, cmdIdx int, cmdData coreutils.MapObject, merger *batchCUDsMerger) (*batchCommand, error) {
+	iCommand, err := batchICommand(cmd, cmdData)
+	if err != nil {
+		return nil, err
+	}
+	bc := &batchCommand{
+		cmdQName:    iCommand.QName(),
+		iCommand:    iCommand,
+		requestData: coreutils.MapObject{},
+	}
+	for name, value := range cmdData {
+		switch {
+		case name == field_BatchCommand:
+		case name == "cuds":
+			if bc.cmdQName != istructs.QNameCommandCUD {
+				return nil, fmt.Errorf("CUDs allowed for %s command only", istructs.QNameCommandCUD)
+			}
+			cuds, _, err := cmdData.AsObjects(name)
+			if err != nil {
+				return nil, err
+			}
+			if bc.requestData[name], err = merger.mergeCUDs(cmdIdx, cuds); err != nil {
+				return nil, err
+			}
+		case bc.cmdQName == istructs.QNameCommandCUD:
+			return nil, fmt.Errorf(`unexpected field "%s" of %s command`, name, bc.cmdQName)
+		default:
+			// args, unloggedArgs and unexpected fields which will be reported by checkUnexpectedRequestBodyFields
+			bc.requestData[name] = value
+		}
+	}
+	return bc, nil
+}
+
+// returns the command of the batch which could be stored within the sys.Batch event
+func batchICommand(cmd *cmdWorkpiece, cmdData coreutils.MapObject) (appdef.ICommand, error) {
+	cmdQNameStr, err := cmdData.AsStringRequired(field_BatchCommand)
+	if err != nil {
+		return nil, err
+	}
+	cmdQName, err := appdef.ParseQName(cmdQNameStr)
+	if err != nil {
+		return nil, err
+	}
+	iCommand := appdef.Command(cmd.iWorkspace.Type, cmdQName)
+	if iCommand == nil {
+		return nil, fmt.Errorf("command %s is not found", cmdQName)
+	}
+	if cmdQName == istructs.QNameCommandBatch {
+		return nil, fmt.Errorf("command %s could not be executed in a batch", cmdQName)
+	}
+	// the event keeps the arguments of the commands as JSON, so ODocs could not be stored
+	for _, param := range []appdef.IType{iCommand.Param(), iCommand.UnloggedParam()} {
+		if param != nil && (param.Kind() != appdef.TypeKind_Object || param.QName() == istructs.QNameRaw) {
+			return nil, fmt.Errorf("command %s with %s argument could not be executed in a batch", cmdQName, param.QName())
+		}
+	}
+	// projectors are triggered by the sys.Batch event, not by the commands of the batch
+	for prj := range appdef.Projectors(cmd.appStructs.AppDef().Types()) {
+		if prj.Triggers(appdef.OperationKind_Execute, iCommand) ||
+			iCommand.Param() != nil && prj.Triggers(appdef.OperationKind_ExecuteWithParam, iCommand.Param()) {
+			return nil, fmt.Errorf("command %s triggers projector %s and could not be executed in a batch", cmdQName, prj.QName())
+		}
+	}
+	return iCommand, nil
+}
+
+// returns CUDs which are not merged into the preceding CUDs of the batch
+func (m *batchCUDsMerger) mergeCUDs(cmdIdx int, cuds []interface{}) (res []interface{}, err error) {
+	for cudIdx, cudIntf := range cuds {
+		cud, ok := cudIntf.(map[string]interface{})
+		if !ok {
+			res = append(res, cudIntf) // will be reported by parseCUDs
+			continue
+		}
+		fields, ok, err := coreutils.MapObject(cud).AsObject("fields")
+		if err != nil || !ok {
+			res = append(res, cudIntf) // will be reported by parseCUDs
+			continue
+		}
+		idToUpdate, isUpdate, err := coreutils.MapObject(cud).AsInt64(appdef.SystemField_ID)
+		if err != nil {
+			res = append(res, cudIntf) // will be reported by parseCUDs
+			continue
+		}
+		if !isUpdate {
+			if rawID, ok, err := fields.AsInt64(appdef.SystemField_ID); err == nil && ok {
+				id := istructs.RecordID(rawID) // nolint G115
+				if err := m.declareRawID(id, cmdIdx); err != nil {
+					return nil, err
+				}
+				m.created[id] = fields
+			}
+			res = append(res, cudIntf)
+			continue
+		}
+		id := istructs.RecordID(idToUpdate) // nolint G115
+		preceding, ok := m.created[id]
+		if !ok {
+			preceding, ok = m.updated[id]
+		}
+		if !ok {
+			m.updated[id] = fields
+			res = append(res, cudIntf)
+			continue
+		}
+		for _, sysField := range []string{appdef.SystemField_ID, appdef.SystemField_QName} {
+			if _, ok := fields[sysField]; ok {
+				return nil, fmt.Errorf("cuds[%d]: field %s could not be updated", cudIdx, sysField)
+			}
+		}
+		maps.Copy(preceding, fields)
+	}
+	return res, nil
+}
+
+// raw ID must be declared once per batch, otherwise references to it are ambiguous
+func (m *batchCUDsMerger) declareRawID(rawID istructs.RecordID, cmdIdx int) error {
+	if !rawID.IsRaw() {
+		return nil
+	}
+	if declaredIn, ok := m.declaredRawIDs[rawID]; ok && declaredIn != cmdIdx {
+		return fmt.Errorf("raw ID %d is already declared in commands[%d]", rawID, declaredIn)
+	}
+	m.declaredRawIDs[rawID] = cmdIdx
+	return nil
+}
+
+// calls f for each command of the batch in the order of the request
+// the workpiece is switched to the command while f is called, so the operators of the single command could be used
+func (c *cmdWorkpiece) forEachBatchCommand(f func(cmdIdx int) error) error {
+	cmdQName, iCommand, requestData := c.cmdQName, c.iCommand, c.requestData
+	argsObject, unloggedArgsObject := c.argsObject, c.unloggedArgsObject
+	cmdResultBuilder, cmdResult := c.cmdResultBuilder, c.cmdResult
+	defer func() {
+		c.cmdQName, c.iCommand, c.requestData = cmdQName, iCommand, requestData
+		c.argsObject, c.unloggedArgsObject = argsObject, unloggedArgsObject
+		c.cmdResultBuilder, c.cmdResult = cmdResultBuilder, cmdResult
+	}()
+	for cmdIdx, bc := range c.batch {
+		c.cmdQName, c.iCommand, c.requestData = bc.cmdQName, bc.iCommand, bc.requestData
+		c.argsObject, c.unloggedArgsObject = bc.argsObject, bc.unloggedArgsObject
+		c.cmdResultBuilder, c.cmdResult = bc.cmdResultBuilder, bc.cmdResult
+		err := f(cmdIdx)
+		bc.argsObject, bc.unloggedArgsObject = c.argsObject, c.unloggedArgsObject
+		bc.cmdResultBuilder, bc.cmdResult = c.cmdResultBuilder, c.cmdResult
+		if err != nil {
+			return batchCommandError(cmdIdx, err)
+		}
+	}
+	return nil
+}
+
+// returns the operator which is called for each command of the batch
+func batchOp(op func(context.Context, *cmdWorkpiece) error) func(context.Context, *cmdWorkpiece) error {
+	return func(ctx context.Context, cmd *cmdWorkpiece) error {
+		return cmd.forEachBatchCommand(func(int) error {
+			return op(ctx, cmd)
+		})
+	}
+}
+
+func getBatchCommandArgsObjects(_ context.Context, cmd *cmdWorkpiece) (err error) {
+	if param := cmd.iCommand.Param(); param != nil {
+		if cmd.argsObject, err = buildBatchCommandObject(cmd, param.QName(), args); err != nil {
+			return fmt.Errorf("argument object build failed: %w", err)
+		}
+	}
+	if unloggedParam := cmd.iCommand.UnloggedParam(); unloggedParam != nil {
+		if cmd.unloggedArgsObject, err = buildBatchCommandObject(cmd, unloggedParam.QName(), "unloggedArgs"); err != nil {
+			return fmt.Errorf("unlogged argument object build failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func buildBatchCommandObject(cmd *cmdWorkpiece, qName appdef.QName, field string) (istructs.IObject, error) {
+	ob := cmd.appStructs.ObjectBuilder(qName)
+	data, exists, err := cmd.requestData.AsObject(field)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		ob.FillFromJSON(data)
+	}
+	return ob.Build()
+}
+
+func parseBatchCUDs(ctx context.Context, cmd *cmdWorkpiece) error {
+	return cmd.forEachBatchCommand(func(cmdIdx int) error {
+		parsedBefore := len(cmd.parsedCUDs)
+		err := parseCUDs(ctx, cmd)
+		for i := parsedBefore; i < len(cmd.parsedCUDs); i++ {
+			cmd.parsedCUDs[i].xPath = xPath(fmt.Sprintf("%s %s", batchCommandXPath(cmdIdx), cmd.parsedCUDs[i].xPath))
+		}
+		return err
+	})
+}
+
+// executes the command of the batch, the CUDs and the result of the command are kept in the event and in the command
+func (cmdProc *cmdProc) execBatchCommand(ctx context.Context, cmd *cmdWorkpiece) error {
+	if cmd.cmdQName == istructs.QNameCommandCUD {
+		// CUDs are written already, see [cmdProc.writeCUDs]
+		return nil
+	}
+	for _, op := range []func(context.Context, *cmdWorkpiece) error{
+		cmdProc.getCmdResultBuilder,
+		cmdProc.buildCommandArgs,
+		cmdProc.getHostState,
+		execCommand,
+		checkResponseIntent,
+		validateCmdResult,
+	} {
+		if err := op(ctx, cmd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CUDs of the event are validated once per each command of the batch
+func (cmdProc *cmdProc) batchCUDsValidators(ctx context.Context, cmd *cmdWorkpiece) error {
+	validated := map[appdef.QName]bool{}
+	return cmd.forEachBatchCommand(func(int) error {
+		if validated[cmd.cmdQName] {
+			return nil
+		}
+		validated[cmd.cmdQName] = true
+		return cmdProc.cudsValidators(ctx, cmd)
+	})
+}
+
+func batchCommandXPath(cmdIdx int) xPath {
+	return xPath("commands[" + strconv.Itoa(cmdIdx) + "]")
+}
+
+// keeps the HTTP status of the error
+func batchCommandError(cmdIdx int, err error) error {
+	var sysErr coreutils.SysError
+	if errors.As(err, &sysErr) {
+		sysErr.Message = batchCommandXPath(cmdIdx).Errorf("%s", sysErr.Error()).Error()
+		return sysErr
+	}
+	return batchCommandXPath(cmdIdx).Error(err)
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package commandprocessor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/goutils/logger"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+// errIdempotentReplay stops the command pipeline when the result of the command is already stored, see [checkIdempotencyKey]
+var errIdempotentReplay = errors.New("command is already executed with the same " + httpu.IdempotencyKey)
+
+// reserves Idempotency-Key before the command is executed, so the concurrent request with the same key is not executed
+// if the command with the same key is already executed in the workspace, the stored response is sent instead
+func checkIdempotencyKey(_ context.Context, cmd *cmdWorkpiece) error {
+	idempotencyKey := cmd.cmdMes.IdempotencyKey()
+	if len(idempotencyKey) == 0 {
+		return nil
+	}
+	ttlStorage := cmd.appStructs.AppTTLStorage()
+	storageKey := idempotencyStorageKey(cmd.cmdMes, idempotencyKey)
+	// the nonce makes the reservation unique per request, so only the request that reserved the key could replace it
+	reservation, err := json.Marshal(&idempotencyRecord{RequestHash: requestHash(cmd), Nonce: rand.Text()})
+	if err != nil {
+		// notest
+		return err
+	}
+	ok, err := ttlStorage.InsertIfNotExists(storageKey, string(reservation), int(idempotencyReservationTTL.Seconds()))
+	if err != nil {
+		return err
+	}
+	if ok {
+		cmd.idempotencyReservation = string(reservation)
+		return nil
+	}
+	data, ok, err := ttlStorage.TTLGet(storageKey)
+	if err != nil {
+		return err
+	}
+	record := &idempotencyRecord{}
+	if ok {
+		if err := json.Unmarshal([]byte(data), record); err != nil {
+			// notest
+			return err
+		}
+		if record.RequestHash != requestHash(cmd) {
+			return coreutils.NewHTTPErrorf(http.StatusUnprocessableEntity, httpu.IdempotencyKey, " ", idempotencyKey, " is already used for another request")
+		}
+	}
+	if record.StatusCode == 0 {
+		// reserved and not stored yet or expired right now
+		return coreutils.NewHTTPErrorf(http.StatusConflict, "request with ", httpu.IdempotencyKey, " ", idempotencyKey, " is in progress")
+	}
+	logger.VerboseCtx(cmd.cmdMes.RequestCtx(), "cp.idempotency", "result of the event at wlog offset ", record.WLogOffset, " is returned")
+	cmd.idempotencyRecord = record
+	cmd.cmdResToLog = record.Response
+	return errIdempotentReplay
+}
+
+// replaces the reservation of Idempotency-Key with the result of the executed command
+// the result which exceeds the max value length of the storage is stored with the wlog offset only
+// the event is already stored at this point, so the failure is logged and the result is returned anyway
+func saveIdempotencyKey(_ context.Context, cmd *cmdWorkpiece) error {
+	if len(cmd.idempotencyReservation) == 0 {
+		return nil
+	}
+	idempotencyKey := cmd.cmdMes.IdempotencyKey()
+	record := &idempotencyRecord{
+		RequestHash: requestHash(cmd),
+		WLogOffset:  cmd.pLogEvent.WLogOffset(),
+		StatusCode:  cmd.statusCodeOfSuccess,
+		Response:    buildResponse(cmd),
+	}
+	data, err := json.Marshal(record)
+	if err == nil && len(data) > maxIdempotencyRecordLength {
+		offsetField := "CurrentWLogOffset"
+		if cmd.cmdMes.APIPath() != 0 {
+			offsetField = "currentWLogOffset"
+		}
+		record.Response = fmt.Sprintf(`{"%s":%d}`, offsetField, record.WLogOffset)
+		data, err = json.Marshal(record)
+	}
+	if err != nil {
+		// notest
+		logIdempotencyKeyNotSaved(cmd, record.WLogOffset, err)
+		return nil
+	}
+	ok, err := cmd.appStructs.AppTTLStorage().CompareAndSwap(idempotencyStorageKey(cmd.cmdMes, idempotencyKey), cmd.idempotencyReservation,
+		string(data), int(idempotencyKeyTTL.Seconds()))
+	if err == nil && !ok {
+		err = errors.New("the reservation is expired")
+	}
+	if err != nil {
+		logIdempotencyKeyNotSaved(cmd, record.WLogOffset, err)
+	}
+	return nil
+}
+
+func logIdempotencyKeyNotSaved(cmd *cmdWorkpiece, wlogOffset istructs.Offset, err error) {
+	logger.ErrorCtx(cmd.cmdMes.RequestCtx(), "cp.idempotency.error", "the event is stored at wlog offset ", wlogOffset,
+		", but failed to store the result for ", httpu.IdempotencyKey, " ", cmd.cmdMes.IdempotencyKey(), ": ", err)
+}
+
+// releases Idempotency-Key reserved by [checkIdempotencyKey] if the command is failed before the event is stored,
+// so the request could be repeated with the same key
+func releaseIdempotencyKey(cmd *cmdWorkpiece) {
+	if len(cmd.idempotencyReservation) == 0 || cmd.pLogEvent != nil {
+		return
+	}
+	idempotencyKey := cmd.cmdMes.IdempotencyKey()
+	if _, err := cmd.appStructs.AppTTLStorage().CompareAndDelete(idempotencyStorageKey(cmd.cmdMes, idempotencyKey), cmd.idempotencyReservation); err != nil {
+		logger.ErrorCtx(cmd.cmdMes.RequestCtx(), "cp.idempotency.error", "failed to release ", httpu.IdempotencyKey, " ", idempotencyKey, ": ", err)
+	}
+}
+
+// Idempotency-Key is unique per workspace
+func idempotencyStorageKey(cmdMes ICommandMessage, idempotencyKey string) string {
+	return fmt.Sprintf("cp.idempotency/%d/%s", cmdMes.WSID(), idempotencyKey)
+}
+
+// the same key could not be used by another principal to get the result of the command
+func requestHash(cmd *cmdWorkpiece) string {
+	cmdMes := cmd.cmdMes
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s %s %s %d %d\n", cmd.GetUserPrincipalName(), cmdMes.Method(), cmdMes.QName(), cmdMes.APIPath(), cmdMes.DocID())
+	hash.Write(cmdMes.Body())
+	return hex.EncodeToString(hash.Sum(nil))
+}
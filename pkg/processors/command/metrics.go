@@ -10,4 +10,7 @@ const (
 	ErrorsTotal       = "voedger_cp_errors_total"
 	ExecSeconds       = "voedger_cp_exec_seconds"
 	ProjectorsSeconds = "voedger_cp_projectors_seconds"
+
+	// histogram, labels: app, vvm, qname
+	CommandDurationSeconds = "voedger_cp_command_duration_seconds"
 )
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/voedger/voedger/pkg/appdef"
@@ -15,6 +16,8 @@ import (
 	"github.com/voedger/voedger/pkg/goutils/timeu"
 	imetrics "github.com/voedger/voedger/pkg/metrics"
 	"github.com/voedger/voedger/pkg/processors"
+	"github.com/voedger/voedger/pkg/processors/querycache"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 
 	"github.com/voedger/voedger/pkg/appparts"
 	"github.com/voedger/voedger/pkg/coreutils"
@@ -46,7 +49,7 @@ type appPartition struct {
 // syncActualizerFactory is a factory(partitionID) that returns a fork operator with a sync actualizer per each application. Inside of an each actualizer - projectors for each application
 func ProvideServiceFactory(appParts appparts.IAppPartitions, tm timeu.ITime,
 	n10nBroker in10n.IN10nBroker, metrics imetrics.IMetrics, vvm processors.VVMName, authenticator iauthnz.IAuthenticator,
-	secretReader isecrets.ISecretReader) ServiceFactory {
+	secretReader isecrets.ISecretReader, slowLog slowlog.ISlowLog, queryCache querycache.IQueryCache) ServiceFactory {
 	return func(commandsChannel CommandChannel) pipeline.IService {
 		cmdProc := &cmdProc{
 			appsPartitions: map[appdef.AppQName]map[istructs.PartitionID]*appPartition{},
@@ -94,6 +97,7 @@ func ProvideServiceFactory(appParts appparts.IAppPartitions, tm timeu.ITime,
 							cmd.appPartitionRestartScheduled = true
 						} else {
 							cmd.workspace.NextWLogOffset++
+							queryCache.WLogOffsetAdvanced(cmd.cmdMes.AppQName(), cmd.pLogEvent.Workspace(), cmd.pLogEvent.WLogOffset())
 						}
 						return err
 					})),
@@ -112,6 +116,7 @@ func ProvideServiceFactory(appParts appparts.IAppPartitions, tm timeu.ITime,
 				pipeline.WireFunc("getAppPartition", cmdProc.getAppPartition),
 				pipeline.WireFunc("getICommand", getICommand),
 				pipeline.WireFunc("authorizeRequest", cmdProc.authorizeRequest),
+				pipeline.WireFunc("checkIdempotencyKey", checkIdempotencyKey),
 				pipeline.WireFunc("unmarshalRequestBody", unmarshalRequestBody),
 				pipeline.WireFunc("checkUnexpectedRequestBodyFields", checkUnexpectedRequestBodyFields),
 				pipeline.WireFunc("getWorkspace", cmdProc.getWorkspace),
@@ -145,40 +150,119 @@ func ProvideServiceFactory(appParts appparts.IAppPartitions, tm timeu.ITime,
 				pipeline.WireFunc("logEventAndCUDs", logEventAndCUDs),
 				pipeline.WireFunc("store", cmdProc.storeOp.DoSync),
 				pipeline.WireFunc("notifyAsyncActualizers", cmdProc.notifyAsyncActualizers),
+				pipeline.WireFunc("saveIdempotencyKey", saveIdempotencyKey),
+			)
+			// the commands of the batch are handled by the operators of the single command, see [parseBatch]
+			batchPipeline := pipeline.NewSyncPipeline(vvmCtx, "Command Processor Batch",
+				pipeline.WireFunc("borrowAppPart", borrowAppPart),
+				pipeline.WireFunc("getCmdQName", getCmdQName),
+				pipeline.WireFunc("getWSDesc", getWSDesc),
+				pipeline.WireFunc("authenticate", cmdProc.authenticate),
+				pipeline.WireFunc("getPrincipalsRoles", getPrincipalsRoles),
+				pipeline.WireFunc("checkWSInitialized", checkWSInitialized),
+				pipeline.WireFunc("checkWSActive", checkWSActive),
+				pipeline.WireFunc("getIWorkspace", getIWorkspace),
+				pipeline.WireFunc("getAppPartition", cmdProc.getAppPartition),
+				pipeline.WireFunc("getICommand", getICommand),
+				pipeline.WireFunc("parseBatch", parseBatch),
+				pipeline.WireFunc("limitCallRate", batchOp(limitCallRate)),
+				pipeline.WireFunc("authorizeRequest", batchOp(cmdProc.authorizeRequest)),
+				pipeline.WireFunc("checkIdempotencyKey", checkIdempotencyKey),
+				pipeline.WireFunc("checkUnexpectedRequestBodyFields", batchOp(checkUnexpectedRequestBodyFields)),
+				pipeline.WireFunc("getWorkspace", cmdProc.getWorkspace),
+				pipeline.WireFunc("setPLogOffset", setPLogOffset),
+				pipeline.WireFunc("getRawEventBuilderBuilders", cmdProc.getRawEventBuilder),
+				pipeline.WireFunc("getArgsObject", getArgsObject),
+				pipeline.WireFunc("getBatchCommandArgsObjects", batchOp(getBatchCommandArgsObjects)),
+				pipeline.WireFunc("checkArgsRefIntegrity", batchOp(checkArgsRefIntegrity)),
+				pipeline.WireFunc("parseCUDs", parseBatchCUDs),
+				pipeline.WireSyncOperator("wrongArgsCatcher", &wrongArgsCatcher{}), // any error before -> wrap error into bad request http error
+				pipeline.WireFunc("getStatusCodeOfSuccess", getStatusCodeOfSuccess),
+				pipeline.WireFunc("checkIsActiveInCUDs", checkIsActiveInCUDs),
+				pipeline.WireFunc("authorizeRequestCUDs", cmdProc.authorizeRequestCUDs),
+				pipeline.WireFunc("appendBLOBOwnershipUpdaters", appendBLOBOwnershipUpdaters),
+				pipeline.WireFunc("writeCUDs", cmdProc.writeCUDs),
+				pipeline.WireFunc("execCommands", batchOp(cmdProc.execBatchCommand)),
+				pipeline.WireFunc("getHostState", cmdProc.getHostState),
+				pipeline.WireFunc("build raw event", buildRawEvent),
+				pipeline.WireFunc("eventValidators", cmdProc.eventValidators),
+				pipeline.WireFunc("validateCUDsQNames", cmdProc.validateCUDsQNames),
+				pipeline.WireFunc("getCommandCtxStorage", getCommandCtxStorage),
+				pipeline.WireFunc("cudsValidators", cmdProc.batchCUDsValidators),
+				pipeline.WireFunc("getIDGenerator", getIDGenerator),
+				pipeline.WireFunc("putPLog", cmdProc.putPLog),
+				pipeline.WireFunc("logEventAndCUDs", logEventAndCUDs),
+				pipeline.WireFunc("store", cmdProc.storeOp.DoSync),
+				pipeline.WireFunc("notifyAsyncActualizers", cmdProc.notifyAsyncActualizers),
+				pipeline.WireFunc("saveIdempotencyKey", saveIdempotencyKey),
 			)
 			// TODO: later make so that each partition has its own plogOffset, wsid has its own wlogOffset
 			defer cmdPipeline.Close()
+			defer batchPipeline.Close()
+			handleCommand := func(cmdMes ICommandMessage, cmdPipeline pipeline.ISyncPipeline) {
+				start := tm.Now()
+				cmd := &cmdWorkpiece{
+					cmdMes:      cmdMes,
+					requestData: coreutils.MapObject{},
+					appParts:    appParts,
+					hostState:   hs,
+					metrics: commandProcessorMetrics{
+						vvmName: string(vvm),
+						app:     cmdMes.AppQName(),
+						metrics: metrics,
+					},
+				}
+				if slowLog.Enabled() {
+					cmd.stages = &slowlog.Stages{}
+				}
+				var cmdHandlingErr error
+				func() { // borrowed application partition should be guaranteed to be freed
+					defer cmd.Release()
+					cmd.metrics.increase(CommandsTotal, 1.0)
+					cmdHandlingErr = cmdPipeline.SendSync(cmd)
+					switch {
+					case errors.Is(cmdHandlingErr, errIdempotentReplay):
+						cmdHandlingErr = nil
+					case cmdHandlingErr != nil:
+						releaseIdempotencyKey(cmd)
+					}
+					logHandlingError(cmd, cmdHandlingErr)
+					responseStart := time.Now()
+					sendResponse(cmd, cmdHandlingErr)
+					cmd.AddStage(slowlog.Stage_Response, time.Since(responseStart))
+					if cmdHandlingErr == nil {
+						logSuccess(cmd)
+					}
+					if cmd.appPartitionRestartScheduled {
+						logger.WarningCtx(newRecoveryCtx(cmd.cmdMes.RequestCtx(), cmd.cmdMes.PartitionID()), "cp.partition_recovery", "partition will be restarted due of an error on writing to Log: ", cmdHandlingErr)
+						delete(cmdProc.appsPartitions, cmd.cmdMes.AppQName())
+					}
+				}()
+				cmdSeconds := time.Since(start).Seconds()
+				metrics.IncreaseApp(CommandsSeconds, string(vvm), cmdMes.AppQName(), cmdSeconds)
+				metrics.Histogram(CommandDurationSeconds, string(vvm), cmdMes.AppQName(), cmd.metricsQName(), nil).Observe(cmdSeconds)
+				if cmd.stages != nil {
+					slowLog.Report(cmdMes.RequestCtx(), slowlog.Request{
+						Kind:     slowlog.RequestKind_Command,
+						App:      cmdMes.AppQName(),
+						WSID:     cmdMes.WSID(),
+						QName:    cmdMes.QName(),
+						Start:    start,
+						Duration: tm.Now().Sub(start),
+						Stages:   *cmd.stages,
+						Err:      cmdHandlingErr,
+					})
+				}
+			}
 			for vvmCtx.Err() == nil {
 				select {
 				case intf := <-commandsChannel:
-					start := tm.Now()
 					cmdMes := intf.(ICommandMessage)
-					cmd := &cmdWorkpiece{
-						cmdMes:      cmdMes,
-						requestData: coreutils.MapObject{},
-						appParts:    appParts,
-						hostState:   hs,
-						metrics: commandProcessorMetrics{
-							vvmName: string(vvm),
-							app:     cmdMes.AppQName(),
-							metrics: metrics,
-						},
+					if cmdMes.APIPath() == processors.APIPath_Batch {
+						handleCommand(cmdMes, batchPipeline)
+					} else {
+						handleCommand(cmdMes, cmdPipeline)
 					}
-					func() { // borrowed application partition should be guaranteed to be freed
-						defer cmd.Release()
-						cmd.metrics.increase(CommandsTotal, 1.0)
-						cmdHandlingErr := cmdPipeline.SendSync(cmd)
-						logHandlingError(cmd, cmdHandlingErr)
-						sendResponse(cmd, cmdHandlingErr)
-						if cmdHandlingErr == nil {
-							logSuccess(cmd)
-						}
-						if cmd.appPartitionRestartScheduled {
-							logger.WarningCtx(newRecoveryCtx(cmd.cmdMes.RequestCtx(), cmd.cmdMes.PartitionID()), "cp.partition_recovery", "partition will be restarted due of an error on writing to Log: ", cmdHandlingErr)
-							delete(cmdProc.appsPartitions, cmd.cmdMes.AppQName())
-						}
-					}()
-					metrics.IncreaseApp(CommandsSeconds, string(vvm), cmdMes.AppQName(), time.Since(start).Seconds())
 				case <-vvmCtx.Done():
 				}
 			}
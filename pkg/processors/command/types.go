@@ -20,6 +20,7 @@ import (
 	"github.com/voedger/voedger/pkg/pipeline"
 	"github.com/voedger/voedger/pkg/processors"
 	"github.com/voedger/voedger/pkg/processors/actualizers"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/state/stateprovide"
 )
@@ -45,6 +46,7 @@ type ICommandMessage interface {
 	DocID() istructs.RecordID
 	Method() string
 	Origin() string
+	IdempotencyKey() string // empty -> the command is not idempotent
 }
 
 type xPath string
@@ -92,11 +94,16 @@ type cmdWorkpiece struct {
 	reapplier                    istructs.IEventReapplier
 	commandCtxStorage            istructs.IStateValue
 	cmdResToLog                  string
-	pLogOffset                   istructs.Offset // need for logging
-	logCtx                       context.Context // enriched log ctx from logEventAndCUDs (woffset, poffset, evqname)
+	pLogOffset                   istructs.Offset    // need for logging
+	logCtx                       context.Context    // enriched log ctx from logEventAndCUDs (woffset, poffset, evqname)
+	stages                       *slowlog.Stages    // nil -> slow log is disabled
+	idempotencyRecord            *idempotencyRecord // not nil -> the response is taken from the record
+	idempotencyReservation       string             // not empty -> Idempotency-Key is reserved by the command, see [checkIdempotencyKey]
+	batch                        []*batchCommand    // not nil -> the commands of the batch are stored as the single event, see [parseBatch]
 }
 
 var _ processors.IProcessorWorkpiece = (*cmdWorkpiece)(nil)
+var _ pipeline.IStagesWorkpiece = (*cmdWorkpiece)(nil)
 
 type implIDGeneratorReporter struct {
 	istructs.IIDGenerator
@@ -113,19 +120,48 @@ type parsedCUD struct {
 }
 
 type implICommandMessage struct {
-	body        []byte
-	appQName    appdef.AppQName // need to determine where to send c.sys.Init request on create a new workspace
-	wsid        istructs.WSID
-	responder   bus.IResponder
-	partitionID istructs.PartitionID
-	requestCtx  context.Context
-	qName       appdef.QName // APIv1 -> cmd QName, APIv2 -> cmdQName or DocQName
-	token       string
-	host        string
-	apiPath     processors.APIPath
-	docID       istructs.RecordID
-	method      string
-	origin      string
+	body           []byte
+	appQName       appdef.AppQName // need to determine where to send c.sys.Init request on create a new workspace
+	wsid           istructs.WSID
+	responder      bus.IResponder
+	partitionID    istructs.PartitionID
+	requestCtx     context.Context
+	qName          appdef.QName // APIv1 -> cmd QName, APIv2 -> cmdQName or DocQName
+	token          string
+	host           string
+	apiPath        processors.APIPath
+	docID          istructs.RecordID
+	method         string
+	origin         string
+	idempotencyKey string
+}
+
+// batchCommand is the command sent to the batch endpoint
+// its fields are set to the workpiece while the command is handled, see [cmdWorkpiece.forEachBatchCommand]
+type batchCommand struct {
+	cmdQName           appdef.QName
+	iCommand           appdef.ICommand
+	requestData        coreutils.MapObject
+	argsObject         istructs.IObject
+	unloggedArgsObject istructs.IObject
+	cmdResultBuilder   istructs.IObjectBuilder
+	cmdResult          istructs.IObject
+}
+
+// batchCUDsMerger merges updates of the records created or updated by the previous commands of the batch into the preceding CUD
+type batchCUDsMerger struct {
+	declaredRawIDs map[istructs.RecordID]int                 // raw ID -> index of the command that declares it
+	created        map[istructs.RecordID]coreutils.MapObject // raw ID -> fields of the CUD that creates the record
+	updated        map[istructs.RecordID]coreutils.MapObject // ID -> fields of the CUD that updates the record
+}
+
+// idempotencyRecord is the result of the command stored per workspace and Idempotency-Key
+type idempotencyRecord struct {
+	RequestHash string // the same key with another request -> 422
+	Nonce       string `json:",omitempty"` // unique per request, set in the reservation only
+	WLogOffset  istructs.Offset
+	StatusCode  int // 0 -> Idempotency-Key is reserved and the command is not executed yet
+	Response    string
 }
 
 type wrongArgsCatcher struct {
@@ -152,7 +188,7 @@ func newReusableHostState(vvmCtx context.Context, secretReader isecrets.ISecretR
 		func() istructs.IObject { return b.wp.argsObject },
 		func() istructs.IObject { return b.wp.unloggedArgsObject },
 		func() istructs.Offset { return b.wp.workspace.NextWLogOffset },
-		state.NullOpts,
+		state.StateOpts{LogCtxFunc: func() context.Context { return b.wp.cmdMes.RequestCtx() }},
 		func() string { return b.wp.cmdMes.Origin() },
 	)
 	return b
@@ -21,4 +21,6 @@ const (
 	APIPath_Auth_Refresh
 	APIPath_Users
 	APIPath_N10N_SubscribeAndWatch
+	APIPath_Docs_History
+	APIPath_Batch
 )
@@ -127,10 +127,48 @@ func (p *implIN10NProc) authnzEntities(ctx context.Context, n10nWP *n10nWorkpiec
 			// [~server.n10n/err.routerAddSubscriptionNoPermissions~impl]
 			return coreutils.NewHTTPErrorf(http.StatusForbidden)
 		}
+		if fields := allowedPayloadFields(iWorkspace, s.entity, roles); fields != nil {
+			if n10nWP.payloadFields == nil {
+				n10nWP.payloadFields = map[in10n.ProjectionKey]map[appdef.FieldName]bool{}
+			}
+			n10nWP.payloadFields[in10n.ProjectionKey{App: n10nWP.appQName, Projection: s.entity, WS: s.wsid}] = fields
+		}
 	}
 	return nil
 }
 
+// returns fields of the view which roles are allowed to select
+// returns nil if the entity is not a view that publishes n10n payloads
+func allowedPayloadFields(ws appdef.IWorkspace, entity appdef.QName, roles []appdef.QName) map[appdef.FieldName]bool {
+	view := appdef.View(ws.Type, entity)
+	if view == nil || !view.N10nPayload() {
+		return nil
+	}
+	fields := map[appdef.FieldName]bool{}
+	for _, f := range view.Fields() {
+		if ok, err := acl.IsOperationAllowed(ws, appdef.OperationKind_Select, entity, []appdef.FieldName{f.Name()}, roles); ok && err == nil {
+			fields[f.Name()] = true
+		}
+	}
+	return fields
+}
+
+// payloads are filtered by the fields allowed to the subscriber of the channel, nothing is allowed if the fields are unknown
+func (p *implIN10NProc) payloadFieldFilter(channelID in10n.ChannelID, projection in10n.ProjectionKey) func(appdef.FieldName) bool {
+	fields, _ := p.payloadFields.Load(channelProjection{channelID: channelID, projection: projection})
+	allowed, _ := fields.(map[appdef.FieldName]bool)
+	return func(f appdef.FieldName) bool { return allowed[f] }
+}
+
+func (p *implIN10NProc) forgetPayloadFields(channelID in10n.ChannelID) {
+	p.payloadFields.Range(func(key, _ any) bool {
+		if key.(channelProjection).channelID == channelID {
+			p.payloadFields.Delete(key)
+		}
+		return true
+	})
+}
+
 func (p *implIN10NProc) newChannel(_ context.Context, n10nWP *n10nWorkpiece) (err error) {
 	n10nWP.channelID, n10nWP.channelCleanup, err = p.n10nBroker.NewChannel(n10nWP.subjectLogin, n10nWP.expiresIn)
 	if err == nil {
@@ -161,6 +199,9 @@ func (p *implIN10NProc) subscribe(_ context.Context, n10nWP *n10nWorkpiece) (err
 			logger.ErrorCtx(n10nProjectionLogCtx(n10nWP.logCtx, projectionKey), "n10n.subscribe.error", err)
 			return fmt.Errorf("subscribe failed: %w", err)
 		}
+		if fields, ok := n10nWP.payloadFields[projectionKey]; ok {
+			p.payloadFields.Store(channelProjection{channelID: n10nWP.channelID, projection: projectionKey}, fields)
+		}
 		n10nWP.subscribedProjectionKeys = append(n10nWP.subscribedProjectionKeys, projectionKey)
 	}
 	return nil
@@ -183,8 +224,13 @@ func (p *implIN10NProc) watchChannel(_ context.Context, n10nWP *n10nWorkpiece) (
 		defer cancel()
 		// unsubscribe and channel cleanup is done within WatchChannel
 		//nolint:contextcheck // callback signature is fixed by IN10NBroker.WatchChannel; uses captured watchChannelCtx by design
-		p.n10nBroker.WatchChannel(watchChannelCtx, n10nWP.channelID, func(projection in10n.ProjectionKey, offset istructs.Offset) {
+		p.n10nBroker.WatchChannelWithPayloads(watchChannelCtx, n10nWP.channelID, func(projection in10n.ProjectionKey, offset istructs.Offset, payloads []in10n.Payload) {
 			sseMessage := fmt.Sprintf("event: %s\ndata: %d\n\n", projection.ToJSON(), offset)
+			if len(payloads) > 0 {
+				if data := in10n.PayloadsToJSON(projection, offset, payloads, p.payloadFieldFilter(n10nWP.channelID, projection)); len(data) > 0 {
+					sseMessage += fmt.Sprintf("event: %s\ndata: %s\n\n", in10n.SSEEvent_Payload, data)
+				}
+			}
 			projCtx := n10nProjectionLogCtx(n10nWP.logCtx, projection)
 			if err := n10nWP.responseWriter.Write(sseMessage); err != nil {
 				logger.ErrorCtx(projCtx, "n10n.sse_send.error", err)
@@ -199,6 +245,7 @@ func (p *implIN10NProc) watchChannel(_ context.Context, n10nWP *n10nWorkpiece) (
 				logger.VerboseCtx(n10nProjectionLogCtx(n10nWP.logCtx, pk), "n10n.watch.done")
 			}
 		}
+		p.forgetPayloadFields(n10nWP.channelID)
 		n10nWP.channelCleanup()
 		n10nWP.responseWriter.Close(nil)
 	}()
@@ -7,12 +7,15 @@ package n10n
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/appdef/filter"
 	"github.com/voedger/voedger/pkg/goutils/logger"
 	"github.com/voedger/voedger/pkg/in10n"
 	"github.com/voedger/voedger/pkg/istructs"
@@ -152,7 +155,11 @@ func (immediateWatchBroker) NewChannel(_ istructs.SubjectLogin, _ time.Duration)
 func (immediateWatchBroker) Subscribe(_ in10n.ChannelID, _ in10n.ProjectionKey) error { return nil }
 func (immediateWatchBroker) WatchChannel(_ context.Context, _ in10n.ChannelID, _ func(in10n.ProjectionKey, istructs.Offset)) {
 }
+func (immediateWatchBroker) WatchChannelWithPayloads(_ context.Context, _ in10n.ChannelID, _ func(in10n.ProjectionKey, istructs.Offset, []in10n.Payload)) {
+}
 func (immediateWatchBroker) Update(_ in10n.ProjectionKey, _ istructs.Offset) {}
+func (immediateWatchBroker) UpdateWithPayload(_ in10n.ProjectionKey, _ istructs.Offset, _ in10n.Payload) {
+}
 func (immediateWatchBroker) Unsubscribe(_ in10n.ChannelID, _ in10n.ProjectionKey) error {
 	return nil
 }
@@ -161,3 +168,135 @@ func (immediateWatchBroker) MetricNumSubscriptions() int { return 0 }
 func (immediateWatchBroker) MetricSubject(_ context.Context, _ func(istructs.SubjectLogin, int, int)) {
 }
 func (immediateWatchBroker) MetricNumProjectionSubscriptions(_ in10n.ProjectionKey) int { return 0 }
+
+func TestPayloadFields(t *testing.T) {
+	require := require.New(t)
+
+	wsName := appdef.NewQName("test", "ws")
+	payloadView := appdef.NewQName("test", "PayloadView")
+	plainView := appdef.NewQName("test", "PlainView")
+	reader := appdef.NewQName("test", "reader")
+	owner := appdef.NewQName("test", "owner")
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+	wsb := adb.AddWorkspace(wsName)
+	for _, name := range []appdef.QName{payloadView, plainView} {
+		view := wsb.AddView(name)
+		view.Key().PartKey().AddField("pk", appdef.DataKind_int32)
+		view.Key().ClustCols().AddField("cc", appdef.DataKind_int32)
+		view.Value().
+			AddField("amount", appdef.DataKind_int32, false).
+			AddField("secret", appdef.DataKind_int32, false)
+		view.SetN10nPayload(name == payloadView)
+	}
+	_ = wsb.AddRole(reader)
+	wsb.Grant([]appdef.OperationKind{appdef.OperationKind_Select}, filter.QNames(payloadView, plainView),
+		[]appdef.FieldName{"pk", "cc", "amount"}, reader)
+	_ = wsb.AddRole(owner)
+	wsb.Grant([]appdef.OperationKind{appdef.OperationKind_Select}, filter.QNames(payloadView, plainView), nil, owner)
+	app, err := adb.Build()
+	require.NoError(err)
+	ws := app.Workspace(wsName)
+
+	t.Run("allowed fields", func(t *testing.T) {
+		readerFields := allowedPayloadFields(ws, payloadView, []appdef.QName{reader})
+		require.True(readerFields["amount"])
+		require.True(readerFields["pk"])
+		require.False(readerFields["secret"])
+
+		ownerFields := allowedPayloadFields(ws, payloadView, []appdef.QName{owner})
+		require.True(ownerFields["amount"])
+		require.True(ownerFields["secret"])
+
+		require.Nil(allowedPayloadFields(ws, plainView, []appdef.QName{owner}), "view does not publish payloads")
+	})
+
+	t.Run("payloads are filtered by the fields allowed to the channel", func(t *testing.T) {
+		projKey := in10n.ProjectionKey{
+			App:        appdef.NewAppQName("test", "app"),
+			Projection: payloadView,
+			WS:         istructs.WSID(42),
+		}
+		wp := newN10nWP("chan-payload", projKey)
+		wp.subscriptions = []subscription{{entity: payloadView, wsid: projKey.WS}}
+		wp.payloadFields = map[in10n.ProjectionKey]map[appdef.FieldName]bool{
+			projKey: allowedPayloadFields(ws, payloadView, []appdef.QName{reader}),
+		}
+		wp.channelCleanup = func() {}
+		writer := &collectingResponseWriter{}
+		wp.responseWriter = writer
+
+		broker := &payloadWatchBroker{payload: testPayload{"pk": 1, "amount": 10, "secret": 42}}
+		p := &implIN10NProc{n10nBroker: broker}
+		require.NoError(p.subscribe(context.Background(), wp))
+		require.NoError(p.watchChannel(context.Background(), wp))
+		p.goroutinesWG.Wait()
+
+		require.Len(writer.messages, 1)
+		require.Contains(writer.messages[0], `"Payloads":[{"amount":10,"pk":1}]`)
+		require.NotContains(writer.messages[0], "secret")
+
+		_, ok := p.payloadFields.Load(channelProjection{channelID: wp.channelID, projection: projKey})
+		require.False(ok, "fields should be forgotten after the channel is closed")
+	})
+
+	t.Run("payloads are not sent if the allowed fields are unknown", func(t *testing.T) {
+		projKey := in10n.ProjectionKey{
+			App:        appdef.NewAppQName("test", "app"),
+			Projection: payloadView,
+			WS:         istructs.WSID(42),
+		}
+		wp := newN10nWP("chan-unknown", projKey)
+		wp.channelCleanup = func() {}
+		writer := &collectingResponseWriter{}
+		wp.responseWriter = writer
+
+		p := &implIN10NProc{n10nBroker: &payloadWatchBroker{payload: brokenPayload{}}}
+		require.NoError(p.watchChannel(context.Background(), wp))
+		p.goroutinesWG.Wait()
+
+		require.Len(writer.messages, 1)
+		require.NotContains(writer.messages[0], in10n.SSEEvent_Payload)
+	})
+}
+
+type collectingResponseWriter struct {
+	messages []string
+}
+
+func (w *collectingResponseWriter) Write(obj any) error {
+	w.messages = append(w.messages, obj.(string))
+	return nil
+}
+func (w *collectingResponseWriter) Close(_ error) {}
+
+// delivers the single offset with the payload
+type payloadWatchBroker struct {
+	immediateWatchBroker
+	payload in10n.Payload
+}
+
+func (b *payloadWatchBroker) WatchChannelWithPayloads(_ context.Context, _ in10n.ChannelID, notify func(in10n.ProjectionKey, istructs.Offset, []in10n.Payload)) {
+	notify(in10n.ProjectionKey{App: appdef.NewAppQName("test", "app"), Projection: appdef.NewQName("test", "PayloadView"), WS: istructs.WSID(42)},
+		istructs.Offset(1), []in10n.Payload{b.payload})
+}
+
+// test payload is the map of field values
+type testPayload map[appdef.FieldName]int
+
+func (p testPayload) JSON(fieldFilter func(appdef.FieldName) bool) []byte {
+	res := map[appdef.FieldName]int{}
+	for f, v := range p {
+		if fieldFilter(f) {
+			res[f] = v
+		}
+	}
+	data, _ := json.Marshal(res)
+	return data
+}
+
+// test payload which marshals nothing
+type brokenPayload struct{}
+
+func (brokenPayload) JSON(func(appdef.FieldName) bool) []byte { return nil }
@@ -35,6 +35,7 @@ func (p *implIN10NProc) unsubscribe(_ context.Context, n10nWP *n10nWorkpiece) (e
 		logger.ErrorCtx(n10nProjectionLogCtx(n10nWP.logCtx, projectionKey), "n10n.unsubscribe.error", err)
 		return err
 	}
+	p.payloadFields.Delete(channelProjection{channelID: n10nWP.channelID, projection: projectionKey})
 	n10nWP.subscribedProjectionKeys = append(n10nWP.subscribedProjectionKeys, projectionKey)
 	return nil
 }
@@ -30,6 +30,12 @@ type implIN10NProc struct {
 	appTokensFactory   payloads.IAppTokensFactory
 	goroutinesWG       sync.WaitGroup
 	appStructsProvider istructs.IAppStructsProvider
+	payloadFields      sync.Map // channelProjection -> map[appdef.FieldName]bool, see [allowedPayloadFields]
+}
+
+type channelProjection struct {
+	channelID  in10n.ChannelID
+	projection in10n.ProjectionKey
 }
 
 type n10nWorkpiece struct {
@@ -52,6 +58,7 @@ type n10nWorkpiece struct {
 	wsidFromURL              istructs.WSID
 	appStructs               istructs.IAppStructs
 	appTokens                istructs.IAppTokens
+	payloadFields            map[in10n.ProjectionKey]map[appdef.FieldName]bool // fields of the payload views allowed to the subscriber
 }
 
 type n10nArgs struct {
@@ -74,7 +74,7 @@ var defaultACL = ACL{
 				qNameCmdStoreSubscriptionProfile, qNameCmdUpdateSubscription,
 
 				qNameCDocUnTillOrders, qNameCDocUnTillPBill,
-				qNameTestDeniedCmd, qNameTestDeniedCDoc, qNameCDocLogin, qNameCDocChildWorkspace, qNameTestDeniedQry, qNameTestDeniedCmd_it, qNameTestDeniedQry_it,
+				qNameTestDeniedCmd, qNameTestDeniedCDoc, qNameCDocLogin, qNameCDocChildWorkspace, qNameCDocAPIKey, qNameTestDeniedQry, qNameTestDeniedCmd_it, qNameTestDeniedQry_it,
 			},
 		},
 		policy: appdef.PolicyKind_Deny,
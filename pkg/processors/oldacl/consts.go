@@ -19,6 +19,7 @@ var (
 	qNameTestDeniedCDoc                         = appdef.NewQName("app1pkg", "TestDeniedCDoc")
 	qNameCDocLogin                              = appdef.NewQName(registryPackage, "Login")
 	qNameCDocChildWorkspace                     = appdef.NewQName(appdef.SysPackage, "ChildWorkspace")
+	qNameCDocAPIKey                             = appdef.NewQName(appdef.SysPackage, "APIKey")
 	qNameCmdUpdateSubscription                  = appdef.NewQName(airPackage, "UpdateSubscription")
 	qNameCmdStoreSubscriptionProfile            = appdef.NewQName(airPackage, "StoreSubscriptionProfile")
 	qNameCmdLinkDeviceToRestaurant              = appdef.NewQName(airPackage, "LinkDeviceToRestaurant")
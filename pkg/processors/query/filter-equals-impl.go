@@ -10,6 +10,7 @@ import (
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 )
 
@@ -55,6 +56,20 @@ func (f EqualsFilter) IsMatch(fk FieldsKinds, outputRow IOutputRow) (bool, error
 			return false, err
 		}
 		return outputRow.Value(f.field).(istructs.RecordID) == recordIDIntf.(istructs.RecordID), nil
+	case appdef.DataKind_decimal:
+		d, err := decimalFilterValue(f.value)
+		if err != nil {
+			return false, err
+		}
+		return outputRow.Value(f.field).(decimal.Decimal).Equal(d), nil
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		c, err := compareDateTime(fk[f.field], outputRow.Value(f.field), f.value)
+		if err != nil {
+			return false, err
+		}
+		return c == 0, nil
+	case appdef.DataKind_uuid:
+		return equalUUID(outputRow.Value(f.field), f.value)
 	case appdef.DataKind_null:
 		return false, nil
 	default:
@@ -12,6 +12,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 )
 
@@ -102,6 +103,71 @@ func TestEqualsFilter_IsMatch(t *testing.T) {
 			require.False(t, match(heightFilter(42.71).IsMatch(fk, row(42.7))))
 		})
 	})
+	t.Run("Compare decimal", func(t *testing.T) {
+		row := func(price string) IOutputRow {
+			r := &testOutputRow{fields: []string{"price"}}
+			r.Set("price", decimal.MustParse(price))
+			return r
+		}
+		fk := FieldsKinds{"price": appdef.DataKind_decimal}
+		priceFilter := func(price interface{}) IFilter {
+			return &EqualsFilter{
+				field: "price",
+				value: price,
+			}
+		}
+		t.Run("Should match", func(t *testing.T) {
+			require.True(t, match(priceFilter(json.Number("42.7")).IsMatch(fk, row("42.70"))))
+		})
+		t.Run("Should not match", func(t *testing.T) {
+			require.False(t, match(priceFilter(json.Number("42.71")).IsMatch(fk, row("42.70"))))
+		})
+	})
+	t.Run("Compare timestamp", func(t *testing.T) {
+		row := func(created string) IOutputRow {
+			r := &testOutputRow{fields: []string{"created"}}
+			r.Set("created", created)
+			return r
+		}
+		fk := FieldsKinds{"created": appdef.DataKind_timestamp}
+		createdFilter := func(created interface{}) IFilter {
+			return &EqualsFilter{
+				field: "created",
+				value: created,
+			}
+		}
+		t.Run("Should match", func(t *testing.T) {
+			require.True(t, match(createdFilter("2026-01-01T01:00:00+02:00").IsMatch(fk, row("2025-12-31T23:00:00.000Z"))))
+			require.True(t, match(createdFilter(json.Number("0")).IsMatch(fk, row("1970-01-01T00:00:00.000Z"))))
+		})
+		t.Run("Should not match", func(t *testing.T) {
+			require.False(t, match(createdFilter("2025-12-31T23:00:00.001Z").IsMatch(fk, row("2025-12-31T23:00:00.000Z"))))
+		})
+	})
+	t.Run("Compare uuid", func(t *testing.T) {
+		row := func(clientID string) IOutputRow {
+			r := &testOutputRow{fields: []string{"clientID"}}
+			r.Set("clientID", clientID)
+			return r
+		}
+		fk := FieldsKinds{"clientID": appdef.DataKind_uuid}
+		clientIDFilter := func(clientID interface{}) IFilter {
+			return &EqualsFilter{
+				field: "clientID",
+				value: clientID,
+			}
+		}
+		t.Run("Should match", func(t *testing.T) {
+			require.True(t, match(clientIDFilter("F47AC10B-58CC-4372-A567-0E02B2C3D479").IsMatch(fk, row("f47ac10b-58cc-4372-a567-0e02b2c3d479"))))
+		})
+		t.Run("Should not match", func(t *testing.T) {
+			require.False(t, match(clientIDFilter("f47ac10b-58cc-4372-a567-0e02b2c3d470").IsMatch(fk, row("f47ac10b-58cc-4372-a567-0e02b2c3d479"))))
+		})
+		t.Run("Should return error on invalid uuid", func(t *testing.T) {
+			_, err := clientIDFilter("not-a-uuid").IsMatch(fk, row("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+			require.Error(t, err)
+		})
+	})
 	t.Run("Compare string", func(t *testing.T) {
 		row := func(name string) IOutputRow {
 			r := &testOutputRow{fields: []string{"name"}}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 )
 
 func TestGreaterFilter_IsMatch(t *testing.T) {
@@ -96,6 +97,51 @@ func TestGreaterFilter_IsMatch(t *testing.T) {
 			require.False(t, match(heightFilter(42.71).IsMatch(fk, row(42.7))))
 		})
 	})
+	t.Run("Compare decimal", func(t *testing.T) {
+		row := func(price string) IOutputRow {
+			r := &testOutputRow{fields: []string{"price"}}
+			r.Set("price", decimal.MustParse(price))
+			return r
+		}
+		fk := FieldsKinds{"price": appdef.DataKind_decimal}
+		priceFilter := func(price interface{}) IFilter {
+			return &GreaterFilter{
+				field: "price",
+				value: price,
+			}
+		}
+		t.Run("Should match", func(t *testing.T) {
+			require.True(t, match(priceFilter(42.69).IsMatch(fk, row("42.70"))))
+		})
+		t.Run("Should not match", func(t *testing.T) {
+			require.False(t, match(priceFilter("42.71").IsMatch(fk, row("42.70"))))
+		})
+	})
+	t.Run("Compare date", func(t *testing.T) {
+		row := func(day string) IOutputRow {
+			r := &testOutputRow{fields: []string{"day"}}
+			r.Set("day", day)
+			return r
+		}
+		fk := FieldsKinds{"day": appdef.DataKind_date}
+		dayFilter := func(day interface{}) IFilter {
+			return &GreaterFilter{
+				field: "day",
+				value: day,
+			}
+		}
+		t.Run("Should match", func(t *testing.T) {
+			require.True(t, match(dayFilter("1969-12-31").IsMatch(fk, row("1970-01-01"))))
+			require.True(t, match(dayFilter(float64(-1)).IsMatch(fk, row("1970-01-01"))))
+		})
+		t.Run("Should not match", func(t *testing.T) {
+			require.False(t, match(dayFilter("2025-12-31").IsMatch(fk, row("2025-12-31"))))
+		})
+		t.Run("Should return error if filter value is not a date", func(t *testing.T) {
+			_, err := dayFilter("31.12.2025").IsMatch(fk, row("2025-12-31"))
+			require.Error(t, err)
+		})
+	})
 	t.Run("Compare string", func(t *testing.T) {
 		row := func(name string) IOutputRow {
 			r := &testOutputRow{fields: []string{"name"}}
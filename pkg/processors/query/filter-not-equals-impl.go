@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 )
 
 type NotEqualsFilter struct {
@@ -30,6 +31,21 @@ func (f NotEqualsFilter) IsMatch(fk FieldsKinds, outputRow IOutputRow) (bool, er
 		return outputRow.Value(f.field).(string) != f.value.(string), nil
 	case appdef.DataKind_bool:
 		return outputRow.Value(f.field).(bool) != f.value.(bool), nil
+	case appdef.DataKind_decimal:
+		d, err := decimalFilterValue(f.value)
+		if err != nil {
+			return false, err
+		}
+		return !outputRow.Value(f.field).(decimal.Decimal).Equal(d), nil
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		c, err := compareDateTime(fk[f.field], outputRow.Value(f.field), f.value)
+		if err != nil {
+			return false, err
+		}
+		return c != 0, nil
+	case appdef.DataKind_uuid:
+		eq, err := equalUUID(outputRow.Value(f.field), f.value)
+		return !eq, err
 	case appdef.DataKind_null:
 		return false, nil
 	default:
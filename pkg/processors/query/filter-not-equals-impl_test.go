@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 )
 
 func TestNotEqualsFilter_IsMatch(t *testing.T) {
@@ -98,6 +99,26 @@ func TestNotEqualsFilter_IsMatch(t *testing.T) {
 			require.False(t, match(heightFilter(42.7).IsMatch(fk, row(42.7))))
 		})
 	})
+	t.Run("Compare decimal", func(t *testing.T) {
+		row := func(price string) IOutputRow {
+			r := &testOutputRow{fields: []string{"price"}}
+			r.Set("price", decimal.MustParse(price))
+			return r
+		}
+		fk := FieldsKinds{"price": appdef.DataKind_decimal}
+		priceFilter := func(price interface{}) IFilter {
+			return &NotEqualsFilter{
+				field: "price",
+				value: price,
+			}
+		}
+		t.Run("Should match", func(t *testing.T) {
+			require.True(t, match(priceFilter(42.71).IsMatch(fk, row("42.70"))))
+		})
+		t.Run("Should not match", func(t *testing.T) {
+			require.False(t, match(priceFilter("42.7").IsMatch(fk, row("42.70"))))
+		})
+	})
 	t.Run("Compare string", func(t *testing.T) {
 		row := func(name string) IOutputRow {
 			r := &testOutputRow{fields: []string{"name"}}
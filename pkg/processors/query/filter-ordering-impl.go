@@ -7,9 +7,15 @@ package queryprocessor
 
 import (
 	"cmp"
+	"encoding/json"
 	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/decimal"
 )
 
 func compareOrdered[T cmp.Ordered](a, b T, gt bool) bool {
@@ -19,6 +25,69 @@ func compareOrdered[T cmp.Ordered](a, b T, gt bool) bool {
 	return a < b
 }
 
+// Converts filter value (JSON number, float64 or string) to decimal
+func decimalFilterValue(value interface{}) (decimal.Decimal, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return decimal.Parse(string(v))
+	case float64:
+		return decimal.Parse(strconv.FormatFloat(v, 'f', -1, 64))
+	case string:
+		return decimal.Parse(v)
+	}
+	return decimal.Decimal{}, fmt.Errorf("%v: %w", value, ErrWrongType)
+}
+
+// Converts date, time or timestamp value (ISO-8601 string, JSON number or float64) to stored value
+func dateTimeFilterValue(kind appdef.DataKind, value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case string:
+		return coreutils.ParseDateTime(kind, v)
+	case json.Number:
+		return v.Int64()
+	case float64:
+		return int64(v), nil
+	}
+	return 0, fmt.Errorf("%v: %w", value, ErrWrongType)
+}
+
+// Compares date, time or timestamp output row value with filter value, returns cmp.Compare result
+func compareDateTime(kind appdef.DataKind, rowValue, filterValue interface{}) (int, error) {
+	a, err := dateTimeFilterValue(kind, rowValue)
+	if err != nil {
+		return 0, err
+	}
+	b, err := dateTimeFilterValue(kind, filterValue)
+	if err != nil {
+		return 0, err
+	}
+	return cmp.Compare(a, b), nil
+}
+
+// Converts uuid value (canonical string or uuid.UUID) to uuid.UUID
+func uuidFilterValue(value interface{}) (uuid.UUID, error) {
+	switch v := value.(type) {
+	case string:
+		return uuid.Parse(v)
+	case uuid.UUID:
+		return v, nil
+	}
+	return uuid.Nil, fmt.Errorf("%v: %w", value, ErrWrongType)
+}
+
+// Returns is uuid output row value equals to filter value
+func equalUUID(rowValue, filterValue interface{}) (bool, error) {
+	a, err := uuidFilterValue(rowValue)
+	if err != nil {
+		return false, err
+	}
+	b, err := uuidFilterValue(filterValue)
+	if err != nil {
+		return false, err
+	}
+	return a == b, nil
+}
+
 func matchOrdered(filterKind, field string, gt bool, fk FieldsKinds, outputRow IOutputRow, value interface{}) (bool, error) {
 	switch fk[field] {
 	case appdef.DataKind_int32:
@@ -31,6 +100,18 @@ func matchOrdered(filterKind, field string, gt bool, fk FieldsKinds, outputRow I
 		return compareOrdered(outputRow.Value(field).(float64), value.(float64), gt), nil
 	case appdef.DataKind_string:
 		return compareOrdered(outputRow.Value(field).(string), value.(string), gt), nil
+	case appdef.DataKind_decimal:
+		d, err := decimalFilterValue(value)
+		if err != nil {
+			return false, err
+		}
+		return compareOrdered(outputRow.Value(field).(decimal.Decimal).Cmp(d), 0, gt), nil
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		c, err := compareDateTime(fk[field], outputRow.Value(field), value)
+		if err != nil {
+			return false, err
+		}
+		return compareOrdered(c, 0, gt), nil
 	case appdef.DataKind_null:
 		return false, nil
 	default:
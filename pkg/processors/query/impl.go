@@ -35,6 +35,8 @@ import (
 	"github.com/voedger/voedger/pkg/pipeline"
 	"github.com/voedger/voedger/pkg/processors"
 	"github.com/voedger/voedger/pkg/processors/oldacl"
+	"github.com/voedger/voedger/pkg/processors/querycache"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/state/stateprovide"
 	"github.com/voedger/voedger/pkg/sys/authnz"
@@ -104,7 +106,7 @@ func implServiceFactory(serviceChannel iprocbus.ServiceChannel,
 	appParts appparts.IAppPartitions, maxPrepareQueries int, metrics imetrics.IMetrics, vvm string,
 	authn iauthnz.IAuthenticator, itokens itokens.ITokens, federation federation.IFederation,
 	statelessResources istructsmem.IStatelessResources, secretReader isecrets.ISecretReader,
-	stateOpts state.StateOpts, httpClient httpu.IHTTPClient) pipeline.IService {
+	stateOpts state.StateOpts, httpClient httpu.IHTTPClient, slowLog slowlog.ISlowLog, queryCache querycache.IQueryCache) pipeline.IService {
 	return pipeline.NewService(func(ctx context.Context) {
 		var p pipeline.ISyncPipeline
 		for ctx.Err() == nil {
@@ -119,24 +121,34 @@ func implServiceFactory(serviceChannel iprocbus.ServiceChannel,
 				}
 				qpm.Increase(Metric_QueriesTotal, 1.0)
 				qwork := newQueryWork(msg, appParts, maxPrepareQueries, qpm, secretReader)
+				if slowLog.Enabled() {
+					qwork.stages = &slowlog.Stages{}
+				}
+				var err error
 				func() { // borrowed application partition should be guaranteed to be freed
 					defer qwork.Release()
 					if p == nil {
 						p = newQueryProcessorPipeline(ctx, authn, itokens, federation, statelessResources, stateOpts, httpClient)
 					}
-					err := p.SendSync(qwork)
+					var cachedRows []json.RawMessage
+					cacheHit := false
+					err = p.SendSync(qwork)
 					if err != nil {
 						qpm.Increase(Metric_ErrorsTotal, 1.0)
 						p.Close()
 						p = nil
-					} else {
-						if err = execQuery(ctx, qwork); err == nil {
+					} else if cachedRows, cacheHit = qwork.getCachedResult(queryCache); !cacheHit {
+						execStart := time.Now()
+						err = execQuery(ctx, qwork)
+						qwork.AddStage(slowlog.Stage_Exec, time.Since(execStart))
+						if err == nil {
 							logger.VerboseCtx(qwork.msg.RequestCtx(), "qp.success")
 							if err = processors.CheckResponseIntent(qwork.state); err == nil {
 								err = qwork.state.ApplyIntents()
 							}
 						}
 					}
+					responseStart := time.Now()
 					if qwork.rowsProcessor != nil {
 						// wait until all rows are sent
 						qwork.rowsProcessor.Close()
@@ -161,9 +173,31 @@ func implServiceFactory(serviceChannel iprocbus.ServiceChannel,
 					} else {
 						respWriter = qwork.responseWriterGetter()
 					}
+					if cacheHit {
+						err = writeCachedRows(respWriter, cachedRows)
+					}
 					respWriter.Close(err)
+					if err == nil {
+						qwork.putCachedResult(queryCache)
+					}
+					qwork.AddStage(slowlog.Stage_Response, time.Since(responseStart))
 				}()
-				metrics.IncreaseApp(Metric_QueriesSeconds, vvm, msg.AppQName(), time.Since(now).Seconds())
+				queryDuration := time.Since(now)
+				querySeconds := queryDuration.Seconds()
+				metrics.IncreaseApp(Metric_QueriesSeconds, vvm, msg.AppQName(), querySeconds)
+				metrics.Histogram(Metric_QueryDurationSeconds, vvm, msg.AppQName(), qwork.metricsQName(), nil).Observe(querySeconds)
+				if qwork.stages != nil {
+					slowLog.Report(msg.RequestCtx(), slowlog.Request{
+						Kind:     slowlog.RequestKind_Query,
+						App:      msg.AppQName(),
+						WSID:     msg.WSID(),
+						QName:    msg.QName(),
+						Start:    now,
+						Duration: queryDuration,
+						Stages:   *qwork.stages,
+						Err:      err,
+					})
+				}
 			case <-ctx.Done():
 			}
 		}
@@ -422,8 +456,13 @@ func newQueryProcessorPipeline(requestCtx context.Context, authn iauthnz.IAuthen
 			defer func() {
 				qw.metrics.Increase(Metric_BuildSeconds, time.Since(now).Seconds())
 			}()
+			responder := qw.msg.Responder()
+			if qw.iQuery.CacheTTL() > 0 {
+				qw.cacheRecorder = querycache.NewRecorder(responder)
+				responder = qw.cacheRecorder
+			}
 			qw.rowsProcessor, qw.responseWriterGetter = ProvideRowsProcessorFactory()(qw.msg.RequestCtx(), qw.appStructs.AppDef(),
-				qw.state, qw.queryParams, qw.resultType, qw.msg.Responder(), qw.metrics, qw.rowsProcessorErrCh)
+				qw.state, qw.queryParams, qw.resultType, responder, qw.metrics, qw.rowsProcessorErrCh)
 			return nil
 		}),
 	}
@@ -454,9 +493,14 @@ type queryWork struct {
 	wsDesc               istructs.IRecord
 	callbackFunc         istructs.ExecQueryCallback
 	responseWriterGetter func() bus.IResponseWriter
+	stages               *slowlog.Stages      // nil -> slow log is disabled
+	cacheRecorder        *querycache.Recorder // nil -> query result is not cached
+	cacheKey             querycache.Key
+	cacheVersion         querycache.Version
 }
 
 var _ processors.IProcessorWorkpiece = (*queryWork)(nil)
+var _ pipeline.IStagesWorkpiece = (*queryWork)(nil)
 
 func newQueryWork(msg IQueryMessage, appParts appparts.IAppPartitions,
 	maxPrepareQueries int, metrics *queryProcessorMetrics, secretReader isecrets.ISecretReader) *queryWork {
@@ -471,6 +515,48 @@ func newQueryWork(msg IQueryMessage, appParts appparts.IAppPartitions,
 	}
 }
 
+// returns the cached result of the query declared WITH CACHE
+// must be called before the query execution
+func (qw *queryWork) getCachedResult(queryCache querycache.IQueryCache) (rows []json.RawMessage, ok bool) {
+	if qw.cacheRecorder == nil {
+		return nil, false
+	}
+	qw.cacheKey = querycache.NewKey(querycache.API_V1, qw.msg.AppQName(), qw.msg.WSID(), qw.msg.QName(), string(qw.msg.Body()), qw.principals, qw.roles)
+	qw.cacheVersion = queryCache.Version(qw.msg.AppQName(), qw.msg.WSID())
+	if rows, ok = queryCache.Get(qw.cacheKey); ok {
+		// nothing to record
+		qw.cacheRecorder = nil
+	}
+	return rows, ok
+}
+
+// keeps the result of the successfully executed query declared WITH CACHE
+func (qw *queryWork) putCachedResult(queryCache querycache.IQueryCache) {
+	if qw.cacheRecorder == nil {
+		return
+	}
+	if rows, ok := qw.cacheRecorder.Rows(); ok {
+		queryCache.Put(qw.cacheKey, qw.cacheVersion, qw.iQuery.CacheTTL(), rows)
+	}
+}
+
+// only queries resolved in the workspace are labeled by their QName
+func (qw *queryWork) metricsQName() appdef.QName {
+	if qw.iQuery == nil {
+		return imetrics.QNameUnresolved
+	}
+	return qw.iQuery.QName()
+}
+
+func writeCachedRows(respWriter bus.IResponseWriter, rows []json.RawMessage) error {
+	for _, row := range rows {
+		if err := respWriter.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // used by e.g. q.sys.IssueVerifiedValueToken
 func (qw *queryWork) ResetRateLimit(resource appdef.QName, operation appdef.OperationKind) {
 	qw.appPart.ResetRateLimit(resource, operation, qw.msg.WSID(), qw.msg.Host())
@@ -532,6 +618,18 @@ func (qw *queryWork) LogCtx() context.Context {
 	return qw.msg.RequestCtx()
 }
 
+// pipeline.IContextWorkpiece
+func (qw *queryWork) Context() context.Context {
+	return qw.msg.RequestCtx()
+}
+
+// pipeline.IStagesWorkpiece
+func (qw *queryWork) AddStage(operatorName string, duration time.Duration) {
+	if qw.stages != nil {
+		qw.stages.Add(operatorName, duration)
+	}
+}
+
 func borrowAppPart(_ context.Context, qw *queryWork) error {
 	switch err := qw.borrow(); {
 	case err == nil:
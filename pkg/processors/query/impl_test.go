@@ -27,6 +27,7 @@ import (
 	"github.com/voedger/voedger/pkg/goutils/httpu"
 	"github.com/voedger/voedger/pkg/goutils/logger"
 	"github.com/voedger/voedger/pkg/goutils/testingu"
+	"github.com/voedger/voedger/pkg/goutils/timeu"
 	"github.com/voedger/voedger/pkg/iauthnz"
 	"github.com/voedger/voedger/pkg/iauthnzimpl"
 	"github.com/voedger/voedger/pkg/iextengine"
@@ -43,6 +44,8 @@ import (
 	imetrics "github.com/voedger/voedger/pkg/metrics"
 	"github.com/voedger/voedger/pkg/pipeline"
 	"github.com/voedger/voedger/pkg/processors"
+	"github.com/voedger/voedger/pkg/processors/querycache"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys"
 	"github.com/voedger/voedger/pkg/sys/authnz"
@@ -391,12 +394,12 @@ func TestBasicUsage_ServiceFactory(t *testing.T) {
 	appParts, cleanAppParts, appTokens, statelessResources := deployTestAppWithSecretToken(require, nil)
 	defer cleanAppParts()
 
-	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
+	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestAPIKeyGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
 	queryProcessor := ProvideServiceFactory()(
 		serviceChannel,
 		appParts,
 		3, // max concurrent queries
-		metrics, "vvm", authn, itokensjwt.TestTokensJWT(), nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil)
+		metrics, "vvm", authn, itokensjwt.TestTokensJWT(), nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil, slowlog.Provide(slowlog.Config{}), querycache.Provide(querycache.Config{}, timeu.NewITime()))
 	processorCtx, processorCtxCancel := context.WithCancel(context.Background())
 	wg := sync.WaitGroup{}
 	wg.Go(func() {
@@ -1168,12 +1171,12 @@ func TestRateLimiter(t *testing.T) {
 
 	// create aquery processor
 	metrics := imetrics.Provide()
-	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
+	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestAPIKeyGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
 	queryProcessor := ProvideServiceFactory()(
 		serviceChannel,
 		appParts,
 		3, // max concurrent queries
-		metrics, "vvm", authn, itokensjwt.TestTokensJWT(), nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil)
+		metrics, "vvm", authn, itokensjwt.TestTokensJWT(), nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil, slowlog.Provide(slowlog.Config{}), querycache.Provide(querycache.Config{}, timeu.NewITime()))
 	go queryProcessor.Run(context.Background())
 	systemToken := getSystemToken(appTokens)
 	body := []byte(`{
@@ -1222,12 +1225,12 @@ func TestAuthnz(t *testing.T) {
 	appParts, cleanAppParts, appTokens, statelessResources := deployTestAppWithSecretToken(require, nil)
 	defer cleanAppParts()
 
-	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
+	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestAPIKeyGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
 	queryProcessor := ProvideServiceFactory()(
 		serviceChannel,
 		appParts,
 		3, // max concurrent queries
-		metrics, "vvm", authn, itokensjwt.TestTokensJWT(), nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil)
+		metrics, "vvm", authn, itokensjwt.TestTokensJWT(), nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil, slowlog.Provide(slowlog.Config{}), querycache.Provide(querycache.Config{}, timeu.NewITime()))
 	go queryProcessor.Run(context.Background())
 
 	t.Run("no token for a query that requires authorization -> 403 unauthorized", func(t *testing.T) {
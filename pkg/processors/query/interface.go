@@ -22,6 +22,8 @@ import (
 	"github.com/voedger/voedger/pkg/itokens"
 	imetrics "github.com/voedger/voedger/pkg/metrics"
 	"github.com/voedger/voedger/pkg/pipeline"
+	"github.com/voedger/voedger/pkg/processors/querycache"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/state"
 )
 
@@ -35,4 +37,4 @@ type ServiceFactory func(serviceChannel iprocbus.ServiceChannel,
 	appParts appparts.IAppPartitions, maxPrepareQueries int, metrics imetrics.IMetrics, vvm string,
 	authn iauthnz.IAuthenticator, itokens itokens.ITokens, federation federation.IFederation,
 	statelessResources istructsmem.IStatelessResources, secretReader isecrets.ISecretReader,
-	stateOpts state.StateOpts, httpClient httpu.IHTTPClient) pipeline.IService
+	stateOpts state.StateOpts, httpClient httpu.IHTTPClient, slowLog slowlog.ISlowLog, queryCache querycache.IQueryCache) pipeline.IService
@@ -16,4 +16,7 @@ const (
 	Metric_ExecOrderSeconds  = "voedger_qp_exec_order_seconds"
 	Metric_ExecCountSeconds  = "voedger_qp_exec_count_seconds"
 	Metric_ExecSendSeconds   = "voedger_qp_exec_send_seconds"
+
+	// histogram, labels: app, vvm, qname
+	Metric_QueryDurationSeconds = "voedger_qp_query_duration_seconds"
 )
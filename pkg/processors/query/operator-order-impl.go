@@ -11,6 +11,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/pipeline"
 )
 
@@ -60,6 +61,8 @@ func (o *OrderOperator) Flush(callback pipeline.OpFuncFlush) (err error) {
 				c = cmp.Compare(v, o2.(float64))
 			case string:
 				c = cmp.Compare(v, o2.(string))
+			case decimal.Decimal:
+				c = v.Cmp(o2.(decimal.Decimal))
 			default:
 				err = fmt.Errorf("order by '%s' is impossible: %w", orderBy.Field(), ErrWrongType)
 				return 0
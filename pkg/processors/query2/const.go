@@ -78,12 +78,17 @@ const (
 	schemaMethodPost = "post"
 	schemaMethodGet  = "get"
 
-	schemaFormatInt32  = "int32"
-	schemaFormatInt64  = "int64"
-	schemaFormatFloat  = "float"
-	schemaFormatDouble = "double"
-	schemaFormatByte   = "byte"
-	schemaFormatBinary = "binary"
+	schemaFormatInt32    = "int32"
+	schemaFormatInt64    = "int64"
+	schemaFormatFloat    = "float"
+	schemaFormatDouble   = "double"
+	schemaFormatDecimal  = "decimal"
+	schemaFormatDate     = "date"
+	schemaFormatTime     = "time"
+	schemaFormatDateTime = "date-time"
+	schemaFormatUUID     = "uuid"
+	schemaFormatByte     = "byte"
+	schemaFormatBinary   = "binary"
 
 	schemaKeyType        = "type"
 	schemaKeyFormat      = "format"
@@ -134,6 +139,8 @@ const (
 	fieldError              = "error"
 	fieldArgs               = "args"
 	fieldUnloggedArgs       = "unloggedArgs"
+	fieldTOTPChallengeToken = "totpChallengeToken"
+	fieldTOTPCode           = "totpCode"
 )
 
 // Parameter names
@@ -6,6 +6,7 @@ package query2
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -28,6 +29,8 @@ import (
 	"github.com/voedger/voedger/pkg/pipeline"
 	"github.com/voedger/voedger/pkg/processors"
 	queryprocessor "github.com/voedger/voedger/pkg/processors/query"
+	"github.com/voedger/voedger/pkg/processors/querycache"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/state/stateprovide"
 	"github.com/voedger/voedger/pkg/sys/authnz"
@@ -37,7 +40,7 @@ func implServiceFactory(serviceChannel iprocbus.ServiceChannel,
 	appParts appparts.IAppPartitions, maxPrepareQueries int, metrics imetrics.IMetrics, vvm string,
 	authn iauthnz.IAuthenticator, itokens itokens.ITokens, federation federation.IFederation,
 	statelessResources istructsmem.IStatelessResources, secretReader isecrets.ISecretReader,
-	stateOpts state.StateOpts, httpClient httpu.IHTTPClient) pipeline.IService {
+	stateOpts state.StateOpts, httpClient httpu.IHTTPClient, slowLog slowlog.ISlowLog, queryCache querycache.IQueryCache) pipeline.IService {
 	return pipeline.NewService(func(ctx context.Context) {
 		var p pipeline.ISyncPipeline
 		for ctx.Err() == nil {
@@ -52,22 +55,29 @@ func implServiceFactory(serviceChannel iprocbus.ServiceChannel,
 				}
 				qpm.Increase(queryprocessor.Metric_QueriesTotal, 1.0)
 				qwork := newQueryWork(msg, appParts, maxPrepareQueries, qpm, secretReader, federation)
+				if slowLog.Enabled() {
+					qwork.stages = &slowlog.Stages{}
+				}
+				var err error
 				func() { // borrowed application partition should be guaranteed to be freed
 					defer qwork.Release()
 					if p == nil {
 						p = newQueryProcessorPipeline(ctx, authn, itokens, federation, statelessResources, stateOpts, httpClient)
 					}
-					err := p.SendSync(qwork)
+					var cachedRows []json.RawMessage
+					cacheHit := false
+					err = p.SendSync(qwork)
 					if err != nil {
 						qpm.Increase(queryprocessor.Metric_ErrorsTotal, 1.0)
 						p.Close()
 						p = nil
-					} else {
+					} else if cachedRows, cacheHit = qwork.getCachedResult(queryCache); !cacheHit {
 						now := time.Now()
 						if qwork.apiPathHandler.exec != nil {
 							err = qwork.apiPathHandler.exec(ctx, qwork)
 						}
 						qwork.metrics.Increase(queryprocessor.Metric_ExecSeconds, time.Since(now).Seconds())
+						qwork.AddStage(slowlog.Stage_Exec, time.Since(now))
 						if err == nil {
 							logger.VerboseCtx(qwork.msg.RequestCtx(), "qp.success")
 							if err = processors.CheckResponseIntent(qwork.state); err == nil {
@@ -75,6 +85,7 @@ func implServiceFactory(serviceChannel iprocbus.ServiceChannel,
 							}
 						}
 					}
+					responseStart := time.Now()
 					if qwork.rowsProcessor != nil {
 						// wait until all rows are sent
 						qwork.rowsProcessor.Close()
@@ -100,15 +111,37 @@ func implServiceFactory(serviceChannel iprocbus.ServiceChannel,
 						} else {
 							respWriter = qwork.responseWriterGetter()
 						}
+						if cacheHit {
+							err = writeCachedRows(respWriter, cachedRows)
+						}
 						respWriter.Close(err)
+						if err == nil {
+							qwork.putCachedResult(queryCache)
+						}
 					} else if err != nil {
 						respondErr := qwork.msg.Responder().Respond(bus.ResponseMeta{ContentType: httpu.ContentType_ApplicationJSON, StatusCode: statusCode}, err)
 						if respondErr != nil {
 							logger.ErrorCtx(qwork.msg.RequestCtx(), "qp.error", "failed to send error: ", respondErr.Error())
 						}
 					}
+					qwork.AddStage(slowlog.Stage_Response, time.Since(responseStart))
 				}()
-				metrics.IncreaseApp(queryprocessor.Metric_QueriesSeconds, vvm, msg.AppQName(), time.Since(now).Seconds())
+				queryDuration := time.Since(now)
+				querySeconds := queryDuration.Seconds()
+				metrics.IncreaseApp(queryprocessor.Metric_QueriesSeconds, vvm, msg.AppQName(), querySeconds)
+				metrics.Histogram(queryprocessor.Metric_QueryDurationSeconds, vvm, msg.AppQName(), qwork.metricsQName(), nil).Observe(querySeconds)
+				if qwork.stages != nil {
+					slowLog.Report(msg.RequestCtx(), slowlog.Request{
+						Kind:     slowlog.RequestKind_Query,
+						App:      msg.AppQName(),
+						WSID:     msg.WSID(),
+						QName:    msg.QName(),
+						Start:    now,
+						Duration: queryDuration,
+						Stages:   *qwork.stages,
+						Err:      err,
+					})
+				}
 			case <-ctx.Done():
 			}
 		}
@@ -132,6 +165,8 @@ func newQueryProcessorPipeline(requestCtx context.Context, authn iauthnz.IAuthen
 			case processors.APIPath_Docs:
 				// [~server.apiv2.docs/cmp.provideDocsHandler~impl]
 				qw.apiPathHandler = docsHandler()
+			case processors.APIPath_Docs_History:
+				qw.apiPathHandler = docsHistoryHandler()
 			case processors.APIPaths_Schema:
 				qw.apiPathHandler = schemasHandler()
 			case processors.APIPath_Schemas_WorkspaceRoles:
@@ -14,12 +14,15 @@ import (
 
 	"github.com/voedger/voedger/pkg/bus"
 	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/coreutils/federation"
 	"github.com/voedger/voedger/pkg/goutils/httpu"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/sys/authnz"
 )
 
 // [~server.authnz/cmp.authLoginHandler~impl]
+// the login with TOTP enabled signs in by two steps:
+// {login, password} -> {totpChallengeToken}, then {login, totpChallengeToken, totpCode} -> principal token
 func authLoginHandler() apiPathHandler {
 	return apiPathHandler{
 		exec: func(_ context.Context, qw *queryWork) (err error) {
@@ -28,46 +31,56 @@ func authLoginHandler() apiPathHandler {
 			if err != nil {
 				return coreutils.NewHTTPError(http.StatusBadRequest, err)
 			}
-			password, _, err := args.AsString(fieldPassword)
+			challengeToken, isSecondStep, err := args.AsString(fieldTOTPChallengeToken)
 			if err != nil {
 				return coreutils.NewHTTPError(http.StatusBadRequest, err)
 			}
 
 			pseudoWSID := coreutils.GetPseudoWSID(istructs.NullWSID, login, istructs.CurrentClusterID())
-
-			tokenArgs, err := json.Marshal(map[string]string{
-				"Login":    login,
-				"Password": password,
-				"AppName":  qw.msg.AppQName().String(),
-			})
-			if err != nil {
-				return err
-			}
-			reqURL := fmt.Sprintf(`api/v2/apps/%s/%s/workspaces/%d/queries/registry.IssuePrincipalToken?args=%s`,
-				istructs.SysOwner, istructs.AppQName_sys_registry.Name(), pseudoWSID,
-				url.QueryEscape(string(tokenArgs)))
-
 			// WithRetry to avoid WSAECONNREFUSED errors on stress tests on Windows
 			federationWithRetry := qw.federation.WithRetry()
-			resp, err := federationWithRetry.Query(reqURL)
-			if err != nil {
-				return err
-			}
-			if resp.IsEmpty() {
-				return errors.New("sys.IssuePrincipalToken response is empty")
+
+			var result map[string]interface{}
+			if isSecondStep {
+				totpCode, _, err := args.AsString(fieldTOTPCode)
+				if err != nil {
+					return coreutils.NewHTTPError(http.StatusBadRequest, err)
+				}
+				if result, err = issuePrincipalTokenByTOTP(federationWithRetry, pseudoWSID, login, qw.msg.AppQName().String(), challengeToken, totpCode); err != nil {
+					return err
+				}
+			} else {
+				password, _, err := args.AsString(fieldPassword)
+				if err != nil {
+					return coreutils.NewHTTPError(http.StatusBadRequest, err)
+				}
+				if result, err = issuePrincipalToken(federationWithRetry, pseudoWSID, login, qw.msg.AppQName().String(), password); err != nil {
+					return err
+				}
+				if challengeToken, _ := result["TOTPChallengeToken"].(string); len(challengeToken) > 0 {
+					json := fmt.Sprintf(`{
+						%q: %q,
+						%q: %d
+					}`, fieldTOTPChallengeToken, challengeToken, fieldExpiresInSeconds, int(authnz.TOTPChallengeExpiration.Seconds()))
+					return qw.msg.Responder().Respond(bus.ResponseMeta{ContentType: httpu.ContentType_ApplicationJSON, StatusCode: http.StatusOK}, json)
+				}
 			}
-			token := resp.QPv2Response.Result()["PrincipalToken"].(string)
-			wsError := resp.QPv2Response.Result()["WSError"].(string)
-			wsid := resp.QPv2Response.Result()["WSID"].(float64)
 
-			if len(wsError) > 0 {
+			// WSError and PrincipalToken are missing until the profile is ready
+			if wsError, _ := result[authnz.Field_WSError].(string); len(wsError) > 0 {
 				return errors.New("the login profile is created with an error: " + wsError)
 			}
 
+			wsid, _ := result["WSID"].(float64)
 			if wsid == 0 {
 				return coreutils.NewHTTPError(http.StatusConflict, errors.New("profile workspace is not yet ready, try again later"))
 			}
 
+			token, ok := result["PrincipalToken"].(string)
+			if !ok {
+				return errors.New("principal token is not issued")
+			}
+
 			expiresInSeconds := authnz.DefaultPrincipalTokenExpiration.Seconds()
 			json := fmt.Sprintf(`{
 				%q: %q,
@@ -78,3 +91,54 @@ func authLoginHandler() apiPathHandler {
 		},
 	}
 }
+
+func issuePrincipalToken(federation federation.IFederationWithRetry, pseudoWSID istructs.WSID, login, appName, password string) (map[string]interface{}, error) {
+	tokenArgs, err := json.Marshal(map[string]string{
+		"Login":    login,
+		"Password": password,
+		"AppName":  appName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	reqURL := fmt.Sprintf(`api/v2/apps/%s/%s/workspaces/%d/queries/registry.IssuePrincipalToken?args=%s`,
+		istructs.SysOwner, istructs.AppQName_sys_registry.Name(), pseudoWSID,
+		url.QueryEscape(string(tokenArgs)))
+	resp, err := federation.Query(reqURL)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsEmpty() {
+		return nil, errors.New("sys.IssuePrincipalToken response is empty")
+	}
+	return resp.QPv2Response.Result(), nil
+}
+
+// command, not query: the used TOTP code and recovery code must be remembered
+func issuePrincipalTokenByTOTP(federation federation.IFederationWithRetry, pseudoWSID istructs.WSID, login, appName, challengeToken, totpCode string) (map[string]interface{}, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		fieldArgs: map[string]string{
+			"Login":   login,
+			"AppName": appName,
+		},
+		fieldUnloggedArgs: map[string]string{
+			"ChallengeToken": challengeToken,
+			"TOTPCode":       totpCode,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	reqURL := fmt.Sprintf(`api/v2/apps/%s/%s/workspaces/%d/commands/registry.IssuePrincipalTokenByTOTP`,
+		istructs.SysOwner, istructs.AppQName_sys_registry.Name(), pseudoWSID)
+	// 429 on too many code attempts must reach the client instead of being retried
+	resp, err := federation.Func(reqURL, string(body), httpu.WithMethod(http.MethodPost),
+		httpu.WithRetryPolicy(httpu.WithRetryOnStatus(http.StatusServiceUnavailable)))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.CmdResult) == 0 {
+		return nil, errors.New("registry.IssuePrincipalTokenByTOTP result is empty")
+	}
+	return resp.CmdResult, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
 	"github.com/voedger/voedger/pkg/goutils/logger"
+	"github.com/voedger/voedger/pkg/iauthnz"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
 	"github.com/voedger/voedger/pkg/pipeline"
@@ -105,11 +106,16 @@ func cdocsExec(ctx context.Context, qw *queryWork) (err error) {
 	kb := qw.appStructs.ViewRecords().KeyBuilder(collection.QNameCollectionView)
 	kb.PutInt32(collection.Field_PartKey, collection.PartitionKeyCollection)
 	kb.PutQName(collection.Field_DocQName, qw.msg.QName())
+	policies := qw.selectRowPolicies()
+	subject := iauthnz.SubjectName(qw.principals)
 	return qw.appStructs.ViewRecords().Read(ctx, qw.msg.WSID(), kb, func(_ istructs.IKey, value istructs.IValue) (err error) {
 		r := value.AsRecord(collection.Field_Record)
 		if r.QName() != qw.msg.QName() {
 			return nil
 		}
+		if allowed, err := istructsmem.IsRowAllowed(r, policies, subject); !allowed {
+			return err // rows, which are not accessible by row policies, are skipped
+		}
 		obj := objectBackedByMap{}
 		obj.data = coreutils.FieldsToMap(r, qw.appStructs.AppDef())
 		return qw.callbackFunc(obj)
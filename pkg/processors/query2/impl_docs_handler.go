@@ -13,6 +13,7 @@ import (
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
 	"github.com/voedger/voedger/pkg/goutils/logger"
+	"github.com/voedger/voedger/pkg/iauthnz"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
 	"github.com/voedger/voedger/pkg/pipeline"
@@ -60,23 +61,34 @@ func docsSetResultType(_ context.Context, qw *queryWork, _ istructsmem.IStateles
 	return nil
 }
 func docsAuthorizeResult(_ context.Context, qw *queryWork) (err error) {
-	ws := qw.iWorkspace
-	if ws == nil {
-		return errWorkspaceIsNil
-	}
 	var requestedFields []string
 	if qw.queryParams.Constraints != nil && len(qw.queryParams.Constraints.Keys) != 0 {
 		requestedFields = qw.queryParams.Constraints.Keys
 	} else {
-		var structure appdef.IStructure
-		if qw.iDoc != nil {
-			structure = qw.iDoc
-		} else {
-			structure = qw.iRecord
-		}
-		for _, field := range structure.Fields() {
-			requestedFields = append(requestedFields, field.Name())
-		}
+		requestedFields = docsAllFields(qw)
+	}
+	return docsAuthorizeSelect(qw, requestedFields)
+}
+
+// Returns names of all fields of requested document or record
+func docsAllFields(qw *queryWork) (fields []string) {
+	var structure appdef.IStructure
+	if qw.iDoc != nil {
+		structure = qw.iDoc
+	} else {
+		structure = qw.iRecord
+	}
+	for _, field := range structure.Fields() {
+		fields = append(fields, field.Name())
+	}
+	return fields
+}
+
+// Checks that principals are allowed to select specified fields of requested document or record
+func docsAuthorizeSelect(qw *queryWork, requestedFields []string) (err error) {
+	ws := qw.iWorkspace
+	if ws == nil {
+		return errWorkspaceIsNil
 	}
 	// TODO: what to do with included objects?
 	// TODO: temporary solution. To be eliminated after implementing ACL in VSQL for Air
@@ -142,6 +154,13 @@ func docsExec(_ context.Context, qw *queryWork) (err error) {
 			return coreutils.NewHTTPErrorf(http.StatusNotFound, fmt.Errorf("record %s with ID %d not found", qw.msg.QName(), qw.msg.DocID()))
 		}
 	}
+	allowed, err := istructsmem.IsRowAllowed(rec, qw.selectRowPolicies(), iauthnz.SubjectName(qw.principals))
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return coreutils.NewHTTPErrorf(http.StatusForbidden, fmt.Errorf("%s with ID %d is not accessible by row policies", qw.msg.QName(), qw.msg.DocID()))
+	}
 	obj := objectBackedByMap{}
 	obj.data = coreutils.FieldsToMap(rec, qw.appStructs.AppDef())
 	return qw.callbackFunc(obj)
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package query2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/iauthnz"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/istructsmem"
+	"github.com/voedger/voedger/pkg/pipeline"
+	"github.com/voedger/voedger/pkg/sys/audit"
+)
+
+// Returns changes history of document or record declared WITH AUDIT
+func docsHistoryHandler() apiPathHandler {
+	return apiPathHandler{
+		requestOpKind:   appdef.OperationKind_Select,
+		isArrayResult:   true,
+		setRequestType:  docsHistorySetRequestType,
+		setResultType:   docsSetResultType,
+		authorizeResult: docsHistoryAuthorizeResult,
+		rowsProcessor:   docsHistoryRowsProcessor,
+		exec:            docsHistoryExec,
+	}
+}
+
+func docsHistorySetRequestType(ctx context.Context, qw *queryWork) error {
+	if err := docsSetRequestType(ctx, qw); err != nil {
+		return err
+	}
+	var rec appdef.IRecord = qw.iRecord
+	if qw.iDoc != nil {
+		rec = qw.iDoc
+	}
+	if !rec.Audit() {
+		return coreutils.NewHTTPErrorf(http.StatusBadRequest, fmt.Sprintf("%s is not audited", qw.msg.QName()))
+	}
+	return nil
+}
+
+func docsHistoryAuthorizeResult(_ context.Context, qw *queryWork) error {
+	// history contains values of all fields
+	return docsAuthorizeSelect(qw, docsAllFields(qw))
+}
+
+func docsHistoryRowsProcessor(ctx context.Context, qw *queryWork) (err error) {
+	oo := make([]*pipeline.WiredOperator, 0)
+	if qw.queryParams.Constraints != nil && (len(qw.queryParams.Constraints.Order) != 0 || qw.queryParams.Constraints.Skip > 0 || qw.queryParams.Constraints.Limit > 0) {
+		oo = append(oo, pipeline.WireAsyncOperator("Aggregator", newAggregator(qw.queryParams)))
+	}
+	if qw.queryParams.Constraints != nil && len(qw.queryParams.Constraints.Keys) != 0 {
+		oo = append(oo, pipeline.WireAsyncOperator("Keys", newKeys(qw.queryParams.Constraints.Keys)))
+	}
+	sender, respWriterGetter := qw.getArraySender()
+	oo = append(oo, pipeline.WireAsyncOperator("Sender", sender))
+	qw.rowsProcessor = pipeline.NewAsyncPipeline(ctx, "Docs history rows processor", oo[0], oo[1:]...)
+	qw.responseWriterGetter = respWriterGetter
+	return nil
+}
+
+func docsHistoryExec(ctx context.Context, qw *queryWork) (err error) {
+	id := istructs.RecordID(qw.msg.DocID())
+	rec, err := qw.appStructs.Records().Get(qw.msg.WSID(), true, id)
+	if err != nil {
+		return err
+	}
+	if rec.QName() != qw.msg.QName() {
+		return coreutils.NewHTTPErrorf(http.StatusNotFound, fmt.Errorf("%s with ID %d not found", qw.msg.QName(), id))
+	}
+	allowed, err := istructsmem.IsRowAllowed(rec, qw.selectRowPolicies(), iauthnz.SubjectName(qw.principals))
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return coreutils.NewHTTPErrorf(http.StatusForbidden, fmt.Errorf("%s with ID %d is not accessible by row policies", qw.msg.QName(), id))
+	}
+
+	kb := qw.appStructs.ViewRecords().KeyBuilder(audit.QNameViewRecordHistory)
+	kb.PutRecordID(audit.Field_RecordID, id)
+	return qw.appStructs.ViewRecords().Read(ctx, qw.msg.WSID(), kb, func(key istructs.IKey, value istructs.IValue) error {
+		obj := objectBackedByMap{}
+		obj.data = map[string]any{
+			audit.Field_WLogOffset:   key.AsInt64(audit.Field_WLogOffset),
+			audit.Field_RegisteredAt: value.AsInt64(audit.Field_RegisteredAt),
+			audit.Field_ProfileWSID:  value.AsInt64(audit.Field_ProfileWSID),
+			audit.Field_Principal:    value.AsString(audit.Field_Principal),
+			audit.Field_Changes:      json.RawMessage(value.AsString(audit.Field_Changes)),
+		}
+		return qw.callbackFunc(obj)
+	})
+}
@@ -441,8 +441,15 @@ func (g *schemaGenerator) genAuthLoginPath() {
 								fieldPassword: map[string]interface{}{
 									schemaKeyType: schemaTypeString,
 								},
+								// second step of the sign in by the login with TOTP enabled, instead of the password
+								fieldTOTPChallengeToken: map[string]interface{}{
+									schemaKeyType: schemaTypeString,
+								},
+								fieldTOTPCode: map[string]interface{}{
+									schemaKeyType: schemaTypeString,
+								},
 							},
-							schemaKeyRequired: []string{fieldLogin, fieldPassword},
+							schemaKeyRequired: []string{fieldLogin},
 						},
 					},
 				},
@@ -122,6 +122,24 @@ func (g *schemaGenerator) generateFieldSchema(field appdef.IField, op appdef.Ope
 	case appdef.DataKind_float64:
 		schema[schemaKeyType] = schemaTypeNumber
 		schema[schemaKeyFormat] = schemaFormatDouble
+	case appdef.DataKind_decimal:
+		// decimal is represented as string to avoid precision loss
+		schema[schemaKeyType] = schemaTypeString
+		schema[schemaKeyFormat] = schemaFormatDecimal
+	case appdef.DataKind_date:
+		schema[schemaKeyType] = schemaTypeString
+		schema[schemaKeyFormat] = schemaFormatDate
+	case appdef.DataKind_time:
+		schema[schemaKeyType] = schemaTypeString
+		schema[schemaKeyFormat] = schemaFormatTime
+	case appdef.DataKind_timestamp:
+		schema[schemaKeyType] = schemaTypeString
+		schema[schemaKeyFormat] = schemaFormatDateTime
+	case appdef.DataKind_uuid:
+		schema[schemaKeyType] = schemaTypeString
+		schema[schemaKeyFormat] = schemaFormatUUID
+	case appdef.DataKind_json:
+		// json document may be any json value, so type is not restricted
 	case appdef.DataKind_bool:
 		schema[schemaKeyType] = schemaTypeBoolean
 	case appdef.DataKind_string:
@@ -7,11 +7,15 @@
 package query2
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"github.com/voedger/voedger/pkg/appdef"
 )
 
 func Test_getCombinations(t *testing.T) {
@@ -186,3 +190,132 @@ func Test_splitPath(t *testing.T) {
 		})
 	}
 }
+
+func Test_Where_getAsDateTime(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("should parse ISO-8601 strings and JSON numbers", func(t *testing.T) {
+		w := Where{
+			"day":   "2025-12-31",
+			"at":    map[string]interface{}{"$in": []interface{}{"10:30", json.Number("0")}},
+			"stamp": "2026-01-01T01:00:00+02:00",
+		}
+
+		vv, err := w.getAsDateTime("day", appdef.DataKind_date)
+		require.NoError(err)
+		require.Equal([]int64{20453}, vv)
+
+		vv, err = w.getAsDateTime("at", appdef.DataKind_time)
+		require.NoError(err)
+		require.Equal([]int64{(10*60 + 30) * 60 * 1000, 0}, vv)
+
+		vv, err = w.getAsDateTime("stamp", appdef.DataKind_timestamp)
+		require.NoError(err)
+		require.Equal([]int64{1767222000000}, vv)
+
+		vv, err = w.getAsDateTime("unknown", appdef.DataKind_date)
+		require.NoError(err)
+		require.Nil(vv)
+	})
+
+	t.Run("should be error if invalid value", func(t *testing.T) {
+		w := Where{
+			"day":  "31.12.2025",
+			"at":   json.Number("86400000"),
+			"bool": true,
+			"ne":   map[string]interface{}{"$ne": "2025-12-31"},
+		}
+		_, err := w.getAsDateTime("day", appdef.DataKind_date)
+		require.Error(err)
+		_, err = w.getAsDateTime("at", appdef.DataKind_time)
+		require.Error(err)
+		_, err = w.getAsDateTime("bool", appdef.DataKind_date)
+		require.ErrorIs(err, errUnsupportedType)
+		_, err = w.getAsDateTime("ne", appdef.DataKind_date)
+		require.ErrorIs(err, errUnsupportedConstraint)
+	})
+}
+
+func Test_Where_getAsUUID(t *testing.T) {
+	require := require.New(t)
+
+	id1 := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	id2 := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	t.Run("should parse canonical strings", func(t *testing.T) {
+		w := Where{
+			"owner": "F47AC10B-58CC-4372-A567-0E02B2C3D479",
+			"items": map[string]interface{}{"$in": []interface{}{id1.String(), id2.String()}},
+		}
+
+		vv, err := w.getAsUUID("owner")
+		require.NoError(err)
+		require.Equal([]uuid.UUID{id1}, vv)
+
+		vv, err = w.getAsUUID("items")
+		require.NoError(err)
+		require.Equal([]uuid.UUID{id1, id2}, vv)
+
+		vv, err = w.getAsUUID("unknown")
+		require.NoError(err)
+		require.Nil(vv)
+	})
+
+	t.Run("should be error if invalid value", func(t *testing.T) {
+		w := Where{
+			"owner":  "not-a-uuid",
+			"number": json.Number("1"),
+			"in":     map[string]interface{}{"$in": []interface{}{json.Number("1")}},
+			"ne":     map[string]interface{}{"$ne": id1.String()},
+		}
+		_, err := w.getAsUUID("owner")
+		require.Error(err)
+		_, err = w.getAsUUID("number")
+		require.ErrorIs(err, errUnsupportedType)
+		_, err = w.getAsUUID("in")
+		require.ErrorIs(err, errUnsupportedType)
+		_, err = w.getAsUUID("ne")
+		require.ErrorIs(err, errUnsupportedConstraint)
+	})
+}
+
+func Test_Where_getJSONPaths(t *testing.T) {
+	require := require.New(t)
+
+	w := Where{
+		"Settings.theme":       "dark",
+		"Settings.sizes[0]":    map[string]interface{}{"$in": []interface{}{json.Number("10"), json.Number("20")}},
+		"Settings":             "ignored",
+		"Other.theme":          "ignored",
+		"SettingsLong.theme":   "ignored",
+		"Settings.font.italic": false,
+	}
+
+	ff, err := w.getJSONPaths("Settings")
+	require.NoError(err)
+	require.Len(ff, 3)
+
+	f := filter{JSON: ff}
+	match := func(doc string) bool {
+		work, err := f.DoAsync(context.Background(), objectBackedByMap{data: map[string]interface{}{"Settings": json.RawMessage(doc)}})
+		require.NoError(err)
+		return work != nil
+	}
+	require.True(match(`{"theme":"dark","sizes":[10],"font":{"italic":false}}`))
+	require.True(match(`{"theme":"dark","sizes":[20,10],"font":{"italic":false}}`))
+	require.False(match(`{"theme":"light","sizes":[10],"font":{"italic":false}}`))
+	require.False(match(`{"theme":"dark","sizes":[30],"font":{"italic":false}}`))
+	require.False(match(`{"theme":"dark","sizes":[10]}`))
+	require.False(match(``))
+
+	t.Run("should be error if invalid path or constraint", func(t *testing.T) {
+		_, err := Where{"Settings.a..b": "x"}.getJSONPaths("Settings")
+		require.Error(err)
+		_, err = Where{"Settings.theme": map[string]interface{}{"$ne": "x"}}.getJSONPaths("Settings")
+		require.ErrorIs(err, errUnsupportedConstraint)
+		_, err = Where{"Settings.theme": map[string]interface{}{"$in": "x"}}.getJSONPaths("Settings")
+		require.ErrorIs(err, errUnexpectedParams)
+		_, err = Where{"Settings.theme": []interface{}{"x"}}.getJSONPaths("Settings")
+		require.ErrorIs(err, errUnsupportedType)
+	})
+}
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/google/uuid"
+
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
 	"github.com/voedger/voedger/pkg/goutils/logger"
@@ -155,6 +157,32 @@ func getKeys(qw *queryWork) (keys []istructs.IKeyBuilder, err error) {
 			for _, v := range vv {
 				values[i] = append(values[i], v)
 			}
+		case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+			vv, err := qw.queryParams.Constraints.Where.getAsDateTime(field.Name(), field.DataKind())
+			if err != nil {
+				return nil, err
+			}
+			if vv == nil {
+				partialKey = true
+				continue
+			}
+			values = append(values, make([]interface{}, 0))
+			for _, v := range vv {
+				values[i] = append(values[i], v)
+			}
+		case appdef.DataKind_uuid:
+			vv, err := qw.queryParams.Constraints.Where.getAsUUID(field.Name())
+			if err != nil {
+				return nil, err
+			}
+			if vv == nil {
+				partialKey = true
+				continue
+			}
+			values = append(values, make([]interface{}, 0))
+			for _, v := range vv {
+				values[i] = append(values[i], v)
+			}
 		default:
 			// do nothing
 		}
@@ -169,6 +197,10 @@ func getKeys(qw *queryWork) (keys []istructs.IKeyBuilder, err error) {
 				keys[i].PutInt32(fields[j].Name(), v)
 			case string:
 				keys[i].PutString(fields[j].Name(), v)
+			case int64:
+				keys[i].PutInt64(fields[j].Name(), v)
+			case uuid.UUID:
+				keys[i].PutUUID(fields[j].Name(), v)
 			}
 		}
 	}
@@ -190,10 +222,17 @@ func validateFields(qw *queryWork) (err error) {
 	}
 
 	ff := make(map[string]bool)
+	jsonFields := make(map[string]bool)
 	for _, field := range view.Fields() {
 		ff[field.Name()] = true
+		if field.DataKind() == appdef.DataKind_json {
+			jsonFields[field.Name()] = true
+		}
 	}
 	for k := range qw.queryParams.Constraints.Where {
+		if name, _, ok := splitJSONPath(k); ok && jsonFields[name] {
+			continue
+		}
 		if !ff[k] {
 			return fmt.Errorf("%w: '%s'", errUnexpectedField, k)
 		}
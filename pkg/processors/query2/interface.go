@@ -15,6 +15,8 @@ import (
 	"github.com/voedger/voedger/pkg/itokens"
 	imetrics "github.com/voedger/voedger/pkg/metrics"
 	"github.com/voedger/voedger/pkg/pipeline"
+	"github.com/voedger/voedger/pkg/processors/querycache"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/state"
 )
 
@@ -23,4 +25,4 @@ type ServiceFactory func(serviceChannel iprocbus.ServiceChannel,
 	authn iauthnz.IAuthenticator, itokens itokens.ITokens,
 	federation federation.IFederation,
 	statelessResources istructsmem.IStatelessResources, secretReader isecrets.ISecretReader,
-	stateOpts state.StateOpts, httpClient httpu.IHTTPClient) pipeline.IService
+	stateOpts state.StateOpts, httpClient httpu.IHTTPClient, slowLog slowlog.ISlowLog, queryCache querycache.IQueryCache) pipeline.IService
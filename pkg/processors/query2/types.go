@@ -11,14 +11,18 @@ import (
 	"fmt"
 	"iter"
 	"net/http"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/google/uuid"
+
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/bus"
 	"github.com/voedger/voedger/pkg/coreutils"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
+	"github.com/voedger/voedger/pkg/jsonpath"
 	"github.com/voedger/voedger/pkg/pipeline"
 	"github.com/voedger/voedger/pkg/processors"
 	"github.com/voedger/voedger/pkg/sys/collection"
@@ -381,14 +385,28 @@ func (s *sender) OnError(_ context.Context, err error) {
 
 type filter struct {
 	pipeline.AsyncNOOP
-	Int32  map[string]map[int32]bool
-	String map[string]map[string]bool
+	Int32    map[string]map[int32]bool
+	String   map[string]map[string]bool
+	DateTime map[string]map[int64]bool
+	UUID     map[string]map[uuid.UUID]bool
+	JSON     []jsonPathFilter
+	kinds    map[string]appdef.DataKind
+}
+
+// Filter by value addressed by path in json field, like `{"Settings.theme":"dark"}`
+type jsonPathFilter struct {
+	field  string
+	path   jsonpath.Path
+	values []interface{}
 }
 
 func newFilter(qw *queryWork, fields []appdef.IField) (o pipeline.IAsyncOperator, err error) {
 	f := &filter{
-		Int32:  make(map[string]map[int32]bool),
-		String: make(map[string]map[string]bool),
+		Int32:    make(map[string]map[int32]bool),
+		String:   make(map[string]map[string]bool),
+		DateTime: make(map[string]map[int64]bool),
+		UUID:     make(map[string]map[uuid.UUID]bool),
+		kinds:    make(map[string]appdef.DataKind),
 	}
 	if qw.queryParams.Constraints == nil || qw.queryParams.Constraints.Where == nil || len(qw.queryParams.Constraints.Where) == 0 {
 		return nil, nil
@@ -421,11 +439,44 @@ func newFilter(qw *queryWork, fields []appdef.IField) (o pipeline.IAsyncOperator
 				}
 				m[v] = true
 			}
+		case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+			vv, err := qw.queryParams.Constraints.Where.getAsDateTime(field.Name(), field.DataKind())
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range vv {
+				m, ok := f.DateTime[field.Name()]
+				if !ok {
+					m = make(map[int64]bool)
+					f.DateTime[field.Name()] = m
+					f.kinds[field.Name()] = field.DataKind()
+				}
+				m[v] = true
+			}
+		case appdef.DataKind_uuid:
+			vv, err := qw.queryParams.Constraints.Where.getAsUUID(field.Name())
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range vv {
+				m, ok := f.UUID[field.Name()]
+				if !ok {
+					m = make(map[uuid.UUID]bool)
+					f.UUID[field.Name()] = m
+				}
+				m[v] = true
+			}
+		case appdef.DataKind_json:
+			ff, err := qw.queryParams.Constraints.Where.getJSONPaths(field.Name())
+			if err != nil {
+				return nil, err
+			}
+			f.JSON = append(f.JSON, ff...)
 		default:
 			// Do nothing
 		}
 	}
-	if len(f.Int32) == 0 && len(f.String) == 0 {
+	if len(f.Int32) == 0 && len(f.String) == 0 && len(f.DateTime) == 0 && len(f.UUID) == 0 && len(f.JSON) == 0 {
 		return nil, nil
 	}
 	return f, nil
@@ -442,9 +493,94 @@ func (f filter) DoAsync(_ context.Context, work pipeline.IWorkpiece) (outWork pi
 			return nil, nil
 		}
 	}
+	for fieldName, values := range f.DateTime {
+		v, err := dateTimeValue(work, fieldName, f.kinds[fieldName])
+		if err != nil {
+			return nil, err
+		}
+		if !values[v] {
+			return nil, nil
+		}
+	}
+	for fieldName, values := range f.UUID {
+		v, err := uuidValue(work, fieldName)
+		if err != nil {
+			return nil, err
+		}
+		if !values[v] {
+			return nil, nil
+		}
+	}
+	for _, jf := range f.JSON {
+		v, err := jsonPathValue(work, jf.field, jf.path)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.ContainsFunc(jf.values, func(value interface{}) bool { return jsonpath.Equal(v, value) }) {
+			return nil, nil
+		}
+	}
 	return work, nil
 }
 
+// Returns stored value of date, time or timestamp field.
+//
+// View rows are backed by map with ISO-8601 strings, query results are read as is
+func dateTimeValue(work pipeline.IWorkpiece, fieldName string, kind appdef.DataKind) (int64, error) {
+	if o, ok := work.(objectBackedByMap); ok {
+		if s, ok := o.data[fieldName].(string); ok {
+			return coreutils.ParseDateTime(kind, s)
+		}
+	}
+	return work.(istructs.IRowReader).AsInt64(fieldName), nil
+}
+
+// Returns value of uuid field.
+//
+// View rows are backed by map with canonical strings, query results are read as is
+func uuidValue(work pipeline.IWorkpiece, fieldName string) (uuid.UUID, error) {
+	if o, ok := work.(objectBackedByMap); ok {
+		if s, ok := o.data[fieldName].(string); ok {
+			return uuid.Parse(s)
+		}
+	}
+	return work.(istructs.IRowReader).AsUUID(fieldName), nil
+}
+
+// Returns value addressed by path in json field.
+//
+// View rows are backed by map with raw json documents, query results are read as is
+func jsonPathValue(work pipeline.IWorkpiece, fieldName string, path jsonpath.Path) (interface{}, error) {
+	if o, ok := work.(objectBackedByMap); ok {
+		var data string
+		switch v := o.data[fieldName].(type) {
+		case json.RawMessage:
+			data = string(v)
+		case string:
+			data = v
+		}
+		if len(data) == 0 {
+			return nil, nil
+		}
+		var doc interface{}
+		if err := json.Unmarshal([]byte(data), &doc); err != nil {
+			return nil, err
+		}
+		v, _ := path.Value(doc)
+		return v, nil
+	}
+	return work.(istructs.IRowReader).AsJSONPath(fieldName, path.String()), nil
+}
+
+// Splits where key to json field name and path, like `Settings.font.name` to `Settings` and `font.name`
+func splitJSONPath(k string) (field, path string, ok bool) {
+	i := strings.IndexAny(k, ".[")
+	if i <= 0 {
+		return k, "", false
+	}
+	return k[:i], strings.TrimPrefix(k[i:], "."), true
+}
+
 type Where map[string]interface{}
 
 func (w Where) getAsInt32(k string) (vv []int32, err error) {
@@ -504,6 +640,128 @@ func (w Where) getAsString(k string) (vv []string, err error) {
 	}
 }
 
+// Returns stored values of date, time or timestamp field from ISO-8601 strings or JSON numbers
+func (w Where) getAsDateTime(k string, kind appdef.DataKind) (vv []int64, err error) {
+	parse := func(value interface{}) (int64, error) {
+		switch v := value.(type) {
+		case string:
+			return coreutils.ParseDateTime(kind, v)
+		case json.Number:
+			i, err := coreutils.ClarifyJSONNumber(v, kind)
+			if err != nil {
+				return 0, err
+			}
+			return i.(int64), nil
+		default:
+			return 0, errUnsupportedType
+		}
+	}
+	switch v := w[k].(type) {
+	case string, json.Number:
+		val, err := parse(v)
+		if err != nil {
+			return nil, err
+		}
+		vv = append(vv, val)
+		return vv, nil
+	case map[string]interface{}:
+		in, ok := v["$in"]
+		if !ok {
+			return nil, errUnsupportedConstraint
+		}
+		params, ok := in.([]interface{})
+		if !ok {
+			return nil, errUnexpectedParams
+		}
+		for _, param := range params {
+			val, err := parse(param)
+			if err != nil {
+				return nil, err
+			}
+			vv = append(vv, val)
+		}
+		return vv, nil
+	case nil:
+		return
+	default:
+		return nil, errUnsupportedType
+	}
+}
+
+// Returns values of uuid field from canonical strings
+func (w Where) getAsUUID(k string) (vv []uuid.UUID, err error) {
+	parse := func(value interface{}) (uuid.UUID, error) {
+		s, ok := value.(string)
+		if !ok {
+			return uuid.Nil, errUnsupportedType
+		}
+		return uuid.Parse(s)
+	}
+	switch v := w[k].(type) {
+	case string:
+		val, err := parse(v)
+		if err != nil {
+			return nil, err
+		}
+		vv = append(vv, val)
+		return vv, nil
+	case map[string]interface{}:
+		in, ok := v["$in"]
+		if !ok {
+			return nil, errUnsupportedConstraint
+		}
+		params, ok := in.([]interface{})
+		if !ok {
+			return nil, errUnexpectedParams
+		}
+		for _, param := range params {
+			val, err := parse(param)
+			if err != nil {
+				return nil, err
+			}
+			vv = append(vv, val)
+		}
+		return vv, nil
+	case nil:
+		return
+	default:
+		return nil, errUnsupportedType
+	}
+}
+
+// Returns filters by paths in json field, like `{"Settings.theme":"dark"}` or `{"Settings.sizes[0]":{"$in":[10,20]}}`
+func (w Where) getJSONPaths(field string) (ff []jsonPathFilter, err error) {
+	for k, v := range w {
+		name, path, ok := splitJSONPath(k)
+		if !ok || name != field {
+			continue
+		}
+		p, err := jsonpath.Parse(path)
+		if err != nil {
+			return nil, err
+		}
+		f := jsonPathFilter{field: field, path: p}
+		switch v := v.(type) {
+		case map[string]interface{}:
+			in, ok := v["$in"]
+			if !ok {
+				return nil, errUnsupportedConstraint
+			}
+			params, ok := in.([]interface{})
+			if !ok {
+				return nil, errUnexpectedParams
+			}
+			f.values = params
+		case []interface{}:
+			return nil, errUnsupportedType
+		default:
+			f.values = []interface{}{v}
+		}
+		ff = append(ff, f)
+	}
+	return ff, nil
+}
+
 type queryResultWrapper struct {
 	istructs.IObject
 	qName appdef.QName
@@ -6,12 +6,16 @@ package query2
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/acl"
 	"github.com/voedger/voedger/pkg/appparts"
 	"github.com/voedger/voedger/pkg/bus"
 	"github.com/voedger/voedger/pkg/coreutils"
@@ -24,6 +28,8 @@ import (
 	"github.com/voedger/voedger/pkg/pipeline"
 	"github.com/voedger/voedger/pkg/processors"
 	queryprocessor "github.com/voedger/voedger/pkg/processors/query"
+	"github.com/voedger/voedger/pkg/processors/querycache"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/state"
 )
 
@@ -84,12 +90,25 @@ type queryWork struct {
 	apiPathHandler       apiPathHandler
 	federation           federation.IFederation
 	profileWSID          istructs.WSID
+	stages               *slowlog.Stages      // nil -> slow log is disabled
+	cacheRecorder        *querycache.Recorder // nil -> query result is not cached
+	cacheKey             querycache.Key
+	cacheVersion         querycache.Version
 }
 
 var _ processors.IProcessorWorkpiece = (*queryWork)(nil)
+var _ pipeline.IStagesWorkpiece = (*queryWork)(nil)
 
 func (qw *queryWork) AppPartitions() appparts.IAppPartitions { return qw.appParts }
 
+// only queries and documents resolved in the workspace are labeled by their QName
+func (qw *queryWork) metricsQName() appdef.QName {
+	if qw.iWorkspace == nil || qw.iWorkspace.Type(qw.msg.QName()).Kind() == appdef.TypeKind_null {
+		return imetrics.QNameUnresolved
+	}
+	return qw.msg.QName()
+}
+
 func (qw *queryWork) AppPartition() appparts.IAppPartition { return qw.appPart }
 
 func (qw *queryWork) GetPrincipals() []iauthnz.Principal { return qw.principals }
@@ -137,6 +156,11 @@ func (qw *queryWork) isDocSingleton() bool {
 	return iSingleton.Singleton()
 }
 
+// Returns row-level security policies, which restrict selection of the result type rows
+func (qw *queryWork) selectRowPolicies() []appdef.IRowPolicy {
+	return acl.RowPolicies(qw.iWorkspace, appdef.OperationKind_Select, qw.resultType.QName(), qw.roles)
+}
+
 func newQueryWork(msg IQueryMessage, appParts appparts.IAppPartitions,
 	maxPrepareQueries int, metrics *queryProcessorMetrics, secretReader isecrets.ISecretReader, federation federation.IFederation) *queryWork {
 	return &queryWork{
@@ -188,9 +212,13 @@ func NewIQueryMessage(requestCtx context.Context, appQName appdef.AppQName, wsid
 }
 
 func (qw *queryWork) getArraySender() (pipeline.IAsyncOperator, func() bus.IResponseWriter) {
+	var responder bus.IResponder = qw.msg.Responder()
+	if qw.cacheRecorder != nil {
+		responder = qw.cacheRecorder
+	}
 	res := &arraySender{
 		sender: sender{
-			responder:          qw.msg.Responder(),
+			responder:          responder,
 			rowsProcessorErrCh: qw.rowsProcessorErrCh,
 		},
 	}
@@ -199,6 +227,44 @@ func (qw *queryWork) getArraySender() (pipeline.IAsyncOperator, func() bus.IResp
 	}
 }
 
+// returns the cached result of the query declared WITH CACHE
+// must be called before the query execution
+func (qw *queryWork) getCachedResult(queryCache querycache.IQueryCache) (rows []json.RawMessage, ok bool) {
+	if qw.cacheRecorder == nil {
+		return nil, false
+	}
+	params := url.Values{}
+	for name, value := range qw.msg.RawParams() {
+		params.Set(name, value)
+	}
+	qw.cacheKey = querycache.NewKey(querycache.API_V2, qw.msg.AppQName(), qw.msg.WSID(), qw.msg.QName(), params.Encode(), qw.principals, qw.roles)
+	qw.cacheVersion = queryCache.Version(qw.msg.AppQName(), qw.msg.WSID())
+	if rows, ok = queryCache.Get(qw.cacheKey); ok {
+		// nothing to record
+		qw.cacheRecorder = nil
+	}
+	return rows, ok
+}
+
+// keeps the result of the successfully executed query declared WITH CACHE
+func (qw *queryWork) putCachedResult(queryCache querycache.IQueryCache) {
+	if qw.cacheRecorder == nil {
+		return
+	}
+	if rows, ok := qw.cacheRecorder.Rows(); ok {
+		queryCache.Put(qw.cacheKey, qw.cacheVersion, qw.iQuery.CacheTTL(), rows)
+	}
+}
+
+func writeCachedRows(respWriter bus.IResponseWriter, rows []json.RawMessage) error {
+	for _, row := range rows {
+		if err := respWriter.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (qw *queryWork) getObjectSender() pipeline.IAsyncOperator {
 	return &objectSender{
 		sender: sender{
@@ -216,3 +282,15 @@ func (qw *queryWork) SetPrincipals(prns []iauthnz.Principal) {
 func (qw *queryWork) LogCtx() context.Context {
 	return qw.msg.RequestCtx()
 }
+
+// pipeline.IContextWorkpiece
+func (qw *queryWork) Context() context.Context {
+	return qw.msg.RequestCtx()
+}
+
+// pipeline.IStagesWorkpiece
+func (qw *queryWork) AddStage(operatorName string, duration time.Duration) {
+	if qw.stages != nil {
+		qw.stages.Add(operatorName, duration)
+	}
+}
@@ -0,0 +1,16 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package querycache
+
+// amount of the cached query results kept by default
+const DefaultSize = 1000
+
+// results bigger than this are not cached
+const maxResultBytes = 1024 * 1024
+
+const (
+	API_V1 API = iota + 1
+	API_V2
+)
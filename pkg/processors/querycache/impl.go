@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package querycache
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/bus"
+	"github.com/voedger/voedger/pkg/iauthnz"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+func NewKey(api API, app appdef.AppQName, wsid istructs.WSID, qName appdef.QName, args string, principals []iauthnz.Principal, roles []appdef.QName) Key {
+	rr := make([]string, 0, len(roles))
+	for _, role := range roles {
+		rr = append(rr, role.String())
+	}
+	slices.Sort(rr)
+	key := Key{
+		API:   api,
+		App:   app,
+		WSID:  wsid,
+		QName: qName,
+		Args:  args,
+		Roles: strings.Join(slices.Compact(rr), ","),
+	}
+	for _, prn := range principals {
+		if prn.Kind == iauthnz.PrincipalKind_User || prn.Kind == iauthnz.PrincipalKind_Device {
+			key.Subject = prn.Name
+			key.SubjectWSID = prn.WSID
+			break
+		}
+	}
+	return key
+}
+
+// unknown workspace gets the new version
+func (c *implIQueryCache) Version(app appdef.AppQName, wsid istructs.WSID) Version {
+	c.Lock()
+	defer c.Unlock()
+	if version, ok := c.versions.Get(wsKey{app, wsid}); ok {
+		return version
+	}
+	return c.newVersionLocked(wsKey{app, wsid})
+}
+
+func (c *implIQueryCache) Get(key Key) (rows []json.RawMessage, ok bool) {
+	res, ok := c.results.Get(key)
+	if !ok {
+		return nil, false
+	}
+	if !c.time.Now().Before(res.expireAt) || !c.isActualVersion(key.App, key.WSID, res.version) {
+		return nil, false
+	}
+	return res.rows, true
+}
+
+func (c *implIQueryCache) Put(key Key, version Version, ttl time.Duration, rows []json.RawMessage) {
+	if !c.isActualVersion(key.App, key.WSID, version) {
+		// the workspace is changed during the query execution, the result could be stale already
+		return
+	}
+	c.results.Put(key, &result{
+		version:  version,
+		expireAt: c.time.Now().Add(ttl),
+		rows:     rows,
+	})
+}
+
+func (c *implIQueryCache) WLogOffsetAdvanced(app appdef.AppQName, wsid istructs.WSID, _ istructs.Offset) {
+	c.Lock()
+	c.newVersionLocked(wsKey{app, wsid})
+	c.Unlock()
+}
+
+// false if the workspace is evicted from the cache or its version is changed
+func (c *implIQueryCache) isActualVersion(app appdef.AppQName, wsid istructs.WSID, version Version) bool {
+	c.Lock()
+	defer c.Unlock()
+	actual, ok := c.versions.Get(wsKey{app, wsid})
+	return ok && actual == version
+}
+
+func (c *implIQueryCache) newVersionLocked(ws wsKey) Version {
+	c.lastVersion++
+	c.versions.Put(ws, c.lastVersion)
+	return c.lastVersion
+}
+
+// NewRecorder returns the responder which passes everything to the responder and keeps the rows written via StreamJSON
+func NewRecorder(responder bus.IResponder) *Recorder {
+	return &Recorder{IResponder: responder}
+}
+
+func (r *Recorder) StreamJSON(statusCode int) bus.IResponseWriter {
+	if statusCode != http.StatusOK {
+		r.uncachable = true
+	}
+	return &recordingWriter{IResponseWriter: r.IResponder.StreamJSON(statusCode), r: r}
+}
+
+func (r *Recorder) StreamEvents() bus.IResponseWriter {
+	r.uncachable = true
+	return r.IResponder.StreamEvents()
+}
+
+func (r *Recorder) Respond(responseMeta bus.ResponseMeta, obj any) error {
+	r.uncachable = true
+	return r.IResponder.Respond(responseMeta, obj)
+}
+
+// Rows returns the recorded rows
+// false if the response is not the JSON stream of 200 OK or the rows are too big to be cached
+func (r *Recorder) Rows() (rows []json.RawMessage, ok bool) {
+	if r.uncachable {
+		return nil, false
+	}
+	return r.rows, true
+}
+
+func (w *recordingWriter) Write(obj any) error {
+	if err := w.IResponseWriter.Write(obj); err != nil {
+		return err
+	}
+	if w.r.uncachable {
+		return nil
+	}
+	row, err := json.Marshal(obj)
+	if err != nil || w.r.size+len(row) > maxResultBytes {
+		w.r.uncachable = true
+		w.r.rows = nil
+		return nil
+	}
+	w.r.size += len(row)
+	w.r.rows = append(w.r.rows, row)
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package querycache
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/bus"
+	"github.com/voedger/voedger/pkg/goutils/testingu"
+	"github.com/voedger/voedger/pkg/iauthnz"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+func TestBasicUsage(t *testing.T) {
+	require := require.New(t)
+	mockTime := testingu.NewMockTime()
+	qc := Provide(Config{}, mockTime)
+
+	const wsid = istructs.WSID(42)
+	app := istructs.AppQName_test1_app1
+	qName := appdef.NewQName("test", "qry")
+	roleA, roleB := appdef.NewQName("test", "roleA"), appdef.NewQName("test", "roleB")
+	user := []iauthnz.Principal{{Kind: iauthnz.PrincipalKind_User, Name: "user", WSID: 1}}
+	key := NewKey(API_V1, app, wsid, qName, `{"args":{"a":1}}`, user, []appdef.QName{roleB, roleA})
+	rows := []json.RawMessage{json.RawMessage(`[1]`), json.RawMessage(`[2]`)}
+
+	_, ok := qc.Get(key)
+	require.False(ok)
+
+	qc.Put(key, qc.Version(app, wsid), 10*time.Second, rows)
+
+	t.Run("cached result is returned", func(t *testing.T) {
+		cached, ok := qc.Get(key)
+		require.True(ok)
+		require.Equal(rows, cached)
+	})
+
+	t.Run("roles order does not matter", func(t *testing.T) {
+		_, ok := qc.Get(NewKey(API_V1, app, wsid, qName, `{"args":{"a":1}}`, user, []appdef.QName{roleA, roleB, roleA}))
+		require.True(ok)
+	})
+
+	t.Run("key parts matter", func(t *testing.T) {
+		for _, k := range []Key{
+			NewKey(API_V2, app, wsid, qName, `{"args":{"a":1}}`, user, []appdef.QName{roleA, roleB}),
+			NewKey(API_V1, istructs.AppQName_test1_app2, wsid, qName, `{"args":{"a":1}}`, user, []appdef.QName{roleA, roleB}),
+			NewKey(API_V1, app, wsid+1, qName, `{"args":{"a":1}}`, user, []appdef.QName{roleA, roleB}),
+			NewKey(API_V1, app, wsid, appdef.NewQName("test", "qry2"), `{"args":{"a":1}}`, user, []appdef.QName{roleA, roleB}),
+			NewKey(API_V1, app, wsid, qName, `{"args":{"a":2}}`, user, []appdef.QName{roleA, roleB}),
+			NewKey(API_V1, app, wsid, qName, `{"args":{"a":1}}`, user, []appdef.QName{roleA}),
+			NewKey(API_V1, app, wsid, qName, `{"args":{"a":1}}`, []iauthnz.Principal{{Kind: iauthnz.PrincipalKind_User, Name: "user2", WSID: 2}}, []appdef.QName{roleA, roleB}),
+			NewKey(API_V1, app, wsid, qName, `{"args":{"a":1}}`, []iauthnz.Principal{{Kind: iauthnz.PrincipalKind_Device, WSID: 3}}, []appdef.QName{roleA, roleB}),
+		} {
+			_, ok := qc.Get(k)
+			require.False(ok, k)
+		}
+	})
+
+	t.Run("result expires", func(t *testing.T) {
+		mockTime.Add(10 * time.Second)
+		_, ok := qc.Get(key)
+		require.False(ok)
+	})
+
+	t.Run("result is invalidated when the WLog offset of the workspace advances", func(t *testing.T) {
+		qc.Put(key, qc.Version(app, wsid), 10*time.Second, rows)
+		_, ok := qc.Get(key)
+		require.True(ok)
+
+		qc.WLogOffsetAdvanced(app, wsid+1, 5)
+		_, ok = qc.Get(key)
+		require.True(ok, "other workspace offset should not affect")
+
+		qc.WLogOffsetAdvanced(app, wsid, 5)
+		_, ok = qc.Get(key)
+		require.False(ok)
+	})
+
+	t.Run("result is not kept if the WLog offset advances during the query execution", func(t *testing.T) {
+		version := qc.Version(app, wsid)
+		qc.WLogOffsetAdvanced(app, wsid, 6)
+		qc.Put(key, version, 10*time.Second, rows)
+		_, ok := qc.Get(key)
+		require.False(ok)
+	})
+}
+
+func TestWorkspacesEviction(t *testing.T) {
+	require := require.New(t)
+	const size = 2
+	qc := Provide(Config{Size: size}, testingu.NewMockTime())
+
+	const wsid = istructs.WSID(42)
+	app := istructs.AppQName_test1_app1
+	key := NewKey(API_V1, app, wsid, appdef.NewQName("test", "qry"), `{}`, nil, nil)
+	rows := []json.RawMessage{json.RawMessage(`[1]`)}
+
+	qc.Put(key, qc.Version(app, wsid), time.Minute, rows)
+	_, ok := qc.Get(key)
+	require.True(ok)
+
+	// versions of the other workspaces evict the version of the workspace
+	for i := range size {
+		qc.WLogOffsetAdvanced(app, wsid+1+istructs.WSID(i), 1)
+	}
+
+	_, ok = qc.Get(key)
+	require.False(ok, "result of the evicted workspace must not be returned")
+
+	qc.Version(app, wsid)
+	_, ok = qc.Get(key)
+	require.False(ok, "result of the evicted workspace must not be returned after the workspace is known again")
+}
+
+func TestRecorder(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("rows written to the JSON stream are recorded", func(t *testing.T) {
+		responder := &testResponder{}
+		r := NewRecorder(responder)
+		w := r.StreamJSON(http.StatusOK)
+		require.NoError(w.Write([]interface{}{1, "a"}))
+		require.NoError(w.Write(map[string]interface{}{"x": 2}))
+		w.Close(nil)
+
+		rows, ok := r.Rows()
+		require.True(ok)
+		require.Equal([]json.RawMessage{json.RawMessage(`[1,"a"]`), json.RawMessage(`{"x":2}`)}, rows)
+		require.Len(responder.written, 2, "rows should be passed to the responder")
+	})
+
+	t.Run("empty result is recorded", func(t *testing.T) {
+		rows, ok := NewRecorder(&testResponder{}).Rows()
+		require.True(ok)
+		require.Empty(rows)
+	})
+
+	t.Run("not 200 OK is not recorded", func(t *testing.T) {
+		r := NewRecorder(&testResponder{})
+		r.StreamJSON(http.StatusAccepted).Close(nil)
+		_, ok := r.Rows()
+		require.False(ok)
+	})
+
+	t.Run("custom response is not recorded", func(t *testing.T) {
+		r := NewRecorder(&testResponder{})
+		require.NoError(r.Respond(bus.ResponseMeta{StatusCode: http.StatusOK}, "hello"))
+		_, ok := r.Rows()
+		require.False(ok)
+	})
+
+	t.Run("too big result is not recorded", func(t *testing.T) {
+		responder := &testResponder{}
+		r := NewRecorder(responder)
+		w := r.StreamJSON(http.StatusOK)
+		bigRow := []string{strings.Repeat("x", maxResultBytes/2)}
+		for range 3 {
+			require.NoError(w.Write(bigRow))
+		}
+		_, ok := r.Rows()
+		require.False(ok)
+		require.Len(responder.written, 3)
+	})
+}
+
+type testResponder struct {
+	written []any
+}
+
+func (r *testResponder) StreamJSON(int) bus.IResponseWriter  { return r }
+func (r *testResponder) StreamEvents() bus.IResponseWriter   { return r }
+func (r *testResponder) Respond(bus.ResponseMeta, any) error { return nil }
+func (r *testResponder) Write(obj any) error                 { r.written = append(r.written, obj); return nil }
+func (r *testResponder) Close(error)                         {}
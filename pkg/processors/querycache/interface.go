@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package querycache
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+// IQueryCache keeps the results of the queries declared WITH CACHE
+// The result is valid until its time to live expires or the WLog offset of the workspace advances
+//
+// @ConcurrentAccess
+type IQueryCache interface {
+	// Returns the version of the workspace known to the cache
+	// The version is changed each time the WLog offset of the workspace advances
+	// Must be called before the query execution, the result is passed to Put
+	Version(app appdef.AppQName, wsid istructs.WSID) Version
+
+	// Returns the cached result rows
+	// false if there is no result, its time to live is expired or the version of the workspace is changed since the result is put
+	Get(key Key) (rows []json.RawMessage, ok bool)
+
+	// Keeps the result rows during ttl
+	// Rows are not kept if the version of the workspace is changed since version is got
+	Put(key Key, version Version, ttl time.Duration, rows []json.RawMessage)
+
+	// Must be called by the command processor after the event is put to the WLog of the workspace
+	// The results cached in the workspace become invalid
+	WLogOffsetAdvanced(app appdef.AppQName, wsid istructs.WSID, offset istructs.Offset)
+}
@@ -0,0 +1,22 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package querycache
+
+import (
+	"github.com/voedger/voedger/pkg/goutils/timeu"
+	"github.com/voedger/voedger/pkg/objcache"
+)
+
+func Provide(cfg Config, time timeu.ITime) IQueryCache {
+	size := cfg.Size
+	if size <= 0 {
+		size = DefaultSize
+	}
+	return &implIQueryCache{
+		time:     time,
+		results:  objcache.New[Key, *result](size),
+		versions: objcache.New[wsKey, Version](size),
+	}
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package querycache
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/bus"
+	"github.com/voedger/voedger/pkg/goutils/timeu"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/objcache"
+)
+
+type Config struct {
+	// max amount of the cached query results
+	// 0 -> DefaultSize
+	Size int
+}
+
+// API the result is formed for. The results of the same query are different for API v1 and API v2
+type API uint8
+
+// Key identifies the cached query result
+// The result of the query declared WITH CACHE must depend on the arguments, the subject and the roles of the principal only
+// Subject is the part of the key since the result could be restricted by the row policies which use CURRENT_SUBJECT
+type Key struct {
+	API         API
+	App         appdef.AppQName
+	WSID        istructs.WSID
+	QName       appdef.QName
+	Args        string        // request body for API v1, encoded request params for API v2
+	Subject     string        // login of the user or device, empty if there is no user or device principal
+	SubjectWSID istructs.WSID // profile of the user or device
+	Roles       string        // sorted roles of the principal
+}
+
+// Version of the workspace known to the cache
+// Versions are not reused, so the version of the workspace evicted from the cache and known again differs from the previous one
+type Version uint64
+
+type wsKey struct {
+	app  appdef.AppQName
+	wsid istructs.WSID
+}
+
+type result struct {
+	version  Version
+	expireAt time.Time
+	rows     []json.RawMessage
+}
+
+type implIQueryCache struct {
+	time    timeu.ITime
+	results objcache.ICache[Key, *result]
+
+	// amount of the workspaces versions is bounded the same as the amount of the results
+	sync.Mutex
+	versions    objcache.ICache[wsKey, Version]
+	lastVersion Version
+}
+
+// Recorder is the bus.IResponder which keeps the rows sent by the query via StreamJSON
+type Recorder struct {
+	bus.IResponder
+	rows       []json.RawMessage
+	size       int
+	uncachable bool
+}
+
+type recordingWriter struct {
+	bus.IResponseWriter
+	r *Recorder
+}
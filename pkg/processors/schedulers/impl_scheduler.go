@@ -86,23 +86,25 @@ func (a *scheduler) runJob() {
 	if err != nil {
 		return
 	}
+	stateOpts := a.conf.stateOpts
+	stateOpts.LogCtxFunc = func() context.Context { return a.logCtx }
 	state := stateprovide.ProvideSchedulerStateFactory()(
 		a.vvmCtx,
 		func() istructs.IAppStructs { return borrowedPartition.AppStructs() },
 		func() istructs.WSID { return a.conf.Workspace },
-		func(view appdef.QName, wsid istructs.WSID, offset istructs.Offset) {
-			a.conf.Broker.Update(in10n.ProjectionKey{
+		func(view appdef.QName, wsid istructs.WSID, offset istructs.Offset, payload in10n.Payload) {
+			a.conf.Broker.UpdateWithPayload(in10n.ProjectionKey{
 				App:        a.conf.AppQName,
 				Projection: view,
 				WS:         wsid,
-			}, offset)
+			}, offset, payload)
 		},
 		a.conf.SecretReader,
 		a.conf.Tokens,
 		a.conf.Federation,
 		func() int64 { return a.conf.Time.Now().Unix() },
 		a.conf.IntentsLimit,
-		a.conf.stateOpts,
+		stateOpts,
 		a.conf.EmailSender,
 		a.conf.HTTPClient,
 	)
@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package slowlog
+
+const (
+	RequestKind_Command = "command"
+	RequestKind_Query   = "query"
+)
+
+// amount of the last slow requests kept by default
+const DefaultSize = 100
+
+// stages which are not the pipeline operators
+const (
+	Stage_Exec     = "exec"
+	Stage_Response = "response"
+)
+
+const (
+	logStage          = "slow.request"
+	logAttrKind       = "slow.kind"
+	logAttrQName      = "slow.qname"
+	logAttrDurationMs = "slow.duration_ms"
+	logAttrStages     = "slow.stages"
+	logAttrErr        = "slow.err"
+)
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package slowlog
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/voedger/voedger/pkg/goutils/logger"
+)
+
+func (s *Stages) Add(name string, duration time.Duration) {
+	*s = append(*s, Stage{Name: name, Duration: duration})
+}
+
+func (s Stages) logValue() slog.Value {
+	attrs := make([]slog.Attr, 0, len(s))
+	for _, stage := range s {
+		attrs = append(attrs, slog.Duration(stage.Name, stage.Duration))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+func (sl *implISlowLog) Enabled() bool {
+	return true
+}
+
+func (sl *implISlowLog) Report(ctx context.Context, req Request) {
+	if req.Duration < sl.threshold {
+		return
+	}
+
+	sl.Lock()
+	sl.requests[sl.pos] = req
+	sl.pos = (sl.pos + 1) % len(sl.requests)
+	if sl.filled < len(sl.requests) {
+		sl.filled++
+	}
+	sl.Unlock()
+
+	attrs := map[string]any{
+		logAttrKind:       req.Kind,
+		logAttrQName:      req.QName.String(),
+		logAttrDurationMs: req.Duration.Milliseconds(),
+		logAttrStages:     req.Stages.logValue(),
+	}
+	if req.Err != nil {
+		attrs[logAttrErr] = req.Err.Error()
+	}
+	logger.WarningCtx(logger.WithContextAttrs(ctx, attrs), logStage, req.Kind, " ", req.QName, " took ", req.Duration, ", threshold ", sl.threshold)
+}
+
+func (sl *implISlowLog) Last() []Request {
+	sl.Lock()
+	defer sl.Unlock()
+	res := make([]Request, 0, sl.filled)
+	for i := 1; i <= sl.filled; i++ {
+		res = append(res, sl.requests[(sl.pos-i+len(sl.requests))%len(sl.requests)])
+	}
+	return res
+}
+
+func (nullSlowLog) Enabled() bool                   { return false }
+func (nullSlowLog) Report(context.Context, Request) {}
+func (nullSlowLog) Last() []Request                 { return nil }
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package slowlog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/goutils/logger"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+func TestBasicUsage(t *testing.T) {
+	require := require.New(t)
+	logCap := logger.StartCapture(t, logger.LogLevelWarning)
+
+	sl := Provide(Config{Threshold: time.Second, Size: 2})
+	require.True(sl.Enabled())
+
+	qName := appdef.NewQName("test", "cmd")
+	report := func(duration time.Duration, err error) {
+		stages := Stages{}
+		stages.Add("exec", duration/2)
+		stages.Add(Stage_Response, duration/4)
+		sl.Report(context.Background(), Request{
+			Kind:     RequestKind_Command,
+			App:      istructs.AppQName_test1_app1,
+			WSID:     42,
+			QName:    qName,
+			Start:    time.Now(),
+			Duration: duration,
+			Stages:   stages,
+			Err:      err,
+		})
+	}
+
+	t.Run("fast request is not logged", func(t *testing.T) {
+		report(time.Millisecond, nil)
+		require.Empty(sl.Last())
+		logCap.NotContains(logStage)
+	})
+
+	t.Run("slow request is logged", func(t *testing.T) {
+		report(2*time.Second, nil)
+		last := sl.Last()
+		require.Len(last, 1)
+		require.Equal(2*time.Second, last[0].Duration)
+		require.Equal(Stages{{"exec", time.Second}, {Stage_Response, 500 * time.Millisecond}}, last[0].Stages)
+		logCap.HasLine(logStage, "slow.kind=command", "slow.qname=test.cmd", "slow.duration_ms=2000", "slow.stages.exec=1s", "slow.stages.response=500ms")
+	})
+
+	t.Run("the last Size requests are kept, the most recent first", func(t *testing.T) {
+		report(3*time.Second, errors.New("test error"))
+		report(4*time.Second, nil)
+		last := sl.Last()
+		require.Len(last, 2)
+		require.Equal(4*time.Second, last[0].Duration)
+		require.Equal(3*time.Second, last[1].Duration)
+		require.EqualError(last[1].Err, "test error")
+		logCap.HasLine(logStage, "slow.duration_ms=3000", `slow.err="test error"`)
+	})
+}
+
+func TestDisabled(t *testing.T) {
+	require := require.New(t)
+	sl := Provide(Config{})
+	require.False(sl.Enabled())
+	sl.Report(context.Background(), Request{Duration: time.Hour})
+	require.Empty(sl.Last())
+}
@@ -0,0 +1,25 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package slowlog
+
+import "context"
+
+// ISlowLog logs the commands and queries the duration of which exceeds the threshold
+// and keeps the last of them
+//
+// @ConcurrentAccess
+type ISlowLog interface {
+	// false if the threshold is not configured
+	// Stages should not be collected if disabled
+	Enabled() bool
+
+	// Logs the request with the stages breakdown and keeps it among the last slow requests
+	// if the request duration exceeds the threshold. Does nothing otherwise
+	// ctx is the request context, its log attributes are added to the log record
+	Report(ctx context.Context, req Request)
+
+	// Returns the last slow requests, the most recent first
+	Last() []Request
+}
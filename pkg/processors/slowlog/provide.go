@@ -0,0 +1,20 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package slowlog
+
+// Provide returns the disabled slow log if cfg.Threshold is not set
+func Provide(cfg Config) ISlowLog {
+	if cfg.Threshold <= 0 {
+		return nullSlowLog{}
+	}
+	size := cfg.Size
+	if size <= 0 {
+		size = DefaultSize
+	}
+	return &implISlowLog{
+		threshold: cfg.Threshold,
+		requests:  make([]Request, size),
+	}
+}
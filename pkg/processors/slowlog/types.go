@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package slowlog
+
+import (
+	"sync"
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/istructs"
+)
+
+type Config struct {
+	// requests longer than the threshold are logged
+	// 0 -> slow log is disabled
+	Threshold time.Duration
+
+	// amount of the last slow requests to keep
+	// 0 -> DefaultSize
+	Size int
+}
+
+// Stage is the time spent in the pipeline operator or in the other part of the request handling
+type Stage struct {
+	Name     string
+	Duration time.Duration
+}
+
+// Stages are in the order of completion, i.e. the operators of a nested pipeline go before the wrapping operator
+type Stages []Stage
+
+type Request struct {
+	Kind     string // RequestKind_*
+	App      appdef.AppQName
+	WSID     istructs.WSID
+	QName    appdef.QName
+	Start    time.Time
+	Duration time.Duration
+	Stages   Stages
+	Err      error
+}
+
+type implISlowLog struct {
+	threshold time.Duration
+	sync.Mutex
+	requests []Request // ring buffer
+	pos      int       // next position in requests
+	filled   int
+}
+
+type nullSlowLog struct{}
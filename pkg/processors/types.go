@@ -35,4 +35,5 @@ type IProjectorWorkpiece interface {
 	Event() istructs.IPLogEvent
 	LogCtx() context.Context
 	PLogOffset() istructs.Offset
+	GetPrincipals() []iauthnz.Principal
 }
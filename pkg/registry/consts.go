@@ -9,6 +9,7 @@ import (
 	"embed"
 	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
@@ -40,7 +41,35 @@ const (
 	field_SourceAppWSID          = "SourceAppWSID"
 	field_TTLHours               = "TTLHours"
 	field_GlobalRoles            = "GlobalRoles"
+	field_Code                   = "Code"
+	field_TOTPSecret             = "TOTPSecret"
+	field_TOTPEnabled            = "TOTPEnabled"
+	field_TOTPRecoveryCodes      = "TOTPRecoveryCodes"
+	field_TOTPLastTimeStep       = "TOTPLastTimeStep"
+	field_TOTPCode               = "TOTPCode"
+	field_TOTPChallengeToken     = "TOTPChallengeToken"
+	field_ChallengeToken         = "ChallengeToken"
+	field_Secret                 = "Secret"
+	field_ProvisioningURI        = "ProvisioningURI"
+	field_RecoveryCodes          = "RecoveryCodes"
 	maxTokenTTLHours             = 168 // 1 week
+	oidcSecretNameFmt            = "oidc-%s-%s"
+	oidcLoginPrefix              = "oidc-"
+	oidcLoginHashLen             = 16
+	oidcMaxResponseSize          = 1 << 20
+	oidcHTTPTimeout              = 10 * time.Second
+	oidcSignInTTL                = 10 * time.Minute
+	oidcSignInKeyPrefix          = "registry.oidc/"
+	field_State                  = "State"
+	field_AuthorizationURL       = "AuthorizationURL"
+	totpSecretLen                = 20 // 160 bits, recommended by RFC 4226
+	totpDigits                   = 6
+	totpModulo                   = 1_000_000 // 10^totpDigits
+	totpPeriod                   = 30 * time.Second
+	totpSkewSteps                = 1
+	totpRecoveryCodesAmount      = 10
+	totpCodeAttempts             = 10 // per totpCodeAttemptsPeriod per login
+	totpCodeAttemptsPeriod       = time.Minute
 )
 
 var (
@@ -59,12 +88,29 @@ var (
 	QNameCommandResetPasswordByEmailUnloggedParams    = appdef.NewQName(RegistryPackage, "ResetPasswordByEmailUnloggedParams")
 	QNameQueryInitiateResetPasswordByEmail            = appdef.NewQName(RegistryPackage, "InitiateResetPasswordByEmail")
 	QNameQueryIssueVerifiedValueTokenForResetPassword = appdef.NewQName(RegistryPackage, "IssueVerifiedValueTokenForResetPassword")
+	QNameQueryInitiateOIDCSignIn                      = appdef.NewQName(RegistryPackage, "InitiateOIDCSignIn")
+	QNameQueryExchangeOIDCCode                        = appdef.NewQName(RegistryPackage, "ExchangeOIDCCode")
+	QNameQueryIssuePrincipalTokenByOIDC               = appdef.NewQName(RegistryPackage, "IssuePrincipalTokenByOIDC")
+	QNameCommandInitiateTOTPEnrollment                = appdef.NewQName(RegistryPackage, "InitiateTOTPEnrollment")
+	QNameCommandConfirmTOTPEnrollment                 = appdef.NewQName(RegistryPackage, "ConfirmTOTPEnrollment")
+	QNameCommandResetTOTP                             = appdef.NewQName(RegistryPackage, "ResetTOTP")
+	QNameCommandIssuePrincipalTokenByTOTP             = appdef.NewQName(RegistryPackage, "IssuePrincipalTokenByTOTP")
+	qNameInitiateTOTPEnrollmentResult                 = appdef.NewQName(RegistryPackage, "InitiateTOTPEnrollmentResult")
+	qNameIssuePrincipalTokenByTOTPResult              = appdef.NewQName(RegistryPackage, "IssuePrincipalTokenByTOTPResult")
+	qNameRateLimitTOTPCode                            = appdef.NewQName(RegistryPackage, "TOTPCodeRate")
 	QNameCDocLogin                                    = appdef.NewQName(RegistryPackage, "Login")
 	QNameCDocLoginAlias                               = appdef.NewQName(RegistryPackage, "LoginAlias")
 	QNameProjectorApplySetLoginAlias                  = appdef.NewQName(RegistryPackage, "ApplySetLoginAlias")
 	qNameProjectorInvokeCreateWorkspaceID_registry    = appdef.NewQName(RegistryPackage, "InvokeCreateWorkspaceID_registry")
 	errPasswordIsIncorrect                            = coreutils.NewHTTPErrorf(http.StatusUnauthorized, "password is incorrect")
 	errLoginOrPasswordIsIncorrect                     = coreutils.NewHTTPErrorf(http.StatusUnauthorized, "login or password is incorrect")
+	errOIDCLoginNotFound                              = coreutils.NewHTTPErrorf(http.StatusNotFound, "login is not found")
+	errOIDCStateUnknown                               = coreutils.NewHTTPErrorf(http.StatusUnauthorized, "OIDC sign in state is unknown or expired")
+	errTOTPIsEnabledAlready                           = coreutils.NewHTTPErrorf(http.StatusConflict, "TOTP is enabled already, reset it first")
+	errTOTPCodeIsIncorrect                            = coreutils.NewHTTPErrorf(http.StatusUnauthorized, "TOTP code is incorrect")
+	errTOTPCodeAttemptsExceeded                       = coreutils.NewHTTPErrorf(http.StatusTooManyRequests, "too many TOTP code attempts, try again later")
+	errTOTPChallengeIsInvalid                         = coreutils.NewHTTPErrorf(http.StatusUnauthorized, "TOTP challenge is invalid or expired")
+	errTOTPRequiresCanonicalLogin                     = coreutils.NewHTTPErrorf(http.StatusUnauthorized, "two-factor authentication requires sign in by the canonical login")
 
 	//go:embed appws.vsql
 	schemasFS embed.FS
@@ -27,12 +27,16 @@ type iptRR struct {
 	principalToken       string
 	profileWSID          int64
 	profileCreationError string // like wsError
+	totpChallengeToken   string
 }
 
 func (q *iptRR) AsInt64(string) int64 { return q.profileWSID }
 func (q *iptRR) AsString(name string) string {
-	if name == authnz.Field_WSError {
+	switch name {
+	case authnz.Field_WSError:
 		return q.profileCreationError
+	case field_TOTPChallengeToken:
+		return q.totpChallengeToken
 	}
 	return q.principalToken
 }
@@ -78,36 +82,18 @@ func provideIssuePrincipalTokenExec(itokens itokens.ITokens, federation federati
 			return errLoginOrPasswordIsIncorrect
 		}
 
-		result := &iptRR{
-			profileWSID:          loginForSignIn.profileWSID,
-			profileCreationError: loginForSignIn.wsError,
-		}
-		if result.profileWSID == 0 || len(result.profileCreationError) > 0 {
-			return callback(result)
-		}
-
-		// read global globalRoles
-		globarRolesStr := loginForSignIn.globalRoles
-		var globalRoles []appdef.QName
-		if len(globarRolesStr) > 0 {
-			globalRolesStr := strings.SplitSeq(globarRolesStr, ",")
-			for role := range globalRolesStr {
-				roleQName, err := appdef.ParseQName(role)
-				if err != nil {
-					return err
-				}
-				globalRoles = append(globalRoles, roleQName)
+		// second step is IssuePrincipalTokenByTOTP
+		if loginForSignIn.totpEnabled {
+			if loginForSignIn.canonicalLogin != login {
+				return errTOTPRequiresCanonicalLogin
 			}
+			challengeToken, err := itokens.IssueToken(appQName, authnz.TOTPChallengeExpiration, &payloads.TOTPChallengePayload{Login: login})
+			if err != nil {
+				return fmt.Errorf("TOTP challenge token issue failed: %w", err)
+			}
+			return callback(&iptRR{totpChallengeToken: challengeToken})
 		}
 
-		// issue principal token
-		principalPayload := payloads.PrincipalPayload{
-			Login:       loginForSignIn.canonicalLogin,
-			Alias:       loginForSignIn.alias,
-			SubjectKind: istructs.SubjectKindType(loginForSignIn.subjectKind),
-			ProfileWSID: istructs.WSID(result.profileWSID), //nolint G115 since WSID is created by NewWSID()
-			GlobalRoles: globalRoles,                       // [~server.authnz.groles/cmp.c.registry.IssuePrincipalToken~impl]
-		}
 		ttl := time.Duration(args.ArgumentObject.AsInt32(field_TTLHours)) * time.Hour
 		if ttl == 0 {
 			ttl = authnz.DefaultPrincipalTokenExpiration
@@ -115,10 +101,48 @@ func provideIssuePrincipalTokenExec(itokens itokens.ITokens, federation federati
 			return coreutils.NewHTTPErrorf(http.StatusBadRequest, fmt.Errorf("max token TTL hours is %d hours", maxTokenTTLHours))
 		}
 
-		if result.principalToken, err = itokens.IssueToken(appQName, ttl, &principalPayload); err != nil {
-			return fmt.Errorf("principal token issue failed: %w", err)
+		result, err := issuePrincipalToken(itokens, appQName, loginForSignIn, ttl)
+		if err != nil {
+			return err
 		}
-
 		return callback(result)
 	}
 }
+
+// returns the result without the token if the profile workspace is not ready yet or is created with an error
+func issuePrincipalToken(itokens itokens.ITokens, appQName appdef.AppQName, loginForSignIn signInLogin, ttl time.Duration) (result *iptRR, err error) {
+	result = &iptRR{
+		profileWSID:          loginForSignIn.profileWSID,
+		profileCreationError: loginForSignIn.wsError,
+	}
+	if result.profileWSID == 0 || len(result.profileCreationError) > 0 {
+		return result, nil
+	}
+
+	// read global globalRoles
+	globarRolesStr := loginForSignIn.globalRoles
+	var globalRoles []appdef.QName
+	if len(globarRolesStr) > 0 {
+		globalRolesStr := strings.SplitSeq(globarRolesStr, ",")
+		for role := range globalRolesStr {
+			roleQName, err := appdef.ParseQName(role)
+			if err != nil {
+				return nil, err
+			}
+			globalRoles = append(globalRoles, roleQName)
+		}
+	}
+
+	// issue principal token
+	principalPayload := payloads.PrincipalPayload{
+		Login:       loginForSignIn.canonicalLogin,
+		Alias:       loginForSignIn.alias,
+		SubjectKind: istructs.SubjectKindType(loginForSignIn.subjectKind),
+		ProfileWSID: istructs.WSID(result.profileWSID), //nolint G115 since WSID is created by NewWSID()
+		GlobalRoles: globalRoles,                       // [~server.authnz.groles/cmp.c.registry.IssuePrincipalToken~impl]
+	}
+	if result.principalToken, err = itokens.IssueToken(appQName, ttl, &principalPayload); err != nil {
+		return nil, fmt.Errorf("principal token issue failed: %w", err)
+	}
+	return result, nil
+}
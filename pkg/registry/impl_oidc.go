@@ -0,0 +1,329 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package registry
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/istructsmem"
+	"github.com/voedger/voedger/pkg/itokens"
+	"github.com/voedger/voedger/pkg/state"
+	"github.com/voedger/voedger/pkg/sys/authnz"
+)
+
+// IdP configuration of an app, read from the app secret named by OIDCSecretName()
+type oidcConfig struct {
+	Issuer           string
+	ClientID         string
+	ClientSecret     string
+	AuthorizationURL string
+	TokenURL         string
+	JWKSURL          string
+	RedirectURL      string // must be the same as the one used in the authorization request
+}
+
+// kept in the app TTL storage by the state from the initiation of the sign in till the code exchange
+type oidcSignIn struct {
+	AppName      string
+	Nonce        string
+	CodeVerifier string
+}
+
+// q.registry.InitiateOIDCSignIn
+type initiateOIDCSignInRR struct {
+	istructs.NullObject
+	authorizationURL string
+	state            string
+}
+
+func (q *initiateOIDCSignInRR) AsString(name string) string {
+	if name == field_State {
+		return q.state
+	}
+	return q.authorizationURL
+}
+
+// q.registry.ExchangeOIDCCode
+type exchangeOIDCCodeRR struct {
+	istructs.NullObject
+	login string
+}
+
+func (q *exchangeOIDCCodeRR) AsString(string) string { return q.login }
+
+// ID token claims checked on the code exchange
+type oidcClaims struct {
+	jwt.RegisteredClaims
+	Nonce string `json:"nonce"`
+}
+
+// OIDCSecretName returns the name of the app secret which keeps the IdP configuration as JSON:
+// {"Issuer":..,"ClientID":..,"ClientSecret":..,"AuthorizationURL":..,"TokenURL":..,"JWKSURL":..,"RedirectURL":..}
+func OIDCSecretName(appQName appdef.AppQName) string {
+	return fmt.Sprintf(oidcSecretNameFmt, appQName.Owner(), appQName.Name())
+}
+
+// OIDCLogin returns the login the external subject is mapped to
+// The login does not depend on the subject letter case and allowed chars
+func OIDCLogin(issuer, subject string) string {
+	hash := sha256.Sum256([]byte(issuer + "\n" + subject))
+	return oidcLoginPrefix + hex.EncodeToString(hash[:oidcLoginHashLen])
+}
+
+// sys/registry, any AppWS, System only
+// returns the IdP authorization URL with the new state, nonce and PKCE code challenge
+// the state is valid for oidcSignInTTL and could be exchanged once
+func execQryInitiateOIDCSignIn(_ context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
+	appName := args.ArgumentObject.AsString(authnz.Field_AppName)
+	cfg, err := readOIDCConfig(args.State, appName)
+	if err != nil {
+		return err
+	}
+	signIn := oidcSignIn{
+		AppName:      appName,
+		Nonce:        rand.Text(),
+		CodeVerifier: rand.Text() + rand.Text(), // 52 chars, RFC 7636 requires 43 at least
+	}
+	signInJSON, err := json.Marshal(signIn)
+	if err != nil {
+		// notest
+		return err
+	}
+	oidcState := rand.Text()
+	ok, err := args.State.AppStructs().AppTTLStorage().InsertIfNotExists(oidcSignInKeyPrefix+oidcState, string(signInJSON), int(oidcSignInTTL.Seconds()))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// notest: random state collision
+		return errors.New("OIDC sign in state is already used")
+	}
+	challenge := sha256.Sum256([]byte(signIn.CodeVerifier))
+	authorizationURL := cfg.AuthorizationURL + "?" + url.Values{
+		"response_type":         {"code"},
+		"scope":                 {"openid"},
+		"client_id":             {cfg.ClientID},
+		"redirect_uri":          {cfg.RedirectURL},
+		"state":                 {oidcState},
+		"nonce":                 {signIn.Nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}.Encode()
+	return callback(&initiateOIDCSignInRR{authorizationURL: authorizationURL, state: oidcState})
+}
+
+// sys/registry, any AppWS, System only
+// exchanges the authorization code for the ID token at the app IdP and returns the login mapped to the ID token subject
+// the state must be got from q.registry.InitiateOIDCSignIn for the same app, it is consumed by the exchange
+func provideExchangeOIDCCodeExec(httpClient *http.Client) istructsmem.ExecQueryClosure {
+	return func(ctx context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
+		appName := args.ArgumentObject.AsString(authnz.Field_AppName)
+		cfg, err := readOIDCConfig(args.State, appName)
+		if err != nil {
+			return err
+		}
+		signIn, err := consumeOIDCSignIn(args.State.AppStructs().AppTTLStorage(), args.ArgumentObject.AsString(field_State))
+		if err != nil {
+			return err
+		}
+		if signIn.AppName != appName {
+			return errOIDCStateUnknown
+		}
+		login, err := oidcLoginByCode(ctx, httpClient, cfg, args.ArgumentObject.AsString(field_Code), signIn)
+		if err != nil {
+			return coreutils.NewHTTPError(http.StatusUnauthorized, err)
+		}
+		return callback(&exchangeOIDCCodeRR{login: login})
+	}
+}
+
+func readOIDCConfig(st istructs.IState, appName string) (cfg oidcConfig, err error) {
+	appQName, err := appdef.ParseAppQName(appName)
+	if err != nil {
+		return cfg, coreutils.NewHTTPError(http.StatusBadRequest, err)
+	}
+	cfgJSON, err := state.ReadSecret(st, OIDCSecretName(appQName))
+	if err != nil {
+		return cfg, coreutils.NewHTTPErrorf(http.StatusBadRequest, "OIDC is not configured for ", appQName, ": ", err)
+	}
+	if err := json.Unmarshal([]byte(cfgJSON), &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal OIDC config of %s: %w", appQName, err)
+	}
+	return cfg, nil
+}
+
+// the state is deleted, so the same state could not be exchanged twice
+func consumeOIDCSignIn(ttlStorage istructs.IAppTTLStorage, oidcState string) (signIn oidcSignIn, err error) {
+	if len(oidcState) == 0 {
+		return signIn, errOIDCStateUnknown
+	}
+	key := oidcSignInKeyPrefix + oidcState
+	signInJSON, ok, err := ttlStorage.TTLGet(key)
+	if err != nil || !ok {
+		return signIn, errors.Join(err, errOIDCStateUnknown)
+	}
+	if ok, err = ttlStorage.CompareAndDelete(key, signInJSON); err != nil || !ok {
+		// !ok -> consumed by the concurrent exchange
+		return signIn, errors.Join(err, errOIDCStateUnknown)
+	}
+	if err := json.Unmarshal([]byte(signInJSON), &signIn); err != nil {
+		// notest
+		return signIn, err
+	}
+	return signIn, nil
+}
+
+// sys/registry, AppWS of the login, System only
+// the login is authenticated by the IdP already, so the password is not checked
+func provideIssuePrincipalTokenByOIDCExec(itokens itokens.ITokens) istructsmem.ExecQueryClosure {
+	return func(ctx context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
+		login := args.ArgumentObject.AsString(authnz.Field_Login)
+		appName := args.ArgumentObject.AsString(authnz.Field_AppName)
+		appQName, err := appdef.ParseAppQName(appName)
+		if err != nil {
+			return coreutils.NewHTTPError(http.StatusBadRequest, err)
+		}
+		cdocLogin, doesLoginExist, err := GetCDocLogin(login, args.State, args.WSID, appName)
+		if err != nil {
+			return err
+		}
+		if !doesLoginExist {
+			return errOIDCLoginNotFound
+		}
+		if !isCanonicalLoginEnabled(cdocLogin) {
+			return errLoginOrPasswordIsIncorrect
+		}
+		result, err := issuePrincipalToken(itokens, appQName, loginFromPrimaryCDoc(login, cdocLogin), authnz.DefaultPrincipalTokenExpiration)
+		if err != nil {
+			return err
+		}
+		return callback(result)
+	}
+}
+
+func oidcLoginByCode(ctx context.Context, httpClient *http.Client, cfg oidcConfig, code string, signIn oidcSignIn) (login string, err error) {
+	if len(code) == 0 {
+		return "", errors.New("authorization code is empty")
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {cfg.RedirectURL},
+		"client_id":     {cfg.ClientID},
+		"client_secret": {cfg.ClientSecret},
+		"code_verifier": {signIn.CodeVerifier},
+	}
+	tokenResp := struct {
+		IDToken string `json:"id_token"`
+	}{}
+	if err := oidcRequest(ctx, httpClient, http.MethodPost, cfg.TokenURL, strings.NewReader(form.Encode()), &tokenResp); err != nil {
+		return "", fmt.Errorf("authorization code exchange failed: %w", err)
+	}
+	if len(tokenResp.IDToken) == 0 {
+		return "", errors.New("IdP response has no id_token")
+	}
+
+	keys, err := oidcJWKS(ctx, httpClient, cfg.JWKSURL)
+	if err != nil {
+		return "", err
+	}
+	claims := oidcClaims{}
+	_, err = jwt.ParseWithClaims(tokenResp.IDToken, &claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		return key, nil
+	}, jwt.WithValidMethods([]string{"RS256"}), jwt.WithIssuer(cfg.Issuer), jwt.WithAudience(cfg.ClientID), jwt.WithExpirationRequired())
+	if err != nil {
+		return "", fmt.Errorf("ID token validation failed: %w", err)
+	}
+	if len(claims.Subject) == 0 {
+		return "", errors.New("ID token has no subject")
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(signIn.Nonce)) != 1 {
+		// the ID token is issued for another sign in
+		return "", errors.New("ID token nonce mismatch")
+	}
+	return OIDCLogin(claims.Issuer, claims.Subject), nil
+}
+
+// returns RSA keys of the IdP by key id
+func oidcJWKS(ctx context.Context, httpClient *http.Client, jwksURL string) (map[string]*rsa.PublicKey, error) {
+	jwks := struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}{}
+	if err := oidcRequest(ctx, httpClient, http.MethodGet, jwksURL, nil, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to read IdP keys: %w", err)
+	}
+	keys := map[string]*rsa.PublicKey{}
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IdP key %q modulus: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IdP key %q exponent: %w", k.Kid, err)
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return keys, nil
+}
+
+func oidcRequest(ctx context.Context, httpClient *http.Client, method string, reqURL string, body io.Reader, result any) error {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, oidcMaxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: %d %s", method, reqURL, resp.StatusCode, respBody)
+	}
+	return json.Unmarshal(respBody, result)
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package registry
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fakeIdPCode         = "good-code"
+	fakeIdPKeyID        = "key1"
+	fakeIdPClientID     = "client1"
+	fakeIdPCodeVerifier = "verifier1"
+	fakeIdPNonce        = "nonce1"
+)
+
+var fakeSignIn = oidcSignIn{Nonce: fakeIdPNonce, CodeVerifier: fakeIdPCodeVerifier}
+
+// fake IdP issues the ID token with the provided claims for fakeIdPCode and fakeIdPCodeVerifier
+func newFakeIdP(t *testing.T, claims func(issuer string) oidcClaims) (cfg oidcConfig) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	cfg = oidcConfig{
+		Issuer:       srv.URL,
+		ClientID:     fakeIdPClientID,
+		ClientSecret: "secret1",
+		TokenURL:     srv.URL + "/token",
+		JWKSURL:      srv.URL + "/jwks",
+		RedirectURL:  "https://example.com/api/v2/apps/test1/app1/auth/oidc/callback",
+	}
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("code") != fakeIdPCode || r.PostFormValue("client_secret") != cfg.ClientSecret ||
+			r.PostFormValue("redirect_uri") != cfg.RedirectURL || r.PostFormValue("grant_type") != "authorization_code" ||
+			r.PostFormValue("code_verifier") != fakeIdPCodeVerifier {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims(cfg.Issuer))
+		token.Header["kid"] = fakeIdPKeyID
+		idToken, err := token.SignedString(key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": idToken})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kid": fakeIdPKeyID,
+			"kty": "RSA",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	return cfg
+}
+
+func TestOIDCLoginByCode(t *testing.T) {
+	require := require.New(t)
+	validClaims := func(issuer string) oidcClaims {
+		return oidcClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    issuer,
+				Subject:   "User-42",
+				Audience:  jwt.ClaimStrings{fakeIdPClientID},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			Nonce: fakeIdPNonce,
+		}
+	}
+
+	t.Run("basic usage", func(t *testing.T) {
+		cfg := newFakeIdP(t, validClaims)
+		login, err := oidcLoginByCode(context.Background(), http.DefaultClient, cfg, fakeIdPCode, fakeSignIn)
+		require.NoError(err)
+		require.Equal(OIDCLogin(cfg.Issuer, "User-42"), login)
+		require.NoError(validateSignInIdentifier(login))
+	})
+
+	t.Run("different subjects are mapped to different logins", func(t *testing.T) {
+		require.NotEqual(OIDCLogin("https://idp", "user"), OIDCLogin("https://idp", "User"))
+		require.NotEqual(OIDCLogin("https://idp1", "user"), OIDCLogin("https://idp2", "user"))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		cases := map[string]struct {
+			claims func(issuer string) oidcClaims
+			code   string
+			signIn oidcSignIn
+		}{
+			"empty code":          {validClaims, "", fakeSignIn},
+			"invalid code":        {validClaims, "bad-code", fakeSignIn},
+			"wrong code verifier": {validClaims, fakeIdPCode, oidcSignIn{Nonce: fakeIdPNonce, CodeVerifier: "other verifier"}},
+			"nonce mismatch":      {validClaims, fakeIdPCode, oidcSignIn{Nonce: "other nonce", CodeVerifier: fakeIdPCodeVerifier}},
+			"wrong audience": {func(issuer string) oidcClaims {
+				c := validClaims(issuer)
+				c.Audience = jwt.ClaimStrings{"other client"}
+				return c
+			}, fakeIdPCode, fakeSignIn},
+			"wrong issuer": {func(string) oidcClaims {
+				return validClaims("https://other.idp")
+			}, fakeIdPCode, fakeSignIn},
+			"expired": {func(issuer string) oidcClaims {
+				c := validClaims(issuer)
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+				return c
+			}, fakeIdPCode, fakeSignIn},
+			"no subject": {func(issuer string) oidcClaims {
+				c := validClaims(issuer)
+				c.Subject = ""
+				return c
+			}, fakeIdPCode, fakeSignIn},
+		}
+		for name, c := range cases {
+			t.Run(name, func(t *testing.T) {
+				cfg := newFakeIdP(t, c.claims)
+				login, err := oidcLoginByCode(context.Background(), http.DefaultClient, cfg, c.code, c.signIn)
+				require.Error(err)
+				require.Empty(login)
+			})
+		}
+	})
+}
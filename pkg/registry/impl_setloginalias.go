@@ -43,6 +43,7 @@ type signInLogin struct {
 	alias          string
 	subjectKind    int32
 	globalRoles    string
+	totpEnabled    bool
 }
 
 func execCmdInitiateSetLoginAlias(args istructs.ExecCommandArgs) error {
@@ -337,6 +338,7 @@ func loginFromPrimaryCDoc(login string, cdocLogin istructs.IStateValue) signInLo
 		alias:          cdocLogin.AsString(field_Alias),
 		subjectKind:    cdocLogin.AsInt32(authnz.Field_SubjectKind),
 		globalRoles:    cdocLogin.AsString(authnz.Field_GlobalRoles),
+		totpEnabled:    cdocLogin.AsBool(field_TOTPEnabled),
 	}
 }
 
@@ -371,6 +373,7 @@ func resolveAliasSignInLogin(submittedLogin, appName string, st istructs.IState,
 	if err != nil {
 		return signInLogin{}, false, err
 	}
+	totpEnabled, _ := sourceLoginMap[field_TOTPEnabled].(bool)
 	return signInLogin{
 		canonicalLogin: loginAlias.AsString(field_Login),
 		pwdHash:        pwdHash,
@@ -379,6 +382,7 @@ func resolveAliasSignInLogin(submittedLogin, appName string, st istructs.IState,
 		alias:          str(sourceLoginMap[field_Alias]),
 		subjectKind:    subjectKind,
 		globalRoles:    str(sourceLoginMap[authnz.Field_GlobalRoles]),
+		totpEnabled:    totpEnabled,
 	}, true, nil
 }
 
@@ -0,0 +1,331 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package registry
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // nolint G505: SHA1 is required by RFC 6238 and by authenticator apps
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/goutils/timeu"
+	"github.com/voedger/voedger/pkg/irates"
+	"github.com/voedger/voedger/pkg/iratesce"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/istructsmem"
+	"github.com/voedger/voedger/pkg/itokens"
+	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
+	"github.com/voedger/voedger/pkg/sys"
+	"github.com/voedger/voedger/pkg/sys/authnz"
+)
+
+func provideTOTP(cfg *istructsmem.AppConfigType, itokens itokens.ITokens, time timeu.ITime) {
+	// TOTP codes are limited per login: the pseudo workspace is shared by many logins
+	// and the requests come through the federation from the VVM
+	buckets := iratesce.Provide(time)
+	buckets.SetDefaultBucketState(qNameRateLimitTOTPCode, irates.BucketState{
+		Period:             totpCodeAttemptsPeriod,
+		MaxTokensPerPeriod: totpCodeAttempts,
+	})
+	cfg.Resources.Add(istructsmem.NewCommandFunction(
+		QNameCommandInitiateTOTPEnrollment,
+		cmdInitiateTOTPEnrollmentExec,
+	))
+	cfg.Resources.Add(istructsmem.NewCommandFunction(
+		QNameCommandConfirmTOTPEnrollment,
+		provideCmdConfirmTOTPEnrollmentExec(time, buckets),
+	))
+	cfg.Resources.Add(istructsmem.NewCommandFunction(
+		QNameCommandResetTOTP,
+		cmdResetTOTPExec,
+	))
+	cfg.Resources.Add(istructsmem.NewCommandFunction(
+		QNameCommandIssuePrincipalTokenByTOTP,
+		provideCmdIssuePrincipalTokenByTOTPExec(itokens, time, buckets),
+	))
+}
+
+// TOTPCode returns the RFC 6238 code for the base32 secret at the moment
+func TOTPCode(secret string, t time.Time) (string, error) {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		return "", err
+	}
+	return totpCode(key, totpTimeStep(t)), nil
+}
+
+// sys/registry/pseudoWSID
+// null auth
+// generates the secret and recovery codes, TOTP is not enabled until ConfirmTOTPEnrollment
+func cmdInitiateTOTPEnrollmentExec(args istructs.ExecCommandArgs) (err error) {
+	login := args.ArgumentObject.AsString(field_Login)
+	appName := args.ArgumentObject.AsString(field_AppName)
+	cdocLogin, err := getCDocLoginByPassword(args.State, args.WSID, login, appName, args.ArgumentUnloggedObject.AsString(field_Passwrd))
+	if err != nil {
+		return err
+	}
+	if cdocLogin.AsBool(field_TOTPEnabled) {
+		return errTOTPIsEnabledAlready
+	}
+
+	key := make([]byte, totpSecretLen)
+	if _, err := rand.Read(key); err != nil {
+		// notest
+		return err
+	}
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(key)
+	recoveryCodes := make([]string, totpRecoveryCodesAmount)
+	recoveryCodesHashes := make([]string, totpRecoveryCodesAmount)
+	for i := range recoveryCodes {
+		recoveryCodes[i] = coreutils.RecoveryCode()
+		recoveryCodesHashes[i] = recoveryCodeHash(recoveryCodes[i])
+	}
+
+	loginUpdater, err := updateCDocLogin(args.State, args.Intents, cdocLogin)
+	if err != nil {
+		return err
+	}
+	loginUpdater.PutString(field_TOTPSecret, secret)
+	loginUpdater.PutBool(field_TOTPEnabled, false)
+	loginUpdater.PutString(field_TOTPRecoveryCodes, strings.Join(recoveryCodesHashes, ","))
+	loginUpdater.PutInt64(field_TOTPLastTimeStep, 0)
+
+	kb, err := args.State.KeyBuilder(sys.Storage_Result, qNameInitiateTOTPEnrollmentResult)
+	if err != nil {
+		// notest
+		return err
+	}
+	result, err := args.Intents.NewValue(kb)
+	if err != nil {
+		// notest
+		return err
+	}
+	result.PutString(field_Secret, secret)
+	result.PutString(field_ProvisioningURI, totpProvisioningURI(appName, login, secret))
+	result.PutString(field_RecoveryCodes, strings.Join(recoveryCodes, ","))
+	return nil
+}
+
+// sys/registry/pseudoWSID
+// null auth
+func provideCmdConfirmTOTPEnrollmentExec(time timeu.ITime, buckets irates.IBuckets) istructsmem.ExecCommandClosure {
+	return func(args istructs.ExecCommandArgs) (err error) {
+		login := args.ArgumentObject.AsString(field_Login)
+		appName := args.ArgumentObject.AsString(field_AppName)
+		cdocLogin, err := getCDocLoginByPassword(args.State, args.WSID, login, appName, args.ArgumentUnloggedObject.AsString(field_Passwrd))
+		if err != nil {
+			return err
+		}
+		if cdocLogin.AsBool(field_TOTPEnabled) {
+			return errTOTPIsEnabledAlready
+		}
+		if len(cdocLogin.AsString(field_TOTPSecret)) == 0 {
+			return coreutils.NewHTTPErrorf(http.StatusBadRequest, "TOTP enrollment is not initiated")
+		}
+		if err := takeTOTPCodeAttempt(buckets, args.WSID, cdocLogin); err != nil {
+			return err
+		}
+		timeStep, ok := checkTOTPCode(cdocLogin, args.ArgumentUnloggedObject.AsString(field_TOTPCode), time.Now())
+		if !ok {
+			return errTOTPCodeIsIncorrect
+		}
+		loginUpdater, err := updateCDocLogin(args.State, args.Intents, cdocLogin)
+		if err != nil {
+			return err
+		}
+		loginUpdater.PutBool(field_TOTPEnabled, true)
+		loginUpdater.PutInt64(field_TOTPLastTimeStep, timeStep)
+		return nil
+	}
+}
+
+// sys/registry/pseudoWSID
+// auth: System
+func cmdResetTOTPExec(args istructs.ExecCommandArgs) (err error) {
+	login := args.ArgumentObject.AsString(field_Login)
+	appName := args.ArgumentObject.AsString(field_AppName)
+	cdocLogin, loginExists, err := GetCDocLogin(login, args.State, args.WSID, appName)
+	if err != nil {
+		return err
+	}
+	if !loginExists {
+		return errLoginDoesNotExist(login)
+	}
+	loginUpdater, err := updateCDocLogin(args.State, args.Intents, cdocLogin)
+	if err != nil {
+		return err
+	}
+	loginUpdater.PutString(field_TOTPSecret, "")
+	loginUpdater.PutBool(field_TOTPEnabled, false)
+	loginUpdater.PutString(field_TOTPRecoveryCodes, "")
+	loginUpdater.PutInt64(field_TOTPLastTimeStep, 0)
+	return nil
+}
+
+// sys/registry/pseudoWSID
+// null auth, authenticated by the challenge token issued by IssuePrincipalToken
+// the recovery code could be used instead of the TOTP code once
+func provideCmdIssuePrincipalTokenByTOTPExec(itokens itokens.ITokens, time timeu.ITime, buckets irates.IBuckets) istructsmem.ExecCommandClosure {
+	return func(args istructs.ExecCommandArgs) (err error) {
+		login := args.ArgumentObject.AsString(field_Login)
+		appName := args.ArgumentObject.AsString(field_AppName)
+		appQName, err := appdef.ParseAppQName(appName)
+		if err != nil {
+			return coreutils.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		challenge := payloads.TOTPChallengePayload{}
+		gp, err := itokens.ValidateToken(args.ArgumentUnloggedObject.AsString(field_ChallengeToken), &challenge)
+		if err != nil || gp.AppQName != appQName || challenge.Login != login {
+			return errTOTPChallengeIsInvalid
+		}
+
+		cdocLogin, loginExists, err := GetCDocLogin(login, args.State, args.WSID, appName)
+		if err != nil {
+			return err
+		}
+		if !loginExists || !isCanonicalLoginEnabled(cdocLogin) || !cdocLogin.AsBool(field_TOTPEnabled) {
+			return errTOTPChallengeIsInvalid
+		}
+		if err := takeTOTPCodeAttempt(buckets, args.WSID, cdocLogin); err != nil {
+			return err
+		}
+
+		code := args.ArgumentUnloggedObject.AsString(field_TOTPCode)
+		loginUpdater, err := updateCDocLogin(args.State, args.Intents, cdocLogin)
+		if err != nil {
+			return err
+		}
+		if timeStep, ok := checkTOTPCode(cdocLogin, code, time.Now()); ok {
+			loginUpdater.PutInt64(field_TOTPLastTimeStep, timeStep)
+		} else {
+			recoveryCodesHashes := strings.Split(cdocLogin.AsString(field_TOTPRecoveryCodes), ",")
+			idx := slices.Index(recoveryCodesHashes, recoveryCodeHash(code))
+			if len(code) == 0 || idx < 0 {
+				return errTOTPCodeIsIncorrect
+			}
+			loginUpdater.PutString(field_TOTPRecoveryCodes, strings.Join(slices.Delete(recoveryCodesHashes, idx, idx+1), ","))
+		}
+
+		ipt, err := issuePrincipalToken(itokens, appQName, loginFromPrimaryCDoc(login, cdocLogin), authnz.DefaultPrincipalTokenExpiration)
+		if err != nil {
+			return err
+		}
+		kb, err := args.State.KeyBuilder(sys.Storage_Result, qNameIssuePrincipalTokenByTOTPResult)
+		if err != nil {
+			// notest
+			return err
+		}
+		result, err := args.Intents.NewValue(kb)
+		if err != nil {
+			// notest
+			return err
+		}
+		result.PutString(authnz.Field_PrincipalToken, ipt.principalToken)
+		result.PutInt64(authnz.Field_WSID, ipt.profileWSID)
+		result.PutString(authnz.Field_WSError, ipt.profileCreationError)
+		return nil
+	}
+}
+
+func getCDocLoginByPassword(st istructs.IState, wsid istructs.WSID, login, appName, pwd string) (cdocLogin istructs.IStateValue, err error) {
+	cdocLogin, loginExists, err := GetCDocLogin(login, st, wsid, appName)
+	if err != nil {
+		return nil, err
+	}
+	if !loginExists {
+		return nil, errLoginOrPasswordIsIncorrect
+	}
+	isPasswordOK, err := CheckPassword(cdocLogin, pwd)
+	if err != nil {
+		return nil, err
+	}
+	if !isPasswordOK {
+		return nil, errLoginOrPasswordIsIncorrect
+	}
+	return cdocLogin, nil
+}
+
+func takeTOTPCodeAttempt(buckets irates.IBuckets, wsid istructs.WSID, cdocLogin istructs.IStateValue) error {
+	key := irates.BucketKey{
+		RateLimitName: qNameRateLimitTOTPCode,
+		Workspace:     wsid,
+		ID:            cdocLogin.AsRecordID(appdef.SystemField_ID),
+	}
+	if ok, _ := buckets.TakeTokens([]irates.BucketKey{key}, 1); !ok {
+		return errTOTPCodeAttemptsExceeded
+	}
+	return nil
+}
+
+func updateCDocLogin(st istructs.IState, intents istructs.IIntents, cdocLogin istructs.IStateValue) (istructs.IStateValueBuilder, error) {
+	kb, err := st.KeyBuilder(sys.Storage_Record, appdef.NullQName)
+	if err != nil {
+		return nil, err
+	}
+	return intents.UpdateValue(kb, cdocLogin)
+}
+
+// returns the time step of the code
+// the code of the previous and the next time step is accepted also to tolerate clock skew
+// the code of the already used time step is not accepted
+func checkTOTPCode(cdocLogin istructs.IStateValue, code string, now time.Time) (timeStep int64, ok bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(cdocLogin.AsString(field_TOTPSecret))
+	if err != nil {
+		return 0, false
+	}
+	lastTimeStep := cdocLogin.AsInt64(field_TOTPLastTimeStep)
+	current := totpTimeStep(now)
+	for timeStep := current - totpSkewSteps; timeStep <= current+totpSkewSteps; timeStep++ {
+		if timeStep > lastTimeStep && hmac.Equal([]byte(totpCode(key, timeStep)), []byte(code)) {
+			return timeStep, true
+		}
+	}
+	return 0, false
+}
+
+func totpTimeStep(t time.Time) int64 {
+	return t.Unix() / int64(totpPeriod/time.Second)
+}
+
+func totpCode(key []byte, timeStep int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(timeStep)) // nolint G115
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, code%totpModulo)
+}
+
+func totpProvisioningURI(appName, login, secret string) string {
+	label := url.PathEscape(appName + ":" + login)
+	params := url.Values{
+		"secret":    {secret},
+		"issuer":    {appName},
+		"algorithm": {"SHA1"},
+		"digits":    {fmt.Sprint(totpDigits)},
+		"period":    {fmt.Sprint(int(totpPeriod / time.Second))},
+	}
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+func recoveryCodeHash(code string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(code)))
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package registry
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPCode(t *testing.T) {
+	require := require.New(t)
+
+	// RFC 6238 Appendix B test vectors (SHA1), last 6 digits
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	cases := []struct {
+		unix     int64
+		expected string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, c := range cases {
+		code, err := TOTPCode(secret, time.Unix(c.unix, 0))
+		require.NoError(err)
+		require.Equal(c.expected, code, c.unix)
+	}
+
+	t.Run("invalid secret", func(t *testing.T) {
+		_, err := TOTPCode("not base32!", time.Now())
+		require.Error(err)
+	})
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	require.Equal(t,
+		"otpauth://totp/test1%2Fapp1:user@example.com?algorithm=SHA1&digits=6&issuer=test1%2Fapp1&period=30&secret=ABCDEF",
+		totpProvisioningURI("test1/app1", "user@example.com", "ABCDEF"))
+}
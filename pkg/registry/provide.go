@@ -6,8 +6,11 @@
 package registry
 
 import (
+	"net/http"
+
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils/federation"
+	"github.com/voedger/voedger/pkg/goutils/timeu"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
 	"github.com/voedger/voedger/pkg/itokens"
@@ -15,7 +18,7 @@ import (
 	_ "github.com/voedger/voedger/pkg/sys"
 )
 
-func Provide(cfg *istructsmem.AppConfigType, itokens itokens.ITokens, federation federation.IFederation) parser.PackageFS {
+func Provide(cfg *istructsmem.AppConfigType, itokens itokens.ITokens, federation federation.IFederation, time timeu.ITime) parser.PackageFS {
 	cfg.Resources.Add(istructsmem.NewCommandFunction(
 		QNameCommandCreateLogin,
 		execCmdCreateLogin,
@@ -40,10 +43,20 @@ func Provide(cfg *istructsmem.AppConfigType, itokens itokens.ITokens, federation
 	cfg.Resources.Add(istructsmem.NewQueryFunction(
 		appdef.NewQName(RegistryPackage, "IssuePrincipalToken"),
 		provideIssuePrincipalTokenExec(itokens, federation)))
+	cfg.Resources.Add(istructsmem.NewQueryFunction(
+		QNameQueryInitiateOIDCSignIn,
+		execQryInitiateOIDCSignIn))
+	cfg.Resources.Add(istructsmem.NewQueryFunction(
+		QNameQueryExchangeOIDCCode,
+		provideExchangeOIDCCodeExec(&http.Client{Timeout: oidcHTTPTimeout})))
+	cfg.Resources.Add(istructsmem.NewQueryFunction(
+		QNameQueryIssuePrincipalTokenByOIDC,
+		provideIssuePrincipalTokenByOIDCExec(itokens)))
 	provideChangePassword(cfg)
 	provideResetPassword(cfg, itokens, federation)
 	provideUpdateGlobalRoles(cfg)
 	provideCanonicalLoginEnablement(cfg)
+	provideTOTP(cfg, itokens, time)
 	cfg.AddAsyncProjectors(
 		provideAsyncProjectorInvokeCreateWorkspaceID(federation.WithRetry(), itokens),
 		provideAsyncProjectorApplySetLoginAlias(federation.WithRetry(), itokens),
@@ -55,6 +55,7 @@ const (
 	logAttrib_Projection          = "projection"
 	logAttrib_ChannelID           = "channelid"
 	n10nErrorStage                = "n10n.error"
+	maxIncomingRequestIDLen       = 128
 )
 
 var (
@@ -65,6 +66,22 @@ var (
 )
 
 const (
-	fieldLogin    = "login"
-	fieldPassword = "password"
+	fieldLogin            = "login"
+	fieldPassword         = "password"
+	fieldPrincipalToken   = "principalToken"
+	fieldExpiresInSeconds = "expiresInSeconds"
+	fieldProfileWSID      = "profileWSID"
+)
+
+// [httpu.IdempotencyKey] header value is stored per workspace by the command processor
+const maxIdempotencyKeyLength = 255
+
+const (
+	oidcProfileReadyTimeout      = 30 * time.Second
+	oidcProfileReadyPollInterval = 200 * time.Millisecond
+
+	// binds the OIDC sign in state to the user agent which initiated the sign in
+	// must live not longer than the state kept by the registry
+	oidcStateCookie       = "oidc_state"
+	oidcStateCookieMaxAge = 10 * time.Minute
 )
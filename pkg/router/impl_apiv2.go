@@ -46,6 +46,13 @@ func (s *routerService) registerHandlersV2() {
 		corsHandler(requestHandlerV2_table(s.requestSender, processors.APIPath_Docs, s.numsAppsWorkspaces, l))).
 		Methods(http.MethodOptions, http.MethodPatch, http.MethodDelete, http.MethodGet).Name("update or read single")
 
+	// read doc history: /api/v2/apps/{owner}/{app}/workspaces/{wsid}/docs/{pkg}.{table}/{id}/history
+	s.router.HandleFunc(fmt.Sprintf("/api/v2/apps/{%s}/{%s}/workspaces/{%s:[0-9]+}/docs/{%s}.{%s}/{%s:[0-9]+}/history",
+		URLPlaceholder_appOwner, URLPlaceholder_appName, URLPlaceholder_wsid, URLPlaceholder_pkg, URLPlaceholder_table,
+		URLPlaceholder_id),
+		corsHandler(requestHandlerV2_table(s.requestSender, processors.APIPath_Docs_History, s.numsAppsWorkspaces, l))).
+		Methods(http.MethodOptions, http.MethodGet).Name("read doc history")
+
 	// read collection: /api/v2/apps/{owner}/{app}/workspaces/{wsid}/cdocs/{pkg}.{table}
 	s.router.HandleFunc(fmt.Sprintf("/api/v2/apps/{%s}/{%s}/workspaces/{%s:[0-9]+}/cdocs/{%s}.{%s}",
 		URLPlaceholder_appOwner, URLPlaceholder_appName, URLPlaceholder_wsid, URLPlaceholder_pkg, URLPlaceholder_table),
@@ -58,6 +65,12 @@ func (s *routerService) registerHandlersV2() {
 		corsHandler(requestHandlerV2_extension(s.requestSender, processors.APIPath_Commands, s.numsAppsWorkspaces, l))).
 		Methods(http.MethodOptions, http.MethodPost).Name("exec cmd")
 
+	// execute batch of commands: /api/v2/apps/{owner}/{app}/workspaces/{wsid}/batch
+	s.router.HandleFunc(fmt.Sprintf("/api/v2/apps/{%s}/{%s}/workspaces/{%s:[0-9]+}/batch",
+		URLPlaceholder_appOwner, URLPlaceholder_appName, URLPlaceholder_wsid),
+		corsHandler(requestHandlerV2_batch(s.requestSender, s.numsAppsWorkspaces, l))).
+		Methods(http.MethodOptions, http.MethodPost).Name("exec batch")
+
 	// execute query: /api/v2/apps/{owner}/{app}/workspaces/{wsid}/queries/{pkg}.{query}
 	s.router.HandleFunc(fmt.Sprintf("/api/v2/apps/{%s}/{%s}/workspaces/{%s:[0-9]+}/queries/{%s}.{%s}",
 		URLPlaceholder_appOwner, URLPlaceholder_appName, URLPlaceholder_wsid, URLPlaceholder_pkg, URLPlaceholder_query),
@@ -100,6 +113,18 @@ func (s *routerService) registerHandlersV2() {
 		corsHandler(requestHandlerV2_auth_refresh(s.requestSender, s.numsAppsWorkspaces, l))).
 		Methods(http.MethodOptions, http.MethodPost).Name("auth refresh")
 
+	// OIDC sign in, redirects to the IdP: /api/v2/apps/{owner}/{app}/auth/oidc/authorize
+	s.router.HandleFunc(fmt.Sprintf("/api/v2/apps/{%s}/{%s}/auth/oidc/authorize",
+		URLPlaceholder_appOwner, URLPlaceholder_appName),
+		corsHandler(requestHandlerV2_auth_oidc_authorize(s.numsAppsWorkspaces, s.iTokens, s.federation))).
+		Methods(http.MethodOptions, http.MethodGet).Name("auth oidc authorize")
+
+	// OIDC authorization code callback: /api/v2/apps/{owner}/{app}/auth/oidc/callback
+	s.router.HandleFunc(fmt.Sprintf("/api/v2/apps/{%s}/{%s}/auth/oidc/callback",
+		URLPlaceholder_appOwner, URLPlaceholder_appName),
+		corsHandler(requestHandlerV2_auth_oidc_callback(s.numsAppsWorkspaces, s.iTokens, s.federation))).
+		Methods(http.MethodOptions, http.MethodGet).Name("auth oidc callback")
+
 	// create user /api/v2/apps/{owner}/{app}/users
 	s.router.HandleFunc(fmt.Sprintf("/api/v2/apps/{%s}/{%s}/users",
 		URLPlaceholder_appOwner, URLPlaceholder_appName),
@@ -468,6 +493,16 @@ func requestHandlerV2_extension(reqSender bus.IRequestSender, apiPath processors
 	})
 }
 
+func requestHandlerV2_batch(reqSender bus.IRequestSender, numsAppsWorkspaces map[appdef.AppQName]istructs.NumAppWorkspaces,
+	limiter *wsQueryLimiter) http.HandlerFunc {
+	return withValidateForFuncs(numsAppsWorkspaces, func(req *http.Request, rw http.ResponseWriter, data validatedData) {
+		busRequest := createBusRequest(data, req)
+		busRequest.IsAPIV2 = true
+		busRequest.APIPath = int(processors.APIPath_Batch)
+		sendRequestAndReadResponse(req, busRequest, reqSender, rw, data, limiter)
+	})
+}
+
 func requestHandlerV2_table(reqSender bus.IRequestSender, apiPath processors.APIPath, numsAppsWorkspaces map[appdef.AppQName]istructs.NumAppWorkspaces,
 	limiter *wsQueryLimiter) http.HandlerFunc {
 	return withValidateForFuncs(numsAppsWorkspaces, func(req *http.Request, rw http.ResponseWriter, data validatedData) {
@@ -491,6 +526,7 @@ func requestHandlerV2_table(reqSender bus.IRequestSender, apiPath processors.API
 func sendRequestAndReadResponse(req *http.Request, busRequest bus.Request, reqSender bus.IRequestSender, rw http.ResponseWriter, data validatedData,
 	limiter *wsQueryLimiter) {
 	reqCtxWithExtensionAttrib := withLogAttribs(req.Context(), data, busRequest, req)
+	rw.Header().Set(httpu.XRequestID, busRequest.RequestID)
 
 	// [~server.vsqlupdate/cmp.routerVSqlUpdateShim~impl]
 	// The shim reroutes c.cluster.VSqlUpdate to q.cluster.VSqlUpdate2 (query processor),
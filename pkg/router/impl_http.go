@@ -24,6 +24,7 @@ import (
 	"github.com/voedger/voedger/pkg/goutils/httpu"
 	"github.com/voedger/voedger/pkg/goutils/logger"
 	"github.com/voedger/voedger/pkg/sys"
+	"github.com/voedger/voedger/pkg/tracing"
 	"golang.org/x/net/netutil"
 
 	"github.com/voedger/voedger/pkg/istructs"
@@ -72,7 +73,8 @@ func (s *routerService) Prepare(work interface{}) error {
 		return err
 	}
 
-	return s.prepareBasicServer(s.router)
+	// server span per request, traceparent header is respected
+	return s.prepareBasicServer(tracing.HTTPHandler(s.tracer, s.router))
 }
 
 // pipeline.IServiceBase
@@ -228,6 +230,7 @@ func RequestHandler_V1(requestSender bus.IRequestSender, numsAppsWorkspaces map[
 		busRequest := createBusRequest(data, req)
 
 		reqCtxWithExtensionAttrib := withLogAttribs(req.Context(), data, busRequest, req)
+		rw.Header().Set(httpu.XRequestID, busRequest.RequestID)
 
 		// [~server.vsqlupdate/cmp.routerVSqlUpdateShim~impl]
 		// c.cluster.VSqlUpdate synchronously calls c.sys.CUD on the same command processor.
@@ -276,7 +279,7 @@ func RequestHandler_V1(requestSender bus.IRequestSender, numsAppsWorkspaces map[
 func corsHandler(h http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, Blob-Name")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, Blob-Name, Idempotency-Key")
 		if r.Method == "OPTIONS" {
 			return
 		}
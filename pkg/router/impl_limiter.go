@@ -102,7 +102,7 @@ func logRejections(entries map[rejectionKey]*rejectionCounter) {
 
 func isQPBoundAPIPath(apiPath processors.APIPath) bool {
 	switch apiPath {
-	case processors.APIPath_Queries, processors.APIPath_Views, processors.APIPath_Docs, processors.APIPath_CDocs:
+	case processors.APIPath_Queries, processors.APIPath_Views, processors.APIPath_Docs, processors.APIPath_CDocs, processors.APIPath_Docs_History:
 		return true
 	}
 	return false
@@ -0,0 +1,255 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package router
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/coreutils/federation"
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/itokens"
+	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
+	"github.com/voedger/voedger/pkg/sys/authnz"
+)
+
+// starts the sign in: the state, nonce and PKCE code challenge are made by the registry
+// the state is bound to the user agent by the cookie, then the user agent is redirected to the IdP
+func requestHandlerV2_auth_oidc_authorize(numsAppsWorkspaces map[appdef.AppQName]istructs.NumAppWorkspaces,
+	iTokens itokens.ITokens, federation federation.IFederation) http.HandlerFunc {
+	return withValidateForFuncs(numsAppsWorkspaces, func(req *http.Request, rw http.ResponseWriter, data validatedData) {
+		o, err := newOIDCSignIn(iTokens, federation, data.appQName)
+		if err != nil {
+			// notest
+			replyErr(rw, err)
+			return
+		}
+		authorizationURL, state, err := o.initiate()
+		if err != nil {
+			replyErr(rw, err)
+			return
+		}
+		http.SetCookie(rw, &http.Cookie{
+			Name:     oidcStateCookie,
+			Value:    state,
+			Path:     oidcCookiePath(data.appQName),
+			MaxAge:   int(oidcStateCookieMaxAge.Seconds()),
+			HttpOnly: true,
+			Secure:   true,
+			// sent on the top-level redirect from the IdP
+			SameSite: http.SameSiteLaxMode,
+		})
+		http.Redirect(rw, req, authorizationURL, http.StatusFound)
+	})
+}
+
+// IdP redirects here after the user is authenticated
+// the state must be the one bound to the user agent by auth/oidc/authorize
+// the code is exchanged for the login mapped to the IdP subject, the login is created on first sign in
+// replies the same as auth/login
+func requestHandlerV2_auth_oidc_callback(numsAppsWorkspaces map[appdef.AppQName]istructs.NumAppWorkspaces,
+	iTokens itokens.ITokens, federation federation.IFederation) http.HandlerFunc {
+	return withValidateForFuncs(numsAppsWorkspaces, func(req *http.Request, rw http.ResponseWriter, data validatedData) {
+		query := req.URL.Query()
+		if idpErr := query.Get("error"); len(idpErr) > 0 {
+			ReplyCommonError(rw, fmt.Sprintf("IdP error: %s %s", idpErr, query.Get("error_description")), http.StatusUnauthorized)
+			return
+		}
+		code := query.Get("code")
+		if len(code) == 0 {
+			ReplyCommonError(rw, "code query param is missing", http.StatusBadRequest)
+			return
+		}
+		state := query.Get("state")
+		if len(state) == 0 {
+			ReplyCommonError(rw, "state query param is missing", http.StatusBadRequest)
+			return
+		}
+		// the sign in must be initiated by the same user agent, protects against login CSRF
+		stateCookie, err := req.Cookie(oidcStateCookie)
+		if err != nil || subtle.ConstantTimeCompare([]byte(stateCookie.Value), []byte(state)) != 1 {
+			ReplyCommonError(rw, "state does not match the sign in initiated by the user agent", http.StatusUnauthorized)
+			return
+		}
+		http.SetCookie(rw, &http.Cookie{Name: oidcStateCookie, Path: oidcCookiePath(data.appQName), MaxAge: -1})
+		o, err := newOIDCSignIn(iTokens, federation, data.appQName)
+		if err != nil {
+			// notest
+			replyErr(rw, err)
+			return
+		}
+
+		login, err := o.exchangeCode(code, state)
+		if err != nil {
+			replyErr(rw, err)
+			return
+		}
+		token, profileWSID, err := o.issueToken(req.Context(), login)
+		if err != nil {
+			replyErr(rw, err)
+			return
+		}
+		result := fmt.Sprintf(`{%q:%q,%q:%d,%q:%d}`, fieldPrincipalToken, token,
+			fieldExpiresInSeconds, int(authnz.DefaultPrincipalTokenExpiration.Seconds()), fieldProfileWSID, profileWSID)
+		ReplyJSON(rw, result, http.StatusOK)
+	})
+}
+
+type oidcSignIn struct {
+	federation federation.IFederation
+	sysToken   string
+	appQName   appdef.AppQName
+}
+
+func newOIDCSignIn(iTokens itokens.ITokens, federation federation.IFederation, appQName appdef.AppQName) (*oidcSignIn, error) {
+	sysToken, err := payloads.GetSystemPrincipalToken(iTokens, istructs.AppQName_sys_registry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue sys token: %w", err)
+	}
+	return &oidcSignIn{
+		federation: federation,
+		sysToken:   sysToken,
+		appQName:   appQName,
+	}, nil
+}
+
+func (o *oidcSignIn) initiate() (authorizationURL string, state string, err error) {
+	pseudoWSID := coreutils.GetPseudoWSID(istructs.NullWSID, o.appQName.String(), istructs.CurrentClusterID())
+	resp, err := o.query(pseudoWSID, "registry.InitiateOIDCSignIn", map[string]string{
+		authnz.Field_AppName: o.appQName.String(),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	result := resp.QPv2Response.Result()
+	authorizationURL, ok := result["AuthorizationURL"].(string)
+	if !ok {
+		return "", "", errors.New("registry.InitiateOIDCSignIn result has no AuthorizationURL")
+	}
+	if state, ok = result["State"].(string); !ok {
+		return "", "", errors.New("registry.InitiateOIDCSignIn result has no State")
+	}
+	return authorizationURL, state, nil
+}
+
+func (o *oidcSignIn) exchangeCode(code string, state string) (login string, err error) {
+	pseudoWSID := coreutils.GetPseudoWSID(istructs.NullWSID, o.appQName.String(), istructs.CurrentClusterID())
+	resp, err := o.query(pseudoWSID, "registry.ExchangeOIDCCode", map[string]string{
+		authnz.Field_AppName: o.appQName.String(),
+		"Code":               code,
+		"State":              state,
+	})
+	if err != nil {
+		return "", err
+	}
+	login, ok := resp.QPv2Response.Result()[authnz.Field_Login].(string)
+	if !ok {
+		return "", errors.New("registry.ExchangeOIDCCode result has no " + authnz.Field_Login)
+	}
+	return login, nil
+}
+
+// creates the login on first sign in and waits for the profile workspace
+func (o *oidcSignIn) issueToken(ctx context.Context, login string) (token string, profileWSID int64, err error) {
+	pseudoWSID := coreutils.GetPseudoWSID(istructs.NullWSID, login, istructs.CurrentClusterID())
+	deadline := time.Now().Add(oidcProfileReadyTimeout)
+	for {
+		resp, err := o.query(pseudoWSID, "registry.IssuePrincipalTokenByOIDC", map[string]string{
+			authnz.Field_Login:   login,
+			authnz.Field_AppName: o.appQName.String(),
+		})
+		if err != nil {
+			var sysErr coreutils.SysError
+			if !errors.As(err, &sysErr) || sysErr.HTTPStatus != http.StatusNotFound {
+				return "", 0, err
+			}
+			if err := o.createLogin(pseudoWSID, login); err != nil {
+				// concurrent first sign in of the same user -> the login is created by the other request
+				if !errors.As(err, &sysErr) || sysErr.HTTPStatus != http.StatusConflict {
+					return "", 0, err
+				}
+			}
+		} else {
+			result := resp.QPv2Response.Result()
+			// WSError and PrincipalToken are missing until the profile is ready
+			if wsError, _ := result[authnz.Field_WSError].(string); len(wsError) > 0 {
+				return "", 0, errors.New("the login profile is created with an error: " + wsError)
+			}
+			wsid, ok := result["WSID"].(float64)
+			if !ok {
+				return "", 0, errors.New("registry.IssuePrincipalTokenByOIDC result has no WSID")
+			}
+			if wsid > 0 {
+				principalToken, ok := result["PrincipalToken"].(string)
+				if !ok {
+					return "", 0, errors.New("registry.IssuePrincipalTokenByOIDC result has no PrincipalToken")
+				}
+				return principalToken, int64(wsid), nil
+			}
+		}
+		if time.Now().After(deadline) {
+			return "", 0, coreutils.NewHTTPError(http.StatusConflict, errors.New("profile workspace is not yet ready, try again later"))
+		}
+		select {
+		case <-ctx.Done():
+			return "", 0, ctx.Err()
+		case <-time.After(oidcProfileReadyPollInterval):
+		}
+	}
+}
+
+// the login is signed in via OIDC only, so the password is random and is not kept
+func (o *oidcSignIn) createLogin(pseudoWSID istructs.WSID, login string) error {
+	body, err := json.Marshal(map[string]any{
+		"args": map[string]any{
+			authnz.Field_Login:                    login,
+			authnz.Field_AppName:                  o.appQName.String(),
+			authnz.Field_SubjectKind:              istructs.SubjectKind_User,
+			authnz.Field_WSKindInitializationData: "{}",
+			authnz.Field_ProfileCluster:           istructs.CurrentClusterID(),
+		},
+		"unloggedArgs": map[string]any{
+			"Password": coreutils.RandomPwd(),
+		},
+	})
+	if err != nil {
+		// notest
+		return err
+	}
+	url := fmt.Sprintf("api/v2/apps/sys/registry/workspaces/%d/commands/registry.CreateLogin", pseudoWSID)
+	_, err = o.federation.Func(url, string(body), httpu.WithAuthorizeBy(o.sysToken), httpu.WithMethod(http.MethodPost))
+	return err
+}
+
+// the cookie is sent to auth/oidc/* only
+func oidcCookiePath(appQName appdef.AppQName) string {
+	return fmt.Sprintf("/api/v2/apps/%s/%s/auth/oidc", appQName.Owner(), appQName.Name())
+}
+
+func (o *oidcSignIn) query(wsid istructs.WSID, query string, args map[string]string) (*federation.FuncResponse, error) {
+	argsBytes, err := json.Marshal(args)
+	if err != nil {
+		// notest
+		return nil, err
+	}
+	reqURL := fmt.Sprintf("api/v2/apps/sys/registry/workspaces/%d/queries/%s?args=%s", wsid, query, url.QueryEscape(string(argsBytes)))
+	resp, err := o.federation.Query(reqURL, httpu.WithAuthorizeBy(o.sysToken))
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsEmpty() {
+		return nil, fmt.Errorf("%s response is empty", query)
+	}
+	return resp, nil
+}
@@ -13,6 +13,8 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/valyala/bytebufferpool"
+
+	"github.com/voedger/voedger/pkg/tracing"
 )
 
 func parseRoutes(routesURLs map[string]route, routes map[string]string, isRewrite bool) error {
@@ -40,7 +42,8 @@ func parseRoutes(routesURLs map[string]route, routes map[string]string, isRewrit
 // route domain : resellerportal.dev.untill.ru=http://resellerportal : https://resellerportal.dev.untill.ru/foo -> http://resellerportal/foo
 func (s *routerService) getRedirectMatcher() (redirectMatcher mux.MatcherFunc, err error) {
 	routes := map[string]route{}
-	reverseProxy := &httputil.ReverseProxy{Director: func(r *http.Request) {}} // director's job is done by redirectMatcher
+	// director's job is done by redirectMatcher. Traced request -> the target receives the traceparent of the router span
+	reverseProxy := &httputil.ReverseProxy{Director: func(r *http.Request) { tracing.Inject(r.Context(), r.Header) }}
 	if err := parseRoutes(routes, s.routes, false); err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@
 package router
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
@@ -164,6 +165,40 @@ func TestBasicUsage_Respond(t *testing.T) {
 	}
 }
 
+func TestRequestID(t *testing.T) {
+	require := require.New(t)
+	requestIDs := make(chan string, 1)
+	router := setUp(t, func(requestCtx context.Context, request bus.Request, responder bus.IResponder) {
+		requestIDs <- request.RequestID
+		go func() {
+			err := responder.Respond(bus.ResponseMeta{ContentType: httpu.ContentType_ApplicationJSON, StatusCode: http.StatusOK}, nil)
+			require.NoError(err)
+		}()
+	})
+	defer tearDown(router)
+	url := fmt.Sprintf("http://127.0.0.1:%d/api/v2/apps/test1/app1/workspaces/%d/queries/test.query", router.port(), testWSID)
+
+	t.Run("generated", func(t *testing.T) {
+		resp, err := http.Get(url)
+		require.NoError(err)
+		defer resp.Body.Close()
+		reqID := <-requestIDs
+		require.NotEmpty(reqID)
+		require.Equal(reqID, resp.Header.Get(httpu.XRequestID))
+	})
+
+	t.Run("incoming header is kept", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
+		require.NoError(err)
+		req.Header.Set(httpu.XRequestID, "my-request-id")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(err)
+		defer resp.Body.Close()
+		require.Equal("my-request-id", <-requestIDs)
+		require.Equal("my-request-id", resp.Header.Get(httpu.XRequestID))
+	})
+}
+
 type testObject struct {
 	IntField int
 	StrField string
@@ -654,7 +689,7 @@ func expectResp(t *testing.T, resp *http.Response, contentType string, statusCod
 	require.Equal(t, statusCode, resp.StatusCode)
 	require.Contains(t, resp.Header["Content-Type"][0], contentType, resp.Header)
 	require.Equal(t, []string{"*"}, resp.Header["Access-Control-Allow-Origin"])
-	require.Equal(t, []string{"Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, Blob-Name"}, resp.Header["Access-Control-Allow-Headers"])
+	require.Equal(t, []string{"Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, Blob-Name, Idempotency-Key"}, resp.Header["Access-Control-Allow-Headers"])
 }
 
 func Test_HTTPErrorLog_ForwardedToLogger(t *testing.T) {
@@ -735,6 +770,55 @@ func TestSubscribeAndWatch_NoSuperfluousWriteHeader(t *testing.T) {
 	logCap.NotContains("http: superfluous response.WriteHeader call")
 }
 
+// payloads are delivered by the api v2 n10n processor only, see [in10n.Payload]
+func TestSubscribeAndWatch_NoPayloads(t *testing.T) {
+	require := require.New(t)
+
+	broker, brokerCleanup := in10nmem.NewN10nBroker(in10n.Quotas{
+		Channels:                1,
+		ChannelsPerSubject:      1,
+		Subscriptions:           1,
+		SubscriptionsPerSubject: 1,
+		PayloadsPerChannel:      1,
+	}, testingu.MockTime)
+	defer brokerCleanup()
+
+	svc := &routerService{n10n: broker}
+	srv := httptest.NewServer(svc.subscribeAndWatchHandler())
+	defer srv.Close()
+
+	pk := in10n.ProjectionKey{
+		App:        istructs.AppQName_test1_app1,
+		Projection: appdef.NewQName("test", "view"),
+		WS:         1,
+	}
+	broker.Update(pk, istructs.Offset(1))
+
+	payload := `{"SubjectLogin":"test","ProjectionKey":[` + pk.ToJSON() + `]}`
+	resp, err := http.Get(srv.URL + "/n10n/channel?payload=" + url.QueryEscape(payload))
+	require.NoError(err)
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Split(coreutils.ScanSSE)
+	nextSSE := func() string {
+		require.True(scanner.Scan())
+		return scanner.Text()
+	}
+	require.Contains(nextSSE(), "event: channelId")
+	require.Equal(fmt.Sprintf("event: %s\ndata: 1", pk.ToJSON()), nextSSE())
+
+	broker.UpdateWithPayload(pk, istructs.Offset(2), testPayload(`{"key":{"k":1}}`))
+	require.Equal(fmt.Sprintf("event: %s\ndata: 2", pk.ToJSON()), nextSSE())
+
+	broker.Update(pk, istructs.Offset(3))
+	require.Equal(fmt.Sprintf("event: %s\ndata: 3", pk.ToJSON()), nextSSE())
+}
+
+type testPayload string
+
+func (p testPayload) JSON(func(appdef.FieldName) bool) []byte { return []byte(p) }
+
 func TestApiV1_PlainErrorOnStreamClose(t *testing.T) {
 	require := require.New(t)
 	cases := []struct {
@@ -20,7 +20,7 @@ import (
 )
 
 func withValidateForFuncs(numsAppsWorkspaces map[appdef.AppQName]istructs.NumAppWorkspaces, handler func(req *http.Request, rw http.ResponseWriter, data validatedData)) http.HandlerFunc {
-	return withValidate(numsAppsWorkspaces, handler, readBody, cookiesTokenToHeaders)
+	return withValidate(numsAppsWorkspaces, handler, readBody, cookiesTokenToHeaders, validateIdempotencyKey)
 }
 
 func withValidateForN10N(numsAppsWorkspaces map[appdef.AppQName]istructs.NumAppWorkspaces, handler func(req *http.Request, rw http.ResponseWriter, data validatedData)) http.HandlerFunc {
@@ -108,6 +108,15 @@ func readBody(validatedData validatedData, req *http.Request) (validatedData, er
 	return validatedData, nil
 }
 
+func validateIdempotencyKey(validatedData validatedData, _ *http.Request) (validatedData, error) {
+	if idempotencyKey, ok := validatedData.header[httpu.IdempotencyKey]; ok {
+		if len(idempotencyKey) == 0 || len(idempotencyKey) > maxIdempotencyKeyLength {
+			return validatedData, fmt.Errorf("%s header value length must be in range [1..%d]", httpu.IdempotencyKey, maxIdempotencyKeyLength)
+		}
+	}
+	return validatedData, nil
+}
+
 // does not read body
 func cookiesTokenToHeaders(validatedData validatedData, req *http.Request) (validatedData, error) {
 	if _, ok := validatedData.header[httpu.Authorization]; !ok {
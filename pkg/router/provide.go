@@ -35,6 +35,7 @@ func Provide(rp RouterParams, broker in10n.IN10nBroker, blobRequestHandler blobp
 			ReadTimeout:      rp.ReadTimeout,
 			ConnectionsLimit: rp.ConnectionsLimit,
 		},
+		Tracer: rp.Tracer,
 	}, broker, nil, requestSender, numsAppsWorkspaces, iTokens, federation, appTokensFactory)
 
 	if rp.Port != HTTPSPort {
@@ -96,6 +97,7 @@ func getRouterService(name string, listenAddress string, rp RouterParams, broker
 			iTime:      rp.ITime,
 			rejections: make(map[rejectionKey]*rejectionCounter),
 		},
+		tracer: rp.Tracer,
 	}
 }
 
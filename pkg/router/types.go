@@ -26,6 +26,7 @@ import (
 	"github.com/voedger/voedger/pkg/itokens"
 	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
 	blobprocessor "github.com/voedger/voedger/pkg/processors/blobber"
+	"github.com/voedger/voedger/pkg/tracing"
 )
 
 type HTTPServerParams struct {
@@ -47,6 +48,7 @@ type RouterParams struct {
 	RouteDomains         map[string]string // resellerportal.dev.untill.ru=http://resellerportal : https://resellerportal.dev.untill.ru/foo -> http://resellerportal/foo
 	MaxQueriesPerWS      int
 	ITime                timeu.ITime
+	Tracer               tracing.ITracer // nil -> requests are not traced
 }
 
 type httpServer struct {
@@ -74,6 +76,7 @@ type routerService struct {
 	federation         federation.IFederation
 	appTokensFactory   payloads.IAppTokensFactory
 	queryLimiter       *wsQueryLimiter
+	tracer             tracing.ITracer
 }
 
 type httpsService struct {
@@ -108,14 +108,15 @@ func GetCookieBearerAuth(req *http.Request) (cookieBearerToken string, ok bool,
 // createBusRequest creates a bus.Request from validated data
 func createBusRequest(data validatedData, req *http.Request) bus.Request {
 	res := bus.Request{
-		Method:   req.Method,
-		WSID:     data.wsid,
-		Query:    map[string]string{},
-		Header:   data.header,
-		AppQName: data.appQName,
-		Resource: data.vars[URLPlaceholder_resourceName],
-		Body:     data.body,
-		Host:     remoteIP(req.RemoteAddr),
+		Method:    req.Method,
+		WSID:      data.wsid,
+		Query:     map[string]string{},
+		Header:    data.header,
+		AppQName:  data.appQName,
+		Resource:  data.vars[URLPlaceholder_resourceName],
+		Body:      data.body,
+		Host:      remoteIP(req.RemoteAddr),
+		RequestID: requestID(req),
 	}
 
 	if docIDStr, hasDocID := data.vars[URLPlaceholder_id]; hasDocID {
@@ -143,10 +144,21 @@ func resolveExtension(busRequest bus.Request) string {
 	return busRequest.Resource
 }
 
+// requestID returns the request ID provided by the caller in X-Request-ID header, e.g. by the federation call
+// otherwise generates a new one
+func requestID(req *http.Request) string {
+	if incoming := req.Header.Get(httpu.XRequestID); len(incoming) > 0 && len(incoming) <= maxIncomingRequestIDLen {
+		return incoming
+	}
+	return fmt.Sprintf("%s-%d", globalServerStartTime, reqID.Add(1))
+}
+
 func withLogAttribs(ctx context.Context, data validatedData, busRequest bus.Request, req *http.Request) context.Context {
-	newReqID := fmt.Sprintf("%s-%d", globalServerStartTime, reqID.Add(1))
+	if len(busRequest.RequestID) == 0 {
+		busRequest.RequestID = requestID(req)
+	}
 	enrichedCtx := logger.WithContextAttrs(ctx, map[string]any{
-		logger.LogAttr_ReqID:     newReqID,
+		logger.LogAttr_ReqID:     busRequest.RequestID,
 		logger.LogAttr_WSID:      data.wsid,
 		logger.LogAttr_VApp:      data.appQName,
 		logger.LogAttr_Extension: resolveExtension(busRequest),
@@ -177,6 +189,8 @@ func apiPathToExtension(apiPath processors.APIPath) string {
 		return "sys._Docs"
 	case processors.APIPath_CDocs:
 		return "sys._CDocs"
+	case processors.APIPath_Docs_History:
+		return "sys._Docs_History"
 	case processors.APIPaths_Schema:
 		return "sys._Schema"
 	case processors.APIPath_Schemas_WorkspaceRoles:
@@ -191,6 +205,8 @@ func apiPathToExtension(apiPath processors.APIPath) string {
 		return "sys._Users"
 	case processors.APIPath_N10N_SubscribeAndWatch:
 		return "sys._N10N_SubscribeAndWatch"
+	case processors.APIPath_Batch:
+		return "sys._Batch"
 	}
 	return strconv.Itoa(int(apiPath))
 }
@@ -33,6 +33,7 @@ type IStateSafeAPI interface {
 	KeyBuilderPutBytes(key TKeyBuilder, name string, value []byte)
 	KeyBuilderPutQName(key TKeyBuilder, name string, value QName)
 	KeyBuilderPutBool(key TKeyBuilder, name string, value bool)
+	KeyBuilderPutDecimal(key TKeyBuilder, name string, value string)
 
 	// Key
 	KeyAsInt32(k TKey, name string) int32
@@ -43,6 +44,7 @@ type IStateSafeAPI interface {
 	KeyAsString(k TKey, name string) string
 	KeyAsQName(k TKey, name string) QName
 	KeyAsBool(k TKey, name string) bool
+	KeyAsDecimal(k TKey, name string) string
 
 	// Value
 	ValueAsValue(v TValue, name string) (result TValue)
@@ -54,6 +56,7 @@ type IStateSafeAPI interface {
 	ValueAsQName(v TValue, name string) QName
 	ValueAsBool(v TValue, name string) bool
 	ValueAsString(v TValue, name string) string
+	ValueAsDecimal(v TValue, name string) string
 
 	ValueLen(v TValue) int
 	ValueGetAsValue(v TValue, index int) (result TValue)
@@ -75,4 +78,5 @@ type IStateSafeAPI interface {
 	IntentPutBytes(v TIntent, name string, value []byte)
 	IntentPutQName(v TIntent, name string, value QName)
 	IntentPutBool(v TIntent, name string, value bool)
+	IntentPutDecimal(v TIntent, name string, value string)
 }
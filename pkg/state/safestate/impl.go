@@ -8,6 +8,7 @@ import (
 	"errors"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/state"
 	safe "github.com/voedger/voedger/pkg/state/isafestateapi"
@@ -144,6 +145,14 @@ func (s *safeState) KeyBuilderPutString(key safe.TKeyBuilder, name string, value
 	s.kb(key).PutString(name, value)
 }
 
+func (s *safeState) KeyBuilderPutDecimal(key safe.TKeyBuilder, name string, value string) {
+	d, err := decimal.Parse(value)
+	if err != nil {
+		panic(err)
+	}
+	s.kb(key).PutDecimal(name, d)
+}
+
 func (s *safeState) KeyBuilderPutBytes(key safe.TKeyBuilder, name string, value []byte) {
 	s.kb(key).PutBytes(name, value)
 }
@@ -202,6 +211,10 @@ func (s *safeState) ValueAsString(v safe.TValue, name string) string {
 	return s.value(v).AsString(name)
 }
 
+func (s *safeState) ValueAsDecimal(v safe.TValue, name string) string {
+	return s.value(v).AsDecimal(name).String()
+}
+
 func (s *safeState) ValueLen(v safe.TValue) int {
 	return s.value(v).Length()
 }
@@ -277,6 +290,14 @@ func (s *safeState) IntentPutString(v safe.TIntent, name string, value string) {
 	s.vb(v).PutString(name, value)
 }
 
+func (s *safeState) IntentPutDecimal(v safe.TIntent, name string, value string) {
+	d, err := decimal.Parse(value)
+	if err != nil {
+		panic(err)
+	}
+	s.vb(v).PutDecimal(name, d)
+}
+
 func (s *safeState) IntentPutBytes(v safe.TIntent, name string, value []byte) {
 	s.vb(v).PutBytes(name, value)
 }
@@ -331,6 +352,10 @@ func (s *safeState) KeyAsString(k safe.TKey, name string) string {
 	return s.key(k).AsString(name)
 }
 
+func (s *safeState) KeyAsDecimal(k safe.TKey, name string) string {
+	return s.key(k).AsDecimal(name).String()
+}
+
 func (s *safeState) KeyAsQName(k safe.TKey, name string) safe.QName {
 	qname := s.key(k).AsQName(name)
 	return safe.QName{
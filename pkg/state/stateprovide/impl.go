@@ -3,3 +3,16 @@
  */
 
 package stateprovide
+
+import (
+	"context"
+
+	"github.com/voedger/voedger/pkg/state"
+)
+
+func logCtxFunc(stateCtx context.Context, stateOpts state.StateOpts) state.LogCtxFunc {
+	if stateOpts.LogCtxFunc != nil {
+		return stateOpts.LogCtxFunc
+	}
+	return func() context.Context { return stateCtx }
+}
@@ -49,11 +49,11 @@ func implProvideAsyncActualizerState(vvmCtx context.Context, appStructsFunc stat
 	state.addStorage(sys.Storage_WLog, storages.NewWLogStorage(vvmCtx, ieventsFunc, wsidFunc), S_GET|S_READ)
 	state.addStorage(sys.Storage_SendMail, storages.NewSendMailStorage(emailSender), S_GET|S_INSERT)
 	state.addStorage(sys.Storage_HTTP, storages.NewHTTPStorage(httpClient), S_READ)
-	state.addStorage(sys.Storage_FederationCommand, storages.NewFederationCommandStorage(appStructsFunc, wsidFunc, federationFunc, tokensFunc, stateOpts.FederationCommandHandler), S_GET)
-	state.addStorage(sys.Storage_FederationBlob, storages.NewFederationBlobStorage(appStructsFunc, wsidFunc, federationFunc, tokensFunc, stateOpts.FederationBlobHandler), S_READ)
+	state.addStorage(sys.Storage_FederationCommand, storages.NewFederationCommandStorage(appStructsFunc, wsidFunc, federationFunc, tokensFunc, stateOpts.FederationCommandHandler, logCtxFunc(vvmCtx, stateOpts)), S_GET)
+	state.addStorage(sys.Storage_FederationBlob, storages.NewFederationBlobStorage(appStructsFunc, wsidFunc, federationFunc, tokensFunc, stateOpts.FederationBlobHandler, logCtxFunc(vvmCtx, stateOpts)), S_READ)
 	state.addStorage(sys.Storage_AppSecret, storages.NewAppSecretsStorage(secretReader), S_GET)
 	state.addStorage(sys.Storage_Uniq, storages.NewUniquesStorage(appStructsFunc, wsidFunc, stateOpts.UniquesHandler), S_GET)
-	state.addStorage(sys.Storage_Logger, storages.NewLoggerStorage(), S_INSERT)
+	state.addStorage(sys.Storage_Logger, storages.NewLoggerStorage(logCtxFunc(vvmCtx, stateOpts)), S_INSERT)
 
 	return state
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/in10n"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys"
@@ -21,7 +22,7 @@ import (
 func TestBundledHostState_BasicUsage(t *testing.T) {
 	require := require.New(t)
 	factory := ProvideAsyncActualizerStateFactory()
-	n10nFn := func(view appdef.QName, wsid istructs.WSID, offset istructs.Offset) {}
+	n10nFn := func(view appdef.QName, wsid istructs.WSID, offset istructs.Offset, payload in10n.Payload) {}
 
 	// Create instance of async actualizer state
 	aaState := factory(context.Background(), mockedAppStructs, state.SimpleWSIDFunc(istructs.WSID(1)), n10nFn, nil, nil, nil, nil, 2, 1, state.NullOpts, nil, nil)
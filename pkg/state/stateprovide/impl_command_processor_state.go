@@ -57,7 +57,7 @@ func implProvideCommandProcessorState(
 	state.addStorage(sys.Storage_Uniq, storages.NewUniquesStorage(appStructsFunc, wsidFunc, stateOpts.UniquesHandler), S_GET)
 	state.addStorage(sys.Storage_Response, storages.NewResponseStorage(), S_INSERT)
 	state.addStorage(sys.Storage_CommandContext, storages.NewCommandContextStorage(argFunc, unloggedArgFunc, wsidFunc, wlogOffsetFunc, originFunc), S_GET)
-	state.addStorage(sys.Storage_Logger, storages.NewLoggerStorage(), S_INSERT)
+	state.addStorage(sys.Storage_Logger, storages.NewLoggerStorage(logCtxFunc(vvmCtx, stateOpts)), S_INSERT)
 
 	return state
 }
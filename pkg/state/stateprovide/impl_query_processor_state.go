@@ -94,23 +94,20 @@ func implProvideQueryProcessorState(
 		queryCallback: queryCallbackFunc,
 	}
 
-	ieventsFunc := func() istructs.IEvents {
-		return appStructsFunc().Events()
-	}
-
-	state.addStorage(sys.Storage_View, storages.NewViewRecordsStorage(requestCtx, appStructsFunc, wsidFunc, nil), S_GET|S_GET_BATCH|S_READ)
-	state.addStorage(sys.Storage_Record, storages.NewRecordsStorage(appStructsFunc, wsidFunc, nil), S_GET|S_GET_BATCH)
-	state.addStorage(sys.Storage_WLog, storages.NewWLogStorage(requestCtx, ieventsFunc, wsidFunc), S_GET|S_READ)
+	// records are restricted by row-level security policies of the request roles
+	state.addStorage(sys.Storage_View, storages.NewQueryViewRecordsStorage(requestCtx, appStructsFunc, wsidFunc, principalsFunc), S_GET|S_GET_BATCH|S_READ)
+	state.addStorage(sys.Storage_Record, storages.NewQueryRecordsStorage(appStructsFunc, wsidFunc, principalsFunc), S_GET|S_GET_BATCH)
+	state.addStorage(sys.Storage_WLog, storages.NewQueryWLogStorage(requestCtx, appStructsFunc, wsidFunc, principalsFunc), S_GET|S_READ)
 	state.addStorage(sys.Storage_HTTP, storages.NewHTTPStorage(httpClient), S_READ)
-	state.addStorage(sys.Storage_FederationCommand, storages.NewFederationCommandStorage(appStructsFunc, wsidFunc, federation, itokens, stateOpts.FederationCommandHandler), S_GET)
-	state.addStorage(sys.Storage_FederationBlob, storages.NewFederationBlobStorage(appStructsFunc, wsidFunc, federation, itokens, stateOpts.FederationBlobHandler), S_READ)
+	state.addStorage(sys.Storage_FederationCommand, storages.NewFederationCommandStorage(appStructsFunc, wsidFunc, federation, itokens, stateOpts.FederationCommandHandler, logCtxFunc(requestCtx, stateOpts)), S_GET)
+	state.addStorage(sys.Storage_FederationBlob, storages.NewFederationBlobStorage(appStructsFunc, wsidFunc, federation, itokens, stateOpts.FederationBlobHandler, logCtxFunc(requestCtx, stateOpts)), S_READ)
 	state.addStorage(sys.Storage_AppSecret, storages.NewAppSecretsStorage(secretReader), S_GET)
 	state.addStorage(sys.Storage_RequestSubject, storages.NewSubjectStorage(principalsFunc, tokenFunc), S_GET)
 	state.addStorage(sys.Storage_QueryContext, storages.NewQueryContextStorage(argFunc, wsidFunc), S_GET)
 	state.addStorage(sys.Storage_Response, storages.NewResponseStorage(), S_INSERT)
 	state.addStorage(sys.Storage_Result, storages.NewResultStorage(resultBuilderFunc), S_INSERT)
 	state.addStorage(sys.Storage_Uniq, storages.NewUniquesStorage(appStructsFunc, wsidFunc, stateOpts.UniquesHandler), S_GET)
-	state.addStorage(sys.Storage_Logger, storages.NewLoggerStorage(), S_INSERT)
+	state.addStorage(sys.Storage_Logger, storages.NewLoggerStorage(logCtxFunc(requestCtx, stateOpts)), S_INSERT)
 
 	return state
 }
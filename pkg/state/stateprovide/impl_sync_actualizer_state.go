@@ -24,7 +24,8 @@ func (s *syncActualizerState) PLogEvent() istructs.IPLogEvent {
 }
 
 func implProvideSyncActualizerState(vvmCtx context.Context, appStructsFunc state.AppStructsFunc, partitionIDFunc state.PartitionIDFunc,
-	wsidFunc state.WSIDFunc, n10nFunc state.N10nFunc, secretReader isecrets.ISecretReader, eventFunc state.PLogEventFunc, intentsLimit int, stateOpts state.StateOpts) state.IHostState {
+	wsidFunc state.WSIDFunc, n10nFunc state.N10nFunc, secretReader isecrets.ISecretReader, eventFunc state.PLogEventFunc, principalsFunc state.PrincipalsFunc,
+	intentsLimit int, stateOpts state.StateOpts) state.IHostState {
 	hs := &syncActualizerState{
 		hostState: newHostState(vvmCtx, "SyncActualizer", intentsLimit, appStructsFunc),
 		eventFunc: eventFunc,
@@ -37,6 +38,8 @@ func implProvideSyncActualizerState(vvmCtx context.Context, appStructsFunc state
 	hs.addStorage(sys.Storage_WLog, storages.NewWLogStorage(vvmCtx, ieventsFunc, wsidFunc), S_GET)
 	hs.addStorage(sys.Storage_AppSecret, storages.NewAppSecretsStorage(secretReader), S_GET)
 	hs.addStorage(sys.Storage_Uniq, storages.NewUniquesStorage(appStructsFunc, wsidFunc, stateOpts.UniquesHandler), S_GET)
-	hs.addStorage(sys.Storage_Logger, storages.NewLoggerStorage(), S_INSERT)
+	hs.addStorage(sys.Storage_Logger, storages.NewLoggerStorage(logCtxFunc(vvmCtx, stateOpts)), S_INSERT)
+	// token is not available for projectors, only principals of the command are
+	hs.addStorage(sys.Storage_RequestSubject, storages.NewSubjectStorage(principalsFunc, func() string { return "" }), S_GET)
 	return hs
 }
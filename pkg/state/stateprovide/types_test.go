@@ -10,11 +10,14 @@ import (
 	"fmt"
 	"maps"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys"
@@ -61,6 +64,9 @@ func (b *mapKeyBuilder) PutString(name string, value string)              { b.da
 func (b *mapKeyBuilder) PutQName(name string, value appdef.QName)         { b.data[name] = value }
 func (b *mapKeyBuilder) PutBool(name string, value bool)                  { b.data[name] = value }
 func (b *mapKeyBuilder) PutRecordID(name string, value istructs.RecordID) { b.data[name] = value }
+func (b *mapKeyBuilder) PutDecimal(name string, value decimal.Decimal)    { b.data[name] = value }
+func (b *mapKeyBuilder) PutTime(name string, value time.Time)             { b.data[name] = value }
+func (b *mapKeyBuilder) PutUUID(name string, value uuid.UUID)             { b.data[name] = value }
 func (b *mapKeyBuilder) PutNumber(string, json.Number)                    { panic(ErrNotSupported) }
 func (b *mapKeyBuilder) PutChars(string, string)                          { panic(ErrNotSupported) }
 func (b *mapKeyBuilder) PutFromJSON(j map[string]any)                     { maps.Copy(b.data, j) }
@@ -10,6 +10,7 @@ import (
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/goutils/httpu"
 	"github.com/voedger/voedger/pkg/iauthnz"
+	"github.com/voedger/voedger/pkg/in10n"
 	"github.com/voedger/voedger/pkg/isecrets"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/itokens"
@@ -20,7 +21,8 @@ import (
 
 type PartitionIDFunc func() istructs.PartitionID
 type WSIDFunc func() istructs.WSID
-type N10nFunc func(view appdef.QName, wsid istructs.WSID, offset istructs.Offset)
+type N10nFunc func(view appdef.QName, wsid istructs.WSID, offset istructs.Offset, payload in10n.Payload) // payload is nil if the view is not N10nPayload
+
 type AppStructsFunc func() istructs.IAppStructs
 type CUDFunc func() istructs.ICUD
 type ObjectBuilderFunc func() istructs.IObjectBuilder
@@ -38,9 +40,10 @@ type TokensFunc func() itokens.ITokens
 type PrepareArgsFunc func() istructs.PrepareArgs
 type ExecQueryCallbackFunc func() istructs.ExecQueryCallback
 type UnixTimeFunc func() int64
+type LogCtxFunc func() context.Context
 type MockedStateFactory func(vvmCtx context.Context, intentsLimit int, appStructsFunc AppStructsFunc) IHostState
 type CommandProcessorStateFactory func(vvmCtx context.Context, appStructsFunc AppStructsFunc, wsidFunc WSIDFunc, secretReader isecrets.ISecretReader, cudFunc CUDFunc, principalPayloadFunc PrincipalsFunc, tokenFunc TokenFunc, intentsLimit int, cmdResultBuilderFunc ObjectBuilderFunc, execCmdArgsFunc CommandPrepareArgsFunc, argFunc ArgFunc, unloggedArgFunc UnloggedArgFunc, wlogOffsetFunc WLogOffsetFunc, stateOpts StateOpts, originFunc OriginFunc) IHostState
-type SyncActualizerStateFactory func(vvmCtx context.Context, appStructsFunc AppStructsFunc, partitionIDFunc PartitionIDFunc, wsidFunc WSIDFunc, n10nFunc N10nFunc, secretReader isecrets.ISecretReader, eventFunc PLogEventFunc, intentsLimit int, stateOpts StateOpts) IHostState
+type SyncActualizerStateFactory func(vvmCtx context.Context, appStructsFunc AppStructsFunc, partitionIDFunc PartitionIDFunc, wsidFunc WSIDFunc, n10nFunc N10nFunc, secretReader isecrets.ISecretReader, eventFunc PLogEventFunc, principalsFunc PrincipalsFunc, intentsLimit int, stateOpts StateOpts) IHostState
 
 // requestCtx instead of vvmCtx because the query state is created per-request
 // other state factories receive vvmCtx for VVM-lifetime state.
@@ -72,6 +75,10 @@ type StateOpts struct {
 	FederationCommandHandler FederationCommandHandler
 	FederationBlobHandler    FederationBlobHandler
 	UniquesHandler           UniquesHandler
+
+	// ctx with log attributes of the current request or event, used by sys.Logger storage and federation calls
+	// nil -> ctx of the state
+	LogCtxFunc LogCtxFunc
 }
 
 type ApplyBatchItem struct {
@@ -0,0 +1,33 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package apikeys
+
+import (
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+)
+
+const (
+	field_Name             = "Name"
+	field_KeyHash          = "KeyHash"
+	field_Roles            = "Roles"
+	field_ExpiresAt        = "ExpiresAt"
+	field_ExpiresInSeconds = "ExpiresInSeconds"
+	field_APIKey           = "APIKey"
+	field_APIKeyID         = "APIKeyID"
+	field_Revoked          = "Revoked"
+
+	// the key is valid until revoked
+	apiKeyNoExpiration = 100 * 365 * 24 * time.Hour
+)
+
+var (
+	QNameCDocAPIKey        = appdef.NewQName(appdef.SysPackage, "APIKey")
+	qNameCmdIssueAPIKey    = appdef.NewQName(appdef.SysPackage, "IssueAPIKey")
+	qNameCmdRevokeAPIKey   = appdef.NewQName(appdef.SysPackage, "RevokeAPIKey")
+	qNameQryAPIKeys        = appdef.NewQName(appdef.SysPackage, "APIKeys")
+	qNameIssueAPIKeyResult = appdef.NewQName(appdef.SysPackage, "IssueAPIKeyResult")
+)
@@ -0,0 +1,18 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package apikeys
+
+import "errors"
+
+var (
+	ErrAPIKeyNameEmpty      = errors.New("API key name is empty")
+	ErrAPIKeyRolesEmpty     = errors.New("API key roles are empty")
+	ErrAPIKeyRoleInvalid    = errors.New("invalid API key role")
+	ErrAPIKeySystemRole     = errors.New("API key can not have a system role")
+	ErrAPIKeyRoleNotFound   = errors.New("API key role is not found in the workspace")
+	ErrAPIKeyRoleNotHeld    = errors.New("API key role is not held by the issuer")
+	ErrAPIKeyExpiration     = errors.New("API key expiration must not be negative")
+	ErrAPIKeyRevokedAlready = errors.New("API key is revoked already")
+)
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/acl"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/goutils/timeu"
+	"github.com/voedger/voedger/pkg/iauthnz"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/istructsmem"
+	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
+	"github.com/voedger/voedger/pkg/processors"
+	"github.com/voedger/voedger/pkg/sys"
+	"github.com/voedger/voedger/pkg/sys/collection"
+)
+
+// the key is returned once, only its hash is kept
+func provideExecCmdIssueAPIKey(tm timeu.ITime) istructsmem.ExecCommandClosure {
+	return func(args istructs.ExecCommandArgs) (err error) {
+		name := strings.TrimSpace(args.ArgumentObject.AsString(field_Name))
+		if len(name) == 0 {
+			return coreutils.NewHTTPError(http.StatusBadRequest, ErrAPIKeyNameEmpty)
+		}
+		roles, err := parseRoles(args.ArgumentObject.AsString(field_Roles), args.Workspace)
+		if err != nil {
+			return coreutils.NewHTTPError(http.StatusBadRequest, err)
+		}
+		issuerRoles := args.Workpiece.(processors.IProcessorWorkpiece).Roles()
+		for _, role := range roles {
+			if !isRoleHeld(role, issuerRoles, args.Workspace) {
+				return coreutils.NewHTTPError(http.StatusForbidden, fmt.Errorf("%w: %s", ErrAPIKeyRoleNotHeld, role))
+			}
+		}
+		expiresInSeconds := args.ArgumentObject.AsInt64(field_ExpiresInSeconds)
+		if expiresInSeconds < 0 {
+			return coreutils.NewHTTPError(http.StatusBadRequest, ErrAPIKeyExpiration)
+		}
+		duration := apiKeyNoExpiration
+		expiresAt := int64(0)
+		if expiresInSeconds > 0 {
+			duration = time.Duration(expiresInSeconds) * time.Second
+			expiresAt = tm.Now().Add(duration).UnixMilli()
+		}
+
+		apiKey, err := args.State.AppStructs().AppTokens().IssueToken(duration, &payloads.APIKeyPayload{
+			WSID:  args.WSID,
+			Nonce: rand.Text(),
+		})
+		if err != nil {
+			return err
+		}
+
+		kb, err := args.State.KeyBuilder(sys.Storage_Record, QNameCDocAPIKey)
+		if err != nil {
+			// notest
+			return err
+		}
+		cdocAPIKey, err := args.Intents.NewValue(kb)
+		if err != nil {
+			// notest
+			return err
+		}
+		cdocAPIKey.PutRecordID(appdef.SystemField_ID, 1)
+		cdocAPIKey.PutString(field_Name, name)
+		cdocAPIKey.PutString(field_KeyHash, KeyHash(apiKey))
+		cdocAPIKey.PutString(field_Roles, rolesToString(roles))
+		cdocAPIKey.PutInt64(field_ExpiresAt, expiresAt)
+
+		kb, err = args.State.KeyBuilder(sys.Storage_Result, qNameIssueAPIKeyResult)
+		if err != nil {
+			// notest
+			return err
+		}
+		result, err := args.Intents.NewValue(kb)
+		if err != nil {
+			// notest
+			return err
+		}
+		result.PutString(field_APIKey, apiKey)
+		return nil
+	}
+}
+
+// revoked key is kept to be listed
+func execCmdRevokeAPIKey(args istructs.ExecCommandArgs) (err error) {
+	kb, err := args.State.KeyBuilder(sys.Storage_Record, QNameCDocAPIKey)
+	if err != nil {
+		// notest
+		return err
+	}
+	kb.PutRecordID(sys.Storage_Record_Field_ID, args.ArgumentObject.AsRecordID(field_APIKeyID))
+	// existence is checked by the referential integrity already
+	cdocAPIKey, err := args.State.MustExist(kb)
+	if err != nil {
+		return err
+	}
+	if !cdocAPIKey.AsBool(appdef.SystemField_IsActive) {
+		return coreutils.NewHTTPError(http.StatusConflict, ErrAPIKeyRevokedAlready)
+	}
+	cdocAPIKeyUpdater, err := args.Intents.UpdateValue(kb, cdocAPIKey)
+	if err != nil {
+		// notest
+		return err
+	}
+	cdocAPIKeyUpdater.PutBool(appdef.SystemField_IsActive, false)
+	return nil
+}
+
+// lists both active and revoked keys of the workspace
+func execQryAPIKeys(_ context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
+	kb, err := args.State.KeyBuilder(sys.Storage_View, collection.QNameCollectionView)
+	if err != nil {
+		// notest
+		return err
+	}
+	kb.PutInt32(collection.Field_PartKey, collection.PartitionKeyCollection)
+	kb.PutQName(collection.Field_DocQName, QNameCDocAPIKey)
+	return args.State.Read(kb, func(_ istructs.IKey, value istructs.IStateValue) (err error) {
+		rec := value.(istructs.IStateViewValue).AsRecord(collection.Field_Record)
+		return callback(&apiKeysRR{
+			id:        rec.ID(),
+			name:      rec.AsString(field_Name),
+			roles:     rec.AsString(field_Roles),
+			expiresAt: rec.AsInt64(field_ExpiresAt),
+			revoked:   !rec.AsBool(appdef.SystemField_IsActive),
+		})
+	})
+}
+
+// the key must not have more rights than its issuer
+// the workspace owner may issue a key with any role of the workspace, the same as it may invite with any role
+func isRoleHeld(role appdef.QName, issuerRoles []appdef.QName, ws appdef.IWorkspace) bool {
+	for _, issuerRole := range issuerRoles {
+		if issuerRole == iauthnz.QNameRoleWorkspaceOwner || issuerRole == role {
+			return true
+		}
+		if r := appdef.Role(ws.Type, issuerRole); r != nil && slices.Contains(acl.RecursiveRoleAncestors(r, ws), role) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseRoles(rolesStr string, ws appdef.IWorkspace) (roles []appdef.QName, err error) {
+	for role := range strings.SplitSeq(rolesStr, ",") {
+		role = strings.TrimSpace(role)
+		if len(role) == 0 {
+			continue
+		}
+		qName, err := appdef.ParseQName(role)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %w", ErrAPIKeyRoleInvalid, role, err)
+		}
+		if iauthnz.IsSystemRole(qName) {
+			return nil, fmt.Errorf("%w: %s", ErrAPIKeySystemRole, role)
+		}
+		if appdef.Role(ws.Type, qName) == nil {
+			return nil, fmt.Errorf("%w: %s", ErrAPIKeyRoleNotFound, role)
+		}
+		if !slices.Contains(roles, qName) {
+			roles = append(roles, qName)
+		}
+	}
+	if len(roles) == 0 {
+		return nil, ErrAPIKeyRolesEmpty
+	}
+	return roles, nil
+}
@@ -0,0 +1,21 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package apikeys
+
+import (
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/goutils/timeu"
+	"github.com/voedger/voedger/pkg/istructsmem"
+)
+
+func Provide(sr istructsmem.IStatelessResources, time timeu.ITime) {
+	sr.AddCommands(appdef.SysPackagePath,
+		istructsmem.NewCommandFunction(qNameCmdIssueAPIKey, provideExecCmdIssueAPIKey(time)),
+		istructsmem.NewCommandFunction(qNameCmdRevokeAPIKey, execCmdRevokeAPIKey),
+	)
+	sr.AddQueries(appdef.SysPackagePath,
+		istructsmem.NewQueryFunction(qNameQryAPIKeys, execQryAPIKeys),
+	)
+}
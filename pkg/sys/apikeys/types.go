@@ -0,0 +1,27 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package apikeys
+
+import "github.com/voedger/voedger/pkg/istructs"
+
+// q.sys.APIKeys
+type apiKeysRR struct {
+	istructs.NullObject
+	id        istructs.RecordID
+	name      string
+	roles     string
+	expiresAt int64
+	revoked   bool
+}
+
+func (r *apiKeysRR) AsRecordID(string) istructs.RecordID { return r.id }
+func (r *apiKeysRR) AsInt64(string) int64                { return r.expiresAt }
+func (r *apiKeysRR) AsBool(string) bool                  { return r.revoked }
+func (r *apiKeysRR) AsString(name string) string {
+	if name == field_Name {
+		return r.name
+	}
+	return r.roles
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package apikeys
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/sys/uniques"
+)
+
+// KeyHash returns the hash the API key is kept by
+func KeyHash(apiKey string) string {
+	hash := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(hash[:])
+}
+
+// GetAPIKey returns the ID and the roles of the active API key issued in the workspace
+// returns NullRecordID if the key is not issued in the workspace or is revoked
+// the key signature and expiration must be validated by the caller
+func GetAPIKey(as istructs.IAppStructs, wsid istructs.WSID, apiKey string) (apiKeyID istructs.RecordID, roles []appdef.QName, err error) {
+	apiKeyID, err = uniques.GetRecordIDByUniqueCombination(wsid, QNameCDocAPIKey, as, map[string]interface{}{
+		field_KeyHash: KeyHash(apiKey),
+	})
+	if err != nil || apiKeyID == istructs.NullRecordID {
+		return istructs.NullRecordID, nil, err
+	}
+	cdocAPIKey, err := as.Records().Get(wsid, true, apiKeyID)
+	if err != nil {
+		// notest
+		return istructs.NullRecordID, nil, err
+	}
+	for role := range strings.SplitSeq(cdocAPIKey.AsString(field_Roles), ",") {
+		roleQName, err := appdef.ParseQName(role)
+		if err != nil {
+			// notest: validated on issue
+			return istructs.NullRecordID, nil, err
+		}
+		roles = append(roles, roleQName)
+	}
+	return apiKeyID, roles, nil
+}
+
+func rolesToString(roles []appdef.QName) string {
+	res := make([]string, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, role.String())
+	}
+	return strings.Join(res, ",")
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package audit
+
+import "github.com/voedger/voedger/pkg/appdef"
+
+// ///////////////////////////////////
+//
+//	VIEW: sys.RecordHistory
+const (
+	Field_RecordID     = "RecordID"
+	Field_WLogOffset   = "WLogOffset"
+	Field_QName        = "QName"
+	Field_RegisteredAt = "RegisteredAt"
+	Field_ProfileWSID  = "ProfileWSID"
+	Field_Principal    = "Principal"
+	Field_Changes      = "Changes"
+)
+
+var (
+	QNameViewRecordHistory      = appdef.NewQName(appdef.SysPackage, "RecordHistory")
+	qNameProjectorRecordHistory = appdef.NewQName(appdef.SysPackage, "ApplyRecordHistory")
+)
+
+// Keys of field change in Changes JSON
+const (
+	change_Old       = "old"
+	change_New       = "new"
+	change_Truncated = "truncated"
+)
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/sys"
+)
+
+// Writes old and new values of changed fields of audited records into sys.RecordHistory view
+func applyRecordHistory(event istructs.IPLogEvent, st istructs.IState, intents istructs.IIntents) (err error) {
+	appDef := st.AppStructs().AppDef()
+	var subject istructs.IStateValue
+	for rec := range event.CUDs {
+		r := appdef.Record(appDef.Type, rec.QName())
+		if r == nil || !r.Audit() {
+			continue
+		}
+
+		var changes map[appdef.FieldName]any
+		if rec.IsNew() || rec.Origin() == nil {
+			changes = specifiedFields(rec)
+		} else {
+			kb, err := st.KeyBuilder(sys.Storage_Record, rec.QName())
+			if err != nil {
+				return err
+			}
+			kb.PutRecordID(sys.Storage_Record_Field_ID, rec.ID())
+			current, err := st.MustExist(kb)
+			if err != nil {
+				return err
+			}
+			changes = changedFields(r, rec.Origin(), current)
+		}
+		if len(changes) == 0 {
+			continue
+		}
+
+		if subject == nil {
+			kb, err := st.KeyBuilder(sys.Storage_RequestSubject, appdef.NullQName)
+			if err != nil {
+				return err
+			}
+			if subject, err = st.MustExist(kb); err != nil {
+				return err
+			}
+		}
+
+		if err := writeHistory(event, rec, subject, changes, st, intents); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns new values of specified user fields.
+//
+// Used for created records and for updated records with unknown values before update
+func specifiedFields(rec istructs.ICUDRow) map[appdef.FieldName]any {
+	changes := make(map[appdef.FieldName]any)
+	rec.SpecifiedValues(func(f appdef.IField, _ any) bool {
+		if !f.IsSys() {
+			changes[f.Name()] = fieldChange(f, nil, coreutils.ReadByKind(f.Name(), f.DataKind(), rec), false)
+		}
+		return true
+	})
+	return changes
+}
+
+// Returns old and new values of changed fields of updated record
+func changedFields(r appdef.IRecord, origin, current istructs.IRowReader) map[appdef.FieldName]any {
+	changes := make(map[appdef.FieldName]any)
+	for _, f := range r.Fields() {
+		if f.IsSys() && f.Name() != appdef.SystemField_IsActive {
+			continue
+		}
+		oldValue := coreutils.ReadByKind(f.Name(), f.DataKind(), origin)
+		newValue := coreutils.ReadByKind(f.Name(), f.DataKind(), current)
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		changes[f.Name()] = fieldChange(f, oldValue, newValue, true)
+	}
+	return changes
+}
+
+// Returns change of field value. Values of encrypted fields are masked
+func fieldChange(f appdef.IField, oldValue, newValue any, withOld bool) map[string]any {
+	if f.Encrypted() {
+		oldValue, newValue = coreutils.MaskedValue, coreutils.MaskedValue
+	}
+	change := map[string]any{change_New: newValue}
+	if withOld {
+		change[change_Old] = oldValue
+	}
+	return change
+}
+
+func writeHistory(event istructs.IPLogEvent, rec istructs.ICUDRow, subject istructs.IStateValue, changes map[appdef.FieldName]any,
+	st istructs.IState, intents istructs.IIntents) error {
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	if len(data) > int(appdef.MaxFieldLength) {
+		// values are too long to be stored, only names of changed fields are kept
+		for n := range changes {
+			changes[n] = map[string]any{change_Truncated: true}
+		}
+		if data, err = json.Marshal(changes); err != nil {
+			// notest
+			return err
+		}
+	}
+
+	kb, err := st.KeyBuilder(sys.Storage_View, QNameViewRecordHistory)
+	if err != nil {
+		return err
+	}
+	kb.PutRecordID(Field_RecordID, rec.ID())
+	kb.PutInt64(Field_WLogOffset, int64(event.WLogOffset())) // nolint G115
+	vb, err := intents.NewValue(kb)
+	if err != nil {
+		return err
+	}
+	vb.PutQName(Field_QName, rec.QName())
+	vb.PutInt64(Field_RegisteredAt, int64(event.RegisteredAt()))
+	vb.PutInt64(Field_ProfileWSID, subject.AsInt64(sys.Storage_RequestSubject_Field_ProfileWSID))
+	vb.PutString(Field_Principal, subject.AsString(sys.Storage_RequestSubject_Field_Name))
+	vb.PutString(Field_Changes, string(data))
+	return nil
+}
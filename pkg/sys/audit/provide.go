@@ -0,0 +1,18 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package audit
+
+import (
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/istructsmem"
+)
+
+func Provide(sr istructsmem.IStatelessResources) {
+	sr.AddProjectors(appdef.SysPackagePath, istructs.Projector{
+		Name: qNameProjectorRecordHistory,
+		Func: applyRecordHistory,
+	})
+}
@@ -28,6 +28,7 @@ const (
 	Field_AppName                   = "AppName"
 	Field_Email                     = "Email" // c.registry.CreateEmailLogin.Email
 	DefaultPrincipalTokenExpiration = time.Hour
+	TOTPChallengeExpiration         = 5 * time.Minute // the second sign in step must be done within
 )
 
 var (
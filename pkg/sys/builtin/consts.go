@@ -21,6 +21,34 @@ const (
 	MaxCUDs             = 100
 )
 
+// q.sys.SlowRequests
+var qNameQuerySlowRequests = appdef.NewQName(appdef.SysPackage, "SlowRequests")
+
+const (
+	field_Count            = "Count"
+	field_StartMs          = "StartMs"
+	field_Kind             = "Kind"
+	field_App              = "App"
+	field_WSID             = "WSID"
+	field_DurationMs       = "DurationMs"
+	field_Stages           = "Stages"
+	field_Error            = "Error"
+	field_ReqQName         = "QName"
+	slowRequestErrorMaxLen = 1024
+)
+
+// q.sys.StorageCache
+var qNameQueryStorageCache = appdef.NewQName(appdef.SysPackage, "StorageCache")
+
+const (
+	field_Flush     = "Flush"
+	field_MaxBytes  = "MaxBytes"
+	field_Bytes     = "Bytes"
+	field_Entries   = "Entries"
+	field_Evictions = "Evictions"
+	field_Tables    = "Tables"
+)
+
 // Records registry view
 var (
 	QNameViewRecordsRegistry      = sys.RecordsRegistryView.Name
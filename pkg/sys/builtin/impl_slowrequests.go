@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package builtin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/iauthnz"
+	"github.com/voedger/voedger/pkg/istructs"
+	istructsmem "github.com/voedger/voedger/pkg/istructsmem"
+	"github.com/voedger/voedger/pkg/processors"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
+)
+
+// q.sys.SlowRequests returns the last slow commands and queries of the VVM, the most recent first
+// system principal only: the result covers all apps of the VVM
+func provideQrySlowRequests(sr istructsmem.IStatelessResources, slowLog slowlog.ISlowLog) {
+	sr.AddQueries(appdef.SysPackagePath, istructsmem.NewQueryFunction(
+		qNameQuerySlowRequests,
+		func(_ context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
+			if !iauthnz.IsSystemPrincipal(args.Workpiece.(processors.IProcessorWorkpiece).GetPrincipals(), args.WSID) {
+				return coreutils.NewHTTPErrorf(http.StatusForbidden, "system principal only")
+			}
+			count := int(args.ArgumentObject.AsInt32(field_Count))
+			for i, req := range slowLog.Last() {
+				if count > 0 && i >= count {
+					break
+				}
+				rr, err := newSlowRequestRR(req)
+				if err != nil {
+					// notest
+					return err
+				}
+				if err := callback(rr); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	))
+}
+
+type slowRequestStage struct {
+	Name       string `json:"name"`
+	DurationUs int64  `json:"durationUs"`
+}
+
+func newSlowRequestRR(req slowlog.Request) (*slowRequestRR, error) {
+	stages := make([]slowRequestStage, 0, len(req.Stages))
+	for _, stage := range req.Stages {
+		stages = append(stages, slowRequestStage{Name: stage.Name, DurationUs: stage.Duration.Microseconds()})
+	}
+	stagesJSON, err := json.Marshal(stages)
+	if err != nil {
+		// notest
+		return nil, err
+	}
+	rr := &slowRequestRR{
+		int64s: map[string]int64{
+			field_StartMs:    req.Start.UnixMilli(),
+			field_WSID:       int64(req.WSID), // nolint G115
+			field_DurationMs: req.Duration.Milliseconds(),
+		},
+		strings: map[string]string{
+			field_Kind:     req.Kind,
+			field_App:      req.App.String(),
+			field_ReqQName: req.QName.String(),
+			field_Stages:   string(stagesJSON),
+		},
+	}
+	if req.Err != nil {
+		errStr := req.Err.Error()
+		if len(errStr) > slowRequestErrorMaxLen {
+			errStr = errStr[:slowRequestErrorMaxLen]
+		}
+		rr.strings[field_Error] = errStr
+	}
+	return rr, nil
+}
+
+type slowRequestRR struct {
+	istructs.NullObject
+	int64s  map[string]int64
+	strings map[string]string
+}
+
+func (rr *slowRequestRR) AsInt64(name string) int64   { return rr.int64s[name] }
+func (rr *slowRequestRR) AsString(name string) string { return rr.strings[name] }
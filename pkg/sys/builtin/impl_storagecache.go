@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package builtin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/iauthnz"
+	"github.com/voedger/voedger/pkg/istorage"
+	"github.com/voedger/voedger/pkg/istoragecache"
+	"github.com/voedger/voedger/pkg/istructs"
+	istructsmem "github.com/voedger/voedger/pkg/istructsmem"
+	"github.com/voedger/voedger/pkg/processors"
+)
+
+// q.sys.StorageCache returns the storage cache stats of the app, the cache is flushed after that if Flush is true
+// system principal only: any app of the VVM could be inspected
+func provideQryStorageCache(sr istructsmem.IStatelessResources, asp istorage.IAppStorageProvider) {
+	sr.AddQueries(appdef.SysPackagePath, istructsmem.NewQueryFunction(
+		qNameQueryStorageCache,
+		func(_ context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
+			if !iauthnz.IsSystemPrincipal(args.Workpiece.(processors.IProcessorWorkpiece).GetPrincipals(), args.WSID) {
+				return coreutils.NewHTTPErrorf(http.StatusForbidden, "system principal only")
+			}
+			cacheAdmin, ok := asp.(istoragecache.IStorageCacheAdmin)
+			if !ok {
+				// notest
+				return coreutils.NewHTTPErrorf(http.StatusNotImplemented, "storage cache is not used")
+			}
+			app, err := appdef.ParseAppQName(args.ArgumentObject.AsString(field_App))
+			if err != nil {
+				return coreutils.NewHTTPError(http.StatusBadRequest, err)
+			}
+			stats, err := cacheAdmin.AppStats(app)
+			if err != nil {
+				if errors.Is(err, istoragecache.ErrAppNotCached) {
+					return coreutils.NewHTTPError(http.StatusNotFound, err)
+				}
+				// notest
+				return err
+			}
+			if args.ArgumentObject.AsBool(field_Flush) {
+				if err := cacheAdmin.Flush(app); err != nil {
+					// notest
+					return err
+				}
+			}
+			rr, err := newStorageCacheRR(stats)
+			if err != nil {
+				// notest
+				return err
+			}
+			return callback(rr)
+		},
+	))
+}
+
+type storageCacheTable struct {
+	Table        string `json:"table"`
+	Hits         uint64 `json:"hits"`
+	NegativeHits uint64 `json:"negativeHits"`
+	Misses       uint64 `json:"misses"`
+}
+
+func newStorageCacheRR(stats istoragecache.AppStats) (*storageCacheRR, error) {
+	tables := make([]storageCacheTable, 0, len(stats.Tables))
+	for table, tableStats := range stats.Tables {
+		tables = append(tables, storageCacheTable{
+			Table:        table.String(),
+			Hits:         tableStats.Hits,
+			NegativeHits: tableStats.NegativeHits,
+			Misses:       tableStats.Misses,
+		})
+	}
+	slices.SortFunc(tables, func(a, b storageCacheTable) int { return strings.Compare(a.Table, b.Table) })
+	tablesJSON, err := json.Marshal(tables)
+	if err != nil {
+		// notest
+		return nil, err
+	}
+	return &storageCacheRR{
+		int64s: map[string]int64{
+			field_MaxBytes:  int64(stats.MaxBytes),  // nolint G115
+			field_Bytes:     int64(stats.Bytes),     // nolint G115
+			field_Entries:   int64(stats.Entries),   // nolint G115
+			field_Evictions: int64(stats.Evictions), // nolint G115
+		},
+		tables: string(tablesJSON),
+	}, nil
+}
+
+type storageCacheRR struct {
+	istructs.NullObject
+	int64s map[string]int64
+	tables string
+}
+
+func (rr *storageCacheRR) AsInt64(name string) int64 { return rr.int64s[name] }
+func (rr *storageCacheRR) AsString(name string) string {
+	if name == field_Tables {
+		return rr.tables
+	}
+	return ""
+}
@@ -11,11 +11,13 @@ import (
 	"github.com/voedger/voedger/pkg/istorage"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 )
 
-func Provide(sr istructsmem.IStatelessResources, buildInfo *debug.BuildInfo, asp istorage.IAppStorageProvider) {
+func Provide(sr istructsmem.IStatelessResources, buildInfo *debug.BuildInfo, asp istorage.IAppStorageProvider, slowLog slowlog.ISlowLog) {
 	sr.AddCommands(appdef.SysPackagePath,
 		istructsmem.NewCommandFunction(istructs.QNameCommandCUD, istructsmem.NullCommandExec),
+		istructsmem.NewCommandFunction(istructs.QNameCommandBatch, istructsmem.NullCommandExec),
 
 		// Deprecated: use c.sys.CUD instead. Kept for backward compatibility only
 		// to import via ImportBO
@@ -27,6 +29,8 @@ func Provide(sr istructsmem.IStatelessResources, buildInfo *debug.BuildInfo, asp
 
 	provideQryEcho(sr)
 	provideQryGRCount(sr)
+	provideQrySlowRequests(sr, slowLog)
+	provideQryStorageCache(sr, asp)
 	proivideRenameQName(sr, asp)
 }
 
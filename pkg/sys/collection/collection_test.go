@@ -40,6 +40,8 @@ import (
 	imetrics "github.com/voedger/voedger/pkg/metrics"
 	"github.com/voedger/voedger/pkg/processors/actualizers"
 	queryprocessor "github.com/voedger/voedger/pkg/processors/query"
+	"github.com/voedger/voedger/pkg/processors/querycache"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys"
 	"github.com/voedger/voedger/pkg/vvm/engines"
@@ -490,14 +492,14 @@ func TestBasicUsage_QueryFunc_Collection(t *testing.T) {
 					}`)
 	serviceChannel := make(iprocbus.ServiceChannel)
 
-	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
+	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestAPIKeyGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
 	tokens := itokensjwt.TestTokensJWT()
 	appTokens := payloads.ProvideIAppTokensFactory(tokens).New(test.appQName)
 	queryProcessor := queryprocessor.ProvideServiceFactory()(
 		serviceChannel,
 		appParts,
 		maxPrepareQueries,
-		imetrics.Provide(), "vvm", authn, tokens, nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil)
+		imetrics.Provide(), "vvm", authn, tokens, nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil, slowlog.Provide(slowlog.Config{}), querycache.Provide(querycache.Config{}, timeu.NewITime()))
 	go queryProcessor.Run(context.Background())
 	sysToken, err := payloads.GetSystemPrincipalTokenApp(appTokens)
 	require.NoError(err)
@@ -607,11 +609,11 @@ func TestBasicUsage_QueryFunc_CDoc(t *testing.T) {
 
 	serviceChannel := make(iprocbus.ServiceChannel)
 
-	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
+	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestAPIKeyGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
 	tokens := itokensjwt.TestTokensJWT()
 	appTokens := payloads.ProvideIAppTokensFactory(tokens).New(test.appQName)
 	queryProcessor := queryprocessor.ProvideServiceFactory()(serviceChannel, appParts, maxPrepareQueries, imetrics.Provide(),
-		"vvm", authn, tokens, nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil)
+		"vvm", authn, tokens, nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil, slowlog.Provide(slowlog.Config{}), querycache.Provide(querycache.Config{}, timeu.NewITime()))
 
 	go queryProcessor.Run(context.Background())
 	sysToken, err := payloads.GetSystemPrincipalTokenApp(appTokens)
@@ -725,11 +727,11 @@ func TestBasicUsage_State(t *testing.T) {
 
 	serviceChannel := make(iprocbus.ServiceChannel)
 
-	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
+	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestAPIKeyGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
 	tokens := itokensjwt.TestTokensJWT()
 	appTokens := payloads.ProvideIAppTokensFactory(tokens).New(test.appQName)
 	queryProcessor := queryprocessor.ProvideServiceFactory()(serviceChannel, appParts, maxPrepareQueries, imetrics.Provide(),
-		"vvm", authn, tokens, nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil)
+		"vvm", authn, tokens, nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil, slowlog.Provide(slowlog.Config{}), querycache.Provide(querycache.Config{}, timeu.NewITime()))
 
 	go queryProcessor.Run(context.Background())
 	sysToken, err := payloads.GetSystemPrincipalTokenApp(appTokens)
@@ -893,11 +895,11 @@ func TestState_withAfterArgument(t *testing.T) {
 
 	serviceChannel := make(iprocbus.ServiceChannel)
 
-	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
+	authn := iauthnzimpl.NewDefaultAuthenticator(iauthnzimpl.TestSubjectRolesGetter, iauthnzimpl.TestAPIKeyGetter, iauthnzimpl.TestIsDeviceAllowedFuncs)
 	tokens := itokensjwt.TestTokensJWT()
 	appTokens := payloads.ProvideIAppTokensFactory(tokens).New(test.appQName)
 	queryProcessor := queryprocessor.ProvideServiceFactory()(serviceChannel, appParts, maxPrepareQueries, imetrics.Provide(),
-		"vvm", authn, tokens, nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil)
+		"vvm", authn, tokens, nil, statelessResources, isecretsimpl.TestSecretReader, state.StateOpts{}, nil, slowlog.Provide(slowlog.Config{}), querycache.Provide(querycache.Config{}, timeu.NewITime()))
 
 	go queryProcessor.Run(context.Background())
 	sysToken, err := payloads.GetSystemPrincipalTokenApp(appTokens)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appparts"
+	"github.com/voedger/voedger/pkg/iauthnz"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/pipeline"
 )
@@ -150,6 +151,8 @@ func (w *testCmdWorkpiece) Context() context.Context    { return context.Backgro
 func (w *testCmdWorkpiece) PLogOffset() istructs.Offset { return 0 }
 func (w *testCmdWorkpiece) LogCtx() context.Context     { return context.Background() }
 
+func (w *testCmdWorkpiece) GetPrincipals() []iauthnz.Principal { return nil }
+
 type testCmdProc struct {
 	pipeline.ISyncPipeline
 	appParts  appparts.IAppPartitions
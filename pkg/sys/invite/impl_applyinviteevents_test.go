@@ -30,6 +30,7 @@ import (
 	"github.com/voedger/voedger/pkg/sys"
 	"github.com/voedger/voedger/pkg/sys/authnz"
 	"github.com/voedger/voedger/pkg/sys/smtp"
+	"github.com/voedger/voedger/pkg/tracing"
 )
 
 const (
@@ -295,7 +296,7 @@ func projectInviteReplacement(t *testing.T, input joinReplacementInput) []captur
 		return serverURL
 	}, func() int {
 		return 0
-	}, nil)
+	}, nil, tracing.NewNoopTracer())
 	t.Cleanup(cleanup)
 
 	currentInviteKey := newApplyInviteEventsKeyBuilder()
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package sys_it
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/istructs"
+	it "github.com/voedger/voedger/pkg/vit"
+)
+
+func TestBasicUsage_APIKeys(t *testing.T) {
+	require := require.New(t)
+	vit := it.NewVIT(t, &it.SharedConfig_App1)
+	defer vit.TearDown()
+
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+	issue := func(name string, expiresInSeconds int) (apiKey string, apiKeyID istructs.RecordID) {
+		body := fmt.Sprintf(`{"args":{"Name":%q,"Roles":"app1pkg.ApiRole","ExpiresInSeconds":%d}}`, name, expiresInSeconds)
+		resp := vit.PostWS(ws, "c.sys.IssueAPIKey", body)
+		return resp.CmdResult["APIKey"].(string), resp.NewID()
+	}
+	categoryID := vit.PostWS(ws, "c.sys.CUD", `{"cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.category","name":"API keys"}}]}`).NewID()
+	sqlQueryBody := fmt.Sprintf(`{"args":{"Query":"select * from app1pkg.category.%d"}}`, categoryID)
+	collectionBody := `{"args":{"Schema":"app1pkg.articles"}}`
+	apiKeysBody := `{"elements":[{"fields":["APIKeyID","Name","Roles","ExpiresAt","Revoked"]}]}`
+
+	apiKey, apiKeyID := issue("integration", 0)
+
+	t.Run("API key has the roles of the key only", func(t *testing.T) {
+		// granted to app1pkg.ApiRole
+		vit.PostWS(ws, "q.sys.SqlQuery", sqlQueryBody, httpu.WithAuthorizeBy(apiKey))
+
+		// granted to sys.WorkspaceOwner only
+		vit.PostWS(ws, "q.sys.Collection", collectionBody, httpu.WithAuthorizeBy(apiKey), httpu.Expect403())
+		vit.PostWS(ws, "q.sys.APIKeys", apiKeysBody, httpu.WithAuthorizeBy(apiKey), httpu.Expect403())
+		vit.PostWS(ws, "c.sys.IssueAPIKey", `{"args":{"Name":"escalated","Roles":"app1pkg.ApiRole"}}`,
+			httpu.WithAuthorizeBy(apiKey), httpu.Expect403())
+	})
+
+	t.Run("403 on role not held by the issuer", func(t *testing.T) {
+		body := `{"args":{"Name":"issuer","Roles":"app1pkg.ApiKeyIssuerRole"}}`
+		issuerKey := vit.PostWS(ws, "c.sys.IssueAPIKey", body).CmdResult["APIKey"].(string)
+		vit.PostWS(ws, "c.sys.IssueAPIKey", `{"args":{"Name":"escalated","Roles":"app1pkg.ApiRole"}}`,
+			httpu.WithAuthorizeBy(issuerKey), it.Expect403("API key role is not held by the issuer: app1pkg.ApiRole"))
+		vit.PostWS(ws, "c.sys.IssueAPIKey", body, httpu.WithAuthorizeBy(issuerKey))
+	})
+
+	t.Run("API key is valid in its workspace only", func(t *testing.T) {
+		anotherWS := vit.WS(istructs.AppQName_test1_app1, "test_ws_another")
+		vit.PostWS(anotherWS, "q.sys.SqlQuery", sqlQueryBody, httpu.WithAuthorizeBy(apiKey), httpu.Expect401())
+	})
+
+	t.Run("list", func(t *testing.T) {
+		resp := vit.PostWS(ws, "q.sys.APIKeys", apiKeysBody)
+		found := false
+		for i := range resp.NumRows() {
+			row := resp.SectionRow(i)
+			if istructs.RecordID(row[0].(float64)) != apiKeyID {
+				continue
+			}
+			found = true
+			require.Equal("integration", row[1])
+			require.Equal("app1pkg.ApiRole", row[2])
+			require.Equal(0.0, row[3])
+			require.False(row[4].(bool))
+		}
+		require.True(found)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		vit.PostWS(ws, "c.sys.RevokeAPIKey", fmt.Sprintf(`{"args":{"APIKeyID":%d}}`, apiKeyID))
+		vit.PostWS(ws, "q.sys.SqlQuery", sqlQueryBody, httpu.WithAuthorizeBy(apiKey), httpu.Expect401())
+
+		// revoked already
+		vit.PostWS(ws, "c.sys.RevokeAPIKey", fmt.Sprintf(`{"args":{"APIKeyID":%d}}`, apiKeyID), httpu.Expect409())
+
+		// revoked key is still listed
+		resp := vit.PostWS(ws, "q.sys.APIKeys", apiKeysBody)
+		for i := range resp.NumRows() {
+			if row := resp.SectionRow(i); istructs.RecordID(row[0].(float64)) == apiKeyID {
+				require.True(row[4].(bool))
+			}
+		}
+	})
+
+	t.Run("expiration", func(t *testing.T) {
+		expiringKey, _ := issue("expiring", 3600)
+		vit.PostWS(ws, "q.sys.SqlQuery", sqlQueryBody, httpu.WithAuthorizeBy(expiringKey))
+		vit.TimeAdd(2 * time.Hour)
+		vit.PostWS(ws, "q.sys.SqlQuery", sqlQueryBody, httpu.WithAuthorizeBy(expiringKey), httpu.Expect401())
+	})
+
+	t.Run("issue errors", func(t *testing.T) {
+		cases := map[string]string{
+			"empty name":        `{"args":{"Name":"","Roles":"app1pkg.ApiRole"}}`,
+			"empty roles":       `{"args":{"Name":"n","Roles":""}}`,
+			"system role":       `{"args":{"Name":"n","Roles":"sys.WorkspaceOwner"}}`,
+			"unknown role":      `{"args":{"Name":"n","Roles":"app1pkg.Unknown"}}`,
+			"wrong role":        `{"args":{"Name":"n","Roles":"wrong"}}`,
+			"negative duration": `{"args":{"Name":"n","Roles":"app1pkg.ApiRole","ExpiresInSeconds":-1}}`,
+		}
+		for name, body := range cases {
+			t.Run(name, func(t *testing.T) {
+				vit.PostWS(ws, "c.sys.IssueAPIKey", body, httpu.Expect400())
+			})
+		}
+		t.Run("revoke unknown key", func(t *testing.T) {
+			vit.PostWS(ws, "c.sys.RevokeAPIKey", `{"args":{"APIKeyID":123456}}`, httpu.Expect400())
+		})
+	})
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package sys_it
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/istructs"
+	it "github.com/voedger/voedger/pkg/vit"
+)
+
+func TestBasicUsage_CommandProcessorV2_Batch(t *testing.T) {
+	vit := it.NewVIT(t, &it.SharedConfig_App1)
+	defer vit.TearDown()
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+	batchURL := fmt.Sprintf("api/v2/apps/test1/app1/workspaces/%d/batch", ws.WSID)
+
+	t.Run("basic usage", func(t *testing.T) {
+		cdoc1Batch := `{"commands":[{"command":"sys.CUD","cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.cdoc1"}}]}]}`
+		resp := vit.POST(batchURL, cdoc1Batch, httpu.WithAuthorizeBy(ws.Owner.Token))
+		offsetBefore := batchResult(t, resp.Body)["currentWLogOffset"].(float64)
+
+		// raw IDs created by the previous commands are referenced in cuds and as the ID of the record to update
+		body := `{"commands":[
+			{"command":"sys.CUD","cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.cdoc1"}}]},
+			{"command":"sys.CUD","cuds":[{"fields":{"sys.ID":2,"sys.QName":"app1pkg.cdoc2","field2":1}}]},
+			{"command":"app1pkg.TestCmd","args":{"Arg1":1}},
+			{"command":"app1pkg.TestCmd","args":{"Arg1":2}},
+			{"command":"sys.CUD","cuds":[{"sys.ID":2,"fields":{"field3":1}}]}
+		]}`
+		resp = vit.POST(batchURL, body, httpu.WithAuthorizeBy(ws.Owner.Token))
+		resp.Println()
+
+		// the whole batch is the single event
+		result := batchResult(t, resp.Body)
+		require.Equal(t, offsetBefore+1, result["currentWLogOffset"].(float64))
+		newIDs := result["newIDs"].(map[string]interface{})
+		cdoc1ID := newIDs["1"].(float64)
+		cdoc2ID := newIDs["2"].(float64)
+
+		// results of the commands in the order of the request
+		require.Equal(t, []interface{}{
+			nil,
+			nil,
+			map[string]interface{}{"Int": float64(42), "Str": "Str", appdef.SystemField_QName: "app1pkg.TestCmdResult"},
+			map[string]interface{}{"Int": float64(42), appdef.SystemField_QName: "app1pkg.TestCmdResult"},
+			nil,
+		}, result["results"])
+
+		resp = vit.GET(fmt.Sprintf("api/v2/apps/test1/app1/workspaces/%d/docs/app1pkg.cdoc2/%d", ws.WSID, istructs.RecordID(cdoc2ID)),
+			httpu.WithAuthorizeBy(ws.Owner.Token))
+		cdoc2 := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal([]byte(resp.Body), &cdoc2))
+		require.Equal(t, cdoc1ID, cdoc2["field2"])
+		require.Equal(t, cdoc1ID, cdoc2["field3"])
+	})
+
+	t.Run("nothing is stored if any command fails", func(t *testing.T) {
+		cdoc1Batch := `{"commands":[{"command":"sys.CUD","cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.cdoc1"}}]}]}`
+		resp := vit.POST(batchURL, cdoc1Batch, httpu.WithAuthorizeBy(ws.Owner.Token))
+		offsetBefore := batchResult(t, resp.Body)["currentWLogOffset"].(float64)
+
+		body := `{"commands":[
+			{"command":"sys.CUD","cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.cdoc1"}}]},
+			{"command":"sys.CUD","cuds":[{"sys.ID":100500,"fields":{"field3":1}}]},
+			{"command":"sys.CUD","cuds":[{"fields":{"sys.ID":3,"sys.QName":"app1pkg.cdoc1"}}]}
+		]}`
+		vit.POST(batchURL, body, httpu.WithAuthorizeBy(ws.Owner.Token), it.Expect404()).Println()
+
+		// the command is executed after the previous ones and fails
+		body = `{"commands":[
+			{"command":"sys.CUD","cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.cdoc1"}}]},
+			{"command":"app1pkg.TestCmd","args":{"Arg1":1}},
+			{"command":"app1pkg.TestCmd","args":{"Arg1":4}}
+		]}`
+		vit.POST(batchURL, body, httpu.WithAuthorizeBy(ws.Owner.Token), it.Expect500("commands[2]")).Println()
+
+		// no event is written by the failed batches
+		resp = vit.POST(batchURL, cdoc1Batch, httpu.WithAuthorizeBy(ws.Owner.Token))
+		require.Equal(t, offsetBefore+1, batchResult(t, resp.Body)["currentWLogOffset"].(float64))
+	})
+
+	t.Run("403 forbidden if any command is not allowed", func(t *testing.T) {
+		body := `{"commands":[
+			{"command":"sys.CUD","cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.cdoc1"}}]},
+			{"command":"app1pkg.TestDeniedCmd"}
+		]}`
+		vit.POST(batchURL, body, httpu.WithAuthorizeBy(ws.Owner.Token), it.Expect403("commands[1]")).Println()
+	})
+
+	t.Run("400 bad request before any command is executed", func(t *testing.T) {
+		cases := map[string]string{
+			`{"commands":[]}`:                              "no commands in the batch",
+			`{"commands":[{"args":{}}]}`:                   `commands[0]: field "command" missing`,
+			`{"commands":[{"command":"app1pkg.Unknown"}]}`: "commands[0]: command app1pkg.Unknown is not found",
+			`{"commands":[
+				{"command":"sys.CUD","cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.cdoc1"}}]},
+				{"command":"sys.CUD","cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.cdoc1"}}]}
+			]}`: "commands[1]: raw ID 1 is already declared in commands[0]",
+			`{"commands":[{"command":"app1pkg.CmdODocTwo","args":{"sys.ID":1}}]}`:      "commands[0]: command app1pkg.CmdODocTwo with app1pkg.odoc2 argument could not be executed in a batch",
+			`{"commands":[{"command":"sys.Batch","args":{"Commands":"[]"}}]}`:          "commands[0]: command sys.Batch could not be executed in a batch",
+			`{"commands":[{"command":"app1pkg.TestCmd","args":{"Arg1":1},"cuds":[]}]}`: "commands[0]: CUDs allowed for sys.CUD command only",
+		}
+		for body, expectedMessage := range cases {
+			vit.POST(batchURL, body, httpu.WithAuthorizeBy(ws.Owner.Token), it.Expect400(expectedMessage)).Println()
+		}
+	})
+}
+
+func batchResult(t *testing.T, body string) map[string]interface{} {
+	result := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal([]byte(body), &result))
+	return result
+}
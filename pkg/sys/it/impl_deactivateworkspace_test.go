@@ -6,6 +6,7 @@
 package sys_it
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -15,10 +16,13 @@ import (
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/coreutils/federation"
 	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/iblobstorage"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/sys/authnz"
 	"github.com/voedger/voedger/pkg/sys/invite"
+	"github.com/voedger/voedger/pkg/sys/workspace"
 	it "github.com/voedger/voedger/pkg/vit"
 )
 
@@ -123,3 +127,95 @@ func TestDeactivateJoinedWorkspace(t *testing.T) {
 	require.NoError(json.Unmarshal(jsonBytes, &cdocWorkspaceID))
 	require.False(cdocWorkspaceID[appdef.SystemField_IsActive].(bool))
 }
+
+func TestPurgeDeactivatedWorkspace(t *testing.T) {
+	require := require.New(t)
+	cfg := it.NewOwnVITConfig(
+		it.WithApp(istructs.AppQName_test1_app1, it.ProvideApp1, it.WithUserLogin(it.TestEmail, "1")),
+	)
+	vit := it.NewVIT(t, &cfg)
+	defer vit.TearDown()
+
+	prn := vit.GetPrincipal(istructs.AppQName_test1_app1, it.TestEmail)
+	ws := vit.CreateWorkspace(it.SimpleWSParams(vit.NextName()), prn)
+	sysToken := vit.GetSystemPrincipal(istructs.AppQName_test1_app1).Token
+
+	// fill the workspace with the data
+	vit.PostWS(ws, "c.sys.CUD", `{"cuds":[{"fields":{"sys.QName":"app1pkg.computers","sys.ID":1}}]}`)
+	blobID := vit.UploadBLOB(istructs.AppQName_test1_app1, ws.WSID, "test", httpu.ContentType_ApplicationXBinary, []byte{1, 2, 3},
+		it.QNameDocWithBLOB, it.Field_Blob, httpu.WithAuthorizeBy(ws.Owner.Token))
+	blobKey := iblobstorage.PersistentBLOBKeyType{
+		ClusterAppID: istructs.ClusterAppID_test1_app1,
+		WSID:         ws.WSID,
+		BlobID:       blobID,
+	}
+
+	// deactivate -> the workspace is scheduled to purge in its app workspace
+	vit.PostWS(ws, "c.sys.InitiateDeactivateWorkspace", "{}")
+	waitForDeactivate(vit, ws.Owner.AppQName, ws.WSID, ws.Name)
+
+	as, err := vit.BuiltIn(istructs.AppQName_test1_app1)
+	require.NoError(err)
+	appWSID := coreutils.PseudoWSIDToAppWSID(ws.WSID, as.NumAppWorkspaces())
+	queryView := func(query string) *federation.FuncResponse {
+		body := fmt.Sprintf(`{"args":{"Query":%q},"elements":[{"fields":["Result"]}]}`, query)
+		return vit.PostApp(istructs.AppQName_test1_app1, appWSID, "q.sys.SqlQuery", body, httpu.WithAuthorizeBy(sysToken))
+	}
+	waitForView := func(query string) map[string]interface{} {
+		deadline := it.TestDeadline()
+		for time.Now().Before(deadline) {
+			if resp := queryView(query); !resp.IsEmpty() {
+				res := map[string]interface{}{}
+				require.NoError(json.Unmarshal([]byte(resp.SectionRow()[0].(string)), &res))
+				return res
+			}
+			time.Sleep(awaitTime)
+		}
+		t.Fatal("no result for", query)
+		return nil
+	}
+	toPurgeQuery := fmt.Sprintf("select * from sys.WorkspacesToPurge where Dummy = 1 and PurgedWSID = %d", ws.WSID)
+	toPurge := waitForView(toPurgeQuery)
+	require.Equal(ws.Name, toPurge["WSName"])
+
+	purgedQuery := fmt.Sprintf("select * from sys.PurgedWorkspaces where PurgedWSID = %d", ws.WSID)
+
+	t.Run("workspace is not purged before the purge delay", func(t *testing.T) {
+		vit.SchedulerTimeAdd(vit.Now().Sub(vit.SchedulerNow()) + time.Hour)
+		time.Sleep(time.Second)
+		require.True(queryView(purgedQuery).IsEmpty())
+	})
+
+	// the job uses the scheduler time which could differ from vit.Now()
+	vit.SchedulerTimeAdd(vit.Now().Add(workspace.PurgeDelay).Sub(vit.SchedulerNow()) + time.Hour)
+	tombstone := waitForView(purgedQuery)
+	require.Equal(ws.Name, tombstone["WSName"])
+	require.Positive(tombstone["Records"])
+	require.Positive(tombstone["Events"])
+	require.EqualValues(1, tombstone["BLOBs"])
+
+	t.Run("workspace data is deleted", func(t *testing.T) {
+		wLogEvents := 0
+		require.NoError(as.Events().ReadWLog(context.Background(), ws.WSID, istructs.FirstOffset, istructs.ReadToTheEnd,
+			func(istructs.Offset, istructs.IWLogEvent) error {
+				wLogEvents++
+				return nil
+			}))
+		require.Zero(wLogEvents)
+
+		_, err := vit.IBLOBStorage.QueryBLOBState(context.Background(), &blobKey)
+		require.ErrorIs(err, iblobstorage.ErrBLOBNotFound)
+	})
+
+	t.Run("workspace is removed from the purge queue by the next job run", func(t *testing.T) {
+		vit.SchedulerTimeAdd(time.Hour)
+		deadline := it.TestDeadline()
+		for !queryView(toPurgeQuery).IsEmpty() {
+			if time.Now().After(deadline) {
+				t.Fatal("workspace is not removed from sys.WorkspacesToPurge")
+			}
+			time.Sleep(awaitTime)
+		}
+		require.False(queryView(purgedQuery).IsEmpty(), "tombstone should be kept")
+	})
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package sys_it
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/istructs"
+	it "github.com/voedger/voedger/pkg/vit"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	vit := it.NewVIT(t, &it.SharedConfig_App1)
+	defer vit.TearDown()
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+	cmdURL := fmt.Sprintf("api/v2/apps/test1/app1/workspaces/%d/commands/sys.CUD", ws.WSID)
+	body := `{"cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.cdoc1"}}]}`
+	idempotencyKey := vit.NextName()
+
+	resp := vit.POST(cmdURL, body, httpu.WithAuthorizeBy(ws.Owner.Token), httpu.WithHeaders(httpu.IdempotencyKey, idempotencyKey))
+	require.Equal(t, http.StatusOK, resp.HTTPResp.StatusCode)
+	firstIDs := newIDs(t, resp)
+
+	t.Run("repeated request returns the original result", func(t *testing.T) {
+		repeatedResp := vit.POST(cmdURL, body, httpu.WithAuthorizeBy(ws.Owner.Token), httpu.WithHeaders(httpu.IdempotencyKey, idempotencyKey))
+		require.Equal(t, http.StatusOK, repeatedResp.HTTPResp.StatusCode)
+		require.JSONEq(t, resp.Body, repeatedResp.Body)
+	})
+
+	t.Run("422 on the same key with another request", func(t *testing.T) {
+		anotherBody := `{"cuds":[{"fields":{"sys.ID":2,"sys.QName":"app1pkg.cdoc1"}}]}`
+		vit.POST(cmdURL, anotherBody, httpu.WithAuthorizeBy(ws.Owner.Token), httpu.WithHeaders(httpu.IdempotencyKey, idempotencyKey),
+			it.WithExpectedCode(http.StatusUnprocessableEntity, "is already used for another request"))
+	})
+
+	t.Run("422 on the same key with the same request of another principal", func(t *testing.T) {
+		sysPrn := vit.GetSystemPrincipal(istructs.AppQName_test1_app1)
+		vit.POST(cmdURL, body, httpu.WithAuthorizeBy(sysPrn.Token), httpu.WithHeaders(httpu.IdempotencyKey, idempotencyKey),
+			it.WithExpectedCode(http.StatusUnprocessableEntity, "is already used for another request"))
+	})
+
+	t.Run("key is released if the command is failed", func(t *testing.T) {
+		failedKey := vit.NextName()
+		failedBody := `{"cuds":[{"sys.ID":100500,"fields":{"field3":1}}]}`
+		for range 2 {
+			vit.POST(cmdURL, failedBody, httpu.WithAuthorizeBy(ws.Owner.Token), httpu.WithHeaders(httpu.IdempotencyKey, failedKey),
+				it.Expect404())
+		}
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		batchURL := fmt.Sprintf("api/v2/apps/test1/app1/workspaces/%d/batch", ws.WSID)
+		batchBody := `{"commands":[{"command":"sys.CUD","cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.cdoc1"}}]}]}`
+		batchKey := vit.NextName()
+		resp := vit.POST(batchURL, batchBody, httpu.WithAuthorizeBy(ws.Owner.Token), httpu.WithHeaders(httpu.IdempotencyKey, batchKey))
+		repeatedResp := vit.POST(batchURL, batchBody, httpu.WithAuthorizeBy(ws.Owner.Token), httpu.WithHeaders(httpu.IdempotencyKey, batchKey))
+		require.JSONEq(t, resp.Body, repeatedResp.Body)
+	})
+
+	t.Run("request without key is executed", func(t *testing.T) {
+		resp := vit.POST(cmdURL, body, httpu.WithAuthorizeBy(ws.Owner.Token))
+		require.NotEqual(t, firstIDs, newIDs(t, resp))
+	})
+
+	t.Run("request is executed again after the key is expired", func(t *testing.T) {
+		vit.TimeAdd(25 * time.Hour)
+		resp := vit.POST(cmdURL, body, httpu.WithAuthorizeBy(ws.Owner.Token), httpu.WithHeaders(httpu.IdempotencyKey, idempotencyKey))
+		require.NotEqual(t, firstIDs, newIDs(t, resp))
+	})
+
+	t.Run("400 on too long key", func(t *testing.T) {
+		vit.POST(cmdURL, body, httpu.WithAuthorizeBy(ws.Owner.Token), httpu.WithHeaders(httpu.IdempotencyKey, strings.Repeat("a", 256)),
+			it.Expect400())
+	})
+}
@@ -15,7 +15,9 @@ import (
 
 	"github.com/voedger/voedger/pkg/goutils/httpu"
 	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/processors/actualizers"
 	commandprocessor "github.com/voedger/voedger/pkg/processors/command"
+	queryprocessor "github.com/voedger/voedger/pkg/processors/query"
 	it "github.com/voedger/voedger/pkg/vit"
 )
 
@@ -72,3 +74,31 @@ func TestCommandProcessorMetrics(t *testing.T) {
 	require.Contains(metrics, commandprocessor.ExecSeconds)
 	require.Contains(metrics, commandprocessor.ProjectorsSeconds)
 }
+
+func TestLatencyHistograms(t *testing.T) {
+	vit := it.NewVIT(t, &it.SharedConfig_App1)
+	defer vit.TearDown()
+	require := require.New(t)
+	client, cleanup := httpu.NewIHTTPClient()
+	defer cleanup()
+
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+	vit.PostWS(ws, "c.sys.CUD", `{"cuds":[{"fields":{"sys.QName":"app1pkg.computers","sys.ID":1}}]}`)
+	vit.PostWS(ws, "q.sys.Collection", `{"args":{"Schema":"app1pkg.computers"}}`)
+	unknownCmd := "app1pkg." + vit.NextName()
+	vit.PostWS(ws, "c."+unknownCmd, `{}`, httpu.Expect404())
+
+	metrics := vit.MetricsRequest(client)
+
+	require.Contains(metrics, "# TYPE "+commandprocessor.CommandDurationSeconds+" histogram\n")
+	require.Regexp(commandprocessor.CommandDurationSeconds+`_bucket\{app="test1/app1",vvm="[^"]*",qname="sys.CUD",le="\+Inf"\} [1-9]`, metrics)
+	require.Regexp(commandprocessor.CommandDurationSeconds+`_sum\{app="test1/app1",vvm="[^"]*",qname="sys.CUD"\} `, metrics)
+	require.Regexp(commandprocessor.CommandDurationSeconds+`_count\{app="test1/app1",vvm="[^"]*",qname="sys.CUD"\} [1-9]`, metrics)
+	require.Regexp(queryprocessor.Metric_QueryDurationSeconds+`_count\{app="test1/app1",vvm="[^"]*",qname="sys.Collection"\} [1-9]`, metrics)
+	require.Contains(metrics, "voedger_istorage_get_duration_seconds_bucket{")
+
+	// unknown functions are not labeled by their QName
+	require.NotContains(metrics, unknownCmd)
+	require.Regexp(commandprocessor.CommandDurationSeconds+`_count\{app="test1/app1",vvm="[^"]*",qname="sys.Unresolved"\} [1-9]`, metrics)
+	require.Contains(metrics, "# TYPE "+actualizers.ProjectorEventDurationSeconds+" summary\n")
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package sys_it
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/istructs"
+	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
+	"github.com/voedger/voedger/pkg/registry"
+	it "github.com/voedger/voedger/pkg/vit"
+)
+
+func TestOIDCSignIn(t *testing.T) {
+	require := require.New(t)
+
+	// fake IdP: code "code-<subject>" authorized by authorize() is exchanged for the ID token of <subject>
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(err)
+	mux := http.NewServeMux()
+	idp := httptest.NewServer(mux)
+	defer idp.Close()
+	const clientID = "voedger"
+	const redirectURL = "https://example.com/api/v2/apps/test1/app1/auth/oidc/callback"
+	type authorization struct {
+		nonce         string
+		codeChallenge string
+	}
+	authorizationsMu := sync.Mutex{}
+	authorizations := map[string]authorization{}
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		code := r.PostFormValue("code")
+		authorizationsMu.Lock()
+		auth, ok := authorizations[code]
+		delete(authorizations, code)
+		authorizationsMu.Unlock()
+		verifierHash := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(verifierHash[:]) != auth.codeChallenge {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":   idp.URL,
+			"sub":   code[len("code-"):],
+			"aud":   clientID,
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"nonce": auth.nonce,
+		})
+		token.Header["kid"] = "key1"
+		idToken, err := token.SignedString(key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": idToken})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kid": "key1",
+			"kty": "RSA",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+
+	oidcCfg, err := json.Marshal(map[string]string{
+		"Issuer":           idp.URL,
+		"ClientID":         clientID,
+		"ClientSecret":     "secret",
+		"AuthorizationURL": idp.URL + "/authorize",
+		"TokenURL":         idp.URL + "/token",
+		"JWKSURL":          idp.URL + "/jwks",
+		"RedirectURL":      redirectURL,
+	})
+	require.NoError(err)
+	vitCfg := it.NewOwnVITConfig(
+		it.WithApp(istructs.AppQName_test1_app1, it.ProvideApp1),
+		it.WithApp(istructs.AppQName_test1_app2, it.ProvideApp2),
+		it.WithSecret(registry.OIDCSecretName(istructs.AppQName_test1_app1), oidcCfg),
+	)
+	vit := it.NewVIT(t, &vitCfg)
+	defer vit.TearDown()
+
+	// the user agent is redirected to the IdP, the IdP authenticates the subject and issues the code
+	noRedirectClient := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	authorize := func(t *testing.T, subject string) (code string, state string) {
+		resp, err := noRedirectClient.Get(vit.URLStr() + "/api/v2/apps/test1/app1/auth/oidc/authorize")
+		require.NoError(err)
+		resp.Body.Close()
+		require.Equal(http.StatusFound, resp.StatusCode)
+		location, err := url.Parse(resp.Header.Get("Location"))
+		require.NoError(err)
+		require.Equal(idp.URL+"/authorize", location.Scheme+"://"+location.Host+location.Path)
+		params := location.Query()
+		require.Equal(clientID, params.Get("client_id"))
+		require.Equal(redirectURL, params.Get("redirect_uri"))
+		require.Equal("S256", params.Get("code_challenge_method"))
+		state = params.Get("state")
+		require.NotEmpty(state)
+		require.NotEmpty(params.Get("nonce"))
+
+		cookies := resp.Cookies()
+		require.Len(cookies, 1)
+		require.Equal(state, cookies[0].Value)
+		require.True(cookies[0].HttpOnly)
+
+		code = "code-" + subject
+		authorizationsMu.Lock()
+		authorizations[code] = authorization{nonce: params.Get("nonce"), codeChallenge: params.Get("code_challenge")}
+		authorizationsMu.Unlock()
+		return code, state
+	}
+
+	callbackURL := func(code, state string) string {
+		return "api/v2/apps/test1/app1/auth/oidc/callback?" + url.Values{"code": {code}, "state": {state}}.Encode()
+	}
+
+	signIn := func(t *testing.T, subject string) (principalToken string, profileWSID istructs.WSID) {
+		code, state := authorize(t, subject)
+		resp := vit.GET(callbackURL(code, state), httpu.WithCookies("oidc_state", state))
+		result := map[string]any{}
+		require.NoError(json.Unmarshal([]byte(resp.Body), &result))
+		require.Equal(3600.0, result["expiresInSeconds"])
+		return result["principalToken"].(string), istructs.WSID(result["profileWSID"].(float64))
+	}
+
+	subject := vit.NextName()
+	var firstProfileWSID istructs.WSID
+
+	t.Run("first sign in creates the login and the profile", func(t *testing.T) {
+		principalToken, profileWSID := signIn(t, subject)
+		require.NotZero(profileWSID)
+		firstProfileWSID = profileWSID
+
+		pp := payloads.PrincipalPayload{}
+		_, err := vit.ValidateToken(principalToken, &pp)
+		require.NoError(err)
+		require.Equal(registry.OIDCLogin(idp.URL, subject), pp.Login)
+		require.Equal(istructs.SubjectKind_User, pp.SubjectKind)
+		require.Equal(profileWSID, pp.ProfileWSID)
+	})
+
+	t.Run("next sign in uses the existing login", func(t *testing.T) {
+		_, profileWSID := signIn(t, subject)
+		require.Equal(firstProfileWSID, profileWSID)
+	})
+
+	t.Run("another subject gets another login", func(t *testing.T) {
+		_, profileWSID := signIn(t, vit.NextName())
+		require.NotEqual(firstProfileWSID, profileWSID)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Run("invalid code", func(t *testing.T) {
+			_, state := authorize(t, vit.NextName())
+			vit.GET(callbackURL("wrong", state), httpu.WithCookies("oidc_state", state), httpu.Expect401())
+		})
+		t.Run("missing code", func(t *testing.T) {
+			vit.GET("api/v2/apps/test1/app1/auth/oidc/callback?state=1", httpu.Expect400())
+		})
+		t.Run("missing state", func(t *testing.T) {
+			vit.GET("api/v2/apps/test1/app1/auth/oidc/callback?code=1", httpu.Expect400())
+		})
+		t.Run("state is not bound to the user agent", func(t *testing.T) {
+			code, state := authorize(t, vit.NextName())
+			vit.GET(callbackURL(code, state), httpu.Expect401())
+			_, anotherState := authorize(t, vit.NextName())
+			vit.GET(callbackURL(code, state), httpu.WithCookies("oidc_state", anotherState), httpu.Expect401())
+		})
+		t.Run("state is not issued by the registry", func(t *testing.T) {
+			code, _ := authorize(t, vit.NextName())
+			vit.GET(callbackURL(code, "forged"), httpu.WithCookies("oidc_state", "forged"), httpu.Expect401())
+		})
+		t.Run("state is exchanged once", func(t *testing.T) {
+			subject := vit.NextName()
+			code, state := authorize(t, subject)
+			authorizationsMu.Lock()
+			auth := authorizations[code]
+			authorizationsMu.Unlock()
+			vit.GET(callbackURL(code, state), httpu.WithCookies("oidc_state", state))
+
+			// the IdP would accept the code again
+			authorizationsMu.Lock()
+			authorizations[code] = auth
+			authorizationsMu.Unlock()
+			resp := vit.GET(callbackURL(code, state), httpu.WithCookies("oidc_state", state), httpu.Expect401())
+			require.Contains(resp.Body, "OIDC sign in state is unknown or expired")
+		})
+		t.Run("IdP error", func(t *testing.T) {
+			resp := vit.GET("api/v2/apps/test1/app1/auth/oidc/callback?error=access_denied&error_description=denied", httpu.Expect401())
+			require.Contains(resp.Body, "access_denied")
+		})
+		t.Run("OIDC is not configured for the app", func(t *testing.T) {
+			vit.GET("api/v2/apps/test1/app2/auth/oidc/authorize", httpu.Expect400())
+		})
+	})
+}
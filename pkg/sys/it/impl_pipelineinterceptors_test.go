@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package sys_it
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/pipeline"
+	it "github.com/voedger/voedger/pkg/vit"
+	sys_test_template "github.com/voedger/voedger/pkg/vit/testdata"
+	"github.com/voedger/voedger/pkg/vvm"
+)
+
+type testPipelineInterceptor struct {
+	sync.Mutex
+	pipelines map[string]bool
+	failOp    string
+}
+
+func (i *testPipelineInterceptor) Before(_ context.Context, call pipeline.OperatorCall) error {
+	i.Lock()
+	defer i.Unlock()
+	i.pipelines[call.Pipeline] = true
+	if call.Operator == i.failOp {
+		return errors.New("injected fault")
+	}
+	return nil
+}
+
+func (i *testPipelineInterceptor) After(_ context.Context, _ pipeline.OperatorCall, _ time.Duration, err error) error {
+	return err
+}
+
+func (i *testPipelineInterceptor) setFailOp(op string) {
+	i.Lock()
+	defer i.Unlock()
+	i.failOp = op
+}
+
+func (i *testPipelineInterceptor) intercepted(pipelineName string) bool {
+	i.Lock()
+	defer i.Unlock()
+	return i.pipelines[pipelineName]
+}
+
+func TestPipelineInterceptors(t *testing.T) {
+	require := require.New(t)
+	interceptor := &testPipelineInterceptor{pipelines: map[string]bool{}}
+	cfg := it.NewOwnVITConfig(
+		it.WithApp(istructs.AppQName_test1_app1, it.ProvideApp1,
+			it.WithWorkspaceTemplate(it.QNameApp1_TestWSKind, "test_template", sys_test_template.TestTemplateFS),
+			it.WithUserLogin("login", "pwd"),
+			it.WithChildWorkspace(it.QNameApp1_TestWSKind, "test_ws", "test_template", "", "login", map[string]interface{}{"IntFld": 42}),
+		),
+		it.WithVVMConfig(func(cfg *vvm.VVMConfig) {
+			cfg.PipelineInterceptors = append(cfg.PipelineInterceptors, interceptor)
+		}),
+	)
+	vit := it.NewVIT(t, &cfg)
+	defer vit.TearDown()
+
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+
+	t.Run("command and query pipelines are intercepted", func(t *testing.T) {
+		vit.PostWS(ws, "c.sys.CUD", `{"cuds":[{"fields":{"sys.QName":"app1pkg.computers","sys.ID":1}}]}`)
+		vit.PostWS(ws, "q.sys.Collection", `{"args":{"Schema":"app1pkg.computers"}}`)
+		require.True(interceptor.intercepted("Command Processor"))
+		require.True(interceptor.intercepted("Query Processor"))
+	})
+
+	t.Run("fault injection", func(t *testing.T) {
+		interceptor.setFailOp("get IQuery")
+		defer interceptor.setFailOp("")
+		resp := vit.PostWS(ws, "q.sys.Collection", `{"args":{"Schema":"app1pkg.computers"}}`, httpu.Expect500())
+		require.ErrorContains(resp.SysError, "injected fault")
+	})
+}
@@ -7,7 +7,9 @@ package sys_it
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"testing"
@@ -2471,3 +2473,64 @@ func TestQueryProcessor2_CDocs(t *testing.T) {
 }
 
 // [~server.authnz/it.TestLogin~impl]
+
+func TestQueryProcessor2_DocsHistory(t *testing.T) {
+	require := require.New(t)
+	vit := it.NewVIT(t, &it.SharedConfig_App1)
+	defer vit.TearDown()
+
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+	docQName := appdef.NewQName("app1pkg", "AuditedDoc")
+
+	resp := vit.POST(fmt.Sprintf("api/v2/apps/test1/app1/workspaces/%d/docs/%s", ws.WSID, docQName), `{"Name":"initial","Amount":1}`,
+		httpu.WithMethod(http.MethodPost),
+		httpu.WithAuthorizeBy(ws.Owner.Token),
+	)
+	docID := newIDs(t, resp)["1"]
+
+	docPath := fmt.Sprintf("api/v2/apps/test1/app1/workspaces/%d/docs/%s/%d", ws.WSID, docQName, docID)
+	vit.POST(docPath, `{"Amount":2}`, httpu.WithMethod(http.MethodPatch), httpu.WithAuthorizeBy(ws.Owner.Token))
+	vit.POST(docPath, `{"Amount":2}`, httpu.WithMethod(http.MethodPatch), httpu.WithAuthorizeBy(ws.Owner.Token)) // nothing changed
+	vit.POST(docPath, `{}`, httpu.WithMethod(http.MethodDelete), httpu.WithAuthorizeBy(ws.Owner.Token))
+
+	t.Run("read document history", func(t *testing.T) {
+		resp := vit.GET(docPath+"/history", httpu.WithAuthorizeBy(ws.Owner.Token))
+
+		history := struct {
+			Results []map[string]any `json:"results"`
+		}{}
+		require.NoError(json.Unmarshal([]byte(resp.Body), &history))
+		require.Len(history.Results, 3)
+
+		expectedChanges := []string{
+			`{"Amount":{"new":1},"Name":{"new":"initial"}}`,
+			`{"Amount":{"old":1,"new":2}}`,
+			`{"sys.IsActive":{"old":true,"new":false}}`,
+		}
+		prevOffset := float64(0)
+		for i, h := range history.Results {
+			require.Greater(h["WLogOffset"], prevOffset)
+			prevOffset = h["WLogOffset"].(float64)
+			require.Positive(h["RegisteredAt"])
+			require.Equal(ws.Owner.Name, h["Principal"])
+			require.EqualValues(ws.Owner.ProfileWSID, h["ProfileWSID"])
+			changes, err := json.Marshal(h["Changes"])
+			require.NoError(err)
+			require.JSONEq(expectedChanges[i], string(changes))
+		}
+	})
+
+	t.Run("400 table is not audited", func(t *testing.T) {
+		path := fmt.Sprintf(`api/v2/apps/test1/app1/workspaces/%d/docs/%s/%d/history`, ws.WSID, it.QNameApp1_CDocCategory, docID)
+		vit.GET(path, httpu.WithAuthorizeBy(ws.Owner.Token), it.Expect400("app1pkg.category is not audited"))
+	})
+
+	t.Run("403 not authorized", func(t *testing.T) {
+		vit.GET(docPath+"/history", httpu.Expect403())
+	})
+
+	t.Run("404 not found", func(t *testing.T) {
+		path := fmt.Sprintf(`api/v2/apps/test1/app1/workspaces/%d/docs/%s/%d/history`, ws.WSID, docQName, 123)
+		vit.GET(path, httpu.WithAuthorizeBy(ws.Owner.Token), it.Expect404())
+	})
+}
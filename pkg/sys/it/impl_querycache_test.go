@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package sys_it
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/iauthnz"
+	"github.com/voedger/voedger/pkg/istructs"
+	it "github.com/voedger/voedger/pkg/vit"
+)
+
+func TestQueryCache(t *testing.T) {
+	require := require.New(t)
+	vit := it.NewVIT(t, &it.SharedConfig_App1)
+	defer vit.TearDown()
+
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+	catID := vit.PostWS(ws, "c.sys.CUD", `{"cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.category","name":"cat1"}}]}`).NewID()
+
+	// returns the category name and the amount of the query executions
+	queryV1 := func() (string, int64) {
+		body := fmt.Sprintf(`{"args":{"CategoryID":%d},"elements":[{"fields":["Name","Execs"]}]}`, catID)
+		resp := vit.PostWS(ws, "q.app1pkg.QryCachedCategory", body)
+		return resp.SectionRow()[0].(string), int64(resp.SectionRow()[1].(float64))
+	}
+	queryV2 := func() (string, int64) {
+		resp := vit.GET(fmt.Sprintf(`api/v2/apps/test1/app1/workspaces/%d/queries/app1pkg.QryCachedCategory?args=%s`, ws.WSID,
+			url.QueryEscape(fmt.Sprintf(`{"CategoryID":%d}`, catID))), httpu.WithAuthorizeBy(ws.Owner.Token))
+		res := struct {
+			Results []struct {
+				Name  string
+				Execs int64
+			}
+		}{}
+		require.NoError(json.Unmarshal([]byte(resp.Body), &res))
+		require.Len(res.Results, 1)
+		return res.Results[0].Name, res.Results[0].Execs
+	}
+
+	name, execs := queryV1()
+	require.Equal("cat1", name)
+
+	t.Run("result is got from the cache", func(t *testing.T) {
+		name, cachedExecs := queryV1()
+		require.Equal("cat1", name)
+		require.Equal(execs, cachedExecs)
+	})
+
+	t.Run("API v2 result is cached separately", func(t *testing.T) {
+		name, execsV2 := queryV2()
+		require.Equal("cat1", name)
+		require.Equal(execs+1, execsV2)
+		execs = execsV2
+
+		_, execsV2 = queryV2()
+		require.Equal(execs, execsV2)
+	})
+
+	t.Run("result is invalidated by the command in the workspace", func(t *testing.T) {
+		vit.PostWS(ws, "c.sys.CUD", fmt.Sprintf(`{"cuds":[{"sys.ID":%d,"fields":{"name":"cat2"}}]}`, catID))
+		name, newExecs := queryV1()
+		require.Equal("cat2", name)
+		require.Equal(execs+1, newExecs)
+		execs = newExecs
+
+		_, newExecs = queryV1()
+		require.Equal(execs, newExecs)
+	})
+
+	t.Run("result expires", func(t *testing.T) {
+		vit.TimeAdd(10 * time.Second)
+		name, newExecs := queryV1()
+		require.Equal("cat2", name)
+		require.Equal(execs+1, newExecs)
+	})
+}
+
+func TestQueryCache_Subjects(t *testing.T) {
+	require := require.New(t)
+	vit := it.NewVIT(t, &it.SharedConfig_App1)
+	defer vit.TearDown()
+
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+
+	// the new login gets the same role in the workspace as the workspace owner has
+	loginName := vit.NextName()
+	login := vit.SignUp(loginName, "1", istructs.AppQName_test1_app1)
+	prn := vit.SignIn(login)
+	body := fmt.Sprintf(`{"cuds": [{"fields": {"sys.ID": 1,"sys.QName": "sys.Subject","Login": "%s","SubjectKind":%d,"Roles": "%s","ProfileWSID":%d}}]}`,
+		loginName, istructs.SubjectKind_User, iauthnz.QNameRoleWorkspaceOwner, prn.ProfileWSID)
+	vit.PostWS(ws, "c.sys.CUD", body)
+
+	// returns the subject login and the amount of the query executions
+	query := func(token string) (string, int64) {
+		resp := vit.PostWS(ws, "q.app1pkg.QryCachedSubject", `{"elements":[{"fields":["Name","Execs"]}]}`, httpu.WithAuthorizeBy(token))
+		return resp.SectionRow()[0].(string), int64(resp.SectionRow()[1].(float64))
+	}
+
+	ownerName, execs := query(ws.Owner.Token)
+	require.Equal(ws.Owner.Name, ownerName)
+
+	t.Run("result cached for one subject is not returned to another one", func(t *testing.T) {
+		name, newExecs := query(prn.Token)
+		require.Equal(loginName, name)
+		require.Equal(execs+1, newExecs)
+		execs = newExecs
+	})
+
+	t.Run("each subject gets its own cached result", func(t *testing.T) {
+		name, cachedExecs := query(ws.Owner.Token)
+		require.Equal(ws.Owner.Name, name)
+		require.Equal(execs-1, cachedExecs)
+
+		name, cachedExecs = query(prn.Token)
+		require.Equal(loginName, name)
+		require.Equal(execs, cachedExecs)
+	})
+}
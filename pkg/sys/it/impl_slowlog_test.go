@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package sys_it
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/goutils/testingu"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
+	it "github.com/voedger/voedger/pkg/vit"
+	sys_test_template "github.com/voedger/voedger/pkg/vit/testdata"
+	"github.com/voedger/voedger/pkg/vvm"
+)
+
+func TestSlowRequests(t *testing.T) {
+	require := require.New(t)
+	cfg := it.NewOwnVITConfig(
+		it.WithApp(istructs.AppQName_test1_app1, it.ProvideApp1,
+			it.WithWorkspaceTemplate(it.QNameApp1_TestWSKind, "test_template", sys_test_template.TestTemplateFS),
+			it.WithUserLogin("login", "pwd"),
+			it.WithChildWorkspace(it.QNameApp1_TestWSKind, "test_ws", "test_template", "", "login", map[string]interface{}{"IntFld": 42}),
+		),
+		it.WithVVMConfig(func(cfg *vvm.VVMConfig) {
+			// each request is slow
+			cfg.SlowLog = slowlog.Config{Threshold: time.Nanosecond}
+			cfg.Time = tickingTime{testingu.MockTime}
+		}),
+	)
+	vit := it.NewVIT(t, &cfg)
+	defer vit.TearDown()
+
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+	vit.PostWS(ws, "c.sys.CUD", `{"cuds":[{"fields":{"sys.QName":"app1pkg.computers","sys.ID":1}}]}`)
+
+	sysToken := vit.GetSystemPrincipal(istructs.AppQName_test1_app1).Token
+	body := `{"args":{"Count":1},"elements":[{"fields":["Kind","App","WSID","QName","DurationMs","Stages","Error"]}]}`
+
+	t.Run("the last slow request", func(t *testing.T) {
+		resp := vit.PostWS(ws, "q.sys.SlowRequests", body, httpu.WithAuthorizeBy(sysToken))
+		require.Equal(1, resp.NumRows())
+		row := resp.SectionRow()
+		require.Equal(slowlog.RequestKind_Command, row[0])
+		require.Equal(istructs.AppQName_test1_app1.String(), row[1])
+		require.Equal(float64(ws.WSID), row[2])
+		require.Equal("sys.CUD", row[3])
+		stages := []struct {
+			Name       string `json:"name"`
+			DurationUs int64  `json:"durationUs"`
+		}{}
+		require.NoError(json.Unmarshal([]byte(row[5].(string)), &stages))
+		names := []string{}
+		for _, stage := range stages {
+			names = append(names, stage.Name)
+		}
+		require.Contains(names, "authenticate")
+		require.Contains(names, "writeCUDs")
+		require.Contains(names, "syncProjectorsAndPutWLog")
+		require.Contains(names, slowlog.Stage_Response)
+		require.Empty(row[6])
+	})
+
+	t.Run("failed queries are reported too", func(t *testing.T) {
+		vit.PostWS(ws, "q.sys.Collection", `{"args":{"Schema":"app1pkg.unknown"}}`, httpu.Expect400())
+		resp := vit.PostWS(ws, "q.sys.SlowRequests", body, httpu.WithAuthorizeBy(sysToken))
+		row := resp.SectionRow()
+		require.Equal(slowlog.RequestKind_Query, row[0])
+		require.Equal("sys.Collection", row[3])
+		require.NotEmpty(row[6])
+	})
+
+	t.Run("403 for non-system principal", func(t *testing.T) {
+		vit.PostWS(ws, "q.sys.SlowRequests", body, httpu.Expect403())
+	})
+}
+
+// tickingTime advances the mocked time on each reading, so the duration of each request is not zero
+type tickingTime struct {
+	testingu.IMockTime
+}
+
+func (t tickingTime) Now() time.Time {
+	t.Add(time.Microsecond)
+	return t.IMockTime.Now()
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package sys_it
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/istructs"
+	it "github.com/voedger/voedger/pkg/vit"
+)
+
+func TestStorageCache(t *testing.T) {
+	require := require.New(t)
+	vit := it.NewVIT(t, &it.SharedConfig_App1)
+	defer vit.TearDown()
+
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+	vit.PostWS(ws, "c.sys.CUD", `{"cuds":[{"fields":{"sys.QName":"app1pkg.computers","sys.ID":1}}]}`)
+
+	sysToken := vit.GetSystemPrincipal(istructs.AppQName_test1_app1).Token
+	body := func(app string, flush bool) string {
+		return fmt.Sprintf(`{"args":{"App":"%s","Flush":%t},"elements":[{"fields":["MaxBytes","Bytes","Entries","Evictions","Tables"]}]}`, app, flush)
+	}
+	type table struct {
+		Table        string `json:"table"`
+		Hits         uint64 `json:"hits"`
+		NegativeHits uint64 `json:"negativeHits"`
+		Misses       uint64 `json:"misses"`
+	}
+
+	entries := float64(0)
+	t.Run("inspect", func(t *testing.T) {
+		resp := vit.PostWS(ws, "q.sys.StorageCache", body(istructs.AppQName_test1_app1.String(), false), httpu.WithAuthorizeBy(sysToken))
+		require.Equal(1, resp.NumRows())
+		row := resp.SectionRow()
+		require.Positive(row[0])
+		require.Positive(row[1])
+		entries = row[2].(float64)
+		require.Positive(entries)
+
+		tables := []table{}
+		require.NoError(json.Unmarshal([]byte(row[4].(string)), &tables))
+		tableNames := []string{}
+		for _, table := range tables {
+			tableNames = append(tableNames, table.Table)
+			if table.Table == "sys.Records" {
+				require.Positive(table.Hits + table.Misses)
+			}
+		}
+		require.Equal([]string{"sys.Other", "sys.PLog", "sys.Records", "sys.Views", "sys.WLog"}, tableNames)
+	})
+
+	t.Run("flush", func(t *testing.T) {
+		vit.PostWS(ws, "q.sys.StorageCache", body(istructs.AppQName_test1_app1.String(), true), httpu.WithAuthorizeBy(sysToken))
+		resp := vit.PostWS(ws, "q.sys.StorageCache", body(istructs.AppQName_test1_app1.String(), false), httpu.WithAuthorizeBy(sysToken))
+		require.Less(resp.SectionRow()[2].(float64), entries)
+	})
+
+	t.Run("404 on unknown app", func(t *testing.T) {
+		vit.PostWS(ws, "q.sys.StorageCache", body("test1/unknown", false), httpu.WithAuthorizeBy(sysToken), httpu.Expect404())
+	})
+
+	t.Run("400 on wrong app name", func(t *testing.T) {
+		vit.PostWS(ws, "q.sys.StorageCache", body("wrong", false), httpu.WithAuthorizeBy(sysToken), httpu.Expect400())
+	})
+
+	t.Run("403 for non-system principal", func(t *testing.T) {
+		vit.PostWS(ws, "q.sys.StorageCache", body(istructs.AppQName_test1_app1.String(), false), httpu.Expect403())
+	})
+}
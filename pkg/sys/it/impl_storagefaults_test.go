@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package sys_it
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils/federation"
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/in10n"
+	"github.com/voedger/voedger/pkg/istoragefaults"
+	"github.com/voedger/voedger/pkg/istructs"
+	it "github.com/voedger/voedger/pkg/vit"
+	sys_test_template "github.com/voedger/voedger/pkg/vit/testdata"
+)
+
+func newStorageFaultsVITConfig(injector istoragefaults.IFaultInjector) it.VITConfig {
+	return it.NewOwnVITConfig(
+		it.WithApp(istructs.AppQName_test1_app1, it.ProvideApp1,
+			it.WithWorkspaceTemplate(it.QNameApp1_TestWSKind, "test_template", sys_test_template.TestTemplateFS),
+			it.WithUserLogin("login", "pwd"),
+			it.WithChildWorkspace(it.QNameApp1_TestWSKind, "test_ws", "test_template", "", "login", map[string]interface{}{"IntFld": 42}),
+		),
+		it.WithStorageFaults(injector),
+	)
+}
+
+func TestStorageFaults_CommandProcessorRecovery(t *testing.T) {
+	require := require.New(t)
+	injector := istoragefaults.New()
+	cfg := newStorageFaultsVITConfig(injector)
+	vit := it.NewVIT(t, &cfg)
+	defer vit.TearDown()
+
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+	countComputers := func() int {
+		body := `{"args":{"Schema":"app1pkg.computers"},"elements":[{"fields":["sys.ID"]}]}`
+		return vit.PostWS(ws, "q.sys.Collection", body).NumRows()
+	}
+	insertComputer := func(opts ...httpu.ReqOptFunc) *federation.FuncResponse {
+		return vit.PostWS(ws, "c.sys.CUD", `{"cuds":[{"fields":{"sys.QName":"app1pkg.computers","sys.ID":1}}]}`, opts...)
+	}
+	computers := countComputers()
+
+	t.Run("PLog write timeout", func(t *testing.T) {
+		// the event is written to PLog but the error is returned
+		injector.SetRules(istoragefaults.Rule{
+			Methods:         []istoragefaults.Method{istoragefaults.Method_InsertIfNotExists},
+			Apps:            []appdef.AppQName{istructs.AppQName_test1_app1},
+			ErrorRate:       1,
+			MaxFaults:       1,
+			ApplyBeforeFail: true,
+		})
+		insertComputer(it.Expect500(istoragefaults.ErrInjectedFault.Error()))
+		require.Equal(uint64(1), injector.Injected())
+		injector.SetRules()
+
+		// partition recovery re-applies the stored event
+		insertComputer()
+		computers += 2
+		require.Equal(computers, countComputers())
+	})
+
+	t.Run("partial records batch", func(t *testing.T) {
+		injector.SetRules(istoragefaults.Rule{
+			Methods:      []istoragefaults.Method{istoragefaults.Method_PutBatch},
+			Apps:         []appdef.AppQName{istructs.AppQName_test1_app1},
+			ErrorRate:    1,
+			MaxFaults:    1,
+			PartialBatch: true,
+		})
+		insertComputer(it.Expect500(istoragefaults.ErrInjectedFault.Error()))
+		require.Equal(uint64(1), injector.Injected())
+		injector.SetRules()
+
+		insertComputer()
+		computers += 2
+		require.Equal(computers, countComputers())
+	})
+
+	t.Run("slow storage", func(t *testing.T) {
+		injector.SetRules(istoragefaults.Rule{
+			Apps:    []appdef.AppQName{istructs.AppQName_test1_app1},
+			Latency: 10 * time.Millisecond,
+		})
+		defer injector.SetRules()
+		start := time.Now()
+		insertComputer()
+		require.Greater(time.Since(start), 10*time.Millisecond)
+		computers++
+		require.Equal(computers, countComputers())
+	})
+}
+
+func TestStorageFaults_AsyncActualizerRecovery(t *testing.T) {
+	require := require.New(t)
+	injector := istoragefaults.New()
+	cfg := newStorageFaultsVITConfig(injector)
+	vit := it.NewVIT(t, &cfg)
+	defer vit.TearDown()
+
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+	offsetsChan, unsubscribe := vit.SubscribeForN10nUnsubscribe(in10n.ProjectionKey{
+		App:        istructs.AppQName_test1_app1,
+		Projection: it.QNameApp1_ViewClients,
+		WS:         ws.WSID,
+	})
+	defer unsubscribe()
+
+	body := `{"cuds":[
+		{"fields":{"sys.ID":1,"sys.QName":"app1pkg.Currency","CharCode":"EUR","Code":978}},
+		{"fields":{"sys.ID":2,"sys.QName":"app1pkg.Country","Name":"Spain"}},
+		{"fields":{"sys.ID":3,"sys.QName":"app1pkg.Wallet","Balance":1000,"Currency":1}},
+		{"fields":{"sys.ID":4,"sys.QName":"app1pkg.Client","FirstName":"Juan","LastName":"Carlos","DOB":568209600000,"Wallet":3,"Country":2}}
+	]}`
+	offset := vit.PostWS(ws, "c.sys.CUD", body).CurrentWLogOffset
+
+	// the command is done, async actualizers flush their batches by timer later and fail few times
+	injector.SetRules(istoragefaults.Rule{
+		Methods:   []istoragefaults.Method{istoragefaults.Method_PutBatch},
+		Apps:      []appdef.AppQName{istructs.AppQName_test1_app1},
+		ErrorRate: 1,
+		MaxFaults: 3,
+	})
+
+	// the projection is actualized after retries
+	waitForOffset(t, offset, offsetsChan)
+	require.Positive(injector.Injected())
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package sys_it
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/coreutils/federation"
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/istructs"
+	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
+	"github.com/voedger/voedger/pkg/registry"
+	it "github.com/voedger/voedger/pkg/vit"
+)
+
+// see registry totpCodeAttemptsPeriod
+const totpCodeAttemptsPeriod = time.Minute
+
+func TestTOTP(t *testing.T) {
+	require := require.New(t)
+	vit := it.NewVIT(t, &it.SharedConfig_App1)
+	defer vit.TearDown()
+
+	login := vit.SignUp(vit.NextName(), "pwd1", istructs.AppQName_test1_app1)
+	totpCmd := func(cmd string, unloggedArgs string, opts ...httpu.ReqOptFunc) *federation.FuncResponse {
+		body := fmt.Sprintf(`{"args":{"Login":%q,"AppName":%q},"unloggedArgs":%s}`, login.Name, login.AppQName, unloggedArgs)
+		return vit.PostApp(istructs.AppQName_sys_registry, login.PseudoProfileWSID, "c.registry."+cmd, body, opts...)
+	}
+	authLogin := func(body string, opts ...httpu.ReqOptFunc) map[string]any {
+		resp := vit.POST("api/v2/apps/test1/app1/auth/login", body, opts...)
+		result := map[string]any{}
+		require.NoError(json.Unmarshal([]byte(resp.Body), &result))
+		return result
+	}
+	passwordStep := func() (challengeToken string) {
+		result := authLogin(fmt.Sprintf(`{"login":%q,"password":%q}`, login.Name, login.Pwd))
+		require.Equal(300.0, result["expiresInSeconds"])
+		require.NotContains(result, "principalToken")
+		return result["totpChallengeToken"].(string)
+	}
+	codeStep := func(challengeToken string, code string, opts ...httpu.ReqOptFunc) map[string]any {
+		return authLogin(fmt.Sprintf(`{"login":%q,"totpChallengeToken":%q,"totpCode":%q}`, login.Name, challengeToken, code), opts...)
+	}
+	requirePrincipalToken := func(result map[string]any) {
+		pp := payloads.PrincipalPayload{}
+		_, err := vit.ValidateToken(result["principalToken"].(string), &pp)
+		require.NoError(err)
+		require.Equal(login.Name, pp.Login)
+		require.Equal(3600.0, result["expiresInSeconds"])
+	}
+
+	var secret string
+	var recoveryCodes []string
+	totpCode := func() string {
+		code, err := registry.TOTPCode(secret, vit.Now())
+		require.NoError(err)
+		return code
+	}
+
+	t.Run("enroll", func(t *testing.T) {
+		resp := totpCmd("InitiateTOTPEnrollment", fmt.Sprintf(`{"Password":%q}`, login.Pwd))
+		secret = resp.CmdResult["Secret"].(string)
+		require.NotEmpty(secret)
+		require.Contains(resp.CmdResult["ProvisioningURI"], "secret="+secret)
+		recoveryCodes = strings.Split(resp.CmdResult["RecoveryCodes"].(string), ",")
+		require.Len(recoveryCodes, 10)
+
+		// not enabled until confirmed -> signs in by the password only
+		vit.SignIn(login)
+
+		totpCmd("ConfirmTOTPEnrollment", fmt.Sprintf(`{"Password":%q,"TOTPCode":"wrong"}`, login.Pwd), httpu.Expect401())
+		totpCmd("ConfirmTOTPEnrollment", fmt.Sprintf(`{"Password":"wrong","TOTPCode":%q}`, totpCode()), httpu.Expect401())
+		totpCmd("ConfirmTOTPEnrollment", fmt.Sprintf(`{"Password":%q,"TOTPCode":%q}`, login.Pwd, totpCode()))
+
+		// enabled already -> enrollment is denied until reset
+		totpCmd("InitiateTOTPEnrollment", fmt.Sprintf(`{"Password":%q}`, login.Pwd), httpu.Expect409())
+	})
+
+	t.Run("sign in by the TOTP code", func(t *testing.T) {
+		vit.TimeAdd(30 * time.Second) // the code used on confirmation can not be used again
+		challengeToken := passwordStep()
+		code := totpCode()
+		requirePrincipalToken(codeStep(challengeToken, code))
+
+		t.Run("used code is rejected", func(t *testing.T) {
+			codeStep(passwordStep(), code, httpu.Expect401())
+		})
+	})
+
+	t.Run("sign in by the recovery code", func(t *testing.T) {
+		requirePrincipalToken(codeStep(passwordStep(), recoveryCodes[0]))
+
+		t.Run("used recovery code is rejected", func(t *testing.T) {
+			codeStep(passwordStep(), recoveryCodes[0], httpu.Expect401())
+		})
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		vit.TimeAdd(totpCodeAttemptsPeriod) // avoid 429 too many requests
+		t.Run("wrong code", func(t *testing.T) {
+			codeStep(passwordStep(), "000000x", httpu.Expect401())
+		})
+		t.Run("wrong challenge token", func(t *testing.T) {
+			codeStep("wrong", totpCode(), httpu.Expect401())
+		})
+		t.Run("challenge token of another login", func(t *testing.T) {
+			challengeToken := passwordStep()
+			body := fmt.Sprintf(`{"login":%q,"totpChallengeToken":%q,"totpCode":%q}`, vit.NextName(), challengeToken, totpCode())
+			vit.POST("api/v2/apps/test1/app1/auth/login", body, httpu.Expect401())
+		})
+		t.Run("expired challenge token", func(t *testing.T) {
+			challengeToken := passwordStep()
+			vit.TimeAdd(6 * time.Minute)
+			codeStep(challengeToken, totpCode(), httpu.Expect401())
+		})
+		t.Run("wrong password on the first step", func(t *testing.T) {
+			authLogin(fmt.Sprintf(`{"login":%q,"password":"wrong"}`, login.Name), httpu.Expect401())
+		})
+		t.Run("429 on too many code attempts of the login", func(t *testing.T) {
+			vit.TimeAdd(totpCodeAttemptsPeriod)
+			challengeToken := passwordStep()
+			for range 10 {
+				codeStep(challengeToken, "000000", httpu.Expect401())
+			}
+			codeStep(challengeToken, totpCode(), httpu.Expect429())
+
+			vit.TimeAdd(totpCodeAttemptsPeriod)
+			requirePrincipalToken(codeStep(passwordStep(), totpCode()))
+		})
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		totpCmd("ResetTOTP", "{}", httpu.Expect403())
+		sysToken := vit.GetSystemPrincipal(istructs.AppQName_sys_registry).Token
+		totpCmd("ResetTOTP", "{}", httpu.WithAuthorizeBy(sysToken))
+
+		// signs in by the password only again
+		vit.SignIn(login)
+	})
+}
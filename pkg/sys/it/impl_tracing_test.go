@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package sys_it
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/tracing"
+	it "github.com/voedger/voedger/pkg/vit"
+	"github.com/voedger/voedger/pkg/vvm"
+)
+
+func TestTracing(t *testing.T) {
+	require := require.New(t)
+	tracesFilePath := filepath.Join(t.TempDir(), "traces.jsonl")
+	cfg := it.NewOwnVITConfig(
+		it.WithApp(istructs.AppQName_test1_app1, it.ProvideApp1, it.WithUserLogin(it.TestEmail, "1")),
+		it.WithVVMConfig(func(cfg *vvm.VVMConfig) {
+			cfg.Tracing = tracing.Config{
+				Exporter: tracing.ExporterKind_File,
+				FilePath: tracesFilePath,
+			}
+		}),
+	)
+	vit := it.NewVIT(t, &cfg)
+	prn := vit.GetPrincipal(istructs.AppQName_test1_app1, it.TestEmail)
+	ws := vit.CreateWorkspace(it.SimpleWSParams(vit.NextName()), prn)
+
+	const (
+		traceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		clientSpanID = "00f067aa0ba902b7"
+	)
+	vit.PostWS(ws, "c.sys.CUD", `{"cuds":[{"fields":{"sys.QName":"app1pkg.computers","sys.ID":1}}]}`,
+		httpu.WithHeaders(tracing.TraceParentHeader, "00-"+traceID+"-"+clientSpanID+"-01"))
+
+	// spans are flushed on VVM shutdown
+	vit.TearDown()
+
+	type span struct {
+		SpanID       string `json:"spanId"`
+		ParentSpanID string `json:"parentSpanId"`
+		Name         string `json:"name"`
+	}
+	spans := map[string]span{} // by spanID
+	file, err := os.Open(tracesFilePath)
+	require.NoError(err)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		req := struct {
+			ResourceSpans []struct {
+				ScopeSpans []struct {
+					Spans []struct {
+						span
+						TraceID string `json:"traceId"`
+					} `json:"spans"`
+				} `json:"scopeSpans"`
+			} `json:"resourceSpans"`
+		}{}
+		require.NoError(json.Unmarshal(scanner.Bytes(), &req))
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				for _, s := range ss.Spans {
+					if s.TraceID == traceID {
+						spans[s.SpanID] = s.span
+					}
+				}
+			}
+		}
+	}
+	require.NoError(scanner.Err())
+
+	// every span of the trace must lead to the client span
+	names := map[string]bool{}
+	for _, s := range spans {
+		names[strings.Fields(s.Name)[0]] = true
+		for parent := s; parent.ParentSpanID != clientSpanID; {
+			var ok bool
+			parent, ok = spans[parent.ParentSpanID]
+			require.True(ok, "span %s is not connected to the client span", s.Name)
+		}
+	}
+	require.True(names["HTTP"])
+	require.True(names["bus.SendRequest"])
+	require.True(names["pipeline.op"])
+	require.True(names["extension.Invoke"])
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	"github.com/voedger/voedger/pkg/appdef"
@@ -510,3 +511,35 @@ func TestGetRecordIDByUniqueCombination_AllKinds(t *testing.T) {
 	require.NoError(err)
 	require.Equal(expectedID, actualID)
 }
+
+func TestUniques_UUID(t *testing.T) {
+	require := require.New(t)
+	vit := it.NewVIT(t, &it.SharedConfig_App1)
+	defer vit.TearDown()
+
+	ws := vit.WS(istructs.AppQName_test1_app1, "test_ws")
+	clientID := uuid.New()
+
+	// insert a record identified by the client-generated UUID
+	body := fmt.Sprintf(`{"cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.DocUUIDUnique","ClientID":"%s","Name":"offline"}}]}`, clientID)
+	expectedID := vit.PostWS(ws, "c.sys.CUD", body).NewID()
+
+	t.Run("should be conflict on the same UUID in another form", func(t *testing.T) {
+		body := fmt.Sprintf(`{"cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.DocUUIDUnique","ClientID":"%s"}}]}`, strings.ToUpper(clientID.String()))
+		vit.PostWS(ws, "c.sys.CUD", body, it.Expect409(fmt.Sprintf("unique constraint violation with ID %d", expectedID)))
+	})
+
+	t.Run("should find the record by UUID", func(t *testing.T) {
+		as, err := vit.IAppStructsProvider.BuiltIn(istructs.AppQName_test1_app1)
+		require.NoError(err)
+		for _, v := range []interface{}{clientID, clientID.String(), clientID[:]} {
+			actualID, err := uniques.GetRecordIDByUniqueCombination(ws.WSID, appdef.NewQName("app1pkg", "DocUUIDUnique"), as, map[string]interface{}{"ClientID": v})
+			require.NoError(err)
+			require.Equal(expectedID, actualID)
+		}
+
+		actualID, err := uniques.GetRecordIDByUniqueCombination(ws.WSID, appdef.NewQName("app1pkg", "DocUUIDUnique"), as, map[string]interface{}{"ClientID": uuid.New()})
+		require.NoError(err)
+		require.Zero(actualID)
+	})
+}
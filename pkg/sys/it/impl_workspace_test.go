@@ -5,6 +5,7 @@
 package sys_it
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
@@ -349,3 +350,56 @@ func TestCreateChildOfChildWorkspace(t *testing.T) {
 	require.NoError(t, err)
 	vit.PostWS(newWS, "c.sys.CUD", body, httpu.WithAuthorizeBy(tokenForChildOfChild))
 }
+
+func TestWorkspaceExportImport(t *testing.T) {
+	require := require.New(t)
+	vit := it.NewVIT(t, &it.SharedConfig_App1)
+	defer vit.TearDown()
+
+	prn := vit.GetPrincipal(istructs.AppQName_test1_app1, it.TestEmail)
+	srcWS := vit.CreateWorkspace(it.SimpleWSParams(vit.NextName()), prn)
+
+	// department with nested record that refers to options, article with blob
+	blobContent := []byte{1, 2, 3, 4, 5}
+	qNameArticles := appdef.NewQName("app1pkg", "articles")
+	blobID := vit.UploadBLOB(istructs.AppQName_test1_app1, srcWS.WSID, "picture.png", "image/png", blobContent,
+		qNameArticles, "picture", httpu.WithAuthorizeBy(prn.Token))
+	body := fmt.Sprintf(`{"cuds":[
+		{"fields":{"sys.ID":1,"sys.QName":"app1pkg.options"}},
+		{"fields":{"sys.ID":2,"sys.QName":"app1pkg.department","pc_fix_button":1,"rm_fix_button":2}},
+		{"fields":{"sys.ID":3,"sys.QName":"app1pkg.department_options","sys.ParentID":2,"sys.Container":"department_options","id_department":2,"id_options":1,"option_number":7}},
+		{"fields":{"sys.ID":4,"sys.QName":"app1pkg.articles","name":"cola","article_manual":1,"article_hash":2,"hideonhold":0,"time_active":0,"control_active":0,"picture":%d}}
+	]}`, blobID)
+	vit.PostWS(srcWS, "c.sys.CUD", body)
+
+	// inactive docs are not exported
+	inactiveID := vit.PostWS(srcWS, "c.sys.CUD", `{"cuds":[{"fields":{"sys.ID":1,"sys.QName":"app1pkg.computers","name":"old"}}]}`).NewID()
+	vit.PostWS(srcWS, "c.sys.CUD", fmt.Sprintf(`{"cuds":[{"sys.ID":%d,"fields":{"sys.IsActive":false}}]}`, inactiveID))
+
+	srcData, err := workspace.ExportWorkspace(vit.IFederation, istructs.AppQName_test1_app1, srcWS.WSID, prn.Token)
+	require.NoError(err)
+
+	exp := workspace.WorkspaceExport{}
+	require.NoError(json.Unmarshal(srcData, &exp))
+	require.Equal(it.QNameApp1_TestWSKind, exp.WSKind)
+	require.Len(exp.Data, 4)
+	require.Len(exp.BLOBs, 1)
+	require.Equal(blobContent, exp.BLOBs[0].Content)
+	require.Equal("picture.png", exp.BLOBs[0].Name)
+
+	t.Run("import into workspace of the same kind", func(t *testing.T) {
+		dstWS := vit.CreateWorkspace(it.SimpleWSParams(vit.NextName()), prn)
+		require.NoError(workspace.ImportWorkspace(vit.IFederation, istructs.AppQName_test1_app1, dstWS.WSID, prn.Token, srcData))
+
+		// export of the imported workspace must be the same
+		dstData, err := workspace.ExportWorkspace(vit.IFederation, istructs.AppQName_test1_app1, dstWS.WSID, prn.Token)
+		require.NoError(err)
+		require.JSONEq(string(srcData), string(dstData))
+	})
+
+	t.Run("import into workspace of another kind", func(t *testing.T) {
+		anotherWS := vit.WS(istructs.AppQName_test1_app1, "test_ws_another")
+		err := workspace.ImportWorkspace(vit.IFederation, istructs.AppQName_test1_app1, anotherWS.WSID, anotherWS.Owner.Token, srcData)
+		require.ErrorContains(err, "c.sys.ImportWorkspace failed")
+	})
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/blastrain/vitess-sqlparser/sqlparser"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/acl"
+	"github.com/voedger/voedger/pkg/appparts"
 	"github.com/voedger/voedger/pkg/bus"
 	"github.com/voedger/voedger/pkg/coreutils"
 	"github.com/voedger/voedger/pkg/coreutils/federation"
@@ -23,7 +25,9 @@ import (
 	"github.com/voedger/voedger/pkg/goutils/jsonu"
 	"github.com/voedger/voedger/pkg/goutils/logger"
 	"github.com/voedger/voedger/pkg/goutils/strconvu"
+	"github.com/voedger/voedger/pkg/iauthnz"
 	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/istructsmem"
 	"github.com/voedger/voedger/pkg/itokens"
 	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
 	"github.com/voedger/voedger/pkg/processors"
@@ -32,7 +36,7 @@ import (
 	"github.com/voedger/voedger/pkg/sys/authnz"
 )
 
-func provideExecQrySQLQuery(federation federation.IFederation, itokens itokens.ITokens, blobHandlerPtr blobprocessor.IRequestHandlerPtr, requestSenderPtr bus.IRequestSenderPtr) func(ctx context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
+func provideExecQrySQLQuery(fed federation.IFederation, itokens itokens.ITokens, blobHandlerPtr blobprocessor.IRequestHandlerPtr, requestSenderPtr bus.IRequestSenderPtr) func(ctx context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
 	return func(ctx context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
 
 		query := args.ArgumentObject.AsString(field_Query)
@@ -91,8 +95,9 @@ func provideExecQrySQLQuery(federation federation.IFederation, itokens itokens.I
 			}
 			logger.Info(fmt.Sprintf("forwarding query to %s/%d", targetAppQName, targetWSID))
 			body := jsonu.Jprintf(`{"args":{"Query":%q},"elements":[{"fields":["Result"]}]}`, op.VSQLWithoutAppAndWSID)
-			resp, err := federation.Func(fmt.Sprintf("api/%s/%d/q.sys.SqlQuery", targetAppQName, targetWSID),
-				body, httpu.WithAuthorizeBy(tokenForTargetApp))
+			resp, err := fed.Func(fmt.Sprintf("api/%s/%d/q.sys.SqlQuery", targetAppQName, targetWSID),
+				body, httpu.WithAuthorizeBy(tokenForTargetApp),
+				federation.WithRequestIDFrom(args.Workpiece.(processors.IProcessorWorkpiece).LogCtx()))
 			if err != nil {
 				return err
 			}
@@ -174,6 +179,11 @@ func provideExecQrySQLQuery(federation federation.IFederation, itokens itokens.I
 			}
 		}
 
+		wp := args.Workpiece.(processors.IProcessorWorkpiece)
+		apppart := wp.AppPartition()
+		roles := wp.Roles()
+		f.mask = encryptedFieldsMask(args.Workspace, appStructs.AppDef(), apppart, roles)
+
 		kind := appStructs.AppDef().Type(sourceTableName).Kind()
 		switch kind {
 		case appdef.TypeKind_ViewRecord, appdef.TypeKind_CDoc, appdef.TypeKind_CRecord,
@@ -182,6 +192,9 @@ func provideExecQrySQLQuery(federation federation.IFederation, itokens itokens.I
 			if f.acceptAll {
 				if wf, ok := sourceTableType.(appdef.IWithFields); ok {
 					for _, fld := range wf.Fields() {
+						if f.mask(sourceTableName, fld.Name()) {
+							continue // inaccessible encrypted fields are masked in output
+						}
 						aclFields[fld.Name()] = true
 					}
 				}
@@ -201,9 +214,6 @@ func provideExecQrySQLQuery(federation federation.IFederation, itokens itokens.I
 			for fld := range aclFields {
 				fields = append(fields, fld)
 			}
-			wp := args.Workpiece.(processors.IProcessorWorkpiece)
-			apppart := wp.AppPartition()
-			roles := wp.Roles()
 			ok, err := apppart.IsOperationAllowed(args.Workspace, appdef.OperationKind_Select, sourceTableName, fields, roles)
 			if err != nil {
 				// notest
@@ -215,6 +225,15 @@ func provideExecQrySQLQuery(federation federation.IFederation, itokens itokens.I
 			if !ok {
 				return coreutils.NewHTTPErrorf(http.StatusForbidden)
 			}
+			if kind != appdef.TypeKind_ViewRecord {
+				f.policies = acl.RowPolicies(args.Workspace, appdef.OperationKind_Select, sourceTableName, roles)
+				f.subject = iauthnz.SubjectName(wp.GetPrincipals())
+			}
+		}
+		if wf, ok := sourceTableType.(appdef.IWithFields); ok && (whereExpr != nil) {
+			if whereExpr, f.json, err = extractJSONPredicates(whereExpr, wf); err != nil {
+				return coreutils.NewHTTPError(http.StatusBadRequest, err)
+			}
 		}
 		switch kind {
 		case appdef.TypeKind_ViewRecord:
@@ -242,6 +261,7 @@ func provideExecQrySQLQuery(federation federation.IFederation, itokens itokens.I
 				return coreutils.WrapSysError(e, http.StatusBadRequest)
 			}
 			appParts := args.Workpiece.(processors.IProcessorWorkpiece).AppPartitions()
+			f.cuds = eventCUDsFilter(appStructs, roles, iauthnz.SubjectName(wp.GetPrincipals()))
 			if sourceTableName == plog {
 				return coreutils.WrapSysError(readPlog(ctx, wsID, offset, limit, appStructs, f, callback, appStructs.AppDef(), appParts),
 					http.StatusBadRequest)
@@ -448,7 +468,59 @@ func getFilter(f func(string) bool) coreutils.MapperOpt {
 	})
 }
 
-func renderDBEvent(data map[string]interface{}, f *filter, event istructs.IDbEvent, appDef appdef.IAppDef, offset istructs.Offset) {
+// Returns function to check is field value should be masked.
+//
+// Encrypted fields, which can not be selected by roles, should be masked.
+func encryptedFieldsMask(ws appdef.IWorkspace, appDef appdef.IAppDef, appPart appparts.IAppPartition, roles []appdef.QName) func(appdef.QName, string) bool {
+	masked := map[appdef.QName]map[string]bool{}
+	return func(qName appdef.QName, name string) bool {
+		ff, ok := masked[qName]
+		if !ok {
+			ff = map[string]bool{}
+			if wf, ok := appDef.Type(qName).(appdef.IWithFields); ok {
+				for _, fld := range wf.Fields() {
+					if fld.Encrypted() {
+						allowed, err := appPart.IsOperationAllowed(ws, appdef.OperationKind_Select, qName, []appdef.FieldName{fld.Name()}, roles)
+						ff[fld.Name()] = err != nil || !allowed
+					}
+				}
+			}
+			masked[qName] = ff
+		}
+		return ff[name]
+	}
+}
+
+// Returns function to obtain filter of event CUDs, which are accessible by SELECT row-level security policies.
+//
+// Events from plog may belong to different workspaces, so policies are obtained for each event workspace.
+// CUDs from workspaces without descriptor are not restricted.
+func eventCUDsFilter(appStructs istructs.IAppStructs, roles []appdef.QName, subject string) func(istructs.WSID) func(istructs.ICUDRow) bool {
+	filters := map[istructs.WSID]func(istructs.ICUDRow) bool{}
+	return func(wsid istructs.WSID) func(istructs.ICUDRow) bool {
+		if flt, ok := filters[wsid]; ok {
+			return flt
+		}
+		var ws appdef.IWorkspace
+		wsDesc, err := processors.GetWSDesc(wsid, appStructs)
+		switch {
+		case err == nil:
+			ws = appStructs.AppDef().WorkspaceByDescriptor(wsDesc.AsQName(authnz.Field_WSKind))
+		case !errors.Is(err, processors.ErrWSNotInited):
+			// notest
+			return func(istructs.ICUDRow) bool { return false }
+		}
+		policies := istructsmem.NewSelectRowPolicies(ws, roles, subject)
+		flt := func(rec istructs.ICUDRow) bool {
+			ok, _ := policies.IsAllowed(rec, rec.QName())
+			return ok
+		}
+		filters[wsid] = flt
+		return flt
+	}
+}
+
+func renderDBEvent(data map[string]interface{}, f *filter, event istructs.IDbEvent, wsid istructs.WSID, appDef appdef.IAppDef, offset istructs.Offset) {
 	defer func() {
 		if r := recover(); r != nil {
 			eventKind := "plog"
@@ -465,10 +537,14 @@ func renderDBEvent(data map[string]interface{}, f *filter, event istructs.IDbEve
 		data["QName"] = event.QName().String()
 	}
 	if f.filter("ArgumentObject") {
-		data["ArgumentObject"] = coreutils.ObjectToMap(event.ArgumentObject(), appDef)
+		data["ArgumentObject"] = coreutils.ObjectToMap(event.ArgumentObject(), appDef, coreutils.WithMask(f.mask))
 	}
 	if f.filter("CUDs") {
-		data["CUDs"] = coreutils.CUDsToMap(event, appDef)
+		opts := []coreutils.CUDsOpt{coreutils.WithMapperOpts(coreutils.WithMask(f.mask))}
+		if f.cuds != nil {
+			opts = append(opts, coreutils.WithRowFilter(f.cuds(wsid)))
+		}
+		data["CUDs"] = coreutils.CUDsToMap(event, appDef, opts...)
 	}
 	if f.filter("RegisteredAt") {
 		data["RegisteredAt"] = event.RegisteredAt()
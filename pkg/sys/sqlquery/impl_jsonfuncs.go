@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package sqlquery
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/blastrain/vitess-sqlparser/sqlparser"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/jsonpath"
+)
+
+const jsonFuncJSONExtract = "json_extract"
+
+// Predicate by value addressed by path in json field, like `json_extract(Settings, '$.theme') = 'dark'`
+type jsonPredicate struct {
+	fieldName string
+	path      jsonpath.Path
+	values    []interface{}
+}
+
+func (p jsonPredicate) match(rr istructs.IRowReader) bool {
+	v := rr.AsJSONPath(p.fieldName, p.path.String())
+	return slices.ContainsFunc(p.values, func(value interface{}) bool { return jsonpath.Equal(v, value) })
+}
+
+// Extracts json_extract() predicates from top-level AND conjunction of where expression.
+//
+// Returns rest of expression, which is nil if all conditions are json predicates
+func extractJSONPredicates(expr sqlparser.Expr, withFields appdef.IWithFields) (rest sqlparser.Expr, pp []jsonPredicate, err error) {
+	switch r := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, lp, err := extractJSONPredicates(r.Left, withFields)
+		if err != nil {
+			return nil, nil, err
+		}
+		right, rp, err := extractJSONPredicates(r.Right, withFields)
+		if err != nil {
+			return nil, nil, err
+		}
+		pp = append(lp, rp...)
+		switch {
+		case left == nil:
+			return right, pp, nil
+		case right == nil:
+			return left, pp, nil
+		}
+		return &sqlparser.AndExpr{Left: left, Right: right}, pp, nil
+	case *sqlparser.ComparisonExpr:
+		funcExpr, ok := r.Left.(*sqlparser.FuncExpr)
+		if !ok || funcExpr.Name.Lowered() != jsonFuncJSONExtract {
+			return expr, nil, nil
+		}
+		p, err := parseJSONPredicate(funcExpr, r, withFields)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, []jsonPredicate{p}, nil
+	}
+	return expr, nil, nil
+}
+
+func parseJSONPredicate(funcExpr *sqlparser.FuncExpr, compExpr *sqlparser.ComparisonExpr, withFields appdef.IWithFields) (p jsonPredicate, err error) {
+	if len(funcExpr.Exprs) != 2 {
+		return p, fmt.Errorf("%s requires two arguments (field name and path)", jsonFuncJSONExtract)
+	}
+
+	firstArg, ok := funcExpr.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		// notest: do not know how to trigger
+		return p, fmt.Errorf("%s: first argument must be a field name", jsonFuncJSONExtract)
+	}
+	colName, ok := firstArg.Expr.(*sqlparser.ColName)
+	if !ok {
+		return p, fmt.Errorf("%s: first argument must be a field name", jsonFuncJSONExtract)
+	}
+	p.fieldName = recoverFieldName(withFields, colName.Name.String())
+	if fld := withFields.Field(p.fieldName); fld == nil || fld.DataKind() != appdef.DataKind_json {
+		return p, fmt.Errorf("%s: field '%s' is not a json field", jsonFuncJSONExtract, p.fieldName)
+	}
+
+	secondArg, ok := funcExpr.Exprs[1].(*sqlparser.AliasedExpr)
+	if !ok {
+		// notest: do not know how to trigger
+		return p, fmt.Errorf("%s: second argument must be a path string", jsonFuncJSONExtract)
+	}
+	pathVal, ok := secondArg.Expr.(*sqlparser.SQLVal)
+	if !ok || pathVal.Type != sqlparser.StrVal {
+		return p, fmt.Errorf("%s: second argument must be a path string", jsonFuncJSONExtract)
+	}
+	if p.path, err = jsonpath.Parse(string(pathVal.Val)); err != nil {
+		return p, err
+	}
+
+	switch compExpr.Operator {
+	case sqlparser.EqualStr:
+		v, err := jsonValue(compExpr.Right)
+		if err != nil {
+			return p, err
+		}
+		p.values = append(p.values, v)
+	case sqlparser.InStr:
+		tuple, ok := compExpr.Right.(sqlparser.ValTuple)
+		if !ok {
+			// notest: IN requires tuple by sql syntax
+			return p, fmt.Errorf("unsupported %s values expression: %T", jsonFuncJSONExtract, compExpr.Right)
+		}
+		for _, e := range tuple {
+			v, err := jsonValue(e)
+			if err != nil {
+				return p, err
+			}
+			p.values = append(p.values, v)
+		}
+	default:
+		return p, fmt.Errorf("unsupported %s operation: %s", jsonFuncJSONExtract, compExpr.Operator)
+	}
+
+	return p, nil
+}
+
+// Converts sql literal to value comparable with json value
+func jsonValue(expr sqlparser.Expr) (interface{}, error) {
+	switch v := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch v.Type {
+		case sqlparser.StrVal:
+			return string(v.Val), nil
+		case sqlparser.IntVal, sqlparser.FloatVal:
+			return json.Number(v.Val), nil
+		}
+	case sqlparser.BoolVal:
+		return bool(v), nil
+	case *sqlparser.NullVal:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unsupported %s value expression: %s", jsonFuncJSONExtract, sqlparser.String(expr))
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package sqlquery
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/blastrain/vitess-sqlparser/sqlparser"
+	"github.com/stretchr/testify/require"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/appdef/builder"
+	"github.com/voedger/voedger/pkg/coreutils"
+)
+
+func TestExtractJSONPredicates(t *testing.T) {
+	docName := appdef.NewQName("test", "doc")
+
+	adb := builder.New()
+	adb.AddPackage("test", "test.com/test")
+	wsb := adb.AddWorkspace(appdef.NewQName("test", "workspace"))
+	wsb.AddCDoc(docName).
+		AddField("Settings", appdef.DataKind_json, false).
+		AddField("Name", appdef.DataKind_string, false)
+	app, err := adb.Build()
+	require.NoError(t, err)
+	doc := appdef.CDoc(app.Type, docName)
+
+	where := func(t *testing.T, sql string) sqlparser.Expr {
+		stmt, err := sqlparser.Parse("select * from test.doc where " + sql)
+		require.NoError(t, err)
+		return stmt.(*sqlparser.Select).Where.Expr
+	}
+
+	t.Run("should extract predicates", func(t *testing.T) {
+		require := require.New(t)
+
+		rest, pp, err := extractJSONPredicates(where(t, `json_extract(settings, '$.theme') = 'dark' and id = 1 and json_extract(Settings, 'sizes[0]') in (10, 20.5, null)`), doc)
+		require.NoError(err)
+		require.Equal("id = 1", sqlparser.String(rest))
+		require.Len(pp, 2)
+		require.Equal("Settings", pp[0].fieldName)
+		require.Equal("$.theme", pp[0].path.String())
+		require.Equal([]interface{}{"dark"}, pp[0].values)
+		require.Equal("$.sizes[0]", pp[1].path.String())
+		require.Equal([]interface{}{json.Number("10"), json.Number("20.5"), nil}, pp[1].values)
+
+		rest, pp, err = extractJSONPredicates(where(t, `json_extract(Settings, '$.dark') = true`), doc)
+		require.NoError(err)
+		require.Nil(rest)
+		require.Equal([]interface{}{true}, pp[0].values)
+	})
+
+	t.Run("should match rows", func(t *testing.T) {
+		require := require.New(t)
+
+		_, pp, err := extractJSONPredicates(where(t, `json_extract(Settings, '$.sizes[1]') in (10, 20)`), doc)
+		require.NoError(err)
+		f := filter{json: pp}
+
+		row := &coreutils.TestObject{Data: map[string]interface{}{"Settings": `{"sizes":[10,20]}`}}
+		require.True(f.match(row))
+
+		row.Data["Settings"] = `{"sizes":[10,30]}`
+		require.False(f.match(row))
+
+		row.Data["Settings"] = `{}`
+		require.False(f.match(row))
+	})
+
+	t.Run("should return error", func(t *testing.T) {
+		tests := []struct {
+			sql string
+			err string
+		}{
+			{`json_extract(Settings) = 1`, "requires two arguments"},
+			{`json_extract('Settings', '$.a') = 1`, "first argument must be a field name"},
+			{`json_extract(Name, '$.a') = 1`, "'Name' is not a json field"},
+			{`json_extract(Unknown, '$.a') = 1`, "'Unknown' is not a json field"},
+			{`json_extract(Settings, 1) = 1`, "second argument must be a path string"},
+			{`json_extract(Settings, '$.a[x]') = 1`, "invalid json path"},
+			{`json_extract(Settings, '$.a') > 1`, "unsupported json_extract operation: >"},
+			{`json_extract(Settings, '$.a') = Name`, "unsupported json_extract value expression: Name"},
+		}
+		for _, test := range tests {
+			t.Run(test.sql, func(t *testing.T) {
+				_, _, err := extractJSONPredicates(where(t, test.sql), doc)
+				require.ErrorContains(t, err, test.err)
+			})
+		}
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/voedger/voedger/pkg/coreutils"
 	"github.com/voedger/voedger/pkg/goutils/strconvu"
 	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/istructsmem"
 )
 
 func readRecords(wsid istructs.WSID, qName appdef.QName, expr sqlparser.Expr, appStructs istructs.IAppStructs, f *filter,
@@ -131,8 +132,14 @@ func callbackRec(rec istructs.IRecord, appStructs istructs.IAppStructs, qName ap
 	if rec.QName() != qName {
 		return fmt.Errorf("record with ID '%d' has mismatching QName '%s'", rec.ID(), rec.QName())
 	}
+	if !f.match(rec) {
+		return nil
+	}
+	if allowed, err := istructsmem.IsRowAllowed(rec, f.policies, f.subject); !allowed {
+		return err // rows, which are not accessible by row policies, are skipped
+	}
 
-	data := coreutils.FieldsToMap(rec, appStructs.AppDef(), getFilter(f.filter), coreutils.WithAllFields())
+	data := coreutils.FieldsToMap(rec, appStructs.AppDef(), getFilter(f.filter), coreutils.WithMask(f.mask), coreutils.WithAllFields())
 	bb, err := json.Marshal(data)
 	if err != nil {
 		// notest
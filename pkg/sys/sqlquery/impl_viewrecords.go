@@ -88,9 +88,10 @@ func readViewRecords(ctx context.Context, wsid istructs.WSID, viewRecordQName ap
 
 		switch f.DataKind() {
 		case appdef.DataKind_int8, appdef.DataKind_int16, appdef.DataKind_int32, appdef.DataKind_int64,
-			appdef.DataKind_float32, appdef.DataKind_float64, appdef.DataKind_RecordID:
+			appdef.DataKind_float32, appdef.DataKind_float64, appdef.DataKind_RecordID, appdef.DataKind_decimal:
 			kb.PutNumber(k.name, json.Number(k.value))
-		case appdef.DataKind_bytes, appdef.DataKind_string, appdef.DataKind_QName:
+		case appdef.DataKind_bytes, appdef.DataKind_string, appdef.DataKind_QName,
+			appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp, appdef.DataKind_uuid:
 			kb.PutChars(k.name, string(k.value))
 		default:
 			return errUnsupportedDataKind
@@ -98,8 +99,11 @@ func readViewRecords(ctx context.Context, wsid istructs.WSID, viewRecordQName ap
 	}
 
 	return appStructs.ViewRecords().Read(ctx, wsid, kb, func(key istructs.IKey, value istructs.IValue) (err error) {
+		if !f.match(value) {
+			return nil
+		}
 		data := coreutils.FieldsToMap(key, appStructs.AppDef(), getFilter(f.filter))
-		for k, v := range coreutils.FieldsToMap(value, appStructs.AppDef(), getFilter(f.filter)) {
+		for k, v := range coreutils.FieldsToMap(value, appStructs.AppDef(), getFilter(f.filter), coreutils.WithMask(f.mask)) {
 			data[k] = v
 		}
 		bb, err := json.Marshal(data)
@@ -29,7 +29,7 @@ func readWlog(ctx context.Context, wsid istructs.WSID, offset istructs.Offset, c
 			data["WlogOffset"] = wlogOffset
 		}
 
-		renderDBEvent(data, f, event, appDef, offset)
+		renderDBEvent(data, f, event, wsid, appDef, offset)
 
 		bb, err := json.Marshal(data)
 		if err != nil {
@@ -5,12 +5,28 @@
 package sqlquery
 
 import (
+	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/istructs"
 )
 
 type filter struct {
 	acceptAll bool
 	fields    map[string]bool
+	json      []jsonPredicate
+	policies  []appdef.IRowPolicy                                  // row-level security policies for records
+	subject   string                                               // request subject name to evaluate policies
+	mask      func(qName appdef.QName, field string) bool          // returns is field value should be masked
+	cuds      func(wsid istructs.WSID) func(istructs.ICUDRow) bool // returns filter of accessible CUDs of plog/wlog events of workspace
+}
+
+// Returns is row matches all json predicates
+func (f *filter) match(rr istructs.IRowReader) bool {
+	for _, p := range f.json {
+		if !p.match(rr) {
+			return false
+		}
+	}
+	return true
 }
 
 func (f *filter) filter(field string) bool {
@@ -13,4 +13,5 @@ const (
 	httpStorageKeyBuilderStringerSliceCap = 3
 	field_WSKind                          = "WSKind"
 	wsidTypeValidatorCacheSize            = 100
+	loggerStorageLogStage                 = "ext.log"
 )
@@ -65,6 +65,22 @@ func errRecordIDFieldUndefined(name string) error {
 	return errors.New("undefined RecordID field: " + name)
 }
 
+func errDecimalFieldUndefined(name string) error {
+	return errors.New("undefined decimal field: " + name)
+}
+
+func errTimeFieldUndefined(name string) error {
+	return errors.New("undefined date or time field: " + name)
+}
+
+func errUUIDFieldUndefined(name string) error {
+	return errors.New("undefined uuid field: " + name)
+}
+
+func errJSONFieldUndefined(name string) error {
+	return errors.New("undefined json field: " + name)
+}
+
 func errNumberFieldUndefined(name string) error {
 	return errors.New("undefined number field: " + name)
 }
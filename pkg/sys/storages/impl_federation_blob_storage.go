@@ -30,15 +30,18 @@ type federationBlobStorage struct {
 	federation federation.IFederation
 	tokens     itokens.ITokens
 	emulation  state.FederationBlobHandler
+	logCtx     state.LogCtxFunc
 }
 
-func NewFederationBlobStorage(appStructs state.AppStructsFunc, wsid state.WSIDFunc, federation federation.IFederation, tokens itokens.ITokens, emulation state.FederationBlobHandler) state.IStateStorage {
+func NewFederationBlobStorage(appStructs state.AppStructsFunc, wsid state.WSIDFunc, federation federation.IFederation, tokens itokens.ITokens, emulation state.FederationBlobHandler,
+	logCtx state.LogCtxFunc) state.IStateStorage {
 	return &federationBlobStorage{
 		appStructs: appStructs,
 		wsid:       wsid,
 		federation: federation,
 		tokens:     tokens,
 		emulation:  emulation,
+		logCtx:     logCtx,
 	}
 }
 
@@ -214,6 +217,7 @@ func (s *federationBlobStorage) getReadCloser(key istructs.IStateKeyBuilder) (io
 			}
 			opts = append(opts, httpu.WithAuthorizeBy(systemPrincipalToken))
 		}
+		opts = append(opts, federation.WithRequestIDFrom(s.logCtx()))
 		blobReader, err := s.federation.ReadBLOB(appdef.NewAppQName(owner, appname), wsid, kb.ownerRecord, kb.ownerRecordField,
 			kb.ownerID, opts...)
 		if err != nil {
@@ -34,7 +34,7 @@ func TestFederationBlobStorage_BasicUsage(t *testing.T) {
 	appStructsFunc := func() istructs.IAppStructs {
 		return mockedStructs
 	}
-	storage := NewFederationBlobStorage(appStructsFunc, nil, nil, nil, federatioBlobHandler)
+	storage := NewFederationBlobStorage(appStructsFunc, nil, nil, nil, federatioBlobHandler, nil)
 	k := storage.NewKeyBuilder(appdef.NullQName, nil)
 	k.PutString(sys.Storage_FederationBlob_Field_Owner, "owner")
 	k.PutString(sys.Storage_FederationBlob_Field_AppName, "appname")
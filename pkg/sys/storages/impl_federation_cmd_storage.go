@@ -31,15 +31,18 @@ type federationCommandStorage struct {
 	federation federation.IFederation
 	tokens     itokens.ITokens
 	emulation  state.FederationCommandHandler
+	logCtx     state.LogCtxFunc
 }
 
-func NewFederationCommandStorage(appStructs state.AppStructsFunc, wsid state.WSIDFunc, federation federation.IFederation, tokens itokens.ITokens, emulation state.FederationCommandHandler) *federationCommandStorage {
+func NewFederationCommandStorage(appStructs state.AppStructsFunc, wsid state.WSIDFunc, federation federation.IFederation, tokens itokens.ITokens, emulation state.FederationCommandHandler,
+	logCtx state.LogCtxFunc) *federationCommandStorage {
 	return &federationCommandStorage{
 		appStructs: appStructs,
 		wsid:       wsid,
 		federation: federation,
 		tokens:     tokens,
 		emulation:  emulation,
+		logCtx:     logCtx,
 	}
 }
 
@@ -210,6 +213,7 @@ func (s *federationCommandStorage) Get(key istructs.IStateKeyBuilder) (istructs.
 			opts = append(opts, httpu.WithAuthorizeBy(systemPrincipalToken))
 		}
 
+		opts = append(opts, federation.WithRequestIDFrom(s.logCtx()))
 		resp, err := s.federation.Func(relativeURL, body, opts...)
 		if err != nil {
 			return nil, err
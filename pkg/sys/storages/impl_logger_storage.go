@@ -13,10 +13,14 @@ import (
 )
 
 type loggerStorage struct {
+	logCtx state.LogCtxFunc
 }
 
-func NewLoggerStorage() state.IStateStorage {
-	return &loggerStorage{}
+// log records are written with the attributes of the request or event being handled by the extension
+func NewLoggerStorage(logCtx state.LogCtxFunc) state.IStateStorage {
+	return &loggerStorage{
+		logCtx: logCtx,
+	}
 }
 
 type loggerStorageKeyBuilder struct {
@@ -80,18 +84,7 @@ func (s *loggerStorage) ApplyBatch(items []state.ApplyBatchItem) (err error) {
 			continue
 		}
 		value := item.Value.(*loggerStorageValueBuilder)
-		switch key.logLevel {
-		case logger.LogLevelError:
-			logger.Error(value.message)
-		case logger.LogLevelWarning:
-			logger.Warning(value.message)
-		case logger.LogLevelInfo:
-			logger.Info(value.message)
-		case logger.LogLevelVerbose:
-			logger.Verbose(value.message)
-		case logger.LogLevelTrace:
-			logger.Trace(value.message)
-		}
+		logger.LogCtx(s.logCtx(), 0, key.logLevel, loggerStorageLogStage, value.message)
 	}
 	return nil
 }
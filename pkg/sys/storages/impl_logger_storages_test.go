@@ -5,7 +5,7 @@
 package storages
 
 import (
-	"strings"
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -15,7 +15,11 @@ import (
 )
 
 func Test_LoggerStorage(t *testing.T) {
-	storage := NewLoggerStorage()
+	logCtx := logger.WithContextAttrs(context.Background(), map[string]any{
+		logger.LogAttr_ReqID: "req-1",
+		logger.LogAttr_WSID:  42,
+	})
+	storage := NewLoggerStorage(func() context.Context { return logCtx })
 
 	key := storage.NewKeyBuilder(sys.Storage_Logger, nil)
 	key.PutInt32(sys.Storage_Logger_Field_LogLevel, int32(logger.LogLevelTrace))
@@ -27,19 +31,13 @@ func Test_LoggerStorage(t *testing.T) {
 
 	value.PutString(sys.Storage_Logger_Field_Message, "Hello, World!")
 
-	var line string
-	logger.PrintLine = func(level logger.TLogLevel, message string) {
-		line = message
-	}
-
-	logger.SetLogLevel(logger.LogLevelVerbose)
-	defer logger.SetLogLevel(logger.LogLevelInfo)
+	logCap := logger.StartCapture(t, logger.LogLevelVerbose)
 	err = intents.ApplyBatch([]state.ApplyBatchItem{{Key: key, Value: value}})
 	require.NoError(t, err)
-	require.Empty(t, line)
+	logCap.NotContains("Hello, World!")
 
 	logger.SetLogLevel(logger.LogLevelTrace)
 	err = intents.ApplyBatch([]state.ApplyBatchItem{{Key: key, Value: value}})
 	require.NoError(t, err)
-	require.True(t, strings.HasSuffix(line, "Hello, World!"))
+	logCap.HasLine("Hello, World!", "stage="+loggerStorageLogStage, "reqid=req-1", "wsid=42")
 }
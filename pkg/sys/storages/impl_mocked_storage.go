@@ -11,9 +11,13 @@ import (
 	"hash/fnv"
 	"reflect"
 	"sort"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys"
@@ -404,6 +408,18 @@ func (mkb *mockedKeyBuilder) PutRecordID(field appdef.FieldName, value istructs.
 	mkb.TestObject.Data[field] = value
 }
 
+func (mkb *mockedKeyBuilder) PutDecimal(field appdef.FieldName, value decimal.Decimal) {
+	mkb.TestObject.Data[field] = value
+}
+
+func (mkb *mockedKeyBuilder) PutTime(field appdef.FieldName, value time.Time) {
+	mkb.TestObject.Data[field] = value
+}
+
+func (mkb *mockedKeyBuilder) PutUUID(field appdef.FieldName, value uuid.UUID) {
+	mkb.TestObject.Data[field] = value
+}
+
 func (mkb *mockedKeyBuilder) PutNumber(field appdef.FieldName, value json.Number) {
 	mkb.TestObject.Data[field] = value
 }
@@ -477,6 +493,18 @@ func (mvb *mockedValueBuilder) PutRecordID(name appdef.FieldName, id istructs.Re
 	}
 }
 
+func (mvb *mockedValueBuilder) PutDecimal(name appdef.FieldName, d decimal.Decimal) {
+	mvb.value.TestObjects[0].Data[name] = d
+}
+
+func (mvb *mockedValueBuilder) PutTime(name appdef.FieldName, t time.Time) {
+	mvb.value.TestObjects[0].Data[name] = t
+}
+
+func (mvb *mockedValueBuilder) PutUUID(name appdef.FieldName, u uuid.UUID) {
+	mvb.value.TestObjects[0].Data[name] = u
+}
+
 func (mvb *mockedValueBuilder) PutNumber(name appdef.FieldName, number json.Number) {
 	mvb.value.TestObjects[0].Data[name] = number
 }
@@ -710,6 +738,22 @@ func (m *mockedStateValue) AsRecordID(name appdef.FieldName) istructs.RecordID {
 	}
 }
 
+func (m *mockedStateValue) AsDecimal(name appdef.FieldName) decimal.Decimal {
+	return m.TestObjects[0].AsDecimal(name)
+}
+
+func (m *mockedStateValue) AsTime(name appdef.FieldName) time.Time {
+	return m.TestObjects[0].AsTime(name)
+}
+
+func (m *mockedStateValue) AsUUID(name appdef.FieldName) uuid.UUID {
+	return m.TestObjects[0].AsUUID(name)
+}
+
+func (m *mockedStateValue) AsJSONPath(name appdef.FieldName, path string) any {
+	return m.TestObjects[0].AsJSONPath(name, path)
+}
+
 func (m *mockedStateValue) RecordIDs(includeNulls bool) func(func(appdef.FieldName, istructs.RecordID) bool) {
 	panic(errNotImplemented)
 }
@@ -9,9 +9,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys"
@@ -22,6 +26,7 @@ type recordsStorage struct {
 	cudFunc          state.CUDFunc
 	wsidFunc         state.WSIDFunc
 	wsTypeVailidator wsTypeVailidator
+	rowPolicies      *rowPoliciesFilter
 }
 
 type iStructureInt64FieldTypeChecker interface {
@@ -123,6 +128,15 @@ func NewRecordsStorage(appStructsFunc state.AppStructsFunc, wsidFunc state.WSIDF
 	}
 }
 
+// Returns records storage for the query processor state.
+//
+// Records, which are not accessible by SELECT row-level security policies of the request roles, are read as not existing.
+func NewQueryRecordsStorage(appStructsFunc state.AppStructsFunc, wsidFunc state.WSIDFunc, principalsFunc state.PrincipalsFunc) state.IStateStorage {
+	s := NewRecordsStorage(appStructsFunc, wsidFunc, nil).(*recordsStorage)
+	s.rowPolicies = newRowPoliciesFilter(appStructsFunc, principalsFunc)
+	return s
+}
+
 func (s *recordsStorage) NewKeyBuilder(entity appdef.QName, _ istructs.IStateKeyBuilder) istructs.IStateKeyBuilder {
 	return &recordsKeyBuilder{
 		id:             istructs.NullRecordID,
@@ -153,6 +167,9 @@ func (s *recordsStorage) Get(key istructs.IStateKeyBuilder) (value istructs.ISta
 		if singleton.QName() == appdef.NullQName {
 			return nil, nil
 		}
+		if ok, err := s.rowPolicies.isRecordAllowed(k.wsid, singleton); !ok {
+			return nil, err
+		}
 		return &recordsValue{record: singleton}, nil
 	}
 	if k.id == istructs.NullRecordID {
@@ -166,6 +183,9 @@ func (s *recordsStorage) Get(key istructs.IStateKeyBuilder) (value istructs.ISta
 	if record.QName() == appdef.NullQName {
 		return nil, nil
 	}
+	if ok, err := s.rowPolicies.isRecordAllowed(k.wsid, record); !ok {
+		return nil, err
+	}
 	return &recordsValue{record: record}, nil
 }
 
@@ -212,6 +232,12 @@ func (s *recordsStorage) GetBatch(items []state.GetBatchItem) (err error) {
 			if batchItem.Record.QName() == appdef.NullQName {
 				continue
 			}
+			if ok, err := s.rowPolicies.isRecordAllowed(wsid, batchItem.Record); !ok {
+				if err != nil {
+					return err
+				}
+				continue
+			}
 			items[wsidToItemIdx[wsid][i]].Value = &recordsValue{record: batchItem.Record}
 		}
 	}
@@ -223,6 +249,12 @@ func (s *recordsStorage) GetBatch(items []state.GetBatchItem) (err error) {
 		if singleton.QName() == appdef.NullQName {
 			continue
 		}
+		if ok, err := s.rowPolicies.isRecordAllowed(g.wsid, singleton); !ok {
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		items[g.itemIdx].Value = &recordsValue{record: singleton}
 	}
 	return err
@@ -304,6 +336,15 @@ func (b *recordsValueBuilder) PutNumber(name string, value json.Number) { b.rw.P
 func (b *recordsValueBuilder) PutRecordID(name string, value istructs.RecordID) {
 	b.rw.PutRecordID(name, value)
 }
+func (b *recordsValueBuilder) PutDecimal(name string, value decimal.Decimal) {
+	b.rw.PutDecimal(name, value)
+}
+func (b *recordsValueBuilder) PutTime(name string, value time.Time) {
+	b.rw.PutTime(name, value)
+}
+func (b *recordsValueBuilder) PutUUID(name string, value uuid.UUID) {
+	b.rw.PutUUID(name, value)
+}
 
 type recordsValue struct {
 	baseStateValue
@@ -324,5 +365,17 @@ func (v *recordsValue) AsBool(name string) bool          { return v.record.AsBoo
 func (v *recordsValue) AsRecordID(name string) istructs.RecordID {
 	return v.record.AsRecordID(name)
 }
+func (v *recordsValue) AsDecimal(name string) decimal.Decimal {
+	return v.record.AsDecimal(name)
+}
+func (v *recordsValue) AsTime(name string) time.Time {
+	return v.record.AsTime(name)
+}
+func (v *recordsValue) AsUUID(name string) uuid.UUID {
+	return v.record.AsUUID(name)
+}
+func (v *recordsValue) AsJSONPath(name string, path string) any {
+	return v.record.AsJSONPath(name, path)
+}
 func (v *recordsValue) AsRecord() (record istructs.IRecord)           { return v.record }
 func (v *recordsValue) FieldNames(cb func(iField appdef.IField) bool) { v.record.Fields(cb) }
@@ -7,8 +7,12 @@ package storages
 import (
 	"encoding/json"
 	"reflect"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys"
@@ -121,3 +125,12 @@ func (c *resultValueBuilder) PutNumber(name string, value json.Number) {
 func (c *resultValueBuilder) PutRecordID(name string, value istructs.RecordID) {
 	c.resultBuilder.PutRecordID(name, value)
 }
+func (c *resultValueBuilder) PutDecimal(name string, value decimal.Decimal) {
+	c.resultBuilder.PutDecimal(name, value)
+}
+func (c *resultValueBuilder) PutTime(name string, value time.Time) {
+	c.resultBuilder.PutTime(name, value)
+}
+func (c *resultValueBuilder) PutUUID(name string, value uuid.UUID) {
+	c.resultBuilder.PutUUID(name, value)
+}
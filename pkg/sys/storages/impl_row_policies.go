@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2026-present unTill Software Development Group B.V.
+ */
+
+package storages
+
+import (
+	"errors"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/iauthnz"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/istructsmem"
+	"github.com/voedger/voedger/pkg/state"
+)
+
+// Hides records, which are not accessible by SELECT row-level security policies of the request roles.
+//
+// Used by storages of the query processor state. Nil filter does not restrict records.
+type rowPoliciesFilter struct {
+	appStructsFunc state.AppStructsFunc
+	principalsFunc state.PrincipalsFunc
+	wsTypes        wsTypeVailidator
+	policies       map[istructs.WSID]*istructsmem.SelectRowPolicies
+}
+
+func newRowPoliciesFilter(appStructsFunc state.AppStructsFunc, principalsFunc state.PrincipalsFunc) *rowPoliciesFilter {
+	return &rowPoliciesFilter{
+		appStructsFunc: appStructsFunc,
+		principalsFunc: principalsFunc,
+		wsTypes:        newWsTypeValidator(appStructsFunc),
+		policies:       map[istructs.WSID]*istructsmem.SelectRowPolicies{},
+	}
+}
+
+// Returns row policies of the workspace with specified ID
+func (f *rowPoliciesFilter) workspacePolicies(wsid istructs.WSID) (*istructsmem.SelectRowPolicies, error) {
+	if p, ok := f.policies[wsid]; ok {
+		return p, nil
+	}
+	wsKind, err := f.wsTypes.getWSIDKind(wsid, appdef.NullQName)
+	if err != nil && !errors.Is(err, errWorkspaceDescriptorNotFound) {
+		return nil, err
+	}
+	principals := f.principalsFunc()
+	roles := make([]appdef.QName, 0, len(principals))
+	for _, prn := range principals {
+		if prn.Kind == iauthnz.PrincipalKind_Role {
+			roles = append(roles, prn.QName)
+		}
+	}
+	p := istructsmem.NewSelectRowPolicies(f.appStructsFunc().AppDef().WorkspaceByDescriptor(wsKind), roles, iauthnz.SubjectName(principals))
+	if len(f.policies) < wsidTypeValidatorCacheSize {
+		f.policies[wsid] = p
+	}
+	return p, nil
+}
+
+// Returns is record from specified workspace accessible
+func (f *rowPoliciesFilter) isRecordAllowed(wsid istructs.WSID, rec istructs.IRecord) (bool, error) {
+	if f == nil || rec.QName() == appdef.NullQName {
+		return true, nil
+	}
+	p, err := f.workspacePolicies(wsid)
+	if err != nil {
+		return false, err
+	}
+	return p.IsAllowed(rec, rec.QName())
+}
+
+// Returns is view value from specified workspace accessible.
+//
+// View value is accessible if all records stored in its fields are accessible,
+// e.g. rows of sys.CollectionView
+func (f *rowPoliciesFilter) isViewValueAllowed(wsid istructs.WSID, view appdef.QName, value istructs.IValue) (bool, error) {
+	if f == nil {
+		return true, nil
+	}
+	v := appdef.View(f.appStructsFunc().AppDef().Type, view)
+	if v == nil {
+		return true, nil
+	}
+	for _, fld := range v.Value().Fields() {
+		if fld.DataKind() != appdef.DataKind_Record {
+			continue
+		}
+		if ok, err := f.isRecordAllowed(wsid, value.AsRecord(fld.Name())); !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Returns event, which CUDs enumerates accessible CUD rows only
+func (f *rowPoliciesFilter) wLogEvent(wsid istructs.WSID, event istructs.IWLogEvent) (istructs.IWLogEvent, error) {
+	if f == nil {
+		return event, nil
+	}
+	p, err := f.workspacePolicies(wsid)
+	if err != nil {
+		return nil, err
+	}
+	return &rowPoliciesWLogEvent{IWLogEvent: event, cuds: p.CUDs(event.CUDs)}, nil
+}
+
+type rowPoliciesWLogEvent struct {
+	istructs.IWLogEvent
+	cuds func(func(istructs.ICUDRow) bool)
+}
+
+func (e *rowPoliciesWLogEvent) CUDs(cb func(istructs.ICUDRow) bool) { e.cuds(cb) }
@@ -9,8 +9,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys"
@@ -101,6 +105,9 @@ func (b *uniqKeyBuilder) PutString(name string, value string)              { b.d
 func (b *uniqKeyBuilder) PutQName(name string, value appdef.QName)         { b.data[name] = value }
 func (b *uniqKeyBuilder) PutBool(name string, value bool)                  { b.data[name] = value }
 func (b *uniqKeyBuilder) PutRecordID(name string, value istructs.RecordID) { b.data[name] = value }
+func (b *uniqKeyBuilder) PutDecimal(name string, value decimal.Decimal)    { b.data[name] = value }
+func (b *uniqKeyBuilder) PutTime(name string, value time.Time)             { b.data[name] = value }
+func (b *uniqKeyBuilder) PutUUID(name string, value uuid.UUID)             { b.data[name] = value }
 func (b *uniqKeyBuilder) PutNumber(string, json.Number)                    { panic(ErrNotSupported) }
 func (b *uniqKeyBuilder) PutChars(string, string)                          { panic(ErrNotSupported) }
 func (b *uniqKeyBuilder) PutFromJSON(j map[string]any)                     { maps.Copy(b.data, j) }
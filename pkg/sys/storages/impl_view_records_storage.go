@@ -7,13 +7,20 @@ package storages
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/goutils/logger"
+	"github.com/voedger/voedger/pkg/in10n"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys"
@@ -25,6 +32,7 @@ type viewRecordsStorage struct {
 	wsidFunc         state.WSIDFunc
 	n10nFunc         state.N10nFunc
 	wsTypeVailidator wsTypeVailidator
+	rowPolicies      *rowPoliciesFilter
 }
 
 type iViewInt64FieldTypeChecker interface {
@@ -40,6 +48,16 @@ func NewViewRecordsStorage(ctx context.Context, appStructsFunc state.AppStructsF
 		wsTypeVailidator: newWsTypeValidator(appStructsFunc),
 	}
 }
+
+// Returns view records storage for the query processor state.
+//
+// View rows, which store records not accessible by SELECT row-level security policies of the request roles, are read as not existing.
+func NewQueryViewRecordsStorage(ctx context.Context, appStructsFunc state.AppStructsFunc, wsidFunc state.WSIDFunc, principalsFunc state.PrincipalsFunc) state.IStateStorage {
+	s := NewViewRecordsStorage(ctx, appStructsFunc, wsidFunc, nil).(*viewRecordsStorage)
+	s.rowPolicies = newRowPoliciesFilter(appStructsFunc, principalsFunc)
+	return s
+}
+
 func (s *viewRecordsStorage) NewKeyBuilder(entity appdef.QName, _ istructs.IStateKeyBuilder) (newKeyBuilder istructs.IStateKeyBuilder) {
 	return &viewKeyBuilder{
 		IKeyBuilder: s.appStructsFunc().ViewRecords().KeyBuilder(entity),
@@ -64,6 +82,9 @@ func (s *viewRecordsStorage) Get(key istructs.IStateKeyBuilder) (value istructs.
 	if v == nil {
 		return nil, nil
 	}
+	if ok, err := s.rowPolicies.isViewValueAllowed(k.wsid, k.view, v); !ok {
+		return nil, err
+	}
 	return &viewValue{
 		value: v,
 	}, nil
@@ -90,6 +111,12 @@ func (s *viewRecordsStorage) GetBatch(items []state.GetBatchItem) (err error) {
 			if !batchItem.Ok {
 				continue
 			}
+			if ok, err := s.rowPolicies.isViewValueAllowed(wsid, items[itemIndex].Key.(*viewKeyBuilder).view, batchItem.Value); !ok {
+				if err != nil {
+					return err
+				}
+				continue
+			}
 			items[itemIndex].Value = &viewValue{
 				value: batchItem.Value,
 			}
@@ -98,12 +125,15 @@ func (s *viewRecordsStorage) GetBatch(items []state.GetBatchItem) (err error) {
 	return err
 }
 func (s *viewRecordsStorage) Read(kb istructs.IStateKeyBuilder, callback istructs.ValueCallback) (err error) {
-	cb := func(k istructs.IKey, v istructs.IValue) (err error) {
-		return callback(k, &viewValue{
+	k := kb.(*viewKeyBuilder)
+	cb := func(key istructs.IKey, v istructs.IValue) (err error) {
+		if ok, err := s.rowPolicies.isViewValueAllowed(k.wsid, k.view, v); !ok {
+			return err
+		}
+		return callback(key, &viewValue{
 			value: v,
 		})
 	}
-	k := kb.(*viewKeyBuilder)
 	if err = s.wsTypeVailidator.validate(k.wsid, k.view); err != nil {
 		return err
 	}
@@ -113,12 +143,19 @@ func (s *viewRecordsStorage) Validate([]state.ApplyBatchItem) (err error) { retu
 func (s *viewRecordsStorage) ApplyBatch(items []state.ApplyBatchItem) (err error) {
 	batches := make(map[istructs.WSID][]istructs.ViewKV)
 	nn := make(map[n10n]istructs.Offset)
+	changes := make(map[n10n][]istructs.ViewKV) // views that publish n10n payloads only
+	appDef := s.appStructsFunc().AppDef()
 	for _, item := range items {
 		k := item.Key.(*viewKeyBuilder)
 		v := item.Value.(*viewValueBuilder)
-		batches[k.wsid] = append(batches[k.wsid], istructs.ViewKV{Key: k.IKeyBuilder, Value: v.IValueBuilder})
-		if nn[n10n{wsid: k.wsid, view: k.view}] < v.offset {
-			nn[n10n{wsid: k.wsid, view: k.view}] = v.offset
+		kv := istructs.ViewKV{Key: k.IKeyBuilder, Value: v.IValueBuilder}
+		n := n10n{wsid: k.wsid, view: k.view}
+		batches[k.wsid] = append(batches[k.wsid], kv)
+		if nn[n] < v.offset {
+			nn[n] = v.offset
+		}
+		if view, ok := appDef.Type(k.view).(appdef.IView); ok && view.N10nPayload() {
+			changes[n] = append(changes[n], kv)
 		}
 	}
 	var nullWsidBatch []istructs.ViewKV
@@ -142,10 +179,55 @@ func (s *viewRecordsStorage) ApplyBatch(items []state.ApplyBatchItem) (err error
 		if logger.IsVerbose() {
 			logger.Verbose(fmt.Sprintf("viewRecordStorage: sending n10n view: %s, wsid: %d, newOffset: %d", n.view, n.wsid, newOffset))
 		}
-		s.n10nFunc(n.view, n.wsid, newOffset)
+		var payload in10n.Payload
+		if kvs, ok := changes[n]; ok {
+			payload = &viewChangesPayload{appDef: appDef, kvs: kvs}
+		}
+		s.n10nFunc(n.view, n.wsid, newOffset, payload)
 	}
 	return err
 }
+
+// viewChangesPayload is the n10n payload of the changed view keys and values
+// Values are built on the first delivery, so nothing is built if the view has no subscribers
+type viewChangesPayload struct {
+	appDef appdef.IAppDef
+	kvs    []istructs.ViewKV
+	once   sync.Once
+	keys   []istructs.IKey
+	values []istructs.IValue
+}
+
+// Returns JSON array of changed keys and values: [{"key":{...},"value":{...}}, ...]
+// Returns nil if the changes could not be marshaled, the subscribers fall back to offset-only notification then
+func (p *viewChangesPayload) JSON(fieldFilter func(appdef.FieldName) bool) []byte {
+	p.once.Do(func() {
+		for _, kv := range p.kvs {
+			key, _ := kv.Key.(istructs.IKey)
+			p.keys = append(p.keys, key)
+			p.values = append(p.values, kv.Value.Build())
+		}
+		p.kvs = nil
+	})
+	filter := coreutils.Filter(func(name string, _ appdef.DataKind) bool { return fieldFilter(name) })
+	changes := make([]map[string]interface{}, 0, len(p.values))
+	for i, value := range p.values {
+		change := map[string]interface{}{
+			"value": coreutils.FieldsToMap(value, p.appDef, filter),
+		}
+		if p.keys[i] != nil {
+			change["key"] = coreutils.FieldsToMap(p.keys[i], p.appDef, filter)
+		}
+		changes = append(changes, change)
+	}
+	payload, err := json.Marshal(changes)
+	if err != nil {
+		// notest
+		logger.Error("viewRecordStorage: failed to marshal n10n payload: " + err.Error())
+		return nil
+	}
+	return payload
+}
 func (s *viewRecordsStorage) ProvideValueBuilder(kb istructs.IStateKeyBuilder, _ istructs.IStateValueBuilder) (istructs.IStateValueBuilder, error) {
 	k := kb.(*viewKeyBuilder)
 	if err := s.wsTypeVailidator.validate(k.wsid, k.view); err != nil {
@@ -303,6 +385,18 @@ func (v *viewValue) AsBool(name string) bool          { return v.value.AsBool(na
 func (v *viewValue) AsRecordID(name string) istructs.RecordID {
 	return v.value.AsRecordID(name)
 }
+func (v *viewValue) AsDecimal(name string) decimal.Decimal {
+	return v.value.AsDecimal(name)
+}
+func (v *viewValue) AsTime(name string) time.Time {
+	return v.value.AsTime(name)
+}
+func (v *viewValue) AsUUID(name string) uuid.UUID {
+	return v.value.AsUUID(name)
+}
+func (v *viewValue) AsJSONPath(name string, path string) any {
+	return v.value.AsJSONPath(name, path)
+}
 func (v *viewValue) AsRecord(name string) istructs.IRecord {
 	return v.value.AsRecord(name)
 }
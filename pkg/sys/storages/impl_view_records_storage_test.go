@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/in10n"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys"
@@ -247,3 +248,94 @@ func TestViewRecordsStorage_PutInt64ForRecordIDFields(t *testing.T) {
 	value.PutInt64(fieldName2, value2)
 	mockedValueBuilder.AssertExpectations(t)
 }
+
+func TestViewRecordsStorage_ApplyBatch_N10nPayload(t *testing.T) {
+	require := require.New(t)
+
+	app := appStructs(
+		`APPLICATION test();
+		WORKSPACE ws1 (
+			DESCRIPTOR ws1Desc ();
+			TABLE t1 INHERITS sys.CDoc (
+				x int32
+			);
+			VIEW PayloadView (
+				pk int32,
+				cc varchar,
+				val int32,
+				offs int64,
+				PRIMARY KEY ((pk), cc)
+			) AS RESULT OF Proj WITH N10nPayload;
+			VIEW PlainView (
+				pk int32,
+				cc varchar,
+				val int32,
+				offs int64,
+				PRIMARY KEY ((pk), cc)
+			) AS RESULT OF Proj;
+			EXTENSION ENGINE BUILTIN (
+				PROJECTOR Proj AFTER INSERT ON (t1) INTENTS(sys.View(PayloadView, PlainView));
+			);
+		)
+		`, nil)
+	payloadView := appdef.NewQName("main", "PayloadView")
+	plainView := appdef.NewQName("main", "PlainView")
+
+	wsDesc := &mockRecord{}
+	wsDesc.On("AsQName", "WSKind").Return(appdef.NewQName("main", "ws1Desc"))
+	wsDesc.On("QName").Return(appdef.QNameCDocWorkspaceDescriptor)
+	records := &mockRecords{}
+	records.On("GetSingleton", istructs.WSID(1), appdef.QNameCDocWorkspaceDescriptor).Return(wsDesc, nil)
+	mockedStructs := &mockAppStructs{}
+	mockedStructs.
+		On("AppDef").Return(app.AppDef()).
+		On("AppQName").Return(app.AppQName()).
+		On("Records").Return(records).
+		On("ViewRecords").Return(app.ViewRecords())
+
+	type notification struct {
+		offset  istructs.Offset
+		payload in10n.Payload
+	}
+	notifications := map[appdef.QName]notification{}
+	n10nFunc := func(view appdef.QName, wsid istructs.WSID, offset istructs.Offset, payload in10n.Payload) {
+		require.Equal(istructs.WSID(1), wsid)
+		notifications[view] = notification{offset: offset, payload: payload}
+	}
+
+	s := NewViewRecordsStorage(context.Background(), func() istructs.IAppStructs { return mockedStructs }, state.SimpleWSIDFunc(istructs.WSID(1)), n10nFunc)
+
+	batch := []state.ApplyBatchItem{}
+	put := func(view appdef.QName, pk int32, cc string, val int32, offset istructs.Offset) {
+		kb := s.NewKeyBuilder(view, nil)
+		kb.PutInt32("pk", pk)
+		kb.PutString("cc", cc)
+		vb, err := s.(state.IWithInsert).ProvideValueBuilder(kb, nil)
+		require.NoError(err)
+		vb.PutInt32("val", val)
+		vb.PutInt64(state.ColOffset, int64(offset))
+		batch = append(batch, state.ApplyBatchItem{Key: kb, Value: vb})
+	}
+	put(payloadView, 1, "a", 10, 5)
+	put(payloadView, 2, "b", 20, 6)
+	put(plainView, 1, "c", 30, 6)
+
+	require.NoError(s.(state.IWithApplyBatch).ApplyBatch(batch))
+
+	require.Equal(istructs.Offset(6), notifications[payloadView].offset)
+	payload := notifications[payloadView].payload
+	require.JSONEq(`[
+		{"key":{"pk":1,"cc":"a"},"value":{"sys.QName":"main.PayloadView","val":10,"offs":5}},
+		{"key":{"pk":2,"cc":"b"},"value":{"sys.QName":"main.PayloadView","val":20,"offs":6}}
+	]`, string(payload.JSON(func(appdef.FieldName) bool { return true })))
+
+	t.Run("fields not accepted by the subscriber are omitted", func(t *testing.T) {
+		require.JSONEq(`[
+			{"key":{"pk":1,"cc":"a"},"value":{"sys.QName":"main.PayloadView","offs":5}},
+			{"key":{"pk":2,"cc":"b"},"value":{"sys.QName":"main.PayloadView","offs":6}}
+		]`, string(payload.JSON(func(f appdef.FieldName) bool { return f != "val" })))
+	})
+
+	require.Equal(istructs.Offset(6), notifications[plainView].offset)
+	require.Nil(notifications[plainView].payload)
+}
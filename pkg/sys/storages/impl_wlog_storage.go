@@ -17,9 +17,10 @@ import (
 )
 
 type wLogStorage struct {
-	ctx        context.Context
-	eventsFunc state.EventsFunc
-	wsidFunc   state.WSIDFunc
+	ctx         context.Context
+	eventsFunc  state.EventsFunc
+	wsidFunc    state.WSIDFunc
+	rowPolicies *rowPoliciesFilter
 }
 
 func NewWLogStorage(ctx context.Context, eventsFunc state.EventsFunc, wsidFunc state.WSIDFunc) state.IStateStorage {
@@ -30,6 +31,15 @@ func NewWLogStorage(ctx context.Context, eventsFunc state.EventsFunc, wsidFunc s
 	}
 }
 
+// Returns WLog storage for the query processor state.
+//
+// Event CUDs, which are not accessible by SELECT row-level security policies of the request roles, are skipped.
+func NewQueryWLogStorage(ctx context.Context, appStructsFunc state.AppStructsFunc, wsidFunc state.WSIDFunc, principalsFunc state.PrincipalsFunc) state.IStateStorage {
+	s := NewWLogStorage(ctx, func() istructs.IEvents { return appStructsFunc().Events() }, wsidFunc).(*wLogStorage)
+	s.rowPolicies = newRowPoliciesFilter(appStructsFunc, principalsFunc)
+	return s
+}
+
 type wLogKeyBuilder struct {
 	baseKeyBuilder
 	offset istructs.Offset
@@ -90,6 +100,9 @@ func (s *wLogStorage) NewKeyBuilder(appdef.QName, istructs.IStateKeyBuilder) ist
 func (s *wLogStorage) Get(kb istructs.IStateKeyBuilder) (value istructs.IStateValue, err error) {
 	k := kb.(*wLogKeyBuilder)
 	cb := func(wlogOffset istructs.Offset, event istructs.IWLogEvent) (err error) {
+		if event, err = s.rowPolicies.wLogEvent(k.wsid, event); err != nil {
+			return err
+		}
 		value = &wLogValue{
 			event:  event,
 			offset: wlogOffset,
@@ -102,6 +115,9 @@ func (s *wLogStorage) Get(kb istructs.IStateKeyBuilder) (value istructs.IStateVa
 func (s *wLogStorage) Read(kb istructs.IStateKeyBuilder, callback istructs.ValueCallback) (err error) {
 	k := kb.(*wLogKeyBuilder)
 	cb := func(wlogOffset istructs.Offset, event istructs.IWLogEvent) (err error) {
+		if event, err = s.rowPolicies.wLogEvent(k.wsid, event); err != nil {
+			return err
+		}
 		offs := wlogOffset
 		return callback(
 			&key{data: map[string]interface{}{sys.Storage_WLog_Field_Offset: offs}},
@@ -8,9 +8,15 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/jsonpath"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys"
 )
@@ -74,6 +80,46 @@ func (b *baseKeyBuilder) PutBool(name appdef.FieldName, value bool) {
 func (b *baseKeyBuilder) PutRecordID(name appdef.FieldName, value istructs.RecordID) {
 	panic(errRecordIDFieldUndefined(name))
 }
+func (b *baseKeyBuilder) PutDecimal(name appdef.FieldName, value decimal.Decimal) {
+	panic(errDecimalFieldUndefined(name))
+}
+func (b *baseKeyBuilder) PutTime(name appdef.FieldName, value time.Time) {
+	panic(errTimeFieldUndefined(name))
+}
+func (v *jsonValue) AsUUID(name string) uuid.UUID {
+	if v, ok := v.json[name]; ok {
+		switch v := v.(type) {
+		case uuid.UUID:
+			return v
+		case string:
+			return uuid.MustParse(v)
+		default:
+			panic(errUnexpectedType(v))
+		}
+	}
+	panic(errUUIDFieldUndefined(name))
+}
+func (v *jsonValue) AsJSONPath(name string, path string) any {
+	if v, ok := v.json[name]; ok {
+		if s, ok := v.(string); ok {
+			res, _, err := jsonpath.Get(s, path)
+			if err != nil {
+				panic(err)
+			}
+			return res
+		}
+		p, err := jsonpath.Parse(path)
+		if err != nil {
+			panic(err)
+		}
+		res, _ := p.Value(v)
+		return res
+	}
+	panic(errJSONFieldUndefined(name))
+}
+func (b *baseKeyBuilder) PutUUID(name appdef.FieldName, value uuid.UUID) {
+	panic(errUUIDFieldUndefined(name))
+}
 func (b *baseKeyBuilder) PutNumber(name appdef.FieldName, value json.Number) {
 	panic(errNumberFieldUndefined(name))
 }
@@ -127,6 +173,15 @@ func (b *baseValueBuilder) PutNumber(name string, value json.Number) {
 func (b *baseValueBuilder) PutRecordID(name string, value istructs.RecordID) {
 	panic(errRecordIDFieldUndefined(name))
 }
+func (b *baseValueBuilder) PutDecimal(name string, value decimal.Decimal) {
+	panic(errDecimalFieldUndefined(name))
+}
+func (b *baseValueBuilder) PutTime(name string, value time.Time) {
+	panic(errTimeFieldUndefined(name))
+}
+func (b *baseValueBuilder) PutUUID(name string, value uuid.UUID) {
+	panic(errUUIDFieldUndefined(name))
+}
 func (b *baseValueBuilder) BuildValue() istructs.IStateValue {
 	panic(errNotImplemented)
 }
@@ -149,6 +204,18 @@ func (v *baseStateValue) AsValue(name string) istructs.IStateValue {
 func (v *baseStateValue) AsRecordID(name string) istructs.RecordID {
 	panic(errRecordIDFieldUndefined(name))
 }
+func (v *baseStateValue) AsDecimal(name string) decimal.Decimal {
+	panic(errDecimalFieldUndefined(name))
+}
+func (v *baseStateValue) AsTime(name string) time.Time {
+	panic(errTimeFieldUndefined(name))
+}
+func (v *baseStateValue) AsUUID(name string) uuid.UUID {
+	panic(errUUIDFieldUndefined(name))
+}
+func (v *baseStateValue) AsJSONPath(name string, _ string) any {
+	panic(errJSONFieldUndefined(name))
+}
 func (v *baseStateValue) RecordIDs(bool) func(func(string, istructs.RecordID) bool) {
 	panic(errNotImplemented)
 }
@@ -200,6 +267,18 @@ func (v *cudRowValue) AsBool(name string) bool {
 func (v *cudRowValue) AsRecordID(name string) istructs.RecordID {
 	return v.value.AsRecordID(name)
 }
+func (v *cudRowValue) AsDecimal(name string) decimal.Decimal {
+	return v.value.AsDecimal(name)
+}
+func (v *cudRowValue) AsTime(name string) time.Time {
+	return v.value.AsTime(name)
+}
+func (v *cudRowValue) AsUUID(name string) uuid.UUID {
+	return v.value.AsUUID(name)
+}
+func (v *cudRowValue) AsJSONPath(name string, path string) any {
+	return v.value.AsJSONPath(name, path)
+}
 
 type ObjectStateValue struct {
 	baseStateValue
@@ -220,6 +299,18 @@ func (v *ObjectStateValue) AsBool(name string) bool          { return v.object.A
 func (v *ObjectStateValue) AsRecordID(name string) istructs.RecordID {
 	return v.object.AsRecordID(name)
 }
+func (v *ObjectStateValue) AsDecimal(name string) decimal.Decimal {
+	return v.object.AsDecimal(name)
+}
+func (v *ObjectStateValue) AsTime(name string) time.Time {
+	return v.object.AsTime(name)
+}
+func (v *ObjectStateValue) AsUUID(name string) uuid.UUID {
+	return v.object.AsUUID(name)
+}
+func (v *ObjectStateValue) AsJSONPath(name string, path string) any {
+	return v.object.AsJSONPath(name, path)
+}
 func (v *ObjectStateValue) RecordIDs(includeNulls bool) func(func(string, istructs.RecordID) bool) {
 	return v.object.RecordIDs(includeNulls)
 }
@@ -377,6 +468,40 @@ func (v *jsonValue) AsRecordID(name string) istructs.RecordID {
 	}
 	panic(errRecordIDFieldUndefined(name))
 }
+func (v *jsonValue) AsDecimal(name string) decimal.Decimal {
+	if v, ok := v.json[name]; ok {
+		var s string
+		switch v := v.(type) {
+		case string:
+			s = v
+		case json.Number:
+			s = string(v)
+		case float64:
+			s = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			panic(errUnexpectedType(v))
+		}
+		return decimal.MustParse(s)
+	}
+	panic(errDecimalFieldUndefined(name))
+}
+func (v *jsonValue) AsTime(name string) time.Time {
+	if v, ok := v.json[name]; ok {
+		switch v := v.(type) {
+		case time.Time:
+			return v
+		case string:
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				panic(err)
+			}
+			return t
+		default:
+			panic(errUnexpectedType(v))
+		}
+	}
+	panic(errTimeFieldUndefined(name))
+}
 func (v *jsonValue) RecordIDs(bool) func(func(string, istructs.RecordID) bool) {
 	return func(cb func(string, istructs.RecordID) bool) {}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/voedger/voedger/pkg/coreutils/federation"
 	"github.com/voedger/voedger/pkg/extensionpoints"
 	"github.com/voedger/voedger/pkg/goutils/timeu"
+	"github.com/voedger/voedger/pkg/iblobstorage"
 	"github.com/voedger/voedger/pkg/istorage"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
@@ -19,7 +20,10 @@ import (
 	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
 	"github.com/voedger/voedger/pkg/parser"
 	blobprocessor "github.com/voedger/voedger/pkg/processors/blobber"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/sys"
+	"github.com/voedger/voedger/pkg/sys/apikeys"
+	"github.com/voedger/voedger/pkg/sys/audit"
 	"github.com/voedger/voedger/pkg/sys/authnz"
 	"github.com/voedger/voedger/pkg/sys/blobber"
 	"github.com/voedger/voedger/pkg/sys/builtin"
@@ -37,17 +41,20 @@ import (
 func ProvideStateless(sr istructsmem.IStatelessResources, smtpCfg smtp.Cfg, eps map[appdef.AppQName]extensionpoints.IExtensionPoint, buildInfo *debug.BuildInfo,
 	storageProvider istorage.IAppStorageProvider, wsPostInitFunc workspace.WSPostInitFunc, time timeu.ITime,
 	itokens itokens.ITokens, federation federation.IFederation, asp istructs.IAppStructsProvider, atf payloads.IAppTokensFactory,
-	blobHandlerPtr blobprocessor.IRequestHandlerPtr, requestSenderPtr bus.IRequestSenderPtr) {
+	blobHandlerPtr blobprocessor.IRequestHandlerPtr, requestSenderPtr bus.IRequestSenderPtr, blobStorage iblobstorage.IBLOBStorage,
+	slowLog slowlog.ISlowLog) {
 	blobber.ProvideBlobberCmds(sr)
 	collection.Provide(sr)
 	journal.Provide(sr, eps)
-	builtin.Provide(sr, buildInfo, storageProvider)
-	workspace.Provide(sr, time, itokens, federation, itokens, wsPostInitFunc, eps)
+	builtin.Provide(sr, buildInfo, storageProvider, slowLog)
+	workspace.Provide(sr, time, itokens, federation, itokens, wsPostInitFunc, eps, blobStorage)
 	sqlquery.Provide(sr, federation, itokens, blobHandlerPtr, requestSenderPtr)
 	verifier.Provide(sr, itokens, federation, asp, smtpCfg)
 	authnz.Provide(sr, itokens, atf)
+	apikeys.Provide(sr, time)
 	invite.Provide(sr, time, federation, itokens, smtpCfg)
 	uniques.Provide(sr)
+	audit.Provide(sr)
 	describe.Provide(sr)
 }
 
@@ -12,7 +12,11 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/voedger/voedger/pkg/decimal"
 	"github.com/voedger/voedger/pkg/sys"
 
 	"github.com/voedger/voedger/pkg/appdef"
@@ -157,14 +161,19 @@ func GetUniqueKeyValuesFromMap(values map[string]interface{}, uniqueFields []app
 		if err := coreutils.CheckValueByKind(val, uniqueField.DataKind()); err != nil {
 			return nil, err
 		}
-		writeUniqueKeyValue(uniqueField, val, buf, uniqueFields)
+		if err := writeUniqueKeyValue(uniqueField, val, buf, uniqueFields); err != nil {
+			return nil, err
+		}
 	}
 	return buf.Bytes(), checkUniqueKeyLen(buf, uniqueQName)
 }
 
 // uniqueFields is provided just to determine if should handle backward compatibility
-func writeUniqueKeyValue(uniqueField appdef.IField, value interface{}, buf *bytes.Buffer, uniqueFields []appdef.IField) {
-	switch uniqueField.DataKind() {
+//
+// decimal, date, time, timestamp and uuid values are written in the stored form,
+// so the same value provided in different forms (e.g. as string or as typed value) gives the same key
+func writeUniqueKeyValue(uniqueField appdef.IField, value interface{}, buf *bytes.Buffer, uniqueFields []appdef.IField) error {
+	switch kind := uniqueField.DataKind(); kind {
 	case appdef.DataKind_string:
 		if len(uniqueFields) > 1 {
 			// backward compatibility
@@ -186,9 +195,49 @@ func writeUniqueKeyValue(uniqueField appdef.IField, value interface{}, buf *byte
 			qNameStr = value.(string)
 		}
 		buf.WriteString(qNameStr)
+	case appdef.DataKind_decimal:
+		d, ok := value.(decimal.Decimal)
+		if !ok {
+			var err error
+			if d, err = decimal.Parse(value.(string)); err != nil {
+				return err
+			}
+		}
+		_, scale := appdef.DecimalPrecisionScale(uniqueField.Data())
+		d, err := d.Rescale(scale)
+		if err != nil {
+			return err
+		}
+		binary.Write(buf, binary.BigEndian, d.Unscaled()) // nolint
+	case appdef.DataKind_date, appdef.DataKind_time, appdef.DataKind_timestamp:
+		var v int64
+		switch typed := value.(type) {
+		case time.Time:
+			v = coreutils.DateTimeValue(kind, typed)
+		default:
+			var err error
+			if v, err = coreutils.ParseDateTime(kind, value.(string)); err != nil {
+				return err
+			}
+		}
+		binary.Write(buf, binary.BigEndian, v) // nolint
+	case appdef.DataKind_uuid:
+		switch typed := value.(type) {
+		case uuid.UUID:
+			buf.Write(typed[:])
+		case []byte:
+			buf.Write(typed)
+		default:
+			u, err := uuid.Parse(value.(string))
+			if err != nil {
+				return err
+			}
+			buf.Write(u[:])
+		}
 	default:
 		binary.Write(buf, binary.BigEndian, value) // nolint
 	}
+	return nil
 }
 
 func checkUniqueKeyLen(buf *bytes.Buffer, uniqueQName appdef.QName) error {
@@ -203,7 +252,9 @@ func getUniqueKeyValuesFromRec(rec istructs.IRowReader, uniqueFields []appdef.IF
 	buf := bytes.NewBuffer(nil)
 	for _, uniqueField := range uniqueFields {
 		val := coreutils.ReadByKind(uniqueField.Name(), uniqueField.DataKind(), rec)
-		writeUniqueKeyValue(uniqueField, val, buf, uniqueFields)
+		if err := writeUniqueKeyValue(uniqueField, val, buf, uniqueFields); err != nil {
+			return nil, err
+		}
 	}
 	return buf.Bytes(), checkUniqueKeyLen(buf, uniqueQName)
 }
@@ -41,7 +41,7 @@ func provideQryInitiateEmailVerification(sr istructsmem.IStatelessResources, ito
 
 // q.sys.InitiateEmailVerification
 // called at targetApp/profileWSID
-func provideIEVExec(itokens itokens.ITokens, federation federation.IFederation, asp istructs.IAppStructsProvider) istructsmem.ExecQueryClosure {
+func provideIEVExec(itokens itokens.ITokens, fed federation.IFederation, asp istructs.IAppStructsProvider) istructsmem.ExecQueryClosure {
 	return func(ctx context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
 		entity := args.ArgumentObject.AsString(field_Entity)
 		targetWSID := istructs.WSID(args.ArgumentObject.AsInt64(field_TargetWSID)) // nolint G115
@@ -75,8 +75,9 @@ func provideIEVExec(itokens itokens.ITokens, federation federation.IFederation,
 
 		// c.sys.SendEmailVerificationCode
 		body := jsonu.Jprintf(`{"args":{"VerificationCode":%q,"Email":%q,"Reason":%q,"Language":%q}}`, verificationCode, email, verifyEmailReason, lng)
-		if _, err = federation.Func(fmt.Sprintf("api/%s/%d/c.sys.SendEmailVerificationCode", as.AppQName(), args.WSID), body,
-			httpu.WithDiscardResponse(), httpu.WithAuthorizeBy(systemPrincipalToken)); err != nil {
+		if _, err = fed.Func(fmt.Sprintf("api/%s/%d/c.sys.SendEmailVerificationCode", as.AppQName(), args.WSID), body,
+			httpu.WithDiscardResponse(), httpu.WithAuthorizeBy(systemPrincipalToken),
+			federation.WithRequestIDFrom(args.Workpiece.(processors.IProcessorWorkpiece).LogCtx())); err != nil {
 			return fmt.Errorf("c.sys.SendEmailVerificationCode failed: %w", err)
 		}
 
@@ -6,6 +6,7 @@ package workspace
 
 import (
 	"sync"
+	"time"
 
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/appdef/sys"
@@ -36,10 +37,25 @@ const (
 	Field_InitError                                 = "InitError"
 	Field_InitCompletedAtMs                         = "InitCompletedAtMs"
 	Field_OwnerQName2                               = "OwnerQName2"
+	field_ID                                        = "ID"
+	field_Record                                    = "Record"
+	field_BLOBFields                                = "BLOBFields"
+	field_PurgedWSID                                = "PurgedWSID"
+	field_DeactivatedAtMs                           = "DeactivatedAtMs"
+	field_PurgedAtMs                                = "PurgedAtMs"
+	field_Records                                   = "Records"
+	field_ViewRecords                               = "ViewRecords"
+	field_Events                                    = "Events"
+	field_BLOBs                                     = "BLOBs"
+	field_Dummy                                     = "Dummy"
+	value_Dummy                                     = 1
 	EPWSTemplates             extensionpoints.EPKey = "WSTemplates"
 
 	//Deprecated: use Field_OwnerQName2
 	Field_OwnerQName = "OwnerQName"
+
+	// deactivated workspace is purged by j.sys.PurgeWorkspaces after this delay
+	PurgeDelay = 30 * 24 * time.Hour
 )
 
 var (
@@ -56,5 +72,12 @@ var (
 	qNameProjectorApplyDeactivateWorkspace = appdef.NewQName(appdef.SysPackage, "ApplyDeactivateWorkspace")
 	QNameCommandCreateWorkspaceID          = appdef.NewQName(appdef.SysPackage, "CreateWorkspaceID")
 	QNameCommandCreateWorkspace            = appdef.NewQName(appdef.SysPackage, "CreateWorkspace")
+	QNameQueryExportWorkspace              = appdef.NewQName(appdef.SysPackage, "ExportWorkspace")
+	QNameCommandImportWorkspace            = appdef.NewQName(appdef.SysPackage, "ImportWorkspace")
+	qNameCmdScheduleWorkspacePurge         = appdef.NewQName(appdef.SysPackage, "ScheduleWorkspacePurge")
+	qNameProjectorWorkspacesToPurge        = appdef.NewQName(appdef.SysPackage, "ProjectorWorkspacesToPurge")
+	qNameJobPurgeWorkspaces                = appdef.NewQName(appdef.SysPackage, "PurgeWorkspaces")
+	QNameViewWorkspacesToPurge             = appdef.NewQName(appdef.SysPackage, "WorkspacesToPurge")
+	QNameViewPurgedWorkspaces              = appdef.NewQName(appdef.SysPackage, "PurgedWorkspaces")
 	nextWSIDGlobalLock                     = sync.Mutex{}
 )
@@ -276,6 +276,14 @@ func projectorApplyDeactivateWorkspace(federation federation.IFederation, tokens
 			return fmt.Errorf("cdoc.sys.WorkspaceDescriptor.Status=Inactive failed: %w", err)
 		}
 
+		// c.sys.ScheduleWorkspacePurge in the app workspace of the deactivated workspace
+		appWSID := coreutils.PseudoWSIDToAppWSID(event.Workspace(), s.AppStructs().NumAppWorkspaces())
+		body = jsonu.Jprintf(`{"args":{"PurgedWSID":%d,"WSName":%q,"DeactivatedAtMs":%d}}`, event.Workspace(), wsName, event.RegisteredAt())
+		if _, err := federation.Func(fmt.Sprintf("api/%s/%d/c.sys.ScheduleWorkspacePurge", projectorAppQName, appWSID), body,
+			httpu.WithDiscardResponse(), httpu.WithAuthorizeBy(projectorAppToken)); err != nil {
+			return fmt.Errorf("c.sys.ScheduleWorkspacePurge failed: %w", err)
+		}
+
 		logger.Info("workspace", wsDesc.AsString(authnz.Field_WSName), "deactivated")
 		return nil
 	}
@@ -0,0 +1,315 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/coreutils/federation"
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/goutils/logger"
+	"github.com/voedger/voedger/pkg/iblobstorage"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/istructsmem"
+	"github.com/voedger/voedger/pkg/sys"
+	"github.com/voedger/voedger/pkg/sys/authnz"
+	"github.com/voedger/voedger/pkg/sys/blobber"
+)
+
+func provideExportImportWorkspace(sr istructsmem.IStatelessResources) {
+	// q.sys.ExportWorkspace
+	sr.AddQueries(appdef.SysPackagePath, istructsmem.NewQueryFunction(
+		QNameQueryExportWorkspace,
+		qryExportWorkspaceExec,
+	))
+
+	// c.sys.ImportWorkspace
+	// records to import are provided as CUDs with raw IDs
+	sr.AddCommands(appdef.SysPackagePath, istructsmem.NewCommandFunction(
+		QNameCommandImportWorkspace,
+		cmdImportWorkspaceExec,
+	))
+}
+
+// Returns active CDocs, WDocs and its records of the current workspace, one result per record.
+// Records of sys package and workspace descriptors are not exported, BLOBs are exported as names of the filled BLOB fields only
+func qryExportWorkspaceExec(ctx context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) error {
+	as := args.State.AppStructs()
+	appDef := as.AppDef()
+
+	wsDesc, err := as.Records().GetSingleton(args.WSID, appdef.QNameCDocWorkspaceDescriptor)
+	if err != nil {
+		// notest
+		return err
+	}
+	wsKind := wsDesc.AsQName(authnz.Field_WSKind)
+
+	// IDs of the records in order of creation
+	ids := []istructs.RecordID{}
+	err = as.Events().ReadWLog(ctx, args.WSID, istructs.FirstOffset, istructs.ReadToTheEnd, func(_ istructs.Offset, event istructs.IWLogEvent) error {
+		for rec := range event.CUDs {
+			if rec.IsNew() && isExportable(appDef, rec.QName()) {
+				ids = append(ids, rec.ID())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// notest
+		return err
+	}
+
+	records := make([]istructs.IRecord, 0, len(ids))
+	exported := map[istructs.RecordID]bool{}
+	for _, id := range ids {
+		rec, err := as.Records().Get(args.WSID, true, id)
+		if err != nil {
+			// notest
+			return err
+		}
+		if rec.QName() == appdef.NullQName || !rec.AsBool(appdef.SystemField_IsActive) {
+			continue
+		}
+		records = append(records, rec)
+		exported[id] = true
+	}
+
+	// records of the not exported parents are not exported too
+	for removed := true; removed; {
+		removed = false
+		for _, rec := range records {
+			if parentID := rec.Parent(); exported[rec.ID()] && parentID != istructs.NullRecordID && !exported[parentID] {
+				delete(exported, rec.ID())
+				removed = true
+			}
+		}
+	}
+
+	if len(exported) > int(istructs.MaxRawRecordID) {
+		return coreutils.NewHTTPErrorf(http.StatusBadRequest, fmt.Sprintf("too many records to export: %d, max is %d", len(exported), istructs.MaxRawRecordID))
+	}
+
+	rawIDs := make(map[istructs.RecordID]istructs.RecordID, len(exported))
+	for _, rec := range records {
+		if exported[rec.ID()] {
+			rawIDs[rec.ID()] = istructs.MinRawRecordID + istructs.RecordID(len(rawIDs)) // nolint G115: len is checked above
+		}
+	}
+
+	for _, rec := range records {
+		rawID, ok := rawIDs[rec.ID()]
+		if !ok {
+			continue
+		}
+		data, blobFields := exportRecord(rec, rawID, rawIDs)
+		bb, err := json.Marshal(data)
+		if err != nil {
+			// notest
+			return err
+		}
+		if err := callback(&exportedRecord{
+			wsKind:     wsKind,
+			id:         rec.ID(),
+			record:     string(bb),
+			blobFields: strings.Join(blobFields, ","),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isExportable(appDef appdef.IAppDef, qName appdef.QName) bool {
+	if qName.Pkg() == appdef.SysPackage || appDef.WorkspaceByDescriptor(qName) != nil {
+		// workspace descriptors are created on workspace init
+		return false
+	}
+	switch appDef.Type(qName).Kind() {
+	case appdef.TypeKind_CDoc, appdef.TypeKind_CRecord, appdef.TypeKind_WDoc, appdef.TypeKind_WRecord:
+		return true
+	}
+	return false
+}
+
+// Returns record fields in template data format and names of the filled BLOB fields.
+//
+// References to the exported records are replaced with raw IDs, references to other records are dropped
+func exportRecord(rec istructs.IRecord, rawID istructs.RecordID, rawIDs map[istructs.RecordID]istructs.RecordID) (data map[string]interface{}, blobFields []string) {
+	data = map[string]interface{}{
+		appdef.SystemField_ID:    rawID,
+		appdef.SystemField_QName: rec.QName().String(),
+	}
+	if parentID := rec.Parent(); parentID != istructs.NullRecordID {
+		data[appdef.SystemField_ParentID] = rawIDs[parentID]
+		data[appdef.SystemField_Container] = rec.Container()
+	}
+	rec.SpecifiedValues(func(f appdef.IField, value any) bool {
+		if f.IsSys() {
+			return true
+		}
+		refField, isRef := f.(appdef.IRefField)
+		switch {
+		case isRef && len(refField.Refs()) > 0 && refField.Ref(blobber.QNameWDocBLOB):
+			if value.(istructs.RecordID) != istructs.NullRecordID {
+				blobFields = append(blobFields, f.Name())
+			}
+		case f.DataKind() == appdef.DataKind_RecordID:
+			if refRawID, ok := rawIDs[value.(istructs.RecordID)]; ok {
+				data[f.Name()] = refRawID
+			}
+		default:
+			data[f.Name()] = coreutils.ReadByKind(f.Name(), f.DataKind(), rec)
+		}
+		return true
+	})
+	return data, blobFields
+}
+
+func cmdImportWorkspaceExec(args istructs.ExecCommandArgs) (err error) {
+	kb, err := args.State.KeyBuilder(sys.Storage_Record, appdef.QNameCDocWorkspaceDescriptor)
+	if err != nil {
+		// notest
+		return err
+	}
+	kb.PutQName(sys.Storage_Record_Field_Singleton, appdef.QNameCDocWorkspaceDescriptor)
+	wsDesc, err := args.State.MustExist(kb)
+	if err != nil {
+		// notest
+		return err
+	}
+	wsKind := wsDesc.AsQName(authnz.Field_WSKind)
+	if exportedWSKind := args.ArgumentObject.AsQName(authnz.Field_WSKind); exportedWSKind != wsKind {
+		return coreutils.NewHTTPErrorf(http.StatusBadRequest, fmt.Sprintf("workspace of kind %s can not be imported into workspace of kind %s", exportedWSKind, wsKind))
+	}
+	return nil
+}
+
+// Exports the workspace into the portable data.
+//
+// Data is JSON of WorkspaceExport. BLOBs content is read and included into the data
+func ExportWorkspace(fed federation.IFederation, appQName appdef.AppQName, wsid istructs.WSID, authToken string) ([]byte, error) {
+	exportURL := fmt.Sprintf("api/v2/apps/%s/%s/workspaces/%d/queries/%s", appQName.Owner(), appQName.Name(), wsid, QNameQueryExportWorkspace)
+	resp, err := fed.Query(exportURL, httpu.WithAuthorizeBy(authToken))
+	if err != nil {
+		return nil, fmt.Errorf("q.sys.ExportWorkspace failed: %w", err)
+	}
+
+	exp := WorkspaceExport{
+		Data: []map[string]interface{}{},
+	}
+	for rowIdx, row := range resp.QPv2Response {
+		wsKindStr, ok := row[authnz.Field_WSKind].(string)
+		if !ok {
+			return nil, fmt.Errorf("row %d: %s is missing or is not a string", rowIdx, authnz.Field_WSKind)
+		}
+		if exp.WSKind, err = appdef.ParseQName(wsKindStr); err != nil {
+			return nil, fmt.Errorf("row %d: %w", rowIdx, err)
+		}
+		recordStr, ok := row[field_Record].(string)
+		if !ok {
+			return nil, fmt.Errorf("row %d: %s is missing or is not a string", rowIdx, field_Record)
+		}
+		record := map[string]interface{}{}
+		if err := coreutils.JSONUnmarshal([]byte(recordStr), &record); err != nil {
+			return nil, fmt.Errorf("row %d: %w", rowIdx, err)
+		}
+		exp.Data = append(exp.Data, record)
+
+		blobFields, _ := row[field_BLOBFields].(string)
+		if len(blobFields) == 0 {
+			continue
+		}
+		ownerQNameStr, ok := record[appdef.SystemField_QName].(string)
+		if !ok {
+			return nil, fmt.Errorf("row %d: record %s is missing or is not a string", rowIdx, appdef.SystemField_QName)
+		}
+		ownerQName, err := appdef.ParseQName(ownerQNameStr)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", rowIdx, err)
+		}
+		ownerRawIDNumber, ok := record[appdef.SystemField_ID].(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("row %d: record %s is missing or is not a number", rowIdx, appdef.SystemField_ID)
+		}
+		ownerRawIDIntf, err := coreutils.ClarifyJSONNumber(ownerRawIDNumber, appdef.DataKind_RecordID)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", rowIdx, err)
+		}
+		ownerIDFloat, ok := row[field_ID].(float64)
+		if !ok {
+			return nil, fmt.Errorf("row %d: %s is missing or is not a number", rowIdx, field_ID)
+		}
+		ownerID := istructs.RecordID(ownerIDFloat)
+		for _, blobField := range strings.Split(blobFields, ",") {
+			blob, err := readBLOB(fed, appQName, wsid, ownerQName, blobField, ownerID, authToken)
+			if err != nil {
+				return nil, fmt.Errorf("blob %s.%s of record %d: %w", ownerQName, blobField, ownerID, err)
+			}
+			blob.OwnerRecordRawID = ownerRawIDIntf.(istructs.RecordID)
+			exp.BLOBs = append(exp.BLOBs, blob)
+		}
+	}
+	return json.Marshal(exp)
+}
+
+func readBLOB(fed federation.IFederation, appQName appdef.AppQName, wsid istructs.WSID, ownerQName appdef.QName,
+	ownerField appdef.FieldName, ownerID istructs.RecordID, authToken string) (blob BLOBWorkspaceTemplateField, err error) {
+	blobReader, err := fed.ReadBLOB(appQName, wsid, ownerQName, ownerField, ownerID, httpu.WithAuthorizeBy(authToken))
+	if err != nil {
+		return blob, err
+	}
+	defer blobReader.Close()
+	content, err := io.ReadAll(blobReader)
+	if err != nil {
+		// notest
+		return blob, err
+	}
+	return BLOBWorkspaceTemplateField{
+		DescrType: iblobstorage.DescrType{
+			Name:        blobReader.Name,
+			ContentType: blobReader.ContentType,
+		},
+		OwnerRecord:      ownerQName,
+		OwnerRecordField: ownerField,
+		Content:          content,
+	}, nil
+}
+
+// Imports the portable data got by ExportWorkspace into the workspace of the same kind.
+//
+// BLOBs are uploaded first, then all records are created by the single c.sys.ImportWorkspace,
+// new IDs are generated by the command processor for the raw IDs of the data
+func ImportWorkspace(fed federation.IFederation, appQName appdef.AppQName, wsid istructs.WSID, authToken string, data []byte) error {
+	exp := WorkspaceExport{}
+	if err := coreutils.JSONUnmarshal(data, &exp); err != nil {
+		return fmt.Errorf("failed to unmarshal workspace data: %w", err)
+	}
+	if len(exp.Data) == 0 {
+		return nil
+	}
+
+	blobsMap, err := uploadBLOBs(exp.BLOBs, fed.WithRetry(), appQName, wsid, authToken)
+	if err != nil {
+		return fmt.Errorf("blobs uploading failed: %w", err)
+	}
+	updateBLOBsIDsMap(exp.Data, blobsMap)
+
+	// {"cuds":[...]} -> {"args":{...},"cuds":[...]}
+	cudBody := coreutils.JSONMapToCUDBody(exp.Data)
+	body := fmt.Sprintf(`{"args":{%q:%q},%s`, authnz.Field_WSKind, exp.WSKind, cudBody[1:])
+	importURL := fmt.Sprintf("api/%s/%d/c.sys.ImportWorkspace", appQName, wsid)
+	if _, err := fed.Func(importURL, body, httpu.WithAuthorizeBy(authToken), httpu.WithDiscardResponse()); err != nil {
+		return fmt.Errorf("c.sys.ImportWorkspace failed: %w", err)
+	}
+	logger.Info(fmt.Sprintf("workspace %d: %d records imported", wsid, len(exp.Data)))
+	return nil
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package workspace
+
+import (
+	"context"
+	"slices"
+
+	"github.com/voedger/voedger/pkg/appdef"
+	"github.com/voedger/voedger/pkg/coreutils"
+	"github.com/voedger/voedger/pkg/goutils/logger"
+	"github.com/voedger/voedger/pkg/iblobstorage"
+	"github.com/voedger/voedger/pkg/istructs"
+	"github.com/voedger/voedger/pkg/istructsmem"
+	"github.com/voedger/voedger/pkg/sys"
+	"github.com/voedger/voedger/pkg/sys/authnz"
+	"github.com/voedger/voedger/pkg/sys/blobber"
+)
+
+// Purge of the deactivated workspaces:
+//   - ApplyDeactivateWorkspace calls c.sys.ScheduleWorkspacePurge in the app workspace of the deactivated workspace
+//   - sp.sys.ProjectorWorkspacesToPurge puts the workspace to view.sys.WorkspacesToPurge
+//   - j.sys.PurgeWorkspaces deletes workspace data after PurgeDelay and puts the tombstone to view.sys.PurgedWorkspaces
+func providePurgeWorkspace(sr istructsmem.IStatelessResources, blobStorage iblobstorage.IBLOBStorage) {
+	sr.AddCommands(appdef.SysPackagePath,
+		// c.sys.ScheduleWorkspacePurge
+		// target app, app workspace of the deactivated workspace
+		istructsmem.NewCommandFunction(
+			qNameCmdScheduleWorkspacePurge,
+			istructsmem.NullCommandExec,
+		),
+	)
+
+	sr.AddProjectors(appdef.SysPackagePath, istructs.Projector{
+		Name: qNameProjectorWorkspacesToPurge,
+		Func: workspacesToPurgeProjector,
+	})
+
+	sr.AddJobs(appdef.SysPackagePath, istructsmem.BuiltinJob{
+		Name: qNameJobPurgeWorkspaces,
+		Func: purgeWorkspacesJob(blobStorage),
+	})
+}
+
+// sp.sys.ProjectorWorkspacesToPurge
+func workspacesToPurgeProjector(event istructs.IPLogEvent, s istructs.IState, intents istructs.IIntents) (err error) {
+	args := event.ArgumentObject()
+	kb, err := s.KeyBuilder(sys.Storage_View, QNameViewWorkspacesToPurge)
+	if err != nil {
+		// notest
+		return err
+	}
+	kb.PutInt32(field_Dummy, value_Dummy)
+	kb.PutInt64(field_PurgedWSID, args.AsInt64(field_PurgedWSID))
+	vb, err := intents.NewValue(kb)
+	if err != nil {
+		// notest
+		return err
+	}
+	vb.PutString(authnz.Field_WSName, args.AsString(authnz.Field_WSName))
+	vb.PutInt64(field_DeactivatedAtMs, args.AsInt64(field_DeactivatedAtMs))
+	return nil
+}
+
+type workspaceToPurge struct {
+	wsid            istructs.WSID
+	wsName          string
+	deactivatedAtMs int64
+}
+
+// j.sys.PurgeWorkspaces
+// app workspace
+func purgeWorkspacesJob(blobStorage iblobstorage.IBLOBStorage) func(st istructs.IState, intents istructs.IIntents) error {
+	return func(st istructs.IState, intents istructs.IIntents) error {
+		kb, err := st.KeyBuilder(sys.Storage_JobContext, appdef.NullQName)
+		if err != nil {
+			// notest
+			return err
+		}
+		jobCtx, err := st.MustExist(kb)
+		if err != nil {
+			// notest
+			return err
+		}
+		nowMs := jobCtx.AsInt64(sys.Storage_JobContext_Field_UnixTime) * 1000
+		jobWSID := istructs.WSID(jobCtx.AsInt64(sys.Storage_JobContext_Field_Workspace)) // nolint G115
+
+		toPurge := []workspaceToPurge{}
+		kb, err = st.KeyBuilder(sys.Storage_View, QNameViewWorkspacesToPurge)
+		if err != nil {
+			// notest
+			return err
+		}
+		kb.PutInt32(field_Dummy, value_Dummy)
+		err = st.Read(kb, func(key istructs.IKey, value istructs.IStateValue) error {
+			ws := workspaceToPurge{
+				wsid:            istructs.WSID(key.AsInt64(field_PurgedWSID)), // nolint G115
+				wsName:          value.AsString(authnz.Field_WSName),
+				deactivatedAtMs: value.AsInt64(field_DeactivatedAtMs),
+			}
+			if ws.deactivatedAtMs+PurgeDelay.Milliseconds() <= nowMs {
+				toPurge = append(toPurge, ws)
+			}
+			return nil
+		})
+		if err != nil {
+			// notest
+			return err
+		}
+
+		for _, ws := range toPurge {
+			tombstoneKB, err := st.KeyBuilder(sys.Storage_View, QNameViewPurgedWorkspaces)
+			if err != nil {
+				// notest
+				return err
+			}
+			tombstoneKB.PutInt64(field_PurgedWSID, int64(ws.wsid)) // nolint G115
+			tombstoneKB.PutInt32(field_Dummy, value_Dummy)
+			_, purged, err := st.CanExist(tombstoneKB)
+			if err != nil {
+				// notest
+				return err
+			}
+
+			as := st.AppStructs()
+			ctx := st.RequestContext()
+
+			if purged {
+				// tombstone is written by the previous job run -> the workspace is not waiting for the purge anymore
+				toPurgeKB := as.ViewRecords().KeyBuilder(QNameViewWorkspacesToPurge)
+				toPurgeKB.PutInt32(field_Dummy, value_Dummy)
+				toPurgeKB.PutInt64(field_PurgedWSID, int64(ws.wsid)) // nolint G115
+				if err := as.ViewRecords().Delete(jobWSID, toPurgeKB); err != nil {
+					return err
+				}
+				continue
+			}
+
+			// references are found by the workspace descriptor, so they are obtained before the workspace data is deleted
+			refs, err := purgedWorkspaceRefs(as, jobWSID, ws.wsid)
+			if err != nil {
+				return err
+			}
+
+			// BLOBs are found by WLog, so they are deleted before the workspace data
+			blobs, err := purgeWorkspaceBLOBs(ctx, as, blobStorage, ws.wsid)
+			if err != nil {
+				return err
+			}
+			stats, err := as.PurgeWorkspace(ctx, ws.wsid, refs...)
+			if err != nil {
+				return err
+			}
+
+			tombstone, err := intents.NewValue(tombstoneKB)
+			if err != nil {
+				// notest
+				return err
+			}
+			tombstone.PutString(authnz.Field_WSName, ws.wsName)
+			tombstone.PutInt64(field_DeactivatedAtMs, ws.deactivatedAtMs)
+			tombstone.PutInt64(field_PurgedAtMs, nowMs)
+			tombstone.PutInt64(field_Records, int64(stats.Records))
+			tombstone.PutInt64(field_ViewRecords, int64(stats.ViewRecords))
+			tombstone.PutInt64(field_Events, int64(stats.Events))
+			tombstone.PutInt64(field_BLOBs, int64(blobs))
+
+			logger.Info("workspace", ws.wsName, ws.wsid, "purged:", stats.Records, "records,", stats.ViewRecords, "view records,",
+				stats.Events, "events,", blobs, "BLOBs,", stats.RedactedEvents, "referencing events redacted")
+		}
+		return nil
+	}
+}
+
+// Returns workspaces of the app, which events could refer to the purged workspace:
+//   - app workspace of the job, where c.sys.ScheduleWorkspacePurge is executed
+//   - app workspace of cdoc.sys.WorkspaceID, see projectorApplyDeactivateWorkspace
+//   - owner workspace with cdoc.sys.ChildWorkspace, if the owner is in the same app
+//
+// If the workspace descriptor is deleted already by the failed purge, then the app workspace of the job only is returned
+func purgedWorkspaceRefs(as istructs.IAppStructs, jobWSID, wsid istructs.WSID) ([]istructs.WSID, error) {
+	refs := []istructs.WSID{jobWSID}
+	wsDesc, err := as.Records().GetSingleton(wsid, appdef.QNameCDocWorkspaceDescriptor)
+	if err != nil {
+		// notest
+		return nil, err
+	}
+	if wsDesc.QName() == appdef.NullQName {
+		return refs, nil
+	}
+	wsName := wsDesc.AsString(authnz.Field_WSName)
+	ownerWSID := istructs.WSID(wsDesc.AsInt64(Field_OwnerWSID)) // nolint G115
+	pseudoWSID := coreutils.GetPseudoWSID(istructs.NullWSID, wsName, wsid.ClusterID())
+	if wsDesc.AsString(Field_OwnerApp) == as.AppQName().String() {
+		refs = append(refs, ownerWSID)
+		pseudoWSID = coreutils.GetPseudoWSID(ownerWSID, wsName, wsid.ClusterID())
+	}
+	if appWSID := coreutils.PseudoWSIDToAppWSID(pseudoWSID, as.NumAppWorkspaces()); !slices.Contains(refs, appWSID) {
+		refs = append(refs, appWSID)
+	}
+	return refs, nil
+}
+
+// deletes BLOBs which are registered by wdoc.sys.BLOB in the workspace
+func purgeWorkspaceBLOBs(ctx context.Context, as istructs.IAppStructs, blobStorage iblobstorage.IBLOBStorage, wsid istructs.WSID) (count int, err error) {
+	blobIDs := []istructs.RecordID{}
+	err = as.Events().ReadWLog(ctx, wsid, istructs.FirstOffset, istructs.ReadToTheEnd, func(_ istructs.Offset, event istructs.IWLogEvent) error {
+		for rec := range event.CUDs {
+			if rec.IsNew() && rec.QName() == blobber.QNameWDocBLOB {
+				blobIDs = append(blobIDs, rec.ID())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// notest
+		return 0, err
+	}
+	for _, blobID := range blobIDs {
+		key := iblobstorage.PersistentBLOBKeyType{
+			ClusterAppID: as.ClusterAppID(),
+			WSID:         wsid,
+			BlobID:       blobID,
+		}
+		if err := blobStorage.DeleteBLOB(ctx, key); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/voedger/voedger/pkg/coreutils/federation"
 	"github.com/voedger/voedger/pkg/extensionpoints"
 	"github.com/voedger/voedger/pkg/goutils/timeu"
+	"github.com/voedger/voedger/pkg/iblobstorage"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
 	"github.com/voedger/voedger/pkg/itokens"
@@ -17,7 +18,7 @@ import (
 
 func Provide(sr istructsmem.IStatelessResources, time timeu.ITime, tokensAPI itokens.ITokens,
 	federation federation.IFederation, itokens itokens.ITokens, wsPostInitFunc WSPostInitFunc,
-	eps map[appdef.AppQName]extensionpoints.IExtensionPoint) {
+	eps map[appdef.AppQName]extensionpoints.IExtensionPoint, blobStorage iblobstorage.IBLOBStorage) {
 
 	federationWithRetry := federation.WithRetry()
 
@@ -53,6 +54,12 @@ func Provide(sr istructsmem.IStatelessResources, time timeu.ITime, tokensAPI ito
 	// deactivate workspace
 	provideDeactivateWorkspace(sr, tokensAPI, federation)
 
+	// export and import workspace data
+	provideExportImportWorkspace(sr)
+
+	// purge deactivated workspaces
+	providePurgeWorkspace(sr, blobStorage)
+
 	sr.AddProjectors(appdef.SysPackagePath,
 		asyncProjectorInvokeCreateWorkspace(federationWithRetry, itokens),
 		asyncProjectorInvokeCreateWorkspaceID(federationWithRetry, itokens),
@@ -23,3 +23,33 @@ type BLOBWorkspaceTemplateField struct {
 	OwnerRecordRawID istructs.RecordID
 	Content          []byte
 }
+
+// Portable data of the workspace, got by ExportWorkspace and replayed by ImportWorkspace.
+//
+// Data has the same format as data.json of the workspace template: record IDs are raw
+// and references between exported records are remapped to these raw IDs
+type WorkspaceExport struct {
+	WSKind appdef.QName
+	Data   []map[string]interface{}
+	BLOBs  []BLOBWorkspaceTemplateField
+}
+
+// result of q.sys.ExportWorkspace, one per exported record
+type exportedRecord struct {
+	istructs.NullObject
+	wsKind     appdef.QName
+	id         istructs.RecordID // ID of the record in the exported workspace
+	record     string            // JSON of the record fields with raw IDs
+	blobFields string            // comma-separated names of the filled BLOB fields
+}
+
+func (r *exportedRecord) AsQName(string) appdef.QName { return r.wsKind }
+
+func (r *exportedRecord) AsRecordID(string) istructs.RecordID { return r.id }
+
+func (r *exportedRecord) AsString(name string) string {
+	if name == field_BLOBFields {
+		return r.blobFields
+	}
+	return r.record
+}
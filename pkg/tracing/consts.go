@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package tracing
+
+import "time"
+
+// W3C Trace Context header, see https://www.w3.org/TR/trace-context/
+const TraceParentHeader = "traceparent"
+
+const (
+	ExporterKind_None ExporterKind = iota
+	ExporterKind_File
+	ExporterKind_OTLP
+)
+
+const (
+	SpanKind_Internal SpanKind = iota + 1
+	SpanKind_Server
+	SpanKind_Client
+)
+
+const (
+	DefaultServiceName = "voedger"
+	DefaultSampleRatio = 1.0
+)
+
+const (
+	traceParentVersion  = "00"
+	traceParentLen      = 55 // 00-<32 hex trace-id>-<16 hex parent-id>-<2 hex flags>
+	traceFlagSampled    = 0x01
+	exportQueueSize     = 4096
+	exportBatchSize     = 512
+	exportFlushInterval = 5 * time.Second
+	otlpTracesPath      = "/v1/traces"
+	otlpStatusCodeError = 2
+	otlpScopeName       = "github.com/voedger/voedger/pkg/tracing"
+	attrServiceName     = "service.name"
+	filePermissions     = 0644
+	otlpExportTimeout   = 10 * time.Second
+	spanNamePrefixHTTP  = "HTTP "
+	attrHTTPMethod      = "http.method"
+	attrHTTPTarget      = "http.target"
+	attrHTTPStatusCode  = "http.status_code"
+)
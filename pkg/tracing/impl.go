@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package tracing
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/voedger/voedger/pkg/goutils/logger"
+)
+
+func newTracer(serviceName string, sampleRatio float64, exporter IExporter) *implITracer {
+	t := &implITracer{
+		serviceName: serviceName,
+		sampleRatio: sampleRatio,
+		exporter:    exporter,
+		queue:       make(chan SpanData, exportQueueSize),
+	}
+	t.wg.Go(t.exportLoop)
+	return t
+}
+
+func (t *implITracer) Start(ctx context.Context, name string, opts ...SpanOptFunc) (context.Context, ISpan) {
+	so := spanOpts{kind: SpanKind_Internal}
+	for _, opt := range opts {
+		opt(&so)
+	}
+	if so.startTime.IsZero() {
+		so.startTime = time.Now()
+	}
+	span := &implISpan{
+		tracer: t,
+		data: SpanData{
+			Name:      name,
+			Kind:      so.kind,
+			StartTime: so.startTime,
+			Attrs:     so.attrs,
+		},
+	}
+	if parent, ok := ctx.Value(spanCtxKeyType{}).(*implISpan); ok {
+		span.data.TraceID = parent.data.TraceID
+		span.data.ParentSpanID = parent.data.SpanID
+		span.data.Sampled = parent.data.Sampled
+	} else if remote, ok := ctx.Value(remoteSpanCtxKeyType{}).(SpanContext); ok {
+		span.data.TraceID = remote.TraceID
+		span.data.ParentSpanID = remote.SpanID
+		span.data.Sampled = remote.Sampled
+	} else {
+		span.data.TraceID = newTraceID()
+		span.data.Sampled = t.sampleRatio >= 1 || rand.Float64() < t.sampleRatio // nolint G404
+	}
+	span.data.SpanID = newSpanID()
+	return context.WithValue(ctx, spanCtxKeyType{}, span), span
+}
+
+func (t *implITracer) Enabled() bool { return true }
+
+func (t *implITracer) export(sd SpanData) {
+	t.queueMu.RLock()
+	defer t.queueMu.RUnlock()
+	if t.closed {
+		return
+	}
+	select {
+	case t.queue <- sd:
+	default:
+		if t.dropped.Add(1) == 1 {
+			logger.Warning("tracing: export queue is full, spans are dropped")
+		}
+	}
+}
+
+func (t *implITracer) exportLoop() {
+	batch := make([]SpanData, 0, exportBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := t.exporter.Export(t.serviceName, batch); err != nil {
+			logger.Error(fmt.Sprintf("tracing: failed to export %d spans: %v", len(batch), err))
+		}
+		batch = batch[:0]
+	}
+	ticker := time.NewTicker(exportFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case sd, ok := <-t.queue:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, sd)
+			if len(batch) >= exportBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// flushes the queued spans and closes the exporter
+func (t *implITracer) close() {
+	t.queueMu.Lock()
+	t.closed = true
+	close(t.queue)
+	t.queueMu.Unlock()
+	t.wg.Wait()
+	if err := t.exporter.Close(); err != nil {
+		logger.Error("tracing: failed to close the exporter:", err)
+	}
+	if dropped := t.dropped.Load(); dropped > 0 {
+		logger.Warning(fmt.Sprintf("tracing: %d spans were dropped", dropped))
+	}
+}
+
+func (s *implISpan) SpanContext() SpanContext {
+	return s.data.SpanContext
+}
+
+func (s *implISpan) SetAttr(key string, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.data.Attrs == nil {
+		s.data.Attrs = map[string]any{}
+	}
+	s.data.Attrs[key] = value
+}
+
+func (s *implISpan) End(err error) {
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.EndTime = time.Now()
+	s.data.Err = err
+	sd := s.data
+	s.mu.Unlock()
+	if sd.Sampled {
+		s.tracer.export(sd)
+	}
+}
+
+func (noopTracer) Start(ctx context.Context, _ string, _ ...SpanOptFunc) (context.Context, ISpan) {
+	return ctx, noopSpan{}
+}
+
+func (noopTracer) Enabled() bool { return false }
+
+func (noopSpan) SpanContext() SpanContext { return SpanContext{} }
+func (noopSpan) SetAttr(string, any)      {}
+func (noopSpan) End(error)                {}
+
+// IsValid returns true if both TraceID and SpanID are non-zero
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != TraceID{} && sc.SpanID != SpanID{}
+}
+
+// TraceParent returns the W3C traceparent header value
+func (sc SpanContext) TraceParent() string {
+	flags := 0
+	if sc.Sampled {
+		flags = traceFlagSampled
+	}
+	return fmt.Sprintf("%s-%s-%s-%02x", traceParentVersion, sc.TraceID, sc.SpanID, flags)
+}
+
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+
+func (id SpanID) String() string { return hex.EncodeToString(id[:]) }
+
+func newTraceID() (res TraceID) {
+	for res == (TraceID{}) {
+		binary.BigEndian.PutUint64(res[:8], rand.Uint64()) // nolint G404
+		binary.BigEndian.PutUint64(res[8:], rand.Uint64()) // nolint G404
+	}
+	return res
+}
+
+func newSpanID() (res SpanID) {
+	for res == (SpanID{}) {
+		binary.BigEndian.PutUint64(res[:], rand.Uint64()) // nolint G404
+	}
+	return res
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+func (e *fileExporter) Export(serviceName string, spans []SpanData) error {
+	line, err := json.Marshal(toOTLP(serviceName, spans))
+	if err != nil {
+		// notest
+		return err
+	}
+	line = append(line, '\n')
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, err = e.file.Write(line)
+	return err
+}
+
+func (e *fileExporter) Close() error {
+	return e.file.Close()
+}
+
+func (e *otlpExporter) Export(serviceName string, spans []SpanData) error {
+	body, err := json.Marshal(toOTLP(serviceName, spans))
+	if err != nil {
+		// notest
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("OTLP collector responded %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
+
+func (e *otlpExporter) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+func toOTLP(serviceName string, spans []SpanData) otlpExportRequest {
+	otlpSpans := make([]otlpSpan, 0, len(spans))
+	for _, sd := range spans {
+		s := otlpSpan{
+			TraceID:           sd.TraceID.String(),
+			SpanID:            sd.SpanID.String(),
+			Name:              sd.Name,
+			Kind:              sd.Kind,
+			StartTimeUnixNano: strconv.FormatInt(sd.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(sd.EndTime.UnixNano(), 10),
+		}
+		if sd.ParentSpanID != (SpanID{}) {
+			s.ParentSpanID = sd.ParentSpanID.String()
+		}
+		for key, value := range sd.Attrs {
+			s.Attributes = append(s.Attributes, otlpKeyValue{Key: key, Value: toOTLPValue(value)})
+		}
+		if sd.Err != nil {
+			s.Status = otlpStatus{Code: otlpStatusCodeError, Message: sd.Err.Error()}
+		}
+		otlpSpans = append(otlpSpans, s)
+	}
+	return otlpExportRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpKeyValue{{Key: attrServiceName, Value: toOTLPValue(serviceName)}},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: otlpScopeName},
+				Spans: otlpSpans,
+			}},
+		}},
+	}
+}
+
+func toOTLPValue(value any) (res otlpAnyValue) {
+	switch v := value.(type) {
+	case string:
+		res.StringValue = &v
+	case bool:
+		res.BoolValue = &v
+	case int:
+		s := strconv.FormatInt(int64(v), 10)
+		res.IntValue = &s
+	case int32:
+		s := strconv.FormatInt(int64(v), 10)
+		res.IntValue = &s
+	case int64:
+		s := strconv.FormatInt(v, 10)
+		res.IntValue = &s
+	case uint32:
+		s := strconv.FormatUint(uint64(v), 10)
+		res.IntValue = &s
+	case uint64:
+		s := strconv.FormatUint(v, 10)
+		res.IntValue = &s
+	case float32:
+		f := float64(v)
+		res.DoubleValue = &f
+	case float64:
+		res.DoubleValue = &v
+	default:
+		s := fmt.Sprint(v)
+		res.StringValue = &s
+	}
+	return res
+}
@@ -0,0 +1,311 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+	"github.com/voedger/voedger/pkg/goutils/testingu/require"
+)
+
+type testExporter struct {
+	sync.Mutex
+	spans  []SpanData
+	closed bool
+}
+
+func (e *testExporter) Export(_ string, spans []SpanData) error {
+	e.Lock()
+	defer e.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *testExporter) Close() error {
+	e.closed = true
+	return nil
+}
+
+func (e *testExporter) byName(name string) (res SpanData, ok bool) {
+	for _, sd := range e.spans {
+		if sd.Name == name {
+			return sd, true
+		}
+	}
+	return res, false
+}
+
+func TestTraceParent(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("roundtrip", func(t *testing.T) {
+		const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+		sc, err := ParseTraceParent(traceParent)
+		require.NoError(err)
+		require.True(sc.Sampled)
+		require.Equal("4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID.String())
+		require.Equal("00f067aa0ba902b7", sc.SpanID.String())
+		require.Equal(traceParent, sc.TraceParent())
+
+		sc.Sampled = false
+		require.Equal("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00", sc.TraceParent())
+	})
+
+	t.Run("future version", func(t *testing.T) {
+		sc, err := ParseTraceParent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-future")
+		require.NoError(err)
+		require.True(sc.IsValid())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, traceParent := range []string{
+			"",
+			"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+			"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+			"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+			"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+			"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+			"00_4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-zz",
+		} {
+			_, err := ParseTraceParent(traceParent)
+			require.Error(err, traceParent)
+		}
+	})
+}
+
+func TestSpans(t *testing.T) {
+	require := require.New(t)
+	exporter := &testExporter{}
+	tracer, cleanup := NewTracer(Config{}, exporter)
+
+	ctx, root := tracer.Start(context.Background(), "root", WithAttr("key", "value"))
+	childCtx, child := StartChild(ctx, "child")
+	_, grandChild := StartChild(childCtx, "grandChild")
+	grandChild.End(nil)
+	testErr := errors.New("test error")
+	child.End(testErr)
+	root.SetAttr("num", 42)
+	root.End(nil)
+	root.End(errors.New("ignored"))
+
+	t.Run("no span in ctx -> StartChild does nothing", func(t *testing.T) {
+		noSpanCtx := context.Background()
+		resCtx, span := StartChild(noSpanCtx, "noop")
+		require.Equal(noSpanCtx, resCtx)
+		require.False(span.SpanContext().IsValid())
+		span.End(nil)
+	})
+
+	cleanup()
+	require.True(exporter.closed)
+	require.Len(exporter.spans, 3)
+
+	rootData, ok := exporter.byName("root")
+	require.True(ok)
+	childData, ok := exporter.byName("child")
+	require.True(ok)
+	grandChildData, ok := exporter.byName("grandChild")
+	require.True(ok)
+
+	require.True(rootData.Sampled)
+	require.Equal(SpanID{}, rootData.ParentSpanID)
+	require.Equal(rootData.TraceID, childData.TraceID)
+	require.Equal(rootData.SpanID, childData.ParentSpanID)
+	require.Equal(rootData.TraceID, grandChildData.TraceID)
+	require.Equal(childData.SpanID, grandChildData.ParentSpanID)
+	require.ErrorIs(childData.Err, testErr)
+	require.NoError(rootData.Err)
+	require.Equal(map[string]any{"key": "value", "num": 42}, rootData.Attrs)
+	require.Equal(SpanKind_Internal, rootData.Kind)
+	require.False(rootData.EndTime.Before(rootData.StartTime))
+
+	t.Run("spans after cleanup are ignored", func(t *testing.T) {
+		_, span := tracer.Start(context.Background(), "late")
+		span.End(nil)
+		require.Len(exporter.spans, 3)
+	})
+}
+
+func TestSampling(t *testing.T) {
+	require := require.New(t)
+	exporter := &testExporter{}
+	tracer, cleanup := NewTracer(Config{SampleRatio: 1e-12}, exporter)
+
+	ctx, root := tracer.Start(context.Background(), "root")
+	require.False(root.SpanContext().Sampled)
+	require.True(root.SpanContext().IsValid())
+	_, child := StartChild(ctx, "child")
+	require.False(child.SpanContext().Sampled)
+	child.End(nil)
+	root.End(nil)
+
+	// sampled remote parent -> sampled regardless of the ratio
+	remoteCtx := ContextWithRemoteSpanContext(context.Background(), SpanContext{TraceID: newTraceID(), SpanID: newSpanID(), Sampled: true})
+	_, remoteChild := tracer.Start(remoteCtx, "remoteChild")
+	require.True(remoteChild.SpanContext().Sampled)
+	remoteChild.End(nil)
+
+	cleanup()
+	require.Len(exporter.spans, 1)
+	require.Equal("remoteChild", exporter.spans[0].Name)
+}
+
+func TestHTTPPropagation(t *testing.T) {
+	require := require.New(t)
+	exporter := &testExporter{}
+	tracer, cleanup := NewTracer(Config{}, exporter)
+
+	incomingTraceParent := ""
+	server := httptest.NewServer(HTTPHandler(tracer, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		incomingTraceParent = r.Header.Get(TraceParentHeader)
+		_, ok := w.(http.Flusher)
+		require.True(ok)
+		_, span := StartChild(r.Context(), "handler")
+		span.End(nil)
+		w.WriteHeader(http.StatusTeapot)
+	})))
+	defer server.Close()
+
+	httpClient, httpClientCleanup := httpu.NewIHTTPClient()
+	defer httpClientCleanup()
+
+	ctx, root := tracer.Start(context.Background(), "root")
+	var clientSpan ISpan
+	resp, err := httpClient.Req(context.Background(), server.URL+"/path", "",
+		httpu.WithExpectedCode(http.StatusTeapot),
+		WithTraceContext(ctx),
+		WithClientSpan(tracer, &clientSpan, "client", WithAttr("http.url", server.URL)),
+	)
+	require.NoError(err)
+	require.Equal(http.StatusTeapot, resp.HTTPResp.StatusCode)
+	clientSpan.End(nil)
+	root.End(nil)
+
+	cleanup()
+
+	clientData, ok := exporter.byName("client")
+	require.True(ok)
+	require.Equal(clientData.TraceParent(), incomingTraceParent)
+	require.Equal(SpanKind_Client, clientData.Kind)
+	require.Equal(root.SpanContext().SpanID, clientData.ParentSpanID)
+
+	serverData, ok := exporter.byName("HTTP GET /path")
+	require.True(ok)
+	require.Equal(SpanKind_Server, serverData.Kind)
+	require.Equal(clientData.TraceID, serverData.TraceID)
+	require.Equal(clientData.SpanID, serverData.ParentSpanID)
+	require.Equal(http.StatusTeapot, serverData.Attrs[attrHTTPStatusCode])
+
+	handlerData, ok := exporter.byName("handler")
+	require.True(ok)
+	require.Equal(serverData.SpanID, handlerData.ParentSpanID)
+
+	t.Run("Inject and Extract", func(t *testing.T) {
+		header := http.Header{}
+		Inject(context.Background(), header)
+		require.Empty(header)
+
+		ctx, span := NewNoopTracer().Start(context.Background(), "noop")
+		Inject(ctx, header)
+		require.Empty(header)
+		span.End(nil)
+
+		header.Set(TraceParentHeader, "wrong")
+		require.Equal(context.Background(), Extract(context.Background(), header))
+	})
+}
+
+func TestExporters(t *testing.T) {
+	require := require.New(t)
+
+	checkOTLP := func(body []byte) {
+		req := otlpExportRequest{}
+		require.NoError(json.Unmarshal(body, &req))
+		require.Len(req.ResourceSpans, 1)
+		require.Equal(attrServiceName, req.ResourceSpans[0].Resource.Attributes[0].Key)
+		require.Equal("test-service", *req.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+		spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+		require.Len(spans, 1)
+		require.Equal("span", spans[0].Name)
+		require.Len(spans[0].TraceID, 32)
+		require.Len(spans[0].SpanID, 16)
+		require.Empty(spans[0].ParentSpanID)
+		require.Equal(otlpStatusCodeError, spans[0].Status.Code)
+		require.Equal("test error", spans[0].Status.Message)
+		require.Equal("42", *spans[0].Attributes[0].Value.IntValue)
+	}
+
+	startSpan := func(tracer ITracer) {
+		_, span := tracer.Start(context.Background(), "span", WithAttr("num", 42))
+		span.End(errors.New("test error"))
+	}
+
+	t.Run("file", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "spans.jsonl")
+		tracer, cleanup, err := Provide(Config{Exporter: ExporterKind_File, FilePath: filePath, ServiceName: "test-service"})
+		require.NoError(err)
+		startSpan(tracer)
+		cleanup()
+
+		content, err := os.ReadFile(filePath)
+		require.NoError(err)
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		require.Len(lines, 1)
+		checkOTLP([]byte(lines[0]))
+	})
+
+	t.Run("OTLP", func(t *testing.T) {
+		var body []byte
+		collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(otlpTracesPath, r.URL.Path)
+			require.Equal(http.MethodPost, r.Method)
+			var err error
+			body, err = io.ReadAll(r.Body)
+			require.NoError(err)
+		}))
+		defer collector.Close()
+
+		tracer, cleanup, err := Provide(Config{Exporter: ExporterKind_OTLP, OTLPEndpoint: collector.URL + "/", ServiceName: "test-service"})
+		require.NoError(err)
+		startSpan(tracer)
+		cleanup()
+		checkOTLP(body)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		tracer, cleanup, err := Provide(Config{})
+		require.NoError(err)
+		defer cleanup()
+		require.False(tracer.Enabled())
+		ctx, span := tracer.Start(context.Background(), "span")
+		require.Equal(context.Background(), ctx)
+		span.End(nil)
+	})
+
+	t.Run("wrong config", func(t *testing.T) {
+		for _, cfg := range []Config{
+			{Exporter: ExporterKind_File},
+			{Exporter: ExporterKind_OTLP},
+			{Exporter: ExporterKind_OTLP + 1},
+			{Exporter: ExporterKind_File, FilePath: t.TempDir()},
+		} {
+			_, _, err := Provide(cfg)
+			require.Error(err)
+		}
+	})
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package tracing
+
+import "context"
+
+// @ConcurrentAccess
+type ITracer interface {
+	// Starts the child of the span from ctx.
+	// No span in ctx -> child of the remote span from ctx (see [Extract]), otherwise a root span is started.
+	// Returned context contains the started span
+	Start(ctx context.Context, name string, opts ...SpanOptFunc) (context.Context, ISpan)
+
+	// false for the tracer provided for ExporterKind_None
+	Enabled() bool
+}
+
+// @ConcurrentAccess
+type ISpan interface {
+	SpanContext() SpanContext
+	SetAttr(key string, value any)
+
+	// err != nil -> span status is Error
+	// Calls after the first one are ignored
+	End(err error)
+}
+
+type IExporter interface {
+	Export(serviceName string, spans []SpanData) error
+	Close() error
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package tracing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Provide returns the tracer configured by cfg
+// cleanup flushes the pending spans and closes the exporter
+// ExporterKind_None -> no-op tracer is returned
+func Provide(cfg Config) (tracer ITracer, cleanup func(), err error) {
+	var exporter IExporter
+	switch cfg.Exporter {
+	case ExporterKind_None:
+		return NewNoopTracer(), func() {}, nil
+	case ExporterKind_File:
+		if len(cfg.FilePath) == 0 {
+			return nil, nil, errors.New("tracing: file path must be specified for the file exporter")
+		}
+		file, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, filePermissions)
+		if err != nil {
+			return nil, nil, fmt.Errorf("tracing: failed to open the file exporter file: %w", err)
+		}
+		exporter = &fileExporter{file: file}
+	case ExporterKind_OTLP:
+		if len(cfg.OTLPEndpoint) == 0 {
+			return nil, nil, errors.New("tracing: endpoint must be specified for the OTLP exporter")
+		}
+		exporter = &otlpExporter{
+			url:    strings.TrimSuffix(cfg.OTLPEndpoint, "/") + otlpTracesPath,
+			client: &http.Client{},
+		}
+	default:
+		return nil, nil, fmt.Errorf("tracing: unsupported exporter kind %d", cfg.Exporter)
+	}
+	tracer, cleanup = NewTracer(cfg, exporter)
+	return tracer, cleanup, nil
+}
+
+// NewTracer returns the tracer that sends the finished sampled spans to the exporter in batches
+// cleanup flushes the pending spans and closes the exporter
+// cfg.Exporter, cfg.OTLPEndpoint and cfg.FilePath are ignored
+func NewTracer(cfg Config, exporter IExporter) (tracer ITracer, cleanup func()) {
+	serviceName := cfg.ServiceName
+	if len(serviceName) == 0 {
+		serviceName = DefaultServiceName
+	}
+	sampleRatio := cfg.SampleRatio
+	if sampleRatio <= 0 {
+		sampleRatio = DefaultSampleRatio
+	}
+	t := newTracer(serviceName, sampleRatio, exporter)
+	return t, t.close
+}
+
+// NewNoopTracer returns the tracer which starts no spans
+func NewNoopTracer() ITracer {
+	return noopTracer{}
+}
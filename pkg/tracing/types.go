@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package tracing
+
+import (
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Exporter the finished spans are sent to
+type ExporterKind int
+
+// OTLP span kind
+type SpanKind int
+
+type TraceID [16]byte
+
+type SpanID [8]byte
+
+// Identifies the span across process boundaries, propagated by the W3C traceparent header
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+// Tracing configuration, see [Provide]
+type Config struct {
+	// ExporterKind_None (default) -> tracing is disabled
+	Exporter ExporterKind
+
+	// OTLP/HTTP collector base URL, e.g. http://localhost:4318. Spans are POSTed to <OTLPEndpoint>/v1/traces
+	// Used if Exporter is ExporterKind_OTLP
+	OTLPEndpoint string
+
+	// Spans are appended to the file as JSON lines, one OTLP ExportTraceServiceRequest per line
+	// Used if Exporter is ExporterKind_File
+	FilePath string
+
+	// Ratio of the root spans to be sampled, (0..1]. 0 -> DefaultSampleRatio
+	// Child spans follow the decision of the parent, incl. the parent extracted from the traceparent header
+	SampleRatio float64
+
+	// "" -> DefaultServiceName
+	ServiceName string
+}
+
+type SpanOptFunc func(opts *spanOpts)
+
+type spanOpts struct {
+	kind      SpanKind
+	attrs     map[string]any
+	startTime time.Time
+}
+
+// Finished span ready to be exported
+type SpanData struct {
+	SpanContext
+	ParentSpanID SpanID // zero for root spans
+	Name         string
+	Kind         SpanKind
+	StartTime    time.Time
+	EndTime      time.Time
+	Attrs        map[string]any
+	Err          error
+}
+
+type implITracer struct {
+	serviceName string
+	sampleRatio float64
+	exporter    IExporter
+	queue       chan SpanData
+	queueMu     sync.RWMutex
+	closed      bool
+	dropped     atomic.Uint64
+	wg          sync.WaitGroup
+}
+
+type implISpan struct {
+	tracer *implITracer
+	mu     sync.Mutex
+	data   SpanData
+	ended  bool
+}
+
+type noopTracer struct{}
+
+type noopSpan struct{}
+
+type spanCtxKeyType struct{}
+
+type remoteSpanCtxKeyType struct{}
+
+type traceCtxOptKeyType struct{}
+
+// remembers the status code to put it to the server span
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+type fileExporter struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+type otlpExporter struct {
+	url    string
+	client *http.Client
+}
+
+// OTLP/JSON encoding, see https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+type otlpExportRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              SpanKind       `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/voedger/voedger/pkg/goutils/httpu"
+)
+
+func WithKind(kind SpanKind) SpanOptFunc {
+	return func(opts *spanOpts) {
+		opts.kind = kind
+	}
+}
+
+// WithAttr adds the attribute to the span on start
+// Supported value types: string, bool, integers, floats. Other values are exported as fmt.Sprint(value)
+func WithAttr(key string, value any) SpanOptFunc {
+	return func(opts *spanOpts) {
+		if opts.attrs == nil {
+			opts.attrs = map[string]any{}
+		}
+		opts.attrs[key] = value
+	}
+}
+
+// WithStartTime sets the start time of the span, the current time is used by default
+// Used to record the span of the already finished call
+func WithStartTime(start time.Time) SpanOptFunc {
+	return func(opts *spanOpts) {
+		opts.startTime = start
+	}
+}
+
+// StartChild starts the child of the span from ctx using the tracer of that span
+// No span in ctx -> ctx and a no-op span are returned, i.e. nothing is traced outside of a traced request
+func StartChild(ctx context.Context, name string, opts ...SpanOptFunc) (context.Context, ISpan) {
+	if parent, ok := ctx.Value(spanCtxKeyType{}).(*implISpan); ok {
+		return parent.tracer.Start(ctx, name, opts...)
+	}
+	return ctx, noopSpan{}
+}
+
+// SpanFromContext returns the span started by [ITracer.Start] or [StartChild]
+// No span in ctx -> no-op span is returned
+func SpanFromContext(ctx context.Context) ISpan {
+	if span, ok := ctx.Value(spanCtxKeyType{}).(*implISpan); ok {
+		return span
+	}
+	return noopSpan{}
+}
+
+// ContextWithSpanOf returns ctx which carries the span from spanCtx
+// Used to trace the calls made with a long-living context (e.g. the processor context) as the children of the request span
+func ContextWithSpanOf(ctx context.Context, spanCtx context.Context) context.Context {
+	if span, ok := spanCtx.Value(spanCtxKeyType{}).(*implISpan); ok {
+		return context.WithValue(ctx, spanCtxKeyType{}, span)
+	}
+	return ctx
+}
+
+// ContextWithRemoteSpanContext returns the context the span started by [ITracer.Start] is the child of the remote span in
+func ContextWithRemoteSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, remoteSpanCtxKeyType{}, sc)
+}
+
+// ParseTraceParent parses the W3C traceparent header value
+// Future versions are accepted as far as the version 00 fields are valid, "ff" version is invalid
+func ParseTraceParent(traceParent string) (sc SpanContext, err error) {
+	if len(traceParent) < traceParentLen || (len(traceParent) > traceParentLen && traceParent[traceParentLen] != '-') {
+		return sc, errors.New("invalid traceparent length")
+	}
+	if traceParent[2] != '-' || traceParent[35] != '-' || traceParent[52] != '-' {
+		return sc, errors.New("invalid traceparent format")
+	}
+	version, err := hex.DecodeString(traceParent[:2])
+	if err != nil || version[0] == 0xff {
+		return sc, errors.New("invalid traceparent version")
+	}
+	if version[0] == 0 && len(traceParent) != traceParentLen {
+		return sc, errors.New("invalid traceparent length")
+	}
+	if err := decodeLowerHex(sc.TraceID[:], traceParent[3:35]); err != nil {
+		return sc, errors.New("invalid traceparent trace-id")
+	}
+	if err := decodeLowerHex(sc.SpanID[:], traceParent[36:52]); err != nil {
+		return sc, errors.New("invalid traceparent parent-id")
+	}
+	flags, err := hex.DecodeString(traceParent[53:55])
+	if err != nil {
+		return sc, errors.New("invalid traceparent flags")
+	}
+	if !sc.IsValid() {
+		return sc, errors.New("traceparent trace-id and parent-id must not be zero")
+	}
+	sc.Sampled = flags[0]&traceFlagSampled != 0
+	return sc, nil
+}
+
+func decodeLowerHex(dst []byte, s string) error {
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return errors.New("not a lowercase hex")
+		}
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err
+}
+
+// Inject puts the traceparent header of the span from ctx to the header
+// No span in ctx -> nothing is done
+func Inject(ctx context.Context, header http.Header) {
+	if span, ok := ctx.Value(spanCtxKeyType{}).(*implISpan); ok {
+		header.Set(TraceParentHeader, span.SpanContext().TraceParent())
+	}
+}
+
+// Extract returns the context with the remote span context taken from the traceparent header
+// No or invalid header -> ctx is returned as is
+func Extract(ctx context.Context, header http.Header) context.Context {
+	traceParent := header.Get(TraceParentHeader)
+	if len(traceParent) == 0 {
+		return ctx
+	}
+	sc, err := ParseTraceParent(traceParent)
+	if err != nil {
+		return ctx
+	}
+	return ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// HTTPHandler wraps h to start a server span per request
+// The span is the child of the span from the incoming traceparent header
+// The span is available in the request context
+func HTTPHandler(tracer ITracer, h http.Handler) http.Handler {
+	if tracer == nil || !tracer.Enabled() {
+		return h
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := Extract(r.Context(), r.Header)
+		ctx, span := tracer.Start(ctx, spanNamePrefixHTTP+r.Method+" "+r.URL.Path,
+			WithKind(SpanKind_Server),
+			WithAttr(attrHTTPMethod, r.Method),
+			WithAttr(attrHTTPTarget, r.URL.Path),
+		)
+		rec := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		h.ServeHTTP(rec, r.WithContext(ctx))
+		span.SetAttr(attrHTTPStatusCode, rec.statusCode)
+		var err error
+		if rec.statusCode >= http.StatusInternalServerError {
+			err = errors.New(http.StatusText(rec.statusCode))
+		}
+		span.End(err)
+	})
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// streaming responses (e.g. n10n events) require http.Flusher
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(r.ResponseWriter).Hijack()
+}
+
+// used by http.ResponseController
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// WithTraceContext makes the request sent by [WithClientSpan] to be traced as the child of the span from ctx
+func WithTraceContext(ctx context.Context) httpu.ReqOptFunc {
+	return httpu.WithCustomOpts(traceCtxOptKeyType{}, ctx)
+}
+
+// WithClientSpan must be the last option of the request.
+// Starts the client span and injects its traceparent header to the request.
+// The parent is the span from the context provided by [WithTraceContext], otherwise a root span is started.
+// The started span is put to *span and must be ended by the caller when the request is done.
+func WithClientSpan(tracer ITracer, span *ISpan, name string, optFuncs ...SpanOptFunc) httpu.ReqOptFunc {
+	optFuncs = append(slices.Clone(optFuncs), WithKind(SpanKind_Client))
+	return func(opts httpu.IReqOpts) {
+		parentCtx, ok := opts.CustomOpts(traceCtxOptKeyType{}).(context.Context)
+		if !ok {
+			parentCtx = context.Background()
+		}
+		_, s := tracer.Start(parentCtx, name, optFuncs...)
+		*span = s
+		if sc := s.SpanContext(); sc.IsValid() {
+			opts.Append(httpu.WithHeaders(TraceParentHeader, sc.TraceParent()))
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/voedger/voedger/pkg/appdef"
 	"github.com/voedger/voedger/pkg/coreutils"
 	"github.com/voedger/voedger/pkg/extensionpoints"
+	"github.com/voedger/voedger/pkg/istoragefaults"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/sys/smtp"
 	"github.com/voedger/voedger/pkg/sys/workspace"
@@ -152,6 +153,16 @@ func WithVVMConfig(configurer func(cfg *vvm.VVMConfig)) VITConfigOptFunc {
 	}
 }
 
+// injector rules are normally set after NewVIT() to not to break the bootstrap and are reset on the VIT teardown
+func WithStorageFaults(injector istoragefaults.IFaultInjector) VITConfigOptFunc {
+	return func(vpc *vitPreConfig) {
+		vpc.vvmCfg.StorageFaults = injector
+		vpc.cleanups = append(vpc.cleanups, func(*VIT) {
+			injector.SetRules()
+		})
+	}
+}
+
 func WithCleanup(cleanup func(*VIT)) VITConfigOptFunc {
 	return func(hpc *vitPreConfig) {
 		hpc.cleanups = append(hpc.cleanups, cleanup)
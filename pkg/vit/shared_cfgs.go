@@ -9,6 +9,7 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"sync/atomic"
 	"time"
 
 	"github.com/voedger/voedger/pkg/appdef"
@@ -527,6 +528,39 @@ func ProvideApp1(apis builtinapps.APIs, cfg *istructsmem.AppConfigType, ep exten
 		return callback(&qryCategory{id: args.ArgumentObject.AsInt64("CategoryID")})
 	}))
 
+	qryCachedCategoryExecs := atomic.Int64{}
+	cfg.Resources.Add(istructsmem.NewQueryFunction(appdef.NewQName(app1PkgName, "QryCachedCategory"), func(ctx context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
+		kb, err := args.State.KeyBuilder(sys.Storage_Record, QNameApp1_CDocCategory)
+		if err != nil {
+			return err
+		}
+		kb.PutRecordID(sys.Storage_Record_Field_ID, istructs.RecordID(args.ArgumentObject.AsInt64("CategoryID"))) // nolint G115
+		category, err := args.State.MustExist(kb)
+		if err != nil {
+			return err
+		}
+		return callback(&qryCachedCategoryResult{
+			name:  category.AsString("name"),
+			execs: qryCachedCategoryExecs.Add(1),
+		})
+	}))
+
+	qryCachedSubjectExecs := atomic.Int64{}
+	cfg.Resources.Add(istructsmem.NewQueryFunction(appdef.NewQName(app1PkgName, "QryCachedSubject"), func(ctx context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
+		kb, err := args.State.KeyBuilder(sys.Storage_RequestSubject, appdef.NullQName)
+		if err != nil {
+			return err
+		}
+		subject, err := args.State.MustExist(kb)
+		if err != nil {
+			return err
+		}
+		return callback(&qryCachedCategoryResult{
+			name:  subject.AsString(sys.Storage_RequestSubject_Field_Name),
+			execs: qryCachedSubjectExecs.Add(1),
+		})
+	}))
+
 	cfg.Resources.Add(istructsmem.NewQueryFunction(appdef.NewQName(app1PkgName, "QryDailyIdx"), func(ctx context.Context, args istructs.ExecQueryArgs, callback istructs.ExecQueryCallback) (err error) {
 		skbViewDailyIdx, err := args.State.KeyBuilder(sys.Storage_View, QNameApp1_ViewDailyIdx)
 		if err != nil {
@@ -647,6 +681,16 @@ func (q *qryCategory) AsRecordID(name appdef.FieldName) istructs.RecordID {
 	return istructs.RecordID(q.id) // nolint G115
 }
 
+type qryCachedCategoryResult struct {
+	istructs.NullObject
+	name  string
+	execs int64
+}
+
+func (q *qryCachedCategoryResult) AsString(appdef.FieldName) string { return q.name }
+
+func (q *qryCachedCategoryResult) AsInt64(appdef.FieldName) int64 { return q.execs }
+
 type qryDailyIdxResult struct {
 	istructs.IObject
 	year        int32
@@ -21,6 +21,7 @@ const (
 	DefaultQuotasChannelsPerSubject                                        = 50
 	DefaultQuotasSubscriptionsFactor                                       = 1000 // Quotas.Subscriptions will be NumCommandProcessors * DefaultQuotasSubscriptionsFactor
 	DefaultQuotasSubscriptionsPerSubject                                   = 100
+	DefaultQuotasPayloadsPerChannel                                        = 100
 	DefaultMetricsServicePort                                              = 8000
 	DefaultCacheSize                                                       = 1024 * 1024 * 1024 // 1Gb
 	ShortestPossibleFunctionNameLen                                        = len("q.a.a")
@@ -54,6 +54,13 @@ func provideStatelessFuncs(statelessResources istructsmem.IStatelessResources) i
 		}
 	}
 
+	for path, job := range statelessResources.Jobs {
+		fullQName := appdef.NewFullQName(path, job.Name.Entity())
+		funcs[fullQName] = func(_ context.Context, io iextengine.IExtensionIO) error {
+			return job.Func(io, io)
+		}
+	}
+
 	return funcs
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/voedger/voedger/pkg/appdef/builder"
 	"github.com/voedger/voedger/pkg/appparts"
 	"github.com/voedger/voedger/pkg/extensionpoints"
+	"github.com/voedger/voedger/pkg/isecrets"
 	"github.com/voedger/voedger/pkg/istructsmem"
 	"github.com/voedger/voedger/pkg/parser"
 	builtinapps "github.com/voedger/voedger/pkg/vvm/builtin"
@@ -43,7 +44,7 @@ func buildAppFromPackagesFS(appQName appdef.AppQName, fses []parser.PackageFS, a
 }
 
 func (ab VVMAppsBuilder) BuildAppsArtefacts(apis builtinapps.APIs, emptyCfgs AppConfigsTypeEmpty,
-	appsEPs map[appdef.AppQName]extensionpoints.IExtensionPoint, schemasCache ISchemasCache) (builtinAppsArtefacts BuiltInAppsArtefacts, err error) {
+	appsEPs map[appdef.AppQName]extensionpoints.IExtensionPoint, schemasCache ISchemasCache, secretReader isecrets.ISecretReader) (builtinAppsArtefacts BuiltInAppsArtefacts, err error) {
 	builtinAppsArtefacts.AppConfigsType = istructsmem.AppConfigsType(emptyCfgs)
 	for appQName, appBuilder := range ab {
 		appEPs := appsEPs[appQName]
@@ -51,6 +52,7 @@ func (ab VVMAppsBuilder) BuildAppsArtefacts(apis builtinapps.APIs, emptyCfgs App
 		cfg := builtinAppsArtefacts.AppConfigsType.AddBuiltInAppConfig(appQName, adb)
 		builtInAppDef := appBuilder(apis, cfg, appEPs)
 		cfg.SetNumAppWorkspaces(builtInAppDef.NumAppWorkspaces)
+		cfg.SetDataKeysReader(secretReader)
 		if err := buildAppFromPackagesFS(appQName, builtInAppDef.Packages, adb, schemasCache); err != nil {
 			return builtinAppsArtefacts, err
 		}
@@ -71,6 +71,8 @@ func provideRequestHandler(appParts appparts.IAppPartitions, procbus iprocbus.IP
 			return
 		}
 
+		requestCtx = withRequestLogAttrs(requestCtx, request, partitionID)
+
 		// deliver to processors
 		if request.IsAPIV2 {
 			if request.Method == http.MethodGet {
@@ -86,7 +88,8 @@ func provideRequestHandler(appParts appparts.IAppPartitions, procbus iprocbus.IP
 				// TODO: use appQName to calculate cmdProcessorIdx in solid range [0..cpCount)
 				cmdProcessorIdx := uint(partitionID) % uint(cpAmount)
 				icm := commandprocessor.NewCommandMessage(requestCtx, request.Body, request.AppQName, request.WSID, responder, partitionID, request.QName, token,
-					request.Host, processors.APIPath(request.APIPath), istructs.RecordID(request.DocID), request.Method, request.Header[httpu.Origin])
+					request.Host, processors.APIPath(request.APIPath), istructs.RecordID(request.DocID), request.Method, request.Header[httpu.Origin],
+					request.Header[httpu.IdempotencyKey])
 				if !procbus.Submit(uint(cpchIdx), cmdProcessorIdx, icm) {
 					replyCommandBusy(requestCtx, responder, partitionID, busyLogMode)
 				}
@@ -112,7 +115,8 @@ func provideRequestHandler(appParts appparts.IAppPartitions, procbus iprocbus.IP
 				// TODO: use appQName to calculate cmdProcessorIdx in solid range [0..cpCount)
 				cmdProcessorIdx := uint(partitionID) % uint(cpAmount)
 				icm := commandprocessor.NewCommandMessage(requestCtx, request.Body, request.AppQName, request.WSID, responder, partitionID, funcQName, token,
-					request.Host, processors.APIPath(request.APIPath), istructs.RecordID(request.DocID), request.Method, request.Header[httpu.Origin])
+					request.Host, processors.APIPath(request.APIPath), istructs.RecordID(request.DocID), request.Method, request.Header[httpu.Origin],
+					request.Header[httpu.IdempotencyKey])
 				if !procbus.Submit(uint(cpchIdx), cmdProcessorIdx, icm) {
 					replyCommandBusy(requestCtx, responder, partitionID, busyLogMode)
 				}
@@ -123,6 +127,19 @@ func provideRequestHandler(appParts appparts.IAppPartitions, procbus iprocbus.IP
 	}
 }
 
+// the request could be sent to the bus directly, not via the router, so app and wsid are set here as well
+func withRequestLogAttrs(requestCtx context.Context, request bus.Request, partitionID istructs.PartitionID) context.Context {
+	attrs := map[string]any{
+		logger.LogAttr_VApp:   request.AppQName,
+		logger.LogAttr_WSID:   request.WSID,
+		logger.LogAttr_PartID: partitionID,
+	}
+	if len(request.RequestID) > 0 {
+		attrs[logger.LogAttr_ReqID] = request.RequestID
+	}
+	return logger.WithContextAttrs(requestCtx, attrs)
+}
+
 func replyQueryBusy(ctx context.Context, isAPIv2 bool, responder bus.IResponder, logMode BusyProcessorLogMode) {
 	str := "v1"
 	if isAPIv2 {
@@ -0,0 +1,10 @@
+/*
+ * Copyright (c) 2025-present unTill Software Development Group B.V.
+ */
+
+package metrics
+
+const (
+	promTypeHistogram = "histogram"
+	promTypeSummary   = "summary"
+)
@@ -64,9 +64,48 @@ func provideHandler(metrics imetrics.IMetrics) http.HandlerFunc {
 			}
 			return
 		})
+		if err == nil {
+			err = writeHistograms(rw, metrics)
+		}
+		if err == nil {
+			err = writeSummaries(rw, metrics)
+		}
 		if err != nil {
 			logger.Error(err)
 			rw.WriteHeader(http.StatusInternalServerError)
 		}
 	}
 }
+
+// histograms are listed ordered by name so the family type is written once before its samples
+func writeHistograms(rw http.ResponseWriter, metrics imetrics.IMetrics) error {
+	family := ""
+	return metrics.ListHistograms(func(metric imetrics.IMetric, value imetrics.HistogramValue) (err error) {
+		if metric.Name() != family {
+			family = metric.Name()
+			if _, err = rw.Write(imetrics.TypeToPrometheus(family, promTypeHistogram)); err != nil {
+				return fmt.Errorf("metrics service: failed to write histogram type %s: %w", family, err)
+			}
+		}
+		if _, err = rw.Write(imetrics.HistogramToPrometheus(metric, value)); err != nil {
+			return fmt.Errorf("metrics service: failed to write histogram %s for app %s on VVM %s: %w", metric.Name(), metric.App(), metric.Vvm(), err)
+		}
+		return nil
+	})
+}
+
+func writeSummaries(rw http.ResponseWriter, metrics imetrics.IMetrics) error {
+	family := ""
+	return metrics.ListSummaries(func(metric imetrics.IMetric, value imetrics.SummaryValue) (err error) {
+		if metric.Name() != family {
+			family = metric.Name()
+			if _, err = rw.Write(imetrics.TypeToPrometheus(family, promTypeSummary)); err != nil {
+				return fmt.Errorf("metrics service: failed to write summary type %s: %w", family, err)
+			}
+		}
+		if _, err = rw.Write(imetrics.SummaryToPrometheus(metric, value)); err != nil {
+			return fmt.Errorf("metrics service: failed to write summary %s for app %s on VVM %s: %w", metric.Name(), metric.App(), metric.Vvm(), err)
+		}
+		return nil
+	})
+}
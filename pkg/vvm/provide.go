@@ -43,7 +43,9 @@ import (
 	blobprocessor "github.com/voedger/voedger/pkg/processors/blobber"
 	"github.com/voedger/voedger/pkg/processors/n10n"
 	"github.com/voedger/voedger/pkg/processors/query2"
+	"github.com/voedger/voedger/pkg/processors/querycache"
 	"github.com/voedger/voedger/pkg/processors/schedulers"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/router"
 	builtinapps "github.com/voedger/voedger/pkg/vvm/builtin"
 	"github.com/voedger/voedger/pkg/vvm/engines"
@@ -56,6 +58,7 @@ import (
 	"github.com/voedger/voedger/pkg/iauthnzimpl"
 	"github.com/voedger/voedger/pkg/iblobstoragestg"
 	"github.com/voedger/voedger/pkg/in10n"
+	"github.com/voedger/voedger/pkg/in10ncluster"
 	"github.com/voedger/voedger/pkg/in10nmem"
 	"github.com/voedger/voedger/pkg/iprocbus"
 	"github.com/voedger/voedger/pkg/iprocbusmem"
@@ -65,6 +68,9 @@ import (
 	"github.com/voedger/voedger/pkg/istorage"
 	"github.com/voedger/voedger/pkg/istorage/provider"
 	"github.com/voedger/voedger/pkg/istoragecache"
+	"github.com/voedger/voedger/pkg/istoragefaults"
+	"github.com/voedger/voedger/pkg/istoragemetrics"
+	"github.com/voedger/voedger/pkg/istoragetracing"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
 	payloads "github.com/voedger/voedger/pkg/itokens-payloads"
@@ -74,8 +80,10 @@ import (
 	commandprocessor "github.com/voedger/voedger/pkg/processors/command"
 	queryprocessor "github.com/voedger/voedger/pkg/processors/query"
 	"github.com/voedger/voedger/pkg/state"
+	"github.com/voedger/voedger/pkg/sys/apikeys"
 	"github.com/voedger/voedger/pkg/sys/invite"
 	"github.com/voedger/voedger/pkg/sys/sysprovide"
+	"github.com/voedger/voedger/pkg/tracing"
 	dbcertcache "github.com/voedger/voedger/pkg/vvm/db_cert_cache"
 	"github.com/voedger/voedger/pkg/vvm/metrics"
 	"github.com/voedger/voedger/pkg/vvm/storage"
@@ -83,7 +91,7 @@ import (
 
 // [~server.design.orch/VVM.Provide~impl]
 func Provide(vvmCfg *VVMConfig) (voedgerVM *VoedgerVM, err error) {
-	vvmCtx, vvmCtxCancel := context.WithCancel(context.Background())
+	vvmCtx, vvmCtxCancel := context.WithCancel(pipeline.WithInterceptors(context.Background(), vvmCfg.PipelineInterceptors...))
 	problemCtx, problemCtxCancel := context.WithCancel(context.Background())
 	vvmShutCtx, vvmShutCtxCancel := context.WithCancel(context.Background())
 	servicesShutCtx, servicesShutCtxCancel := context.WithCancel(context.Background())
@@ -185,13 +193,15 @@ func wireVVM(vvmCtx context.Context, vvmConfig *VVMConfig) (*VVM, func(), error)
 		provideIAppStructsProvider,        // IAppStructsProvider
 		payloads.ProvideIAppTokensFactory, // IAppTokensFactory
 		provideAppPartitions,
-		in10nmem.NewN10nBroker,
+		provideN10nBroker,
 		queryprocessor.ProvideServiceFactory,
 		query2.ProvideServiceFactory,
 		commandprocessor.ProvideServiceFactory,
 		metrics.ProvideMetricsService,
 		dbcertcache.ProvideDBCache,
-		imetrics.Provide,
+		provideIMetrics,
+		provideSlowLog,
+		provideQueryCache,
 		actualizers.ProvideSyncActualizerFactory,
 		actualizers.NewSyncActualizerFactoryFactory,
 		iprocbusmem.Provide,
@@ -204,8 +214,10 @@ func wireVVM(vvmCtx context.Context, vvmConfig *VVMConfig) (*VVM, func(), error)
 		provideSecretKeyJWT,
 		provideBucketsFactory,
 		provideSubjectGetterFunc,
+		provideAPIKeyGetterFunc,
 		provideStorageFactory,
 		provideIAppStorageUncachingProviderFactory,
+		provideTracer,
 		provideAppPartsCtlPipelineService,
 		provideIsDeviceAllowedFunc,
 		provideBuiltInApps,
@@ -244,6 +256,7 @@ func wireVVM(vvmCtx context.Context, vvmConfig *VVMConfig) (*VVM, func(), error)
 			"Name",
 			"MaxPrepareQueries",
 			"StorageCacheSize",
+			"StorageCacheBudget",
 			"VVMPort",
 			"MetricsServicePort",
 			"EmailSender",
@@ -280,9 +293,28 @@ func provideN10NQuotas(vvmCfg *VVMConfig) in10n.Quotas {
 		ChannelsPerSubject:      DefaultQuotasChannelsPerSubject,
 		Subscriptions:           int(DefaultQuotasSubscriptionsFactor * vvmCfg.NumCommandProcessors),
 		SubscriptionsPerSubject: DefaultQuotasSubscriptionsPerSubject,
+		PayloadsPerChannel:      DefaultQuotasPayloadsPerChannel,
 	}
 }
 
+func provideN10nBroker(quotas in10n.Quotas, time timeu.ITime, vvmCfg *VVMConfig) (in10n.IN10nBroker, func(), error) {
+	localBroker, localCleanup := in10nmem.NewN10nBroker(quotas, time)
+	if len(vvmCfg.N10nClusterAddr) == 0 {
+		return localBroker, localCleanup, nil
+	}
+	transport, err := in10ncluster.NewTCPTransport(vvmCfg.N10nClusterAddr, vvmCfg.N10nClusterPeers, vvmCfg.N10nClusterSecret)
+	if err != nil {
+		localCleanup()
+		return nil, nil, err
+	}
+	nb, clusterCleanup := in10ncluster.NewClusterBroker(localBroker, transport)
+	cleanup := func() {
+		clusterCleanup()
+		localCleanup()
+	}
+	return nb, cleanup, nil
+}
+
 func provideSchedulerRunner(cfg schedulers.BasicSchedulerConfig) appparts.ISchedulerRunner {
 	return schedulers.ProvideSchedulers(cfg)
 }
@@ -376,10 +408,12 @@ func provideAppsExtensionPoints(vvmConfig *VVMConfig) map[appdef.AppQName]extens
 
 func provideStatelessResources(cfgs AppConfigsTypeEmpty, vvmCfg *VVMConfig, appEPs map[appdef.AppQName]extensionpoints.IExtensionPoint,
 	buildInfo *debug.BuildInfo, sp istorage.IAppStorageProvider, itokens itokens.ITokens, federation federation.IFederation,
-	asp istructs.IAppStructsProvider, atf payloads.IAppTokensFactory, postWireInterfacePtrs btstrp.PostWireInterfacePtrs) istructsmem.IStatelessResources {
+	asp istructs.IAppStructsProvider, atf payloads.IAppTokensFactory, postWireInterfacePtrs btstrp.PostWireInterfacePtrs,
+	slowLog slowlog.ISlowLog) istructsmem.IStatelessResources {
 	ssr := istructsmem.NewStatelessResources()
 	sysprovide.ProvideStateless(ssr, vvmCfg.SMTPConfig, appEPs, buildInfo, sp, vvmCfg.WSPostInitFunc, vvmCfg.Time, itokens, federation,
-		asp, atf, postWireInterfacePtrs.BlobHandler, postWireInterfacePtrs.RequestSender)
+		asp, atf, postWireInterfacePtrs.BlobHandler, postWireInterfacePtrs.RequestSender,
+		provideBlobStorage(postWireInterfacePtrs.BlobberAppStorage, vvmCfg.Time), slowLog)
 	return ssr
 }
 
@@ -445,14 +479,45 @@ func provideAppPartsCtlPipelineService(ctl apppartsctl.IAppPartitionsController)
 	return &AppPartsCtlPipelineService{IAppPartitionsController: ctl}
 }
 
-func provideIAppStorageUncachingProviderFactory(factory istorage.IAppStorageFactory, vvmCfg *VVMConfig) IAppStorageUncachingProviderFactory {
+func provideIAppStorageUncachingProviderFactory(factory istorage.IAppStorageFactory, vvmCfg *VVMConfig, tracer tracing.ITracer) IAppStorageUncachingProviderFactory {
 	return func() istorage.IAppStorageProvider {
-		return provider.Provide(factory, vvmCfg.KeyspaceIsolationSuffix)
+		return istoragetracing.Provide(provider.Provide(factory, vvmCfg.KeyspaceIsolationSuffix), tracer)
 	}
 }
 
+func provideTracer(vvmCfg *VVMConfig) (tracing.ITracer, func(), error) {
+	return tracing.Provide(vvmCfg.Tracing)
+}
+
+func provideSlowLog(vvmCfg *VVMConfig) slowlog.ISlowLog {
+	return slowlog.Provide(vvmCfg.SlowLog)
+}
+
+func provideQueryCache(vvmCfg *VVMConfig) querycache.IQueryCache {
+	return querycache.Provide(vvmCfg.QueryCache, vvmCfg.Time)
+}
+
+func provideIMetrics(vvmCfg *VVMConfig) imetrics.IMetrics {
+	if vvmCfg.MetricsHistogramBuckets == nil {
+		return imetrics.Provide()
+	}
+	return imetrics.Provide(imetrics.WithDefaultBuckets(vvmCfg.MetricsHistogramBuckets))
+}
+
 func provideStorageFactory(vvmConfig *VVMConfig, time timeu.ITime) (provider istorage.IAppStorageFactory, err error) {
-	return vvmConfig.StorageFactory(time)
+	if provider, err = vvmConfig.StorageFactory(time); err != nil {
+		return nil, err
+	}
+	if vvmConfig.StorageFaults != nil {
+		provider = istoragefaults.Provide(provider, vvmConfig.StorageFaults)
+	}
+	return provider, nil
+}
+
+func provideAPIKeyGetterFunc() iauthnzimpl.APIKeyGetterFunc {
+	return func(_ context.Context, apiKey string, as istructs.IAppStructs, wsid istructs.WSID) (istructs.RecordID, []appdef.QName, error) {
+		return apikeys.GetAPIKey(as, wsid, apiKey)
+	}
 }
 
 func provideSubjectGetterFunc() iauthnzimpl.SubjectGetterFunc {
@@ -539,7 +604,8 @@ func provideMetricsServiceOperator(ms metrics.MetricsService) MetricsServiceOper
 }
 
 // TODO: consider vvmIdx
-func provideIFederation(vvmCtx context.Context, cfg *VVMConfig, vvmPortSource *VVMPortSource, policyForWithRetry federation.PolicyOptsForWithRetry) (federation.IFederation, func()) {
+func provideIFederation(vvmCtx context.Context, cfg *VVMConfig, vvmPortSource *VVMPortSource, policyForWithRetry federation.PolicyOptsForWithRetry,
+	tracer tracing.ITracer) (federation.IFederation, func()) {
 	return federation.New(vvmCtx, func() *url.URL {
 		if cfg.FederationURL != nil {
 			return cfg.FederationURL
@@ -550,7 +616,7 @@ func provideIFederation(vvmCtx context.Context, cfg *VVMConfig, vvmPortSource *V
 			panic(err)
 		}
 		return resultFU
-	}, func() int { return vvmPortSource.adminGetter() }, policyForWithRetry)
+	}, func() int { return vvmPortSource.adminGetter() }, policyForWithRetry, tracer)
 }
 
 // Metrics service port could be dynamic -> need a func that will return the actual port
@@ -560,7 +626,7 @@ func provideMetricsServicePortGetter(ms metrics.MetricsService) func() metrics.M
 	}
 }
 
-func provideRouterParams(cfg *VVMConfig, port VVMPortType) router.RouterParams {
+func provideRouterParams(cfg *VVMConfig, port VVMPortType, tracer tracing.ITracer) router.RouterParams {
 	res := router.RouterParams{
 		HTTPServerParams: router.HTTPServerParams{
 			Port:             int(port),
@@ -576,6 +642,7 @@ func provideRouterParams(cfg *VVMConfig, port VVMPortType) router.RouterParams {
 		RouteDomains:         cfg.RouteDomains,
 		MaxQueriesPerWS:      cfg.RouterMaxQueriesPerWS,
 		ITime:                cfg.Time,
+		Tracer:               tracer,
 	}
 	return res
 }
@@ -589,7 +656,7 @@ func provideVVMApps(builtInApps []appparts.BuiltInApp) (vvmApps VVMApps) {
 
 func provideBuiltInAppsArtefacts(vvmConfig *VVMConfig, apis builtinapps.APIs, cfgs AppConfigsTypeEmpty,
 	appEPs map[appdef.AppQName]extensionpoints.IExtensionPoint, schemasCache ISchemasCache) (BuiltInAppsArtefacts, error) {
-	return vvmConfig.VVMAppsBuilder.BuildAppsArtefacts(apis, cfgs, appEPs, schemasCache)
+	return vvmConfig.VVMAppsBuilder.BuildAppsArtefacts(apis, cfgs, appEPs, schemasCache, vvmConfig.SecretsReader)
 }
 
 // extModuleURLs is filled here
@@ -749,10 +816,10 @@ func provideChannelGroups(cfg *VVMConfig) (res []iprocbusmem.ChannelGroup) {
 	return
 }
 
-func provideCachingAppStorageProvider(storageCacheSize StorageCacheSizeType, metrics imetrics.IMetrics,
+func provideCachingAppStorageProvider(storageCacheSize StorageCacheSizeType, storageCacheBudget istoragecache.Budget, metrics imetrics.IMetrics,
 	vvmName processors.VVMName, uncachingProvider IAppStorageUncachingProviderFactory, iTime timeu.ITime) istorage.IAppStorageProvider {
-	aspNonCaching := uncachingProvider()
-	return istoragecache.Provide(int(storageCacheSize), aspNonCaching, metrics, string(vvmName), iTime)
+	aspNonCaching := istoragemetrics.Provide(uncachingProvider(), metrics, string(vvmName))
+	return istoragecache.Provide(int(storageCacheSize), aspNonCaching, metrics, string(vvmName), iTime, istoragecache.WithBudget(storageCacheBudget))
 }
 
 func provideBlobHandlerPtr() blobprocessor.IRequestHandlerPtr {
@@ -849,11 +916,11 @@ func provideOpBLOBProcessors(numBLOBWorkers istructs.NumBLOBProcessors, blobServ
 func provideQueryProcessors_V1(qpCount istructs.NumQueryProcessors, qc QueryChannel_V1, appParts appparts.IAppPartitions, qpFactory queryprocessor.ServiceFactory,
 	imetrics imetrics.IMetrics, vvm processors.VVMName, mpq MaxPrepareQueriesType, authn iauthnz.IAuthenticator,
 	tokens itokens.ITokens, federation federation.IFederation, statelessResources istructsmem.IStatelessResources, secretReader isecrets.ISecretReader,
-	stateOpts state.StateOpts, httpClient httpu.IHTTPClient) OperatorQueryProcessors_V1 {
+	stateOpts state.StateOpts, httpClient httpu.IHTTPClient, slowLog slowlog.ISlowLog, queryCache querycache.IQueryCache) OperatorQueryProcessors_V1 {
 	forks := make([]pipeline.ForkOperatorOptionFunc, qpCount)
 	for i := 0; i < int(qpCount); i++ {
 		forks[i] = pipeline.ForkBranch(pipeline.ServiceOperator(qpFactory(iprocbus.ServiceChannel(qc), appParts, int(mpq), imetrics,
-			string(vvm), authn, tokens, federation, statelessResources, secretReader, stateOpts, httpClient)))
+			string(vvm), authn, tokens, federation, statelessResources, secretReader, stateOpts, httpClient, slowLog, queryCache)))
 	}
 	return pipeline.ForkOperator(pipeline.ForkSame, forks[0], forks[1:]...)
 }
@@ -861,11 +928,11 @@ func provideQueryProcessors_V1(qpCount istructs.NumQueryProcessors, qc QueryChan
 func provideQueryProcessors_V2(qpCount istructs.NumQueryProcessors, qc QueryChannel_V2, appParts appparts.IAppPartitions, qpFactory query2.ServiceFactory,
 	imetrics imetrics.IMetrics, vvm processors.VVMName, mpq MaxPrepareQueriesType, authn iauthnz.IAuthenticator,
 	tokens itokens.ITokens, federation federation.IFederation, statelessResources istructsmem.IStatelessResources, secretReader isecrets.ISecretReader,
-	stateOpts state.StateOpts, httpClient httpu.IHTTPClient) OperatorQueryProcessors_V2 {
+	stateOpts state.StateOpts, httpClient httpu.IHTTPClient, slowLog slowlog.ISlowLog, queryCache querycache.IQueryCache) OperatorQueryProcessors_V2 {
 	forks := make([]pipeline.ForkOperatorOptionFunc, qpCount)
 	for i := 0; i < int(qpCount); i++ {
 		forks[i] = pipeline.ForkBranch(pipeline.ServiceOperator(qpFactory(iprocbus.ServiceChannel(qc), appParts, int(mpq), imetrics,
-			string(vvm), authn, tokens, federation, statelessResources, secretReader, stateOpts, httpClient)))
+			string(vvm), authn, tokens, federation, statelessResources, secretReader, stateOpts, httpClient, slowLog, queryCache)))
 	}
 	return pipeline.ForkOperator(pipeline.ForkSame, forks[0], forks[1:]...)
 }
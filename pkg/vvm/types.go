@@ -25,6 +25,8 @@ import (
 	"github.com/voedger/voedger/pkg/isecrets"
 	"github.com/voedger/voedger/pkg/isequencer"
 	"github.com/voedger/voedger/pkg/istorage"
+	"github.com/voedger/voedger/pkg/istoragecache"
+	"github.com/voedger/voedger/pkg/istoragefaults"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
 	"github.com/voedger/voedger/pkg/itokens"
@@ -32,10 +34,13 @@ import (
 	"github.com/voedger/voedger/pkg/pipeline"
 	"github.com/voedger/voedger/pkg/processors"
 	commandprocessor "github.com/voedger/voedger/pkg/processors/command"
+	"github.com/voedger/voedger/pkg/processors/querycache"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/router"
 	"github.com/voedger/voedger/pkg/state"
 	"github.com/voedger/voedger/pkg/sys/smtp"
 	"github.com/voedger/voedger/pkg/sys/workspace"
+	"github.com/voedger/voedger/pkg/tracing"
 	builtinapps "github.com/voedger/voedger/pkg/vvm/builtin"
 	"github.com/voedger/voedger/pkg/vvm/metrics"
 	"github.com/voedger/voedger/pkg/vvm/storage"
@@ -157,6 +162,7 @@ type VVMConfig struct {
 	CommandProcessorChannelBufferSize uint
 	MaxPrepareQueries                 MaxPrepareQueriesType
 	StorageCacheSize                  StorageCacheSizeType
+	StorageCacheBudget                istoragecache.Budget // Bytes == 0 -> StorageCacheSize per app is used
 	processorsChannels                []ProcesorChannel
 	EmailSender                       state.IEmailSender
 	SecretsReader                     isecrets.ISecretReader
@@ -189,6 +195,40 @@ type VVMConfig struct {
 	IP     net.IP // current IP of the VVM. Used as the value for leaderhsip elections
 
 	SequencesTrustLevel isequencer.SequencesTrustLevel
+
+	// address to listen for n10n updates from peer VVMs, e.g. ":8081"
+	// empty -> n10n updates are not replicated among VVMs
+	N10nClusterAddr string
+
+	// addresses of peer VVMs to replicate n10n updates to, used if N10nClusterAddr is not empty
+	N10nClusterPeers []string
+
+	// shared secret which authenticates peer VVMs of the n10n cluster, required if N10nClusterAddr is not empty
+	N10nClusterSecret string
+
+	// distributed tracing, disabled by default
+	Tracing tracing.Config
+
+	// upper bounds of latency histograms buckets in seconds
+	// nil -> imetrics.DefaultLatencyBuckets
+	MetricsHistogramBuckets []float64
+
+	// commands and queries longer than SlowLog.Threshold are logged and available via q.sys.SlowRequests
+	// disabled by default
+	SlowLog slowlog.Config
+
+	// results of the queries declared WITH CACHE are kept in the LRU cache of QueryCache.Size entries
+	QueryCache querycache.Config
+
+	// called around each operator call of the pipelines constructed with the VVM ctx or with the ctx of the request came via router
+	// e.g. command, query and actualizers pipelines, see pipeline.IOperatorInterceptor
+	// nil by default
+	PipelineInterceptors []pipeline.IOperatorInterceptor
+
+	// faults and latencies are injected into the storage calls according to the injector rules, see istoragefaults.New()
+	// for chaos testing in VIT and VVM dev mode only
+	// nil by default
+	StorageFaults istoragefaults.IFaultInjector
 }
 
 type VoedgerVM struct {
@@ -30,6 +30,7 @@ import (
 	"github.com/voedger/voedger/pkg/iblobstoragestg"
 	"github.com/voedger/voedger/pkg/iextengine"
 	"github.com/voedger/voedger/pkg/in10n"
+	"github.com/voedger/voedger/pkg/in10ncluster"
 	"github.com/voedger/voedger/pkg/in10nmem"
 	"github.com/voedger/voedger/pkg/iprocbus"
 	"github.com/voedger/voedger/pkg/iprocbusmem"
@@ -40,6 +41,9 @@ import (
 	"github.com/voedger/voedger/pkg/istorage"
 	"github.com/voedger/voedger/pkg/istorage/provider"
 	"github.com/voedger/voedger/pkg/istoragecache"
+	"github.com/voedger/voedger/pkg/istoragefaults"
+	"github.com/voedger/voedger/pkg/istoragemetrics"
+	"github.com/voedger/voedger/pkg/istoragetracing"
 	"github.com/voedger/voedger/pkg/istructs"
 	"github.com/voedger/voedger/pkg/istructsmem"
 	"github.com/voedger/voedger/pkg/itokens"
@@ -55,11 +59,15 @@ import (
 	"github.com/voedger/voedger/pkg/processors/n10n"
 	"github.com/voedger/voedger/pkg/processors/query"
 	"github.com/voedger/voedger/pkg/processors/query2"
+	"github.com/voedger/voedger/pkg/processors/querycache"
 	"github.com/voedger/voedger/pkg/processors/schedulers"
+	"github.com/voedger/voedger/pkg/processors/slowlog"
 	"github.com/voedger/voedger/pkg/router"
 	"github.com/voedger/voedger/pkg/state"
+	"github.com/voedger/voedger/pkg/sys/apikeys"
 	"github.com/voedger/voedger/pkg/sys/invite"
 	"github.com/voedger/voedger/pkg/sys/sysprovide"
+	"github.com/voedger/voedger/pkg/tracing"
 	"github.com/voedger/voedger/pkg/vvm/builtin"
 	"github.com/voedger/voedger/pkg/vvm/db_cert_cache"
 	"github.com/voedger/voedger/pkg/vvm/engines"
@@ -94,42 +102,55 @@ func wireVVM(vvmCtx context.Context, vvmConfig *VVMConfig) (*VVM, func(), error)
 	iTokens := itokensjwt.ProvideITokens(secretKeyType, iTime)
 	iAppTokensFactory := payloads.ProvideIAppTokensFactory(iTokens)
 	storageCacheSizeType := vvmConfig.StorageCacheSize
-	iMetrics := imetrics.Provide()
+	budget := vvmConfig.StorageCacheBudget
+	iMetrics := provideIMetrics(vvmConfig)
+	iSlowLog := provideSlowLog(vvmConfig)
+	iQueryCache := provideQueryCache(vvmConfig)
 	vvmName := vvmConfig.Name
 	iAppStorageFactory, err := provideStorageFactory(vvmConfig, iTime)
 	if err != nil {
 		return nil, nil, err
 	}
-	iAppStorageUncachingProviderFactory := provideIAppStorageUncachingProviderFactory(iAppStorageFactory, vvmConfig)
-	iAppStorageProvider := provideCachingAppStorageProvider(storageCacheSizeType, iMetrics, vvmName, iAppStorageUncachingProviderFactory, iTime)
+	iTracer, cleanup, err := provideTracer(vvmConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	iAppStorageUncachingProviderFactory := provideIAppStorageUncachingProviderFactory(iAppStorageFactory, vvmConfig, iTracer)
+	iAppStorageProvider := provideCachingAppStorageProvider(storageCacheSizeType, budget, iMetrics, vvmName, iAppStorageUncachingProviderFactory, iTime)
 	sequencesTrustLevel := vvmConfig.SequencesTrustLevel
 	iSysVvmStorage, err := provideIVVMAppTTLStorage(iAppStorageProvider)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	iAppStructsProvider := provideIAppStructsProvider(appConfigsTypeEmpty, iAppTokensFactory, iAppStorageProvider, sequencesTrustLevel, iSysVvmStorage)
 	syncActualizerFactory := actualizers.ProvideSyncActualizerFactory()
 	quotas := provideN10NQuotas(vvmConfig)
-	in10nBroker, cleanup := in10nmem.NewN10nBroker(quotas, iTime)
+	in10nBroker, cleanup2, err := provideN10nBroker(quotas, iTime, vvmConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	v2 := provideAppsExtensionPoints(vvmConfig)
 	buildInfo, err := provideBuildInfo()
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	vvmPortSource := provideVVMPortSource()
 	policyOptsForWithRetry := vvmConfig.PolicyOptsForFederationWithRetry
-	iFederation, cleanup2 := provideIFederation(vvmCtx, vvmConfig, vvmPortSource, policyOptsForWithRetry)
+	iFederation, cleanup3 := provideIFederation(vvmCtx, vvmConfig, vvmPortSource, policyOptsForWithRetry, iTracer)
 	blobAppStoragePtr := provideBlobAppStoragePtr(iAppStorageProvider)
 	routerAppStoragePtr := provideRouterAppStoragePtr(iAppStorageProvider)
 	iRequestHandlerPtr := provideBlobHandlerPtr()
 	iRequestSenderPtr := provideIRequestSenderPtr()
 	postWireInterfacePtrs := providePostWireInterfacePtrs(blobAppStoragePtr, routerAppStoragePtr, iRequestHandlerPtr, iRequestSenderPtr)
-	iStatelessResources := provideStatelessResources(appConfigsTypeEmpty, vvmConfig, v2, buildInfo, iAppStorageProvider, iTokens, iFederation, iAppStructsProvider, iAppTokensFactory, postWireInterfacePtrs)
+	iStatelessResources := provideStatelessResources(appConfigsTypeEmpty, vvmConfig, v2, buildInfo, iAppStorageProvider, iTokens, iFederation, iAppStructsProvider, iAppTokensFactory, postWireInterfacePtrs, iSlowLog)
 	v3 := actualizers.NewSyncActualizerFactoryFactory(syncActualizerFactory, iSecretReader, in10nBroker, iStatelessResources)
 	stateOpts := provideStateOpts()
 	iEmailSender := vvmConfig.EmailSender
-	ihttpClient, cleanup3 := provideHTTPClient()
+	ihttpClient, cleanup4 := provideHTTPClient()
 	basicAsyncActualizerConfig := provideBasicAsyncActualizerConfig(vvmName, iSecretReader, iTokens, iMetrics, in10nBroker, iFederation, stateOpts, iEmailSender, ihttpClient)
 	iActualizerRunner := actualizers.ProvideActualizers(basicAsyncActualizerConfig)
 	basicSchedulerConfig := schedulers.BasicSchedulerConfig{
@@ -147,6 +168,7 @@ func wireVVM(vvmCtx context.Context, vvmConfig *VVMConfig) (*VVM, func(), error)
 	bucketsFactoryType := provideBucketsFactory(iTime)
 	v4, err := provideSidecarApps(vvmConfig)
 	if err != nil {
+		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
@@ -166,38 +188,42 @@ func wireVVM(vvmCtx context.Context, vvmConfig *VVMConfig) (*VVM, func(), error)
 	iSchemasCache := vvmConfig.SchemasCache
 	builtInAppsArtefacts, err := provideBuiltInAppsArtefacts(vvmConfig, apIs, appConfigsTypeEmpty, v2, iSchemasCache)
 	if err != nil {
+		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	iAppPartitions, cleanup4, err := provideAppPartitions(vvmCtx, iAppStructsProvider, v3, iActualizerRunner, iSchedulerRunner, bucketsFactoryType, iStatelessResources, builtInAppsArtefacts, vvmName, iMetrics)
+	iAppPartitions, cleanup5, err := provideAppPartitions(vvmCtx, iAppStructsProvider, v3, iActualizerRunner, iSchedulerRunner, bucketsFactoryType, iStatelessResources, builtInAppsArtefacts, vvmName, iMetrics)
 	if err != nil {
+		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	v5 := provideSubjectGetterFunc()
+	apiKeyGetterFunc := provideAPIKeyGetterFunc()
 	isDeviceAllowedFuncs := provideIsDeviceAllowedFunc(v2)
-	iAuthenticator := iauthnzimpl.NewDefaultAuthenticator(v5, isDeviceAllowedFuncs)
-	serviceFactory := commandprocessor.ProvideServiceFactory(iAppPartitions, iTime, in10nBroker, iMetrics, vvmName, iAuthenticator, iSecretReader)
+	iAuthenticator := iauthnzimpl.NewDefaultAuthenticator(v5, apiKeyGetterFunc, isDeviceAllowedFuncs)
+	serviceFactory := commandprocessor.ProvideServiceFactory(iAppPartitions, iTime, in10nBroker, iMetrics, vvmName, iAuthenticator, iSecretReader, iSlowLog, iQueryCache)
 	operatorCommandProcessors := provideCommandProcessors(numCommandProcessors, commandChannelFactory, serviceFactory)
 	numQueryProcessors := vvmConfig.NumQueryProcessors
 	queryChannel_V1 := provideQueryChannel_V1(serviceChannelFactory)
 	queryprocessorServiceFactory := queryprocessor.ProvideServiceFactory()
 	maxPrepareQueriesType := vvmConfig.MaxPrepareQueries
-	operatorQueryProcessors_V1 := provideQueryProcessors_V1(numQueryProcessors, queryChannel_V1, iAppPartitions, queryprocessorServiceFactory, iMetrics, vvmName, maxPrepareQueriesType, iAuthenticator, iTokens, iFederation, iStatelessResources, iSecretReader, stateOpts, ihttpClient)
+	operatorQueryProcessors_V1 := provideQueryProcessors_V1(numQueryProcessors, queryChannel_V1, iAppPartitions, queryprocessorServiceFactory, iMetrics, vvmName, maxPrepareQueriesType, iAuthenticator, iTokens, iFederation, iStatelessResources, iSecretReader, stateOpts, ihttpClient, iSlowLog, iQueryCache)
 	queryChannel_V2 := provideQueryChannel_V2(serviceChannelFactory)
 	query2ServiceFactory := query2.ProvideServiceFactory()
-	operatorQueryProcessors_V2 := provideQueryProcessors_V2(numQueryProcessors, queryChannel_V2, iAppPartitions, query2ServiceFactory, iMetrics, vvmName, maxPrepareQueriesType, iAuthenticator, iTokens, iFederation, iStatelessResources, iSecretReader, stateOpts, ihttpClient)
+	operatorQueryProcessors_V2 := provideQueryProcessors_V2(numQueryProcessors, queryChannel_V2, iAppPartitions, query2ServiceFactory, iMetrics, vvmName, maxPrepareQueriesType, iAuthenticator, iTokens, iFederation, iStatelessResources, iSecretReader, stateOpts, ihttpClient, iSlowLog, iQueryCache)
 	numBLOBProcessors := vvmConfig.NumBLOBProcessors
 	blobServiceChannel := provideBLOBChannel(serviceChannelFactory)
 	blobMaxSizeType := vvmConfig.BLOBMaxSize
 	wLimiterFactory := provideWLimiterFactory(blobMaxSizeType)
 	operatorBLOBProcessors := provideOpBLOBProcessors(numBLOBProcessors, blobServiceChannel, iblobStorage, wLimiterFactory)
-	iAppPartitionsController, cleanup5, err := apppartsctl.New(iAppPartitions)
+	iAppPartitionsController, cleanup6, err := apppartsctl.New(iAppPartitions)
 	if err != nil {
+		cleanup5()
 		cleanup4()
 		cleanup3()
 		cleanup2()
@@ -212,12 +238,13 @@ func wireVVM(vvmCtx context.Context, vvmConfig *VVMConfig) (*VVM, func(), error)
 	queryProcessorsChannelGroupIdxType_V1 := provideProcessorChannelGroupIdxQuery_V1(vvmConfig)
 	queryProcessorsChannelGroupIdxType_V2 := provideProcessorChannelGroupIdxQuery_V2(vvmConfig)
 	vvmApps := provideVVMApps(v6)
-	in10NProc, cleanup6 := n10n.NewIN10NProc(vvmCtx, in10nBroker, iAuthenticator, iAppTokensFactory, iAppStructsProvider)
+	in10NProc, cleanup7 := n10n.NewIN10NProc(vvmCtx, in10nBroker, iAuthenticator, iAppTokensFactory, iAppStructsProvider)
 	busyProcessorLogMode := vvmConfig.BusyProcessorLogMode
 	requestHandler := provideRequestHandler(iAppPartitions, iProcBus, commandProcessorsChannelGroupIdxType, queryProcessorsChannelGroupIdxType_V1, queryProcessorsChannelGroupIdxType_V2, numCommandProcessors, vvmApps, in10NProc, busyProcessorLogMode)
 	iRequestSender := bus.NewIRequestSender(iTime, requestHandler)
 	bootstrapOperator, err := provideBootstrapOperator(iFederation, iAppStructsProvider, iTime, iAppPartitions, v6, v4, iTokens, iAppStorageProvider, postWireInterfacePtrs, iRequestHandler, iRequestSender)
 	if err != nil {
+		cleanup7()
 		cleanup6()
 		cleanup5()
 		cleanup4()
@@ -227,10 +254,11 @@ func wireVVM(vvmCtx context.Context, vvmConfig *VVMConfig) (*VVM, func(), error)
 		return nil, nil, err
 	}
 	vvmPortType := vvmConfig.VVMPort
-	routerParams := provideRouterParams(vvmConfig, vvmPortType)
+	routerParams := provideRouterParams(vvmConfig, vvmPortType, iTracer)
 	cache := dbcertcache.ProvideDBCache(routerAppStoragePtr)
 	v7, err := provideNumsAppsWorkspaces(vvmApps, iAppStructsProvider, v4)
 	if err != nil {
+		cleanup7()
 		cleanup6()
 		cleanup5()
 		cleanup4()
@@ -261,6 +289,7 @@ func wireVVM(vvmCtx context.Context, vvmConfig *VVMConfig) (*VVM, func(), error)
 		ISchedulerRunner:    iSchedulerRunner,
 	}
 	return vvm, func() {
+		cleanup7()
 		cleanup6()
 		cleanup5()
 		cleanup4()
@@ -274,7 +303,7 @@ func wireVVM(vvmCtx context.Context, vvmConfig *VVMConfig) (*VVM, func(), error)
 
 // [~server.design.orch/VVM.Provide~impl]
 func Provide(vvmCfg *VVMConfig) (voedgerVM *VoedgerVM, err error) {
-	vvmCtx, vvmCtxCancel := context.WithCancel(context.Background())
+	vvmCtx, vvmCtxCancel := context.WithCancel(pipeline.WithInterceptors(context.Background(), vvmCfg.PipelineInterceptors...))
 	problemCtx, problemCtxCancel := context.WithCancel(context.Background())
 	vvmShutCtx, vvmShutCtxCancel := context.WithCancel(context.Background())
 	servicesShutCtx, servicesShutCtxCancel := context.WithCancel(context.Background())
@@ -352,9 +381,28 @@ func provideN10NQuotas(vvmCfg *VVMConfig) in10n.Quotas {
 		ChannelsPerSubject:      DefaultQuotasChannelsPerSubject,
 		Subscriptions:           int(DefaultQuotasSubscriptionsFactor * vvmCfg.NumCommandProcessors),
 		SubscriptionsPerSubject: DefaultQuotasSubscriptionsPerSubject,
+		PayloadsPerChannel:      DefaultQuotasPayloadsPerChannel,
 	}
 }
 
+func provideN10nBroker(quotas in10n.Quotas, time timeu.ITime, vvmCfg *VVMConfig) (in10n.IN10nBroker, func(), error) {
+	localBroker, localCleanup := in10nmem.NewN10nBroker(quotas, time)
+	if len(vvmCfg.N10nClusterAddr) == 0 {
+		return localBroker, localCleanup, nil
+	}
+	transport, err := in10ncluster.NewTCPTransport(vvmCfg.N10nClusterAddr, vvmCfg.N10nClusterPeers, vvmCfg.N10nClusterSecret)
+	if err != nil {
+		localCleanup()
+		return nil, nil, err
+	}
+	nb, clusterCleanup := in10ncluster.NewClusterBroker(localBroker, transport)
+	cleanup := func() {
+		clusterCleanup()
+		localCleanup()
+	}
+	return nb, cleanup, nil
+}
+
 func provideSchedulerRunner(cfg schedulers.BasicSchedulerConfig) appparts.ISchedulerRunner {
 	return schedulers.ProvideSchedulers(cfg)
 }
@@ -448,9 +496,10 @@ func provideAppsExtensionPoints(vvmConfig *VVMConfig) map[appdef.AppQName]extens
 
 func provideStatelessResources(cfgs AppConfigsTypeEmpty, vvmCfg *VVMConfig, appEPs map[appdef.AppQName]extensionpoints.IExtensionPoint,
 	buildInfo *debug.BuildInfo, sp istorage.IAppStorageProvider, itokens2 itokens.ITokens, federation2 federation.IFederation,
-	asp istructs.IAppStructsProvider, atf payloads.IAppTokensFactory, postWireInterfacePtrs btstrp.PostWireInterfacePtrs) istructsmem.IStatelessResources {
+	asp istructs.IAppStructsProvider, atf payloads.IAppTokensFactory, postWireInterfacePtrs btstrp.PostWireInterfacePtrs,
+	slowLog slowlog.ISlowLog) istructsmem.IStatelessResources {
 	ssr := istructsmem.NewStatelessResources()
-	sysprovide.ProvideStateless(ssr, vvmCfg.SMTPConfig, appEPs, buildInfo, sp, vvmCfg.WSPostInitFunc, vvmCfg.Time, itokens2, federation2, asp, atf, postWireInterfacePtrs.BlobHandler, postWireInterfacePtrs.RequestSender)
+	sysprovide.ProvideStateless(ssr, vvmCfg.SMTPConfig, appEPs, buildInfo, sp, vvmCfg.WSPostInitFunc, vvmCfg.Time, itokens2, federation2, asp, atf, postWireInterfacePtrs.BlobHandler, postWireInterfacePtrs.RequestSender, provideBlobStorage(postWireInterfacePtrs.BlobberAppStorage, vvmCfg.Time), slowLog)
 	return ssr
 }
 
@@ -516,14 +565,45 @@ func provideAppPartsCtlPipelineService(ctl apppartsctl.IAppPartitionsController)
 	return &AppPartsCtlPipelineService{IAppPartitionsController: ctl}
 }
 
-func provideIAppStorageUncachingProviderFactory(factory istorage.IAppStorageFactory, vvmCfg *VVMConfig) IAppStorageUncachingProviderFactory {
+func provideIAppStorageUncachingProviderFactory(factory istorage.IAppStorageFactory, vvmCfg *VVMConfig, tracer tracing.ITracer) IAppStorageUncachingProviderFactory {
 	return func() istorage.IAppStorageProvider {
-		return provider.Provide(factory, vvmCfg.KeyspaceIsolationSuffix)
+		return istoragetracing.Provide(provider.Provide(factory, vvmCfg.KeyspaceIsolationSuffix), tracer)
 	}
 }
 
+func provideTracer(vvmCfg *VVMConfig) (tracing.ITracer, func(), error) {
+	return tracing.Provide(vvmCfg.Tracing)
+}
+
+func provideSlowLog(vvmCfg *VVMConfig) slowlog.ISlowLog {
+	return slowlog.Provide(vvmCfg.SlowLog)
+}
+
+func provideQueryCache(vvmCfg *VVMConfig) querycache.IQueryCache {
+	return querycache.Provide(vvmCfg.QueryCache, vvmCfg.Time)
+}
+
+func provideIMetrics(vvmCfg *VVMConfig) imetrics.IMetrics {
+	if vvmCfg.MetricsHistogramBuckets == nil {
+		return imetrics.Provide()
+	}
+	return imetrics.Provide(imetrics.WithDefaultBuckets(vvmCfg.MetricsHistogramBuckets))
+}
+
 func provideStorageFactory(vvmConfig *VVMConfig, time timeu.ITime) (provider2 istorage.IAppStorageFactory, err error) {
-	return vvmConfig.StorageFactory(time)
+	if provider2, err = vvmConfig.StorageFactory(time); err != nil {
+		return nil, err
+	}
+	if vvmConfig.StorageFaults != nil {
+		provider2 = istoragefaults.Provide(provider2, vvmConfig.StorageFaults)
+	}
+	return provider2, nil
+}
+
+func provideAPIKeyGetterFunc() iauthnzimpl.APIKeyGetterFunc {
+	return func(_ context.Context, apiKey string, as istructs.IAppStructs, wsid istructs.WSID) (istructs.RecordID, []appdef.QName, error) {
+		return apikeys.GetAPIKey(as, wsid, apiKey)
+	}
 }
 
 func provideSubjectGetterFunc() iauthnzimpl.SubjectGetterFunc {
@@ -609,7 +689,8 @@ func provideMetricsServiceOperator(ms metrics.MetricsService) MetricsServiceOper
 }
 
 // TODO: consider vvmIdx
-func provideIFederation(vvmCtx context.Context, cfg *VVMConfig, vvmPortSource *VVMPortSource, policyForWithRetry federation.PolicyOptsForWithRetry) (federation.IFederation, func()) {
+func provideIFederation(vvmCtx context.Context, cfg *VVMConfig, vvmPortSource *VVMPortSource, policyForWithRetry federation.PolicyOptsForWithRetry,
+	tracer tracing.ITracer) (federation.IFederation, func()) {
 	return federation.New(vvmCtx, func() *url.URL {
 		if cfg.FederationURL != nil {
 			return cfg.FederationURL
@@ -620,7 +701,7 @@ func provideIFederation(vvmCtx context.Context, cfg *VVMConfig, vvmPortSource *V
 			panic(err)
 		}
 		return resultFU
-	}, func() int { return vvmPortSource.adminGetter() }, policyForWithRetry)
+	}, func() int { return vvmPortSource.adminGetter() }, policyForWithRetry, tracer)
 }
 
 // Metrics service port could be dynamic -> need a func that will return the actual port
@@ -630,7 +711,7 @@ func provideMetricsServicePortGetter(ms metrics.MetricsService) func() metrics.M
 	}
 }
 
-func provideRouterParams(cfg *VVMConfig, port VVMPortType) router.RouterParams {
+func provideRouterParams(cfg *VVMConfig, port VVMPortType, tracer tracing.ITracer) router.RouterParams {
 	res := router.RouterParams{
 		HTTPServerParams: router.HTTPServerParams{
 			Port:             int(port),
@@ -646,6 +727,7 @@ func provideRouterParams(cfg *VVMConfig, port VVMPortType) router.RouterParams {
 		RouteDomains:         cfg.RouteDomains,
 		MaxQueriesPerWS:      cfg.RouterMaxQueriesPerWS,
 		ITime:                cfg.Time,
+		Tracer:               tracer,
 	}
 	return res
 }
@@ -659,7 +741,7 @@ func provideVVMApps(builtInApps []appparts.BuiltInApp) (vvmApps VVMApps) {
 
 func provideBuiltInAppsArtefacts(vvmConfig *VVMConfig, apis builtinapps.APIs, cfgs AppConfigsTypeEmpty,
 	appEPs map[appdef.AppQName]extensionpoints.IExtensionPoint, schemasCache ISchemasCache) (BuiltInAppsArtefacts, error) {
-	return vvmConfig.VVMAppsBuilder.BuildAppsArtefacts(apis, cfgs, appEPs, schemasCache)
+	return vvmConfig.VVMAppsBuilder.BuildAppsArtefacts(apis, cfgs, appEPs, schemasCache, vvmConfig.SecretsReader)
 }
 
 // extModuleURLs is filled here
@@ -818,10 +900,10 @@ func provideChannelGroups(cfg *VVMConfig) (res []iprocbusmem.ChannelGroup) {
 	return
 }
 
-func provideCachingAppStorageProvider(storageCacheSize StorageCacheSizeType, metrics2 imetrics.IMetrics,
+func provideCachingAppStorageProvider(storageCacheSize StorageCacheSizeType, storageCacheBudget istoragecache.Budget, metrics2 imetrics.IMetrics,
 	vvmName processors.VVMName, uncachingProvider IAppStorageUncachingProviderFactory, iTime timeu.ITime) istorage.IAppStorageProvider {
-	aspNonCaching := uncachingProvider()
-	return istoragecache.Provide(int(storageCacheSize), aspNonCaching, metrics2, string(vvmName), iTime)
+	aspNonCaching := istoragemetrics.Provide(uncachingProvider(), metrics2, string(vvmName))
+	return istoragecache.Provide(int(storageCacheSize), aspNonCaching, metrics2, string(vvmName), iTime, istoragecache.WithBudget(storageCacheBudget))
 }
 
 func provideBlobHandlerPtr() blobprocessor.IRequestHandlerPtr {
@@ -918,10 +1000,10 @@ func provideOpBLOBProcessors(numBLOBWorkers istructs.NumBLOBProcessors, blobServ
 func provideQueryProcessors_V1(qpCount istructs.NumQueryProcessors, qc QueryChannel_V1, appParts appparts.IAppPartitions, qpFactory queryprocessor.ServiceFactory, imetrics2 imetrics.IMetrics,
 	vvm processors.VVMName, mpq MaxPrepareQueriesType, authn iauthnz.IAuthenticator,
 	tokens itokens.ITokens, federation2 federation.IFederation, statelessResources istructsmem.IStatelessResources, secretReader isecrets.ISecretReader,
-	stateOpts state.StateOpts, httpClient httpu.IHTTPClient) OperatorQueryProcessors_V1 {
+	stateOpts state.StateOpts, httpClient httpu.IHTTPClient, slowLog slowlog.ISlowLog, queryCache querycache.IQueryCache) OperatorQueryProcessors_V1 {
 	forks := make([]pipeline.ForkOperatorOptionFunc, qpCount)
 	for i := 0; i < int(qpCount); i++ {
-		forks[i] = pipeline.ForkBranch(pipeline.ServiceOperator(qpFactory(iprocbus.ServiceChannel(qc), appParts, int(mpq), imetrics2, string(vvm), authn, tokens, federation2, statelessResources, secretReader, stateOpts, httpClient)))
+		forks[i] = pipeline.ForkBranch(pipeline.ServiceOperator(qpFactory(iprocbus.ServiceChannel(qc), appParts, int(mpq), imetrics2, string(vvm), authn, tokens, federation2, statelessResources, secretReader, stateOpts, httpClient, slowLog, queryCache)))
 	}
 	return pipeline.ForkOperator(pipeline.ForkSame, forks[0], forks[1:]...)
 }
@@ -929,10 +1011,10 @@ func provideQueryProcessors_V1(qpCount istructs.NumQueryProcessors, qc QueryChan
 func provideQueryProcessors_V2(qpCount istructs.NumQueryProcessors, qc QueryChannel_V2, appParts appparts.IAppPartitions, qpFactory query2.ServiceFactory, imetrics2 imetrics.IMetrics,
 	vvm processors.VVMName, mpq MaxPrepareQueriesType, authn iauthnz.IAuthenticator,
 	tokens itokens.ITokens, federation2 federation.IFederation, statelessResources istructsmem.IStatelessResources, secretReader isecrets.ISecretReader,
-	stateOpts state.StateOpts, httpClient httpu.IHTTPClient) OperatorQueryProcessors_V2 {
+	stateOpts state.StateOpts, httpClient httpu.IHTTPClient, slowLog slowlog.ISlowLog, queryCache querycache.IQueryCache) OperatorQueryProcessors_V2 {
 	forks := make([]pipeline.ForkOperatorOptionFunc, qpCount)
 	for i := 0; i < int(qpCount); i++ {
-		forks[i] = pipeline.ForkBranch(pipeline.ServiceOperator(qpFactory(iprocbus.ServiceChannel(qc), appParts, int(mpq), imetrics2, string(vvm), authn, tokens, federation2, statelessResources, secretReader, stateOpts, httpClient)))
+		forks[i] = pipeline.ForkBranch(pipeline.ServiceOperator(qpFactory(iprocbus.ServiceChannel(qc), appParts, int(mpq), imetrics2, string(vvm), authn, tokens, federation2, statelessResources, secretReader, stateOpts, httpClient, slowLog, queryCache)))
 	}
 	return pipeline.ForkOperator(pipeline.ForkSame, forks[0], forks[1:]...)
 }